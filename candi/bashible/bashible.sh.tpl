@@ -554,12 +554,14 @@ function main() {
         {{- end }}
         bb-bashible-ready-steps-failed "$step_base"
         >&2 echo "ERROR: Failed to execute step ${step_base}, retry limit reached"
+        bb-telemetry-log ERROR "Failed to execute step ${step_base}, retry limit reached" "$step_base"
 
         bb-telemetry-end-span "$span_ctx" "$step_base"
 
         return 1
       fi
       >&2 echo "Failed to execute step ${step_base}, retrying in 2 seconds"
+      bb-telemetry-log WARN "Failed to execute step ${step_base}, attempt ${attempt}" "$step_base"
       sleep 2
       echo ===
       echo === Step: $step
@@ -579,7 +579,48 @@ bb-telemetry-end-span() {
   local end_time=$(date +%s%N) curl_cmd=curl
   command -v d8-curl &>/dev/null && curl_cmd=d8-curl
   local endpoint="${BB_TELEMETRY_ENDPOINT:-${OTEL_RELAY_ADDRESS:-http://127.0.0.1:4318}/v1/traces}"
-  local payload="{\"resourceSpans\":[{\"resource\":{\"attributes\":[{\"key\":\"service.name\",\"value\":{\"stringValue\":\"bashible\"}}]},\"scopeSpans\":[{\"scope\":{\"name\":\"bashbooster\"},\"spans\":[{\"traceId\":\"${trace_id}\",\"spanId\":\"${span_id}\",\"name\":\"${2}\",\"kind\":1,\"startTimeUnixNano\":\"${start_time}\",\"endTimeUnixNano\":\"${end_time}\"}]}]}]}"
+  local payload="{\"resourceSpans\":[{\"resource\":{\"attributes\":[{\"key\":\"service.name\",\"value\":{\"stringValue\":\"bashible\"}}]},\"scopeSpans\":[{\"scope\":{\"name\":\"bashbooster\"},\"spans\":[{\"traceId\":\"${trace_id}\",\"spanId\":\"${span_id}\",\"name\":\"$(bb-telemetry-json-escape "$2")\",\"kind\":1,\"startTimeUnixNano\":\"${start_time}\",\"endTimeUnixNano\":\"${end_time}\"}]}]}]}"
+  $curl_cmd -s -S -X POST -H "Content-Type: application/json" -d "$payload" "$endpoint" 2>/dev/null || true
+}
+
+# bb-telemetry-json-escape STRING prints STRING escaped for a JSON string literal (without the quotes)
+bb-telemetry-json-escape() {
+  local s="$1" out="" c i
+  if command -v jq &>/dev/null; then
+    out=$(printf '%s' "$s" | jq -Rsj 'tojson') && { out="${out#\"}"; printf '%s' "${out%\"}"; return; }
+  fi
+  s="${s//\\/\\\\}"
+  s="${s//\"/\\\"}"
+  s="${s//$'\n'/\\n}"
+  s="${s//$'\r'/\\r}"
+  s="${s//$'\t'/\\t}"
+  if [[ "$s" != *[[:cntrl:]]* ]]; then
+    printf '%s' "$s"
+    return
+  fi
+  for (( i = 0; i < ${#s}; i++ )); do
+    c="${s:i:1}"
+    case "$c" in
+      [[:cntrl:]]) printf -v c '\\u%04x' "'$c" ;;
+    esac
+    out+="$c"
+  done
+  printf '%s' "$out"
+}
+
+# bb-telemetry-log SEVERITY MESSAGE STEP sends a log record to the dhctl relay (SEVERITY is INFO, WARN or ERROR)
+bb-telemetry-log() {
+  [[ "${DHCTL_TELEMETRY_ENABLED}" != "true" ]] && return
+  local severity_text="$1" message step severity_number=9 curl_cmd=curl
+  message=$(bb-telemetry-json-escape "$2")
+  step=$(bb-telemetry-json-escape "$3")
+  case "$severity_text" in
+    WARN) severity_number=13 ;;
+    ERROR) severity_number=17 ;;
+  esac
+  command -v d8-curl &>/dev/null && curl_cmd=d8-curl
+  local endpoint="${OTEL_RELAY_ADDRESS:-http://127.0.0.1:4318}/v1/logs"
+  local payload="{\"resourceLogs\":[{\"resource\":{\"attributes\":[{\"key\":\"service.name\",\"value\":{\"stringValue\":\"bashible\"}}]},\"scopeLogs\":[{\"scope\":{\"name\":\"bashbooster\"},\"logRecords\":[{\"timeUnixNano\":\"$(date +%s%N)\",\"severityNumber\":${severity_number},\"severityText\":\"${severity_text}\",\"body\":{\"stringValue\":\"${message}\"},\"attributes\":[{\"key\":\"step\",\"value\":{\"stringValue\":\"${step}\"}}]}]}]}]}"
   $curl_cmd -s -S -X POST -H "Content-Type: application/json" -d "$payload" "$endpoint" 2>/dev/null || true
 }
 {{- end }}
@@ -1,3 +1,9 @@
+{{- $kmsPlugin := false }}
+{{- range .apiserver.secretEncryptionKeys }}
+  {{- if .endpoint }}
+    {{- $kmsPlugin = true }}
+  {{- end }}
+{{- end }}
 {{- $baseFeatureGates := list "RotateKubeletServerCertificate=true" "CRDSensitiveData=true" -}}
 {{- if semverCompare ">=1.31 <1.36" .clusterConfiguration.kubernetesVersion }}
   {{- $baseFeatureGates = append $baseFeatureGates "TopologyAwareHints=true" -}}
@@ -193,7 +199,7 @@ spec:
 {{- if .apiserver.auditWebhookURL }}
     - --audit-webhook-config-file=/etc/kubernetes/deckhouse/extra-files/audit-webhook-config.yaml
 {{- end }}
-{{- if or (.apiserver.secretEncryptionKey) (.apiserver.signature) }}
+{{- if or (.apiserver.secretEncryptionKeys) (.apiserver.secretEncryptionKey) (.apiserver.signature) }}
     - --encryption-provider-config=/etc/kubernetes/deckhouse/extra-files/secret-encryption-config.yaml
     - --encryption-provider-config-automatic-reload=true
 {{- end }}
@@ -313,6 +319,10 @@ spec:
       name: kube-audit-log
       readOnly: false
   {{- end }}
+{{- end }}
+{{- if $kmsPlugin }}
+    - mountPath: /var/run/kmsplugin
+      name: kms-plugin
 {{- end }}
   dnsPolicy: ClusterFirstWithHostNet
   hostNetwork: true
@@ -354,3 +364,9 @@ spec:
     name: kube-audit-log
   {{- end }}
 {{- end }}
+{{- if $kmsPlugin }}
+  - hostPath:
+      path: /var/run/kmsplugin
+      type: DirectoryOrCreate
+    name: kms-plugin
+{{- end }}
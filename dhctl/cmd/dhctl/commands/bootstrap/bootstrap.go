@@ -43,12 +43,17 @@ func DefineBootstrapCommand(cmd *kingpin.CmdClause, opts *options.Options) *king
 	app.DefineDeckhouseFlags(cmd, &opts.Bootstrap)
 	app.DefineDontUsePublicImagesFlags(cmd, &opts.Bootstrap)
 	app.DefinePostBootstrapScriptFlags(cmd, &opts.Bootstrap)
+	app.DefineResumeFlags(cmd, &opts.Bootstrap)
 	app.DefinePreflight(cmd, &opts.Preflight)
 	app.DefineImgBundleFlags(cmd, &opts.Registry)
 
 	return cmd.Action(func(c *kingpin.ParseContext) error {
 		ctx := kpcontext.ExtractContext(c)
 
+		if opts.Bootstrap.Resume && opts.Cache.DropCache {
+			return fmt.Errorf("--resume cannot be used together with --yes-i-want-to-drop-cache")
+		}
+
 		span := telemetry.SpanFromContext(ctx)
 		span.SetAttributes(opts.ToSpanAttributes()...)
 
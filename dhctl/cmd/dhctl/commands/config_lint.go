@@ -0,0 +1,116 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"gopkg.in/alecthomas/kingpin.v2"
+
+	"github.com/deckhouse/deckhouse/dhctl/pkg/app"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/app/options"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/config"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/kpcontext"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/telemetry"
+)
+
+func DefineConfigLintCommand(cmd *kingpin.CmdClause, opts *options.Options) *kingpin.CmdClause {
+	app.DefineConfigFlags(cmd, &opts.Global)
+	app.DefineConfigLintFlags(cmd, &opts.ConfigLint)
+
+	return cmd.Action(func(c *kingpin.ParseContext) error {
+		ctx := kpcontext.ExtractContext(c)
+
+		span := telemetry.SpanFromContext(ctx)
+		span.SetAttributes(opts.ToSpanAttributes()...)
+
+		files := make([]config.LintFile, 0, len(opts.Global.ConfigPaths))
+		for _, path := range opts.Global.ConfigPaths {
+			content, err := os.ReadFile(path)
+			if err != nil {
+				return fmt.Errorf("Failed to read config %s: %w", path, err)
+			}
+			files = append(files, config.LintFile{Path: path, Content: content})
+		}
+
+		diagnostics := config.Lint(ctx, config.NewSchemaStore(&opts.Global), files)
+
+		output, err := formatLintDiagnostics(diagnostics, opts.ConfigLint.OutputFormat, opts.BuildInfo.AppVersion)
+		if err != nil {
+			return fmt.Errorf("Failed to format lint diagnostics: %w", err)
+		}
+
+		if opts.ConfigLint.OutputPath != "" {
+			if err := os.WriteFile(opts.ConfigLint.OutputPath, output, 0o644); err != nil {
+				return fmt.Errorf("Failed to write lint diagnostics: %w", err)
+			}
+		} else {
+			fmt.Print(string(output))
+		}
+
+		errorsCount, warningsCount := countLintDiagnostics(diagnostics)
+		switch {
+		case errorsCount > 0:
+			return fmt.Errorf("config lint found %d errors and %d warnings", errorsCount, warningsCount)
+		case warningsCount > 0 && opts.ConfigLint.FailOnWarnings:
+			return fmt.Errorf("config lint found %d warnings", warningsCount)
+		}
+
+		return nil
+	})
+}
+
+func formatLintDiagnostics(diagnostics []config.LintDiagnostic, format, version string) ([]byte, error) {
+	switch format {
+	case "sarif":
+		data, err := config.LintSARIF(diagnostics, version)
+		return append(data, '\n'), err
+	case "json":
+		if diagnostics == nil {
+			diagnostics = []config.LintDiagnostic{}
+		}
+		data, err := json.MarshalIndent(diagnostics, "", "  ")
+		return append(data, '\n'), err
+	}
+
+	b := strings.Builder{}
+	for _, d := range diagnostics {
+		b.WriteString(d.String())
+		b.WriteString("\n")
+	}
+	errorsCount, warningsCount := countLintDiagnostics(diagnostics)
+	if errorsCount+warningsCount == 0 {
+		b.WriteString("No problems found\n")
+	} else {
+		fmt.Fprintf(&b, "%d errors, %d warnings\n", errorsCount, warningsCount)
+	}
+
+	return []byte(b.String()), nil
+}
+
+func countLintDiagnostics(diagnostics []config.LintDiagnostic) (int, int) {
+	var errorsCount, warningsCount int
+	for _, d := range diagnostics {
+		if d.Severity == config.LintSeverityError {
+			errorsCount++
+		} else {
+			warningsCount++
+		}
+	}
+	return errorsCount, warningsCount
+}
@@ -0,0 +1,117 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"fmt"
+
+	"gopkg.in/alecthomas/kingpin.v2"
+
+	dhlog "github.com/deckhouse/lib-dhctl/pkg/logger"
+
+	"github.com/deckhouse/deckhouse/dhctl/pkg/app"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/app/options"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/kpcontext"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/kubernetes/client"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/operations"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/system/providerinitializer"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/telemetry"
+)
+
+func DefineMigrateCommands(parent *kingpin.CmdClause, opts *options.Options) {
+	DefineMigrateConfigFilesCommand(parent, opts)
+
+	for _, cmd := range []*kingpin.CmdClause{
+		baseMigrateConfigCMD(parent, opts, "cluster-configuration", "d8-cluster-configuration", "cluster-configuration.yaml"),
+		baseMigrateConfigCMD(parent, opts, "provider-cluster-configuration", "d8-provider-cluster-configuration", "cloud-provider-cluster-configuration.yaml"),
+		baseMigrateConfigCMD(parent, opts, "static-cluster-configuration", "d8-static-cluster-configuration", "static-cluster-configuration.yaml"),
+	} {
+		connectionFlags(cmd, opts)
+	}
+}
+
+func DefineMigrateConfigFilesCommand(parent *kingpin.CmdClause, opts *options.Options) *kingpin.CmdClause {
+	cmd := parent.Command("files", "Migrate configuration files passed with --config in place.")
+	app.DefineConfigFlags(cmd, &opts.Global)
+	app.DefineConfigMigrateFlags(cmd, &opts.ConfigMigrate)
+
+	return cmd.Action(func(c *kingpin.ParseContext) error {
+		ctx := kpcontext.ExtractContext(c)
+
+		span := telemetry.SpanFromContext(ctx)
+		span.SetAttributes(opts.ToSpanAttributes()...)
+
+		return operations.MigrateConfigFiles(ctx, opts.Global.ConfigPaths, &opts.Global, operations.MigrateOptions{
+			DryRun:           opts.ConfigMigrate.DryRun,
+			SkipConfirmation: opts.ConfigMigrate.SkipConfirmation,
+		})
+	})
+}
+
+func baseMigrateConfigCMD(parent *kingpin.CmdClause, opts *options.Options, name, secret, dataKey string) *kingpin.CmdClause {
+	cmd := parent.Command(name, fmt.Sprintf("Migrate %s in the Kubernetes cluster.", name))
+	app.DefineConfigMigrateFlags(cmd, &opts.ConfigMigrate)
+	app.DefineSanityFlags(cmd, &opts.Global)
+
+	return cmd.Action(func(c *kingpin.ParseContext) error {
+		ctx := kpcontext.ExtractContext(c)
+
+		span := telemetry.SpanFromContext(ctx)
+		span.SetAttributes(opts.ToSpanAttributes()...)
+
+		params := app.ProviderParams(&opts.Global, dhlog.FromContext(ctx))
+		sshProviderInitializer, kubeProvider, err := providerinitializer.GetProviders(
+			ctx,
+			params,
+			providerinitializer.WithKubeFlagsDefined(opts.Kube.IsDefined()),
+			providerinitializer.WithKubeConfig(opts.Kube.Config, opts.Kube.ConfigContext, opts.Kube.InCluster),
+			providerinitializer.WithRequiredKubeProvider(),
+		)
+		if err != nil {
+			return err
+		}
+
+		defer providerinitializer.CleanupSSHProvider(ctx, sshProviderInitializer)
+
+		if kubeProvider == nil {
+			return fmt.Errorf("kubernetes provider is not initialized")
+		}
+
+		kube, err := kubeProvider.Client(ctx)
+		if err != nil {
+			return err
+		}
+
+		kubeCl := &client.KubernetesClient{KubeClient: kube}
+
+		return operations.SecretEdit(
+			ctx,
+			kubeCl,
+			name, "kube-system", secret, dataKey, map[string]string{
+				"name": name,
+			},
+			&opts.Global,
+			operations.EditOptions{
+				TmpDir:      opts.Global.TmpDir,
+				SanityCheck: opts.Global.SanityCheck,
+				OnAbsent:    operations.RejectMigrationOfAbsentSecret,
+				Transform: operations.MigrationTransform(name, &opts.Global, operations.MigrateOptions{
+					DryRun:           opts.ConfigMigrate.DryRun,
+					SkipConfirmation: opts.ConfigMigrate.SkipConfirmation,
+				}),
+			},
+		)
+	})
+}
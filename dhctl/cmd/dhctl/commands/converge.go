@@ -26,9 +26,12 @@ import (
 	"github.com/deckhouse/deckhouse/dhctl/pkg/app/options"
 	"github.com/deckhouse/deckhouse/dhctl/pkg/config"
 	"github.com/deckhouse/deckhouse/dhctl/pkg/infrastructure"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/infrastructure/plan"
 	"github.com/deckhouse/deckhouse/dhctl/pkg/infrastructureprovider"
 	"github.com/deckhouse/deckhouse/dhctl/pkg/infrastructureprovider/cloud"
 	"github.com/deckhouse/deckhouse/dhctl/pkg/kpcontext"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/kubernetes/client"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/operations/check"
 	"github.com/deckhouse/deckhouse/dhctl/pkg/operations/converge"
 	statecache "github.com/deckhouse/deckhouse/dhctl/pkg/state/cache"
 	"github.com/deckhouse/deckhouse/dhctl/pkg/system/providerinitializer"
@@ -68,6 +71,25 @@ func DefineConvergeCommand(cmd *kingpin.CmdClause, opts *options.Options) *kingp
 			GlobalOptions:    &opts.Global,
 		})
 
+		autoApprove := opts.Converge.DestructiveApproved
+
+		var savedPlan *check.ConvergePlan
+		var infrastructureContext *infrastructure.Context
+		if opts.Converge.PlanFile != "" {
+			savedPlan, err = check.LoadConvergePlan(opts.Converge.PlanFile)
+			if err != nil {
+				return err
+			}
+
+			infrastructureContext = infrastructure.NewContextWithProvider(providerGetter).
+				WithUseTfCache(opts.Cache.UseTfCache).
+				WithDebug(opts.Global.IsDebug).
+				WithSavedPlans(savedPlan.SavedPlans)
+
+			// the saved plan may have been reviewed already, but it is applied without the prompt only on request
+			autoApprove = autoApprove || opts.Converge.SkipConfirmation
+		}
+
 		converger := converge.NewConverger(&converge.Params{
 			SSHProviderInitializer: sshProviderInitializer,
 			KubeProvider:           kubeProvider,
@@ -77,15 +99,17 @@ func DefineConvergeCommand(cmd *kingpin.CmdClause, opts *options.Options) *kingp
 					AutoDismissChanges:     false,
 					AutoDismissDestructive: false,
 					AutoApproveSettings: infrastructure.AutoApproveSettings{
-						AutoApprove: opts.Converge.DestructiveApproved,
+						AutoApprove: autoApprove,
 					},
 				},
 			},
-			ProviderGetter:     providerGetter,
-			TmpDir:             opts.Global.TmpDir,
-			IsDebug:            opts.Global.IsDebug,
-			Options:            opts,
-			NoSwitchToNodeUser: app.ForceNoSwitchToNodeUser(),
+			SavedPlan:             savedPlan,
+			InfrastructureContext: infrastructureContext,
+			ProviderGetter:        providerGetter,
+			TmpDir:                opts.Global.TmpDir,
+			IsDebug:               opts.Global.IsDebug,
+			Options:               opts,
+			NoSwitchToNodeUser:    app.ForceNoSwitchToNodeUser(),
 		})
 
 		cacheIdentity := ""
@@ -130,6 +154,84 @@ func DefineConvergeCommand(cmd *kingpin.CmdClause, opts *options.Options) *kingp
 	})
 }
 
+func DefineConvergePlanCommand(cmd *kingpin.CmdClause, opts *options.Options) *kingpin.CmdClause {
+	app.DefineKubeFlags(cmd, &opts.Kube)
+	app.DefineOutputFlag(cmd, &opts.Converge)
+	app.DefineConvergePlanConfigFlags(cmd, &opts.Global)
+	app.DefineConvergeSavePlanFlags(cmd, &opts.Converge)
+	app.DefineSSHFlags(cmd, &opts.SSH, nil)
+	app.DefineBecomeFlags(cmd, &opts.Become)
+
+	return cmd.Action(func(c *kingpin.ParseContext) error {
+		ctx := kpcontext.ExtractContext(c)
+
+		span := telemetry.SpanFromContext(ctx)
+		span.SetAttributes(opts.ToSpanAttributes()...)
+
+		if opts.Converge.PlanFile != "" && len(opts.Global.ConfigPaths) > 0 {
+			return fmt.Errorf("--plan-file can be used with in-cluster configuration only, converge applies in-cluster configuration")
+		}
+
+		params, err := app.DefaultProviderParams(ctx, &opts.Global)
+		if err != nil {
+			return err
+		}
+		sshProviderInitializer, kubeProvider, err := providerinitializer.GetProviders(
+			ctx,
+			params,
+			providerinitializer.WithKubeFlagsDefined(opts.Kube.IsDefined()),
+			providerinitializer.WithKubeConfig(opts.Kube.Config, opts.Kube.ConfigContext, opts.Kube.InCluster),
+			providerinitializer.WithRequiredKubeProvider(),
+		)
+		if err != nil {
+			return err
+		}
+
+		defer providerinitializer.CleanupSSHProvider(ctx, sshProviderInitializer)
+
+		if kubeProvider == nil {
+			return fmt.Errorf("kubernetes provider is not initialized")
+		}
+
+		kube, err := kubeProvider.Client(ctx)
+		if err != nil {
+			return err
+		}
+
+		convergePlan, err := converge.BuildPlan(ctx, converge.PlanParams{
+			KubeCl: &client.KubernetesClient{KubeClient: kube},
+			ProviderGetter: infrastructureprovider.CloudProviderGetter(infrastructureprovider.CloudProviderGetterParams{
+				TmpDir:           opts.Global.TmpDir,
+				AdditionalParams: cloud.ProviderAdditionalParams{},
+				IsDebug:          opts.Global.IsDebug,
+				GlobalOptions:    &opts.Global,
+			}),
+			ConfigPaths: opts.Global.ConfigPaths,
+			Options:     opts,
+		})
+		if err != nil {
+			return err
+		}
+
+		data, err := convergePlan.Format(opts.Converge.OutputFormat)
+		if err != nil {
+			return fmt.Errorf("Failed to format converge plan: %w", err)
+		}
+
+		fmt.Println(string(data))
+
+		if opts.Converge.PlanFile != "" {
+			if err := convergePlan.Save(opts.Converge.PlanFile); err != nil {
+				return err
+			}
+
+			dhlog.FromContext(ctx).InfoContext(ctx, fmt.Sprintf("Converge plan %s saved to %s", convergePlan.ID, opts.Converge.PlanFile))
+		}
+
+		return nil
+	})
+}
+
 func DefineAutoConvergeCommand(cmd *kingpin.CmdClause, opts *options.Options) *kingpin.CmdClause {
 	app.DefineAutoConvergeFlags(cmd, &opts.AutoConverge)
 	app.DefineSSHFlags(cmd, &opts.SSH, config.NewConnectionConfigParser(opts))
@@ -164,6 +266,14 @@ func DefineAutoConvergeCommand(cmd *kingpin.CmdClause, opts *options.Options) *k
 			GlobalOptions:    &opts.Global,
 		})
 
+		var approvalPolicy *plan.ApprovalPolicy
+		if opts.AutoConverge.ApprovalPolicyPath != "" {
+			approvalPolicy, err = plan.LoadApprovalPolicy(opts.AutoConverge.ApprovalPolicyPath)
+			if err != nil {
+				return err
+			}
+		}
+
 		converger := converge.NewConverger(&converge.Params{
 			SSHProviderInitializer: sshProviderInitializer,
 			KubeProvider:           kubeProvider,
@@ -175,6 +285,7 @@ func DefineAutoConvergeCommand(cmd *kingpin.CmdClause, opts *options.Options) *k
 					AutoApproveSettings: infrastructure.AutoApproveSettings{
 						AutoApprove: true,
 					},
+					ApprovalPolicy: approvalPolicy,
 				},
 			},
 			ProviderGetter: providerGetter,
@@ -15,6 +15,7 @@
 package commands
 
 import (
+	"encoding/json"
 	"fmt"
 	"time"
 
@@ -25,6 +26,7 @@ import (
 	"github.com/deckhouse/deckhouse/dhctl/pkg/app"
 	"github.com/deckhouse/deckhouse/dhctl/pkg/app/options"
 	"github.com/deckhouse/deckhouse/dhctl/pkg/config"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/infrastructure/controller"
 	"github.com/deckhouse/deckhouse/dhctl/pkg/kpcontext"
 	"github.com/deckhouse/deckhouse/dhctl/pkg/operations/destroy"
 	"github.com/deckhouse/deckhouse/dhctl/pkg/operations/phases"
@@ -53,6 +55,7 @@ func DefineDestroyCommand(cmd *kingpin.CmdClause, opts *options.Options) *kingpi
 	app.DefineCacheFlags(cmd, &opts.Cache)
 	app.DefineSanityFlags(cmd, &opts.Global)
 	app.DefineDestroyResourcesFlags(cmd, &opts.Destroy)
+	app.DefineDestroyScopeFlags(cmd, &opts.Destroy)
 	app.DefineTFResourceManagementTimeout(cmd, &opts.Cache)
 
 	return cmd.Action(func(c *kingpin.ParseContext) error {
@@ -90,11 +93,41 @@ func DefineDestroyCommand(cmd *kingpin.CmdClause, opts *options.Options) *kingpi
 			KubeProvider:  kubeProvider,
 			StateCache:    cache.Global(),
 			SkipResources: opts.Destroy.SkipResources,
-			Logger:        logger.FromContext(ctx),
-			IsDebug:       opts.Global.IsDebug,
-			TmpDir:        opts.Global.TmpDir,
-			Options:       opts,
+			Scope: controller.DestroyScope{
+				NodeGroup:                opts.Destroy.NodeGroup,
+				KeepBaseInfrastructure:   opts.Destroy.KeepBaseInfrastructure,
+				AllowConfiguredNodeGroup: opts.Destroy.AllowConfiguredNodeGroup,
+			},
+			Logger:  logger.FromContext(ctx),
+			IsDebug: opts.Global.IsDebug,
+			TmpDir:  opts.Global.TmpDir,
+			Options: opts,
 		}
+
+		if opts.Destroy.DryRun {
+			destroyer, err := destroy.NewClusterDestroyer(ctx, destroyerParams)
+			if err != nil {
+				return err
+			}
+
+			inv, err := destroyer.Inventory(ctx)
+			if err != nil {
+				return fmt.Errorf("Failed to get destroy inventory: %w", err)
+			}
+
+			if opts.Destroy.OutputFormat == "json" {
+				data, err := json.MarshalIndent(inv, "", "  ")
+				if err != nil {
+					return err
+				}
+				fmt.Println(string(data))
+				return nil
+			}
+
+			fmt.Print(inv.String())
+			return nil
+		}
+
 		interactive := input.IsTerminal() && !opts.Global.ShowProgress
 		if interactive {
 			progressCh, finishProgress := phases.InitProgress(ctx, logger.FromContext(ctx), "Destroy cluster")
@@ -113,7 +146,12 @@ func DefineDestroyCommand(cmd *kingpin.CmdClause, opts *options.Options) *kingpi
 		if !opts.Global.SanityCheck {
 			l.Warn(fmt.Sprint(destroyApprovalsMessage))
 
-			if !input.NewConfirmation().WithYesByDefault().WithMessage("Do you really want to DELETE all cluster resources?").Ask() {
+			message := "Do you really want to DELETE all cluster resources?"
+			if opts.Destroy.NodeGroup != "" {
+				message = fmt.Sprintf("Do you really want to DELETE all nodes of node group %s?", opts.Destroy.NodeGroup)
+			}
+
+			if !input.NewConfirmation().WithYesByDefault().WithMessage(message).Ask() {
 				return fmt.Errorf("Cluster resource cleanup was not approved")
 			}
 		}
@@ -0,0 +1,125 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"gopkg.in/alecthomas/kingpin.v2"
+
+	dhlog "github.com/deckhouse/lib-dhctl/pkg/logger"
+
+	"github.com/deckhouse/deckhouse/dhctl/pkg/app"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/app/options"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/config"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/infrastructure"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/infrastructureprovider"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/infrastructureprovider/cloud"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/kpcontext"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/kubernetes/actions/entity"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/kubernetes/client"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/operations/infrastructureimport"
+	statecache "github.com/deckhouse/deckhouse/dhctl/pkg/state/cache"
+	infrastructurestate "github.com/deckhouse/deckhouse/dhctl/pkg/state/infrastructure"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/telemetry"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/util/cache"
+)
+
+func DefineInfrastructureImportCommand(cmd *kingpin.CmdClause, opts *options.Options) *kingpin.CmdClause {
+	defineInfrastructureStateKubeFlags(cmd, opts)
+	app.DefineConvergePlanConfigFlags(cmd, &opts.Global)
+	app.DefineInfrastructureImportFlags(cmd, &opts.InfrastructureImport)
+	app.DefineCacheFlags(cmd, &opts.Cache)
+
+	return cmd.Action(func(c *kingpin.ParseContext) error {
+		ctx := kpcontext.ExtractContext(c)
+
+		span := telemetry.SpanFromContext(ctx)
+		span.SetAttributes(opts.ToSpanAttributes()...)
+
+		mapping, err := infrastructureimport.LoadMapping(opts.InfrastructureImport.ResourcesPath)
+		if err != nil {
+			return err
+		}
+
+		return withInfrastructureStateKubeClient(ctx, opts, func(kubeCl *client.KubernetesClient) error {
+			metaConfig, err := infrastructureImportMetaConfig(ctx, kubeCl, opts)
+			if err != nil {
+				return err
+			}
+
+			err = statecache.InitWithOptions(ctx, fmt.Sprintf("infrastructure-import-%s", metaConfig.UUID), statecache.CacheOptions{Cache: opts.Cache})
+			if err != nil {
+				return fmt.Errorf("unable to initialize cache: %w", err)
+			}
+
+			providerGetter := infrastructureprovider.CloudProviderGetter(infrastructureprovider.CloudProviderGetterParams{
+				TmpDir:           opts.Global.TmpDir,
+				AdditionalParams: cloud.ProviderAdditionalParams{},
+				IsDebug:          opts.Global.IsDebug,
+				GlobalOptions:    &opts.Global,
+			})
+
+			provider, err := providerGetter(ctx, metaConfig)
+			if err != nil {
+				return err
+			}
+
+			defer func() {
+				if err := provider.Cleanup(); err != nil {
+					dhlog.FromContext(ctx).ErrorContext(ctx, fmt.Sprintf("Error cleaning up provider: %v", err))
+				}
+			}()
+
+			err = infrastructureimport.Import(ctx, infrastructureimport.Params{
+				KubeCl:     kubeCl,
+				MetaConfig: metaConfig,
+				Mapping:    mapping,
+				InfrastructureContext: infrastructure.NewContextWithProvider(providerGetter).
+					WithUseTfCache(opts.Cache.UseTfCache).
+					WithDebug(opts.Global.IsDebug),
+				StateCache:    statecache.Global(),
+				GlobalOptions: &opts.Global,
+				AllowChanges:  opts.InfrastructureImport.AllowChanges,
+			})
+			if err != nil {
+				cache.GetGlobalTmpCleaner().DisableCleanup(fmt.Sprintf("Infrastructure import failed with error: %v", err))
+				return err
+			}
+
+			return nil
+		})
+	})
+}
+
+// infrastructureImportMetaConfig returns the configuration passed with --config or the in-cluster one.
+func infrastructureImportMetaConfig(ctx context.Context, kubeCl *client.KubernetesClient, opts *options.Options) (*config.MetaConfig, error) {
+	if len(opts.Global.ConfigPaths) == 0 {
+		return entity.GetMetaConfig(ctx, kubeCl, &opts.Global, infrastructureprovider.DhctlOperationConverge)
+	}
+
+	metaConfig, err := config.ParseConfig(ctx, opts.Global.ConfigPaths, infrastructureprovider.MetaConfigValidatorProvider(), &opts.Global)
+	if err != nil {
+		return nil, err
+	}
+
+	metaConfig.UUID, err = infrastructurestate.GetClusterUUID(ctx, kubeCl)
+	if err != nil {
+		return nil, err
+	}
+
+	return metaConfig, nil
+}
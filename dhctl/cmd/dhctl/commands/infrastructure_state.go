@@ -0,0 +1,239 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"gopkg.in/alecthomas/kingpin.v2"
+	"sigs.k8s.io/yaml"
+
+	"github.com/deckhouse/deckhouse/dhctl/pkg/app"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/app/options"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/config"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/infrastructure"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/infrastructureprovider"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/infrastructureprovider/cloud"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/kpcontext"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/kubernetes/client"
+	infrastructurestateops "github.com/deckhouse/deckhouse/dhctl/pkg/operations/infrastructurestate"
+	infrastructurestate "github.com/deckhouse/deckhouse/dhctl/pkg/state/infrastructure"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/system/providerinitializer"
+)
+
+func DefineInfrastructureStateExportCommand(cmd *kingpin.CmdClause, opts *options.Options) *kingpin.CmdClause {
+	defineInfrastructureStateKubeFlags(cmd, opts)
+	app.DefineInfrastructureStateExportFlags(cmd, &opts.InfrastructureState)
+
+	return cmd.Action(func(c *kingpin.ParseContext) error {
+		ctx := kpcontext.ExtractContext(c)
+
+		key, err := readInfrastructureStateSigningKey(opts.InfrastructureState.SigningKeyPath)
+		if err != nil {
+			return err
+		}
+
+		return withInfrastructureStateKubeClient(ctx, opts, func(kubeCl *client.KubernetesClient) error {
+			return infrastructurestateops.Export(ctx, infrastructurestateops.ExportParams{
+				KubeCl:       kubeCl,
+				ArchivePath:  opts.InfrastructureState.ArchivePath,
+				SigningKey:   key,
+				HistoryLimit: opts.InfrastructureState.HistoryLimit,
+			})
+		})
+	})
+}
+
+func DefineInfrastructureStateImportCommand(cmd *kingpin.CmdClause, opts *options.Options) *kingpin.CmdClause {
+	defineInfrastructureStateKubeFlags(cmd, opts)
+	app.DefineInfrastructureStateImportFlags(cmd, &opts.InfrastructureState)
+	app.DefineSanityFlags(cmd, &opts.Global)
+
+	return cmd.Action(func(c *kingpin.ParseContext) error {
+		ctx := kpcontext.ExtractContext(c)
+
+		var key []byte
+		if opts.InfrastructureState.ArchivePath != "" {
+			var err error
+			key, err = readInfrastructureStateSigningKey(opts.InfrastructureState.SigningKeyPath)
+			if err != nil {
+				return err
+			}
+		}
+
+		return withInfrastructureStateKubeClient(ctx, opts, func(kubeCl *client.KubernetesClient) error {
+			params := infrastructurestateops.ImportParams{
+				KubeCl:           kubeCl,
+				ArchivePath:      opts.InfrastructureState.ArchivePath,
+				SigningKey:       key,
+				Revision:         opts.InfrastructureState.Revision,
+				HistoryLimit:     opts.InfrastructureState.HistoryLimit,
+				SkipConfirmation: opts.Global.SanityCheck,
+			}
+
+			if !opts.InfrastructureState.SkipSchemaValidation {
+				provider, err := infrastructureStateCloudProvider(ctx, kubeCl, opts)
+				if err != nil {
+					return err
+				}
+
+				if provider != nil {
+					defer provider.Cleanup()
+					params.CloudProvider = provider
+				}
+			}
+
+			return infrastructurestateops.Import(ctx, params)
+		})
+	})
+}
+
+func DefineInfrastructureStateListCommand(cmd *kingpin.CmdClause, opts *options.Options) *kingpin.CmdClause {
+	defineInfrastructureStateKubeFlags(cmd, opts)
+	app.DefineInfrastructureStateListFlags(cmd, &opts.InfrastructureState)
+
+	return cmd.Action(func(c *kingpin.ParseContext) error {
+		ctx := kpcontext.ExtractContext(c)
+
+		return withInfrastructureStateKubeClient(ctx, opts, func(kubeCl *client.KubernetesClient) error {
+			result, err := infrastructurestateops.List(ctx, kubeCl)
+			if err != nil {
+				return err
+			}
+
+			var data []byte
+			switch opts.InfrastructureState.OutputFormat {
+			case "json":
+				data, err = json.MarshalIndent(result, "", "  ")
+				data = append(data, '\n')
+			default:
+				data, err = yaml.Marshal(result)
+			}
+			if err != nil {
+				return fmt.Errorf("Failed to format infrastructure states: %w", err)
+			}
+
+			fmt.Print(string(data))
+
+			return nil
+		})
+	})
+}
+
+func DefineInfrastructureStateShowCommand(cmd *kingpin.CmdClause, opts *options.Options) *kingpin.CmdClause {
+	defineInfrastructureStateKubeFlags(cmd, opts)
+	app.DefineInfrastructureStateShowFlags(cmd, &opts.InfrastructureState)
+
+	return cmd.Action(func(c *kingpin.ParseContext) error {
+		ctx := kpcontext.ExtractContext(c)
+
+		return withInfrastructureStateKubeClient(ctx, opts, func(kubeCl *client.KubernetesClient) error {
+			st, err := infrastructurestateops.Show(ctx, kubeCl, opts.InfrastructureState.StateName, opts.InfrastructureState.Revision)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(st))
+
+			return nil
+		})
+	})
+}
+
+func defineInfrastructureStateKubeFlags(cmd *kingpin.CmdClause, opts *options.Options) {
+	app.DefineKubeFlags(cmd, &opts.Kube)
+	app.DefineSSHFlags(cmd, &opts.SSH, nil)
+	app.DefineBecomeFlags(cmd, &opts.Become)
+}
+
+func withInfrastructureStateKubeClient(ctx context.Context, opts *options.Options, action func(kubeCl *client.KubernetesClient) error) error {
+	params, err := app.DefaultProviderParams(ctx, &opts.Global)
+	if err != nil {
+		return err
+	}
+	sshProviderInitializer, kubeProvider, err := providerinitializer.GetProviders(
+		ctx,
+		params,
+		providerinitializer.WithKubeFlagsDefined(opts.Kube.IsDefined()),
+		providerinitializer.WithKubeConfig(opts.Kube.Config, opts.Kube.ConfigContext, opts.Kube.InCluster),
+		providerinitializer.WithRequiredKubeProvider(),
+	)
+	if err != nil {
+		return err
+	}
+
+	defer providerinitializer.CleanupSSHProvider(ctx, sshProviderInitializer)
+
+	if kubeProvider == nil {
+		return fmt.Errorf("kubernetes provider is not initialized")
+	}
+
+	kube, err := kubeProvider.Client(ctx)
+	if err != nil {
+		return err
+	}
+
+	return action(&client.KubernetesClient{KubeClient: kube})
+}
+
+// infrastructureStateCloudProvider returns nil for static clusters, they have no infrastructure to validate against.
+func infrastructureStateCloudProvider(ctx context.Context, kubeCl *client.KubernetesClient, opts *options.Options) (infrastructure.CloudProvider, error) {
+	metaConfig, err := config.ParseConfigInCluster(
+		ctx,
+		kubeCl,
+		infrastructureprovider.MetaConfigValidatorProvider(),
+		&opts.Global,
+		infrastructureprovider.DhctlOperationConverge,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if metaConfig.ClusterType != config.CloudClusterType {
+		return nil, nil
+	}
+
+	metaConfig.UUID, err = infrastructurestate.GetClusterUUID(ctx, kubeCl)
+	if err != nil {
+		return nil, err
+	}
+
+	providerGetter := infrastructureprovider.CloudProviderGetter(infrastructureprovider.CloudProviderGetterParams{
+		TmpDir:           opts.Global.TmpDir,
+		AdditionalParams: cloud.ProviderAdditionalParams{},
+		IsDebug:          opts.Global.IsDebug,
+		GlobalOptions:    &opts.Global,
+	})
+
+	return providerGetter(ctx, metaConfig)
+}
+
+func readInfrastructureStateSigningKey(path string) ([]byte, error) {
+	key, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read infrastructure state signing key: %w", err)
+	}
+
+	key = bytes.TrimSpace(key)
+	if len(key) == 0 {
+		return nil, fmt.Errorf("infrastructure state signing key %s is empty", path)
+	}
+
+	return key, nil
+}
@@ -0,0 +1,76 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"errors"
+	"fmt"
+
+	"gopkg.in/alecthomas/kingpin.v2"
+
+	dhlog "github.com/deckhouse/lib-dhctl/pkg/logger"
+
+	"github.com/deckhouse/deckhouse/dhctl/pkg/app"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/app/options"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/config"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/kpcontext"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/operations/preflight"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/system/providerinitializer"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/telemetry"
+)
+
+func DefinePreflightRunCommand(cmd *kingpin.CmdClause, opts *options.Options) *kingpin.CmdClause {
+	app.DefineSSHFlags(cmd, &opts.SSH, config.NewConnectionConfigParser(opts))
+	app.DefineConfigFlags(cmd, &opts.Global)
+	app.DefineBecomeFlags(cmd, &opts.Become)
+	app.DefinePreflight(cmd, &opts.Preflight)
+	app.DefinePreflightRunFlags(cmd, &opts.Preflight)
+
+	return cmd.Action(func(c *kingpin.ParseContext) error {
+		ctx := kpcontext.ExtractContext(c)
+
+		span := telemetry.SpanFromContext(ctx)
+		span.SetAttributes(opts.ToSpanAttributes()...)
+
+		params := app.ProviderParams(&opts.Global, dhlog.FromContext(ctx))
+
+		sshProviderInitializer, _, err := providerinitializer.GetProviders(ctx, params)
+		if err != nil {
+			if !errors.Is(err, providerinitializer.ErrHostsFromCacheNotFound) {
+				return err
+			}
+		}
+
+		defer providerinitializer.CleanupSSHProvider(ctx, sshProviderInitializer)
+
+		report, err := preflight.Run(ctx, preflight.Params{
+			SSHProviderInitializer: sshProviderInitializer,
+			Options:                opts,
+		})
+		if err != nil {
+			return err
+		}
+
+		if err := preflight.WriteReport(report, &opts.Preflight); err != nil {
+			return err
+		}
+
+		if report.Failed() {
+			return fmt.Errorf("%d of %d preflight checks failed", report.Summary.Failed, len(report.Checks))
+		}
+
+		return nil
+	})
+}
@@ -38,10 +38,12 @@ func DefineServerCommand(cmd *kingpin.CmdClause, opts *options.Options) *kingpin
 					Network:       opts.Server.Network,
 					Address:       opts.Server.Address,
 					TmpDir:        opts.Global.TmpDir,
+					OperationsDir: opts.Server.OperationsDir,
 					GlobalOptions: &opts.Global,
 				},
 				ParallelTasksLimit:         opts.Server.ParallelTasksLimit,
 				RequestsCounterMaxDuration: opts.Server.RequestsCounterMaxDuration,
+				OperationsHistoryLimit:     opts.Server.OperationsHistoryLimit,
 			},
 		)
 	})
@@ -60,6 +62,7 @@ func DefineSingleThreadedServerCommand(cmd *kingpin.CmdClause, opts *options.Opt
 					Network:       opts.Server.Network,
 					Address:       opts.Server.Address,
 					TmpDir:        opts.Global.TmpDir,
+					OperationsDir: opts.Server.OperationsDir,
 					GlobalOptions: &opts.Global,
 				},
 			},
@@ -0,0 +1,66 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"gopkg.in/alecthomas/kingpin.v2"
+
+	"github.com/deckhouse/deckhouse/dhctl/pkg/app"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/app/options"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/kpcontext"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/telemetry"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/telemetry/otlpjson"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/telemetry/summary"
+)
+
+func DefineTelemetrySummarizeCommand(cmd *kingpin.CmdClause, opts *options.Options) *kingpin.CmdClause {
+	app.DefineTelemetrySummarizeFlags(cmd, &opts.TelemetrySummarize)
+
+	return cmd.Action(func(c *kingpin.ParseContext) error {
+		ctx := kpcontext.ExtractContext(c)
+
+		span := telemetry.SpanFromContext(ctx)
+		span.SetAttributes(opts.ToSpanAttributes()...)
+
+		file, err := os.Open(opts.TelemetrySummarize.TraceFile)
+		if err != nil {
+			return fmt.Errorf("Failed to open trace file: %w", err)
+		}
+		defer file.Close()
+
+		data, err := otlpjson.Read(file)
+		if err != nil {
+			return fmt.Errorf("Failed to read trace file %s: %w", opts.TelemetrySummarize.TraceFile, err)
+		}
+
+		res := summary.Summarize(data)
+
+		if opts.TelemetrySummarize.OutputFormat == "json" {
+			output, err := json.MarshalIndent(res, "", "  ")
+			if err != nil {
+				return fmt.Errorf("Failed to encode summary: %w", err)
+			}
+			fmt.Println(string(output))
+			return nil
+		}
+
+		fmt.Print(res.String())
+		return nil
+	})
+}
@@ -40,8 +40,10 @@ import (
 const (
 	oneShotDhctlServerCmd = "_server"
 	grpcServerCmd         = "server"
+	convergeGroupCmd      = "converge"
 	autoConvergeCmd       = "converge-periodical"
 	terraformGroupCmd     = "terraform"
+	infrastructureCmd     = "infrastructure"
 	exporterCmd           = "converge-exporter"
 )
 
@@ -102,9 +104,22 @@ var commandList = []Command{
 		Parent:     "bootstrap-phase",
 	},
 	{
-		Name:       "converge",
-		Help:       "Converge a Kubernetes cluster.",
-		DefineFunc: commands.DefineConvergeCommand,
+		Name: convergeGroupCmd,
+		Help: "Converge a Kubernetes cluster.",
+	},
+	{
+		Name: "apply",
+		Help: "Converge a Kubernetes cluster. It is the default subcommand, 'dhctl converge' runs it.",
+		DefineFunc: func(cmd *kingpin.CmdClause, opts *options.Options) *kingpin.CmdClause {
+			return commands.DefineConvergeCommand(cmd.Default(), opts)
+		},
+		Parent: convergeGroupCmd,
+	},
+	{
+		Name:       "plan",
+		Help:       "Show all changes converge is going to make in the infrastructure and the Kubernetes cluster without applying them.",
+		DefineFunc: commands.DefineConvergePlanCommand,
+		Parent:     convergeGroupCmd,
 	},
 	{
 		Name:       autoConvergeCmd,
@@ -116,6 +131,16 @@ var commandList = []Command{
 		Help:       "Migrate state from terraform to opentofu. Start converge if the cluster has no infrastructure changes.",
 		DefineFunc: commands.DefineConvergeMigrationCommand,
 	},
+	{
+		Name: "preflight",
+		Help: "Preflight checks of the bootstrap configuration.",
+	},
+	{
+		Name:       "run",
+		Help:       "Run preflight checks without bootstrap and write the report in JSON and JUnit XML formats.",
+		DefineFunc: commands.DefinePreflightRunCommand,
+		Parent:     "preflight",
+	},
 	{
 		Name: "lock",
 		Help: "Converge cluster lock",
@@ -152,6 +177,45 @@ var commandList = []Command{
 		DefineFunc: commands.DefineInfrastructureCheckCommand,
 		Parent:     "terraform",
 	},
+	{
+		Name: infrastructureCmd,
+		Help: "Infrastructure management commands.",
+	},
+	{
+		Name:       "import",
+		Help:       "Import existing cloud resources into infrastructure states of the cluster.",
+		DefineFunc: commands.DefineInfrastructureImportCommand,
+		Parent:     infrastructureCmd,
+	},
+	{
+		Name:   "state",
+		Help:   "Backup, restore and inspect infrastructure states stored in the cluster.",
+		Parent: infrastructureCmd,
+	},
+	{
+		Name:       "export",
+		Help:       "Export all infrastructure states of the cluster to the signed archive.",
+		DefineFunc: commands.DefineInfrastructureStateExportCommand,
+		Parent:     "state",
+	},
+	{
+		Name:       "import",
+		Help:       "Replace infrastructure states of the cluster with states from the archive or from the history revision.",
+		DefineFunc: commands.DefineInfrastructureStateImportCommand,
+		Parent:     "state",
+	},
+	{
+		Name:       "list",
+		Help:       "List infrastructure states of the cluster and revisions of the states history.",
+		DefineFunc: commands.DefineInfrastructureStateListCommand,
+		Parent:     "state",
+	},
+	{
+		Name:       "show",
+		Help:       "Show infrastructure state of the cluster.",
+		DefineFunc: commands.DefineInfrastructureStateShowCommand,
+		Parent:     "state",
+	},
 	{
 		Name: "config",
 		Help: "Load, edit and save various dhctl configurations.",
@@ -205,6 +269,31 @@ var commandList = []Command{
 			return nil
 		},
 	},
+	{
+		Name:   "migrate",
+		Help:   "Rewrite deprecated fields of configurations with the migration rules shipped with the schemas.",
+		Parent: "config",
+		DefineFunc: func(cmd *kingpin.CmdClause, opts *options.Options) *kingpin.CmdClause {
+			commands.DefineMigrateCommands(cmd, opts)
+			return nil
+		},
+	},
+	{
+		Name:       "lint",
+		Help:       "Validate configuration files offline and report problems with their positions in the files.",
+		DefineFunc: commands.DefineConfigLintCommand,
+		Parent:     "config",
+	},
+	{
+		Name: "telemetry",
+		Help: "Analyze telemetry written by dhctl.",
+	},
+	{
+		Name:       "summarize",
+		Help:       "Render per-phase and per-bashible-step timing breakdown from the OTLP JSON trace file.",
+		DefineFunc: commands.DefineTelemetrySummarizeCommand,
+		Parent:     "telemetry",
+	},
 	{
 		Name: "test",
 		Help: "Commands to test the parts of bootstrap and converge process.",
@@ -33,4 +33,8 @@ func DefineAutoConvergeFlags(cmd *kingpin.CmdClause, o *options.AutoConvergeOpti
 	cmd.Flag("node-name", "Name of the node where the auto-converger pod is running").
 		Envar(configEnvName("RUNNING_NODE_NAME")).
 		StringVar(&o.RunningNodeName)
+
+	cmd.Flag("approval-policy", "Path to the approval policy of infrastructure changes. Changes allowed by the policy are applied even if they are destructive, other changes are skipped").
+		Envar(configEnvName("APPROVAL_POLICY")).
+		StringVar(&o.ApprovalPolicyPath)
 }
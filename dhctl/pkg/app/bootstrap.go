@@ -55,6 +55,15 @@ Experimental. This feature may be deleted in the future.`).
 		DurationVar(&o.PostBootstrapScriptTimeout)
 }
 
+// DefineResumeFlags registers --resume.
+func DefineResumeFlags(cmd *kingpin.CmdClause, o *options.BootstrapOptions) {
+	cmd.Flag("resume", `Continue an interrupted bootstrap from the last completed phase or sub phase recorded in the operation journal.
+Bootstrap refuses to resume if configuration files were changed since the interrupted run.`).
+		Envar(configEnvName("RESUME")).
+		Default("false").
+		BoolVar(&o.Resume)
+}
+
 // DefineResourcesFlags registers --resources / --resources-timeout.
 func DefineResourcesFlags(cmd *kingpin.CmdClause, o *options.BootstrapOptions, isRequired bool) {
 	cmd.Flag("resources", `Path to a file with declared Kubernetes resources in YAML format.
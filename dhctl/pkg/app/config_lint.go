@@ -0,0 +1,38 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"gopkg.in/alecthomas/kingpin.v2"
+
+	"github.com/deckhouse/deckhouse/dhctl/pkg/app/options"
+)
+
+// DefineConfigLintFlags registers flags of the config lint command.
+func DefineConfigLintFlags(cmd *kingpin.CmdClause, o *options.ConfigLintOptions) {
+	cmd.Flag("output", "Output format of the diagnostics").
+		Envar(configEnvName("CONFIG_LINT_OUTPUT")).
+		Short('o').
+		Default(o.OutputFormat).
+		EnumVar(&o.OutputFormat, "text", "json", "sarif")
+
+	cmd.Flag("output-file", "Write the diagnostics to the file instead of stdout").
+		Envar(configEnvName("CONFIG_LINT_OUTPUT_FILE")).
+		StringVar(&o.OutputPath)
+
+	cmd.Flag("fail-on-warnings", "Exit with an error if there are warnings, not only errors").
+		Envar(configEnvName("CONFIG_LINT_FAIL_ON_WARNINGS")).
+		BoolVar(&o.FailOnWarnings)
+}
@@ -0,0 +1,31 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"gopkg.in/alecthomas/kingpin.v2"
+
+	"github.com/deckhouse/deckhouse/dhctl/pkg/app/options"
+)
+
+// DefineConfigMigrateFlags registers flags of the config migrate commands.
+func DefineConfigMigrateFlags(cmd *kingpin.CmdClause, o *options.ConfigMigrateOptions) {
+	cmd.Flag("dry-run", "Only print the changes and the diff of the migrated configuration, do not save anything").
+		Envar(configEnvName("CONFIG_MIGRATE_DRY_RUN")).
+		BoolVar(&o.DryRun)
+	cmd.Flag("yes", "Save the migrated configuration without the confirmation").
+		Envar(configEnvName("CONFIG_MIGRATE_YES")).
+		BoolVar(&o.SkipConfirmation)
+}
@@ -39,6 +39,12 @@ func DefineConvergeFlags(cmd *kingpin.CmdClause, o *options.ConvergeOptions) {
 	cmd.Flag("converge-destructive-auto-approve", "Destructive changes are auto-approved, only for test and dev purposes, use carefully!").
 		Envar(configEnvName("DESTRUCTIVE_APPROVED")).
 		BoolVar(&o.DestructiveApproved)
+	cmd.Flag("plan-file", "Path to a file with converge plan saved by 'converge plan --plan-file'. Exactly these changes are applied, converge fails if the cluster was changed after planning.").
+		Envar(configEnvName("PLAN_FILE")).
+		StringVar(&o.PlanFile)
+	cmd.Flag("yes", "Apply the changes of --plan-file without the confirmation").
+		Envar(configEnvName("CONVERGE_YES")).
+		BoolVar(&o.SkipConfirmation)
 }
 
 // DefineOutputFlag registers --output / -o for the check-style commands.
@@ -49,6 +55,20 @@ func DefineOutputFlag(cmd *kingpin.CmdClause, o *options.ConvergeOptions) {
 		EnumVar(&o.OutputFormat, "yaml", "json")
 }
 
+// DefineConvergePlanConfigFlags registers optional --config with the desired cluster configuration for converge plan.
+func DefineConvergePlanConfigFlags(cmd *kingpin.CmdClause, o *options.GlobalOptions) {
+	cmd.Flag("config", "Path to a file with desired cluster configuration in YAML format. In-cluster configuration is used if not set.").
+		Envar(configEnvName("CONFIG")).
+		StringsVar(&o.ConfigPaths)
+}
+
+// DefineConvergeSavePlanFlags registers --plan-file to save converge plan for 'converge apply --plan-file'.
+func DefineConvergeSavePlanFlags(cmd *kingpin.CmdClause, o *options.ConvergeOptions) {
+	cmd.Flag("plan-file", "Path to a file to save converge plan with infrastructure plan files. File contains sensitive data.").
+		Envar(configEnvName("PLAN_FILE")).
+		StringVar(&o.PlanFile)
+}
+
 // DefineCheckHasTerraformStateBeforeMigrateToTofu registers the migration guard flag.
 func DefineCheckHasTerraformStateBeforeMigrateToTofu(cmd *kingpin.CmdClause, o *options.ConvergeOptions) {
 	cmd.Flag("check-has-terraform-state-before-migrate-to-tofu", "Check that the cluster has terraform state before migrating the state to tofu.").
@@ -27,3 +27,28 @@ func DefineDestroyResourcesFlags(cmd *kingpin.CmdClause, o *options.DestroyOptio
 		Envar(configEnvName("SKIP_RESOURCES")).
 		BoolVar(&o.SkipResources)
 }
+
+// DefineDestroyScopeFlags registers flags of dry-run and selective destroy.
+func DefineDestroyScopeFlags(cmd *kingpin.CmdClause, o *options.DestroyOptions) {
+	cmd.Flag("dry-run", "Do not destroy anything, print the resources which will be removed: Kubernetes objects, resources from infrastructure states and static hosts.").
+		Envar(configEnvName("DESTROY_DRY_RUN")).
+		BoolVar(&o.DryRun)
+
+	cmd.Flag("output", "Output format of the dry-run inventory").
+		Envar(configEnvName("DESTROY_OUTPUT")).
+		Short('o').
+		Default(o.OutputFormat).
+		EnumVar(&o.OutputFormat, "text", "json")
+
+	cmd.Flag("node-group", "Destroy nodes of the cloud node group only. Kubernetes resources and base infrastructure are kept.").
+		Envar(configEnvName("DESTROY_NODE_GROUP")).
+		StringVar(&o.NodeGroup)
+
+	cmd.Flag("allow-configured-node-group", "Destroy the node group passed in --node-group even if it is still present in the provider cluster configuration. Converge will create it again.").
+		Envar(configEnvName("DESTROY_ALLOW_CONFIGURED_NODE_GROUP")).
+		BoolVar(&o.AllowConfiguredNodeGroup)
+
+	cmd.Flag("keep-base-infrastructure", "Destroy nodes only and keep base infrastructure (networks, NAT, etc.) of the cloud cluster for reuse.").
+		Envar(configEnvName("DESTROY_KEEP_BASE_INFRASTRUCTURE")).
+		BoolVar(&o.KeepBaseInfrastructure)
+}
@@ -0,0 +1,33 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"gopkg.in/alecthomas/kingpin.v2"
+
+	"github.com/deckhouse/deckhouse/dhctl/pkg/app/options"
+)
+
+// DefineInfrastructureImportFlags registers flags of the infrastructure import command.
+func DefineInfrastructureImportFlags(cmd *kingpin.CmdClause, o *options.InfrastructureImportOptions) {
+	cmd.Flag("resources", "Path to the YAML file mapping resource addresses of every infrastructure layer to ids of the existing cloud resources").
+		Envar(configEnvName("INFRASTRUCTURE_IMPORT_RESOURCES")).
+		Required().
+		StringVar(&o.ResourcesPath)
+
+	cmd.Flag("allow-changes", "Save imported states even if the infrastructure plan is not empty after import. Differences are applied by the next converge").
+		Envar(configEnvName("INFRASTRUCTURE_IMPORT_ALLOW_CHANGES")).
+		BoolVar(&o.AllowChanges)
+}
@@ -0,0 +1,93 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"strconv"
+
+	"gopkg.in/alecthomas/kingpin.v2"
+
+	"github.com/deckhouse/deckhouse/dhctl/pkg/app/options"
+)
+
+func defineInfrastructureStateSigningKeyFlag(cmd *kingpin.CmdClause) *kingpin.FlagClause {
+	return cmd.Flag("signing-key-file", "Path to the file with the key used to sign and verify the infrastructure state archive").
+		Envar(configEnvName("INFRASTRUCTURE_STATE_SIGNING_KEY_FILE"))
+}
+
+func defineInfrastructureStateHistoryLimitFlag(cmd *kingpin.CmdClause, o *options.InfrastructureStateOptions) {
+	cmd.Flag("history-limit", "Number of infrastructure states history revisions kept in the cluster").
+		Envar(configEnvName("INFRASTRUCTURE_STATE_HISTORY_LIMIT")).
+		Default(strconv.Itoa(o.HistoryLimit)).
+		IntVar(&o.HistoryLimit)
+}
+
+// DefineInfrastructureStateExportFlags registers flags of the infrastructure state export command.
+func DefineInfrastructureStateExportFlags(cmd *kingpin.CmdClause, o *options.InfrastructureStateOptions) {
+	cmd.Flag("archive", "Path to write the infrastructure state archive").
+		Envar(configEnvName("INFRASTRUCTURE_STATE_ARCHIVE")).
+		Required().
+		StringVar(&o.ArchivePath)
+
+	defineInfrastructureStateSigningKeyFlag(cmd).
+		Required().
+		StringVar(&o.SigningKeyPath)
+
+	defineInfrastructureStateHistoryLimitFlag(cmd, o)
+}
+
+// DefineInfrastructureStateImportFlags registers flags of the infrastructure state import command.
+func DefineInfrastructureStateImportFlags(cmd *kingpin.CmdClause, o *options.InfrastructureStateOptions) {
+	cmd.Flag("archive", "Path to the infrastructure state archive created with the export command").
+		Envar(configEnvName("INFRASTRUCTURE_STATE_ARCHIVE")).
+		StringVar(&o.ArchivePath)
+
+	defineInfrastructureStateSigningKeyFlag(cmd).
+		StringVar(&o.SigningKeyPath)
+
+	cmd.Flag("revision", "Roll back infrastructure states to the history revision instead of the archive").
+		Envar(configEnvName("INFRASTRUCTURE_STATE_REVISION")).
+		StringVar(&o.Revision)
+
+	cmd.Flag("skip-schema-validation", "Do not validate imported states against schemas of the infrastructure providers").
+		Envar(configEnvName("INFRASTRUCTURE_STATE_SKIP_SCHEMA_VALIDATION")).
+		BoolVar(&o.SkipSchemaValidation)
+
+	defineInfrastructureStateHistoryLimitFlag(cmd, o)
+
+	cmd.PreAction(func(_ *kingpin.ParseContext) error {
+		return o.ValidateImportSource()
+	})
+}
+
+// DefineInfrastructureStateListFlags registers flags of the infrastructure state list command.
+func DefineInfrastructureStateListFlags(cmd *kingpin.CmdClause, o *options.InfrastructureStateOptions) {
+	cmd.Flag("output", "Output format").
+		Envar(configEnvName("OUTPUT")).
+		Short('o').
+		Default(o.OutputFormat).
+		EnumVar(&o.OutputFormat, "yaml", "json")
+}
+
+// DefineInfrastructureStateShowFlags registers flags of the infrastructure state show command.
+func DefineInfrastructureStateShowFlags(cmd *kingpin.CmdClause, o *options.InfrastructureStateOptions) {
+	cmd.Arg("name", "Name of the state: base-infrastructure or node name").
+		Required().
+		StringVar(&o.StateName)
+
+	cmd.Flag("revision", "Show the state from the history revision").
+		Envar(configEnvName("INFRASTRUCTURE_STATE_REVISION")).
+		StringVar(&o.Revision)
+}
@@ -37,6 +37,8 @@ type BootstrapOptions struct {
 
 	KubeadmBootstrap   bool
 	MasterNodeSelector bool
+
+	Resume bool
 }
 
 // NewBootstrapOptions returns BootstrapOptions with the previous package-level defaults.
@@ -59,5 +61,6 @@ func (o *BootstrapOptions) ToSpanAttributes() []otattribute.KeyValue {
 		otattribute.String("bootstrap.postBootstrapScriptPath", o.PostBootstrapScriptPath),
 		otattribute.Bool("bootstrap.forceAbortFromCache", o.ForceAbortFromCache),
 		otattribute.Bool("bootstrap.dontUsePublicControlPlaneImages", o.DontUsePublicControlPlaneImages),
+		otattribute.Bool("bootstrap.resume", o.Resume),
 	}
 }
@@ -27,6 +27,10 @@ type ConvergeOptions struct {
 	CheckInterval       time.Duration
 	OutputFormat        string
 	DestructiveApproved bool
+	// PlanFile is the converge plan saved by converge plan and applied by converge apply
+	PlanFile string
+	// SkipConfirmation applies the changes of PlanFile without the confirmation
+	SkipConfirmation bool
 
 	CheckHasTerraformStateBeforeMigrateToTofu bool
 }
@@ -47,6 +51,8 @@ func (o *ConvergeOptions) ToSpanAttributes() []otattribute.KeyValue {
 		otattribute.String("converge.listenAddress", o.ListenAddress),
 		otattribute.String("converge.checkInterval", o.CheckInterval.String()),
 		otattribute.String("converge.outputFormat", o.OutputFormat),
+		otattribute.String("converge.planFile", o.PlanFile),
+		otattribute.Bool("converge.skipConfirmation", o.SkipConfirmation),
 		otattribute.Bool("converge.checkHasTerraformStateBeforeMigrateToTofu", o.CheckHasTerraformStateBeforeMigrateToTofu),
 	}
 }
@@ -56,6 +62,8 @@ type AutoConvergeOptions struct {
 	ApplyInterval   time.Duration
 	ListenAddress   string
 	RunningNodeName string
+	// ApprovalPolicyPath is the approval policy for the changes which are not applied automatically by default
+	ApprovalPolicyPath string
 }
 
 // NewAutoConvergeOptions returns AutoConvergeOptions with defaults.
@@ -71,5 +79,6 @@ func (o *AutoConvergeOptions) ToSpanAttributes() []otattribute.KeyValue {
 		otattribute.String("autoConverge.applyInterval", o.ApplyInterval.String()),
 		otattribute.String("autoConverge.listenAddress", o.ListenAddress),
 		otattribute.String("autoConverge.runningNodeName", o.RunningNodeName),
+		otattribute.String("autoConverge.approvalPolicyPath", o.ApprovalPolicyPath),
 	}
 }
@@ -19,10 +19,28 @@ import otattribute "go.opentelemetry.io/otel/attribute"
 // DestroyOptions covers the destroy command.
 type DestroyOptions struct {
 	SkipResources bool
+
+	DryRun       bool
+	OutputFormat string
+
+	NodeGroup                string
+	KeepBaseInfrastructure   bool
+	AllowConfiguredNodeGroup bool
+}
+
+func NewDestroyOptions() DestroyOptions {
+	return DestroyOptions{
+		OutputFormat: "text",
+	}
 }
 
 func (o *DestroyOptions) ToSpanAttributes() []otattribute.KeyValue {
 	return []otattribute.KeyValue{
 		otattribute.Bool("destroy.skipResources", o.SkipResources),
+		otattribute.Bool("destroy.dryRun", o.DryRun),
+		otattribute.String("destroy.outputFormat", o.OutputFormat),
+		otattribute.String("destroy.nodeGroup", o.NodeGroup),
+		otattribute.Bool("destroy.keepBaseInfrastructure", o.KeepBaseInfrastructure),
+		otattribute.Bool("destroy.allowConfiguredNodeGroup", o.AllowConfiguredNodeGroup),
 	}
 }
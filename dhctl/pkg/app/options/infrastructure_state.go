@@ -0,0 +1,65 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package options
+
+import (
+	"fmt"
+
+	otattribute "go.opentelemetry.io/otel/attribute"
+)
+
+const DefaultInfrastructureStateHistoryLimit = 10
+
+// InfrastructureStateOptions covers infrastructure state export/import/list/show commands.
+type InfrastructureStateOptions struct {
+	ArchivePath          string
+	SigningKeyPath       string
+	Revision             string
+	StateName            string
+	HistoryLimit         int
+	SkipSchemaValidation bool
+	OutputFormat         string
+}
+
+func NewInfrastructureStateOptions() InfrastructureStateOptions {
+	return InfrastructureStateOptions{
+		HistoryLimit: DefaultInfrastructureStateHistoryLimit,
+		OutputFormat: "yaml",
+	}
+}
+
+func (o *InfrastructureStateOptions) ToSpanAttributes() []otattribute.KeyValue {
+	return []otattribute.KeyValue{
+		otattribute.String("infrastructureState.archivePath", o.ArchivePath),
+		otattribute.String("infrastructureState.revision", o.Revision),
+		otattribute.String("infrastructureState.stateName", o.StateName),
+		otattribute.Int("infrastructureState.historyLimit", o.HistoryLimit),
+		otattribute.Bool("infrastructureState.skipSchemaValidation", o.SkipSchemaValidation),
+	}
+}
+
+// ValidateImportSource checks that states are imported either from the archive or from the history revision.
+func (o *InfrastructureStateOptions) ValidateImportSource() error {
+	switch {
+	case o.ArchivePath == "" && o.Revision == "":
+		return fmt.Errorf("one of --archive or --revision is required")
+	case o.ArchivePath != "" && o.Revision != "":
+		return fmt.Errorf("--archive and --revision cannot be used together")
+	case o.ArchivePath != "" && o.SigningKeyPath == "":
+		return fmt.Errorf("--signing-key-file is required to verify the archive")
+	}
+
+	return nil
+}
@@ -55,6 +55,12 @@ type Options struct {
 	ControlPlane ControlPlaneOptions
 	Destroy      DestroyOptions
 	Registry     RegistryOptions
+
+	InfrastructureState  InfrastructureStateOptions
+	InfrastructureImport InfrastructureImportOptions
+	ConfigMigrate        ConfigMigrateOptions
+	ConfigLint           ConfigLintOptions
+	TelemetrySummarize   TelemetrySummarizeOptions
 }
 
 func (o *Options) ToSpanAttributes() []otattribute.KeyValue {
@@ -75,6 +81,11 @@ func (o *Options) ToSpanAttributes() []otattribute.KeyValue {
 	attrs = append(attrs, o.Render.ToSpanAttributes()...)
 	attrs = append(attrs, o.ControlPlane.ToSpanAttributes()...)
 	attrs = append(attrs, o.Destroy.ToSpanAttributes()...)
+	attrs = append(attrs, o.InfrastructureState.ToSpanAttributes()...)
+	attrs = append(attrs, o.InfrastructureImport.ToSpanAttributes()...)
+	attrs = append(attrs, o.ConfigMigrate.ToSpanAttributes()...)
+	attrs = append(attrs, o.ConfigLint.ToSpanAttributes()...)
+	attrs = append(attrs, o.TelemetrySummarize.ToSpanAttributes()...)
 
 	return attrs
 }
@@ -93,5 +104,10 @@ func New() *Options {
 		Converge:     NewConvergeOptions(),
 		AutoConverge: NewAutoConvergeOptions(),
 		Render:       NewRenderOptions(),
+		Destroy:      NewDestroyOptions(),
+
+		InfrastructureState: NewInfrastructureStateOptions(),
+		ConfigLint:          NewConfigLintOptions(),
+		TelemetrySummarize:  NewTelemetrySummarizeOptions(),
 	}
 }
@@ -17,6 +17,7 @@ package options
 import (
 	"fmt"
 	"slices"
+	"strings"
 
 	otattribute "go.opentelemetry.io/otel/attribute"
 )
@@ -27,10 +28,34 @@ var legacyPreflightSkipAliases = map[string]string{
 	"preflight-skip-one-ssh-host": "static-single-ssh-host",
 }
 
+// Suites of the preflight checks which can be run by the preflight run command.
+const (
+	PreflightSuiteGlobal      = "global"
+	PreflightSuiteStatic      = "static"
+	PreflightSuiteCloud       = "cloud"
+	PreflightSuiteCloudPost   = "cloud_post"
+	PreflightSuiteStaticAbort = "static_abort"
+)
+
+// PreflightPluginCheckPrefix is the name prefix of the checks loaded from the preflight plugins.
+// These checks are not known in advance, so any name with the prefix is accepted by the flags.
+const PreflightPluginCheckPrefix = "plugin-"
+
 // PreflightOptions describes which preflight checks should be skipped.
 type PreflightOptions struct {
 	SkipAll    bool
 	SkipChecks []string
+
+	// PluginsDir is the directory with executable plugins providing extra checks.
+	PluginsDir string
+
+	// Suites, Phases and OnlyChecks select checks for the preflight run command.
+	Suites     []string
+	Phases     []string
+	OnlyChecks []string
+
+	ReportJSONPath  string
+	ReportJUnitPath string
 }
 
 // ApplySkips appends the given skip names to SkipChecks, normalizing legacy aliases.
@@ -70,6 +95,9 @@ func (o *PreflightOptions) Validate() error {
 	}
 
 	for _, name := range o.SkipChecks {
+		if strings.HasPrefix(name, PreflightPluginCheckPrefix) {
+			continue
+		}
 		if _, ok := known[name]; !ok {
 			return fmt.Errorf("unknown preflight check name: %s", name)
 		}
@@ -78,10 +106,28 @@ func (o *PreflightOptions) Validate() error {
 	return nil
 }
 
+// ValidateOnlyChecks ensures every entry in OnlyChecks matches a known check name.
+func (o *PreflightOptions) ValidateOnlyChecks() error {
+	for _, name := range o.OnlyChecks {
+		if strings.HasPrefix(name, PreflightPluginCheckPrefix) {
+			continue
+		}
+		if !slices.Contains(generatedPreflightChecks, name) {
+			return fmt.Errorf("unknown preflight check name: %s", name)
+		}
+	}
+
+	return nil
+}
+
 func (o *PreflightOptions) ToSpanAttributes() []otattribute.KeyValue {
 	return []otattribute.KeyValue{
 		otattribute.Bool("preflight.skipAll", o.SkipAll),
 		otattribute.StringSlice("preflight.skipChecks", o.SkipChecks),
+		otattribute.String("preflight.pluginsDir", o.PluginsDir),
+		otattribute.StringSlice("preflight.suites", o.Suites),
+		otattribute.StringSlice("preflight.phases", o.Phases),
+		otattribute.StringSlice("preflight.onlyChecks", o.OnlyChecks),
 	}
 }
 
@@ -26,6 +26,8 @@ type ServerOptions struct {
 	Address                    string
 	ParallelTasksLimit         int
 	RequestsCounterMaxDuration time.Duration
+	OperationsDir              string
+	OperationsHistoryLimit     int
 }
 
 func (o *ServerOptions) ToSpanAttributes() []otattribute.KeyValue {
@@ -34,5 +36,7 @@ func (o *ServerOptions) ToSpanAttributes() []otattribute.KeyValue {
 		otattribute.String("server.address", o.Address),
 		otattribute.Int("server.parallelTasksLimit", o.ParallelTasksLimit),
 		otattribute.String("server.requestsCounterMaxDuration", o.RequestsCounterMaxDuration.String()),
+		otattribute.String("server.operationsDir", o.OperationsDir),
+		otattribute.Int("server.operationsHistoryLimit", o.OperationsHistoryLimit),
 	}
 }
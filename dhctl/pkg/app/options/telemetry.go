@@ -0,0 +1,36 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package options
+
+import otattribute "go.opentelemetry.io/otel/attribute"
+
+// TelemetrySummarizeOptions covers the telemetry summarize command.
+type TelemetrySummarizeOptions struct {
+	TraceFile    string
+	OutputFormat string
+}
+
+func NewTelemetrySummarizeOptions() TelemetrySummarizeOptions {
+	return TelemetrySummarizeOptions{
+		OutputFormat: "text",
+	}
+}
+
+func (o *TelemetrySummarizeOptions) ToSpanAttributes() []otattribute.KeyValue {
+	return []otattribute.KeyValue{
+		otattribute.String("telemetrySummarize.traceFile", o.TraceFile),
+		otattribute.String("telemetrySummarize.outputFormat", o.OutputFormat),
+	}
+}
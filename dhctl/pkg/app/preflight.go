@@ -35,7 +35,46 @@ func DefinePreflight(cmd *kingpin.CmdClause, o *options.PreflightOptions) {
 		PlaceHolder("name").
 		StringsVar(&o.SkipChecks)
 
+	cmd.Flag("preflight-plugins-dir", fmt.Sprintf("Directory with executable plugins providing extra preflight checks. Names of plugin checks start with %q.", options.PreflightPluginCheckPrefix)).
+		Envar(configEnvName("PREFLIGHT_PLUGINS_DIR")).
+		StringVar(&o.PluginsDir)
+
 	cmd.PreAction(func(_ *kingpin.ParseContext) error {
 		return o.Validate()
 	})
 }
+
+// DefinePreflightRunFlags registers checks selection and report flags for the preflight run command.
+func DefinePreflightRunFlags(cmd *kingpin.CmdClause, o *options.PreflightOptions) {
+	cmd.Flag("suite", "Run checks of the suite (repeatable). Suites for the cluster type are run if not set.").
+		Envar(configEnvName("PREFLIGHT_SUITES")).
+		EnumsVar(&o.Suites,
+			options.PreflightSuiteGlobal,
+			options.PreflightSuiteStatic,
+			options.PreflightSuiteCloud,
+			options.PreflightSuiteCloudPost,
+			options.PreflightSuiteStaticAbort,
+		)
+
+	cmd.Flag("phase", "Run checks of the phase only (repeatable). Checks of all phases are run if not set.").
+		Envar(configEnvName("PREFLIGHT_PHASES")).
+		EnumsVar(&o.Phases, "pre-infra", "post-infra")
+
+	desc := fmt.Sprintf("Run only specific preflight checks by name (repeatable). Known checks: %s", strings.Join(options.GeneratedChecks(), ", "))
+	cmd.Flag("only", desc).
+		Envar(configEnvName("PREFLIGHT_ONLY_CHECKS")).
+		PlaceHolder("name").
+		StringsVar(&o.OnlyChecks)
+
+	cmd.Flag("report-json", "Path to write the report in JSON format. The report is printed to stdout if no report path is set.").
+		Envar(configEnvName("PREFLIGHT_REPORT_JSON")).
+		StringVar(&o.ReportJSONPath)
+
+	cmd.Flag("report-junit", "Path to write the report in JUnit XML format.").
+		Envar(configEnvName("PREFLIGHT_REPORT_JUNIT")).
+		StringVar(&o.ReportJUnitPath)
+
+	cmd.PreAction(func(_ *kingpin.ParseContext) error {
+		return o.ValidateOnlyChecks()
+	})
+}
@@ -37,4 +37,11 @@ func DefineServerFlags(cmd *kingpin.CmdClause, o *options.ServerOptions) {
 		Default("2h").
 		Envar(configEnvName("SERVER_REQUESTS_COUNTER_MAX_DURATION")).
 		DurationVar(&o.RequestsCounterMaxDuration)
+	cmd.Flag("server-operations-dir", "Directory of the operations history. Default: <tmp-dir>/operations").
+		Envar(configEnvName("SERVER_OPERATIONS_DIR")).
+		StringVar(&o.OperationsDir)
+	cmd.Flag("server-operations-history-limit", "Number of finished operations to keep in the history.").
+		Envar(configEnvName("SERVER_OPERATIONS_HISTORY_LIMIT")).
+		Default("100").
+		IntVar(&o.OperationsHistoryLimit)
 }
@@ -0,0 +1,34 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"gopkg.in/alecthomas/kingpin.v2"
+
+	"github.com/deckhouse/deckhouse/dhctl/pkg/app/options"
+)
+
+// DefineTelemetrySummarizeFlags registers flags of the telemetry summarize command.
+func DefineTelemetrySummarizeFlags(cmd *kingpin.CmdClause, o *options.TelemetrySummarizeOptions) {
+	cmd.Arg("trace-file", "Path to the OTLP JSON file written by dhctl with DHCTL_TRACE_FILE").
+		Required().
+		ExistingFileVar(&o.TraceFile)
+
+	cmd.Flag("output", "Output format of the summary").
+		Envar(configEnvName("TELEMETRY_SUMMARIZE_OUTPUT")).
+		Short('o').
+		Default(o.OutputFormat).
+		EnumVar(&o.OutputFormat, "text", "json")
+}
@@ -43,7 +43,9 @@ const xDocDeprecatedExtension = "x-doc-deprecated"
 // so the warning identifies which resource is affected; it is empty for
 // documents that don't carry a metadata.name, such as ClusterConfiguration.
 func warnDeprecatedFields(ctx context.Context, index *SchemaIndex, name string, doc json.RawMessage, schema *spec.Schema) {
-	warnDeprecatedProperties(ctx, index, name, "", doc, schema)
+	walkDeprecatedFields("", doc, schema, func(path string) {
+		warnDeprecatedField(ctx, index, name, path)
+	})
 }
 
 // extractMetadataName reads metadata.name out of a raw document, or returns
@@ -56,7 +58,10 @@ func extractMetadataName(doc []byte) string {
 	return idx.Metadata.Name
 }
 
-func warnDeprecatedProperties(ctx context.Context, index *SchemaIndex, name, pathPrefix string, doc json.RawMessage, schema *spec.Schema) {
+// walkDeprecatedFields calls report with the path of every field set in doc
+// whose schema node is marked deprecated. Array items are addressed as
+// "field[i]".
+func walkDeprecatedFields(pathPrefix string, doc json.RawMessage, schema *spec.Schema, report func(path string)) {
 	if schema == nil || len(doc) == 0 {
 		return
 	}
@@ -76,10 +81,10 @@ func warnDeprecatedProperties(ctx context.Context, index *SchemaIndex, name, pat
 			path := joinFieldPath(pathPrefix, field)
 
 			if deprecated, _ := fieldSchema.Extensions.GetBool(xDocDeprecatedExtension); deprecated {
-				warnDeprecatedField(ctx, index, name, path)
+				report(path)
 			}
 
-			warnDeprecatedProperties(ctx, index, name, path, raw, &fieldSchema)
+			walkDeprecatedFields(path, raw, &fieldSchema, report)
 		}
 	}
 
@@ -90,7 +95,7 @@ func warnDeprecatedProperties(ctx context.Context, index *SchemaIndex, name, pat
 		}
 
 		for i, item := range items {
-			warnDeprecatedProperties(ctx, index, name, fmt.Sprintf("%s[%d]", pathPrefix, i), item, itemSchema.Schema)
+			walkDeprecatedFields(fmt.Sprintf("%s[%d]", pathPrefix, i), item, itemSchema.Schema, report)
 		}
 	}
 }
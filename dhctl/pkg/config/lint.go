@@ -0,0 +1,589 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	openapierrors "github.com/go-openapi/errors"
+	"github.com/hashicorp/go-multierror"
+	yamlv3 "gopkg.in/yaml.v3"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+type LintSeverity string
+
+const (
+	LintSeverityError   LintSeverity = "error"
+	LintSeverityWarning LintSeverity = "warning"
+)
+
+// Lint rule ids. They are stable: SARIF consumers use them to group and
+// suppress findings.
+const (
+	LintRuleInvalidYAML       = "invalid-yaml"
+	LintRuleMissingKind       = "missing-kind"
+	LintRuleSchema            = "schema"
+	LintRuleNoSchema          = "no-schema"
+	LintRuleResource          = "resource"
+	LintRuleDeprecatedField   = "deprecated-field"
+	LintRuleDuplicateDocument = "duplicate-document"
+	LintRuleSubnetOverlap     = "subnet-overlap"
+	LintRulePublicDomain      = "public-domain-template"
+	LintRuleCNIBootstrap      = "cni-bootstrap"
+)
+
+// LintRules describes every rule the linter reports.
+var LintRules = map[string]string{
+	LintRuleInvalidYAML:       "Document is not valid YAML",
+	LintRuleMissingKind:       "Document has no kind or apiVersion",
+	LintRuleSchema:            "Document does not match its OpenAPI schema",
+	LintRuleNoSchema:          "Document kind has no schema available offline and was not validated",
+	LintRuleResource:          "Kubernetes resource is malformed",
+	LintRuleDeprecatedField:   "Field is deprecated",
+	LintRuleDuplicateDocument: "Document is defined more than once",
+	LintRuleSubnetOverlap:     "Cluster subnets overlap",
+	LintRulePublicDomain:      "publicDomainTemplate is invalid or matches clusterDomain",
+	LintRuleCNIBootstrap:      "CNI ModuleConfig does not match the CNI bootstrap of the provider",
+}
+
+const defaultClusterDomain = "cluster.local"
+
+// LintRange is a 1-based source range; EndColumn is exclusive.
+type LintRange struct {
+	StartLine   int `json:"startLine"`
+	StartColumn int `json:"startColumn"`
+	EndLine     int `json:"endLine"`
+	EndColumn   int `json:"endColumn"`
+}
+
+type LintDiagnostic struct {
+	File     string       `json:"file"`
+	Range    LintRange    `json:"range"`
+	Severity LintSeverity `json:"severity"`
+	Rule     string       `json:"rule"`
+	Message  string       `json:"message"`
+	Kind     string       `json:"kind,omitempty"`
+	Name     string       `json:"name,omitempty"`
+	Path     string       `json:"path,omitempty"`
+}
+
+func (d LintDiagnostic) String() string {
+	b := strings.Builder{}
+	fmt.Fprintf(&b, "%s:%d:%d: %s: ", d.File, d.Range.StartLine, d.Range.StartColumn, d.Severity)
+	if d.Kind != "" {
+		b.WriteString(d.Kind)
+		if d.Name != "" {
+			fmt.Fprintf(&b, " %q", d.Name)
+		}
+		b.WriteString(": ")
+	}
+	fmt.Fprintf(&b, "%s [%s]", d.Message, d.Rule)
+	return b.String()
+}
+
+// LintFile is a configuration file to lint, possibly with several documents.
+type LintFile struct {
+	Path    string
+	Content []byte
+}
+
+// LintHasErrors reports whether any diagnostic has the error severity.
+func LintHasErrors(diagnostics []LintDiagnostic) bool {
+	for _, d := range diagnostics {
+		if d.Severity == LintSeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+type lintDocument struct {
+	file      string
+	node      *yamlv3.Node
+	index     namedIndex
+	namespace string
+	data      []byte
+}
+
+type linter struct {
+	ctx         context.Context
+	store       *SchemaStore
+	docs        []*lintDocument
+	diagnostics []LintDiagnostic
+}
+
+// Lint validates every document of files without connecting anywhere: each
+// document against its schema from schemaStore, then the documents against
+// each other. Unlike the parse/validate functions it does not stop at the
+// first problem and points every diagnostic at the file, line and column of
+// the offending field.
+func Lint(ctx context.Context, schemaStore *SchemaStore, files []LintFile) []LintDiagnostic {
+	l := &linter{ctx: ctx, store: schemaStore}
+
+	for _, file := range files {
+		l.lintFile(file)
+	}
+
+	l.lintDuplicates()
+	l.lintSubnets()
+	l.lintPublicDomainTemplate()
+	l.lintCNIBootstrap()
+
+	sort.SliceStable(l.diagnostics, func(i, j int) bool {
+		a, b := l.diagnostics[i], l.diagnostics[j]
+		if a.File != b.File {
+			return a.File < b.File
+		}
+		if a.Range.StartLine != b.Range.StartLine {
+			return a.Range.StartLine < b.Range.StartLine
+		}
+		return a.Range.StartColumn < b.Range.StartColumn
+	})
+
+	return l.diagnostics
+}
+
+var yamlErrorLineRegexp = regexp.MustCompile(`line (\d+)`)
+
+func (l *linter) lintFile(file LintFile) {
+	decoder := yamlv3.NewDecoder(bytes.NewReader(file.Content))
+	for {
+		var doc yamlv3.Node
+		err := decoder.Decode(&doc)
+		if errors.Is(err, io.EOF) {
+			return
+		}
+		if err != nil {
+			line := 1
+			if m := yamlErrorLineRegexp.FindStringSubmatch(err.Error()); m != nil {
+				line, _ = strconv.Atoi(m[1])
+			}
+			l.diagnostics = append(l.diagnostics, LintDiagnostic{
+				File:     file.Path,
+				Range:    LintRange{StartLine: line, StartColumn: 1, EndLine: line, EndColumn: 2},
+				Severity: LintSeverityError,
+				Rule:     LintRuleInvalidYAML,
+				Message:  err.Error(),
+			})
+			// the decoder can't recover, the rest of the file is not linted
+			return
+		}
+
+		if len(doc.Content) == 0 {
+			continue
+		}
+		node := doc.Content[0]
+		if node.Kind == yamlv3.ScalarNode && node.Tag == "!!null" {
+			continue
+		}
+
+		l.lintDocument(file.Path, node)
+	}
+}
+
+func (l *linter) lintDocument(file string, node *yamlv3.Node) {
+	doc := &lintDocument{file: file, node: node}
+
+	if node.Kind != yamlv3.MappingNode {
+		l.report(doc, "", LintSeverityError, LintRuleInvalidYAML, "document must be an object")
+		return
+	}
+
+	var obj any
+	if err := node.Decode(&obj); err != nil {
+		l.report(doc, "", LintSeverityError, LintRuleInvalidYAML, err.Error())
+		return
+	}
+	data, err := json.Marshal(obj)
+	if err != nil {
+		l.report(doc, "", LintSeverityError, LintRuleInvalidYAML, err.Error())
+		return
+	}
+	doc.data = data
+
+	var meta struct {
+		namedIndex
+		Metadata struct {
+			Name      string `json:"name"`
+			Namespace string `json:"namespace"`
+		} `json:"metadata"`
+	}
+	if err := json.Unmarshal(data, &meta); err != nil {
+		l.report(doc, "", LintSeverityError, LintRuleInvalidYAML, err.Error())
+		return
+	}
+	doc.index = meta.namedIndex
+	doc.index.Metadata.Name = meta.Metadata.Name
+	doc.namespace = meta.Metadata.Namespace
+
+	if !doc.index.IsValid() {
+		l.report(doc, "", LintSeverityError, LintRuleMissingKind, "document must contain \"kind\" and \"apiVersion\" fields")
+		return
+	}
+
+	l.docs = append(l.docs, doc)
+	l.validateDocument(doc)
+}
+
+func (l *linter) validateDocument(doc *lintDocument) {
+	prefix := ""
+	if doc.index.Kind == ModuleConfigKind {
+		// module config schemas describe settings only
+		prefix = "spec.settings"
+	}
+
+	index := SchemaIndex{Kind: doc.index.Kind, Version: doc.index.Version}
+	data := append([]byte(nil), doc.data...)
+
+	err := l.store.ValidateWithIndex(&index, &data,
+		ValidateOptionOmitDocInError(true),
+		ValidateOptionValidateExtensions(true),
+		ValidateOptionOnDeprecatedField(func(path string) {
+			path = joinFieldPath(prefix, path)
+			l.report(doc, path, LintSeverityWarning, LintRuleDeprecatedField,
+				fmt.Sprintf("%s is deprecated, support for this field will be removed in a future release", path))
+		}),
+	)
+
+	switch {
+	case err == nil:
+	case errors.Is(err, ErrSchemaNotFound):
+		l.reportNoSchema(doc)
+	default:
+		validationErrs := openAPIValidationErrors(err)
+		if len(validationErrs) == 0 {
+			l.report(doc, "", LintSeverityError, LintRuleSchema, err.Error())
+			return
+		}
+		for _, e := range validationErrs {
+			path, value := strings.Trim(e.Name, "."), e.Value
+			if e.Code() == openapierrors.UnallowedPropertyCode {
+				path, value = joinFieldPath(path, fmt.Sprint(e.Value)), nil
+			}
+			l.reportValue(doc, joinFieldPath(prefix, path), value, LintSeverityError, LintRuleSchema, e.Error())
+		}
+	}
+}
+
+func (l *linter) reportNoSchema(doc *lintDocument) {
+	kind := doc.index.Kind
+	switch {
+	case kind == ModuleConfigKind:
+		l.report(doc, "", LintSeverityWarning, LintRuleNoSchema,
+			fmt.Sprintf("module %q is unknown to this dhctl, its settings were not validated", doc.index.Metadata.Name))
+	case kind == InitConfigurationKind || strings.HasSuffix(kind, "ClusterConfiguration"):
+		l.report(doc, "", LintSeverityWarning, LintRuleNoSchema,
+			fmt.Sprintf("no schema for %s %s, the document was not validated", kind, doc.index.Version))
+	case doc.index.Metadata.Name == "":
+		l.report(doc, "metadata", LintSeverityError, LintRuleResource, "metadata.name is required")
+	}
+}
+
+// openAPIValidationErrors digs the per-field errors of the schema validator
+// out of err.
+func openAPIValidationErrors(err error) []*openapierrors.Validation {
+	var result []*openapierrors.Validation
+
+	var walk func(err error)
+	walk = func(err error) {
+		var (
+			multiErr     *multierror.Error
+			compositeErr *openapierrors.CompositeError
+			validation   *openapierrors.Validation
+		)
+		switch {
+		case errors.As(err, &multiErr):
+			for _, e := range multiErr.Errors {
+				walk(e)
+			}
+		case errors.As(err, &compositeErr):
+			for _, e := range compositeErr.Errors {
+				walk(e)
+			}
+		case errors.As(err, &validation):
+			result = append(result, validation)
+		}
+	}
+	walk(err)
+
+	return result
+}
+
+func (l *linter) lintDuplicates() {
+	seen := make(map[string]*lintDocument)
+	for _, doc := range l.docs {
+		key := strings.Join([]string{doc.index.Kind, doc.namespace, doc.index.Metadata.Name}, "/")
+		first, ok := seen[key]
+		if !ok {
+			seen[key] = doc
+			continue
+		}
+		l.report(doc, "", LintSeverityError, LintRuleDuplicateDocument,
+			fmt.Sprintf("%s is already defined at %s:%d", kindLabel(&SchemaIndex{Kind: doc.index.Kind}, doc.index.Metadata.Name), first.file, first.node.Line))
+	}
+}
+
+func (l *linter) lintSubnets() {
+	cluster := l.first(ClusterConfigurationKind, "")
+	if cluster == nil {
+		return
+	}
+
+	var clusterConfig struct {
+		PodSubnetCIDR     string `json:"podSubnetCIDR"`
+		ServiceSubnetCIDR string `json:"serviceSubnetCIDR"`
+	}
+	if err := json.Unmarshal(cluster.data, &clusterConfig); err != nil {
+		return
+	}
+
+	// malformed CIDRs are reported by the schema validation
+	_, podSubnet, podErr := net.ParseCIDR(clusterConfig.PodSubnetCIDR)
+	_, serviceSubnet, serviceErr := net.ParseCIDR(clusterConfig.ServiceSubnetCIDR)
+	if podErr == nil && serviceErr == nil && subnetsOverlap(podSubnet, serviceSubnet) {
+		l.report(cluster, "serviceSubnetCIDR", LintSeverityError, LintRuleSubnetOverlap,
+			fmt.Sprintf("serviceSubnetCIDR %s overlaps podSubnetCIDR %s", clusterConfig.ServiceSubnetCIDR, clusterConfig.PodSubnetCIDR))
+	}
+
+	static := l.first(StaticClusterConfigurationKind, "")
+	if static == nil {
+		return
+	}
+	var staticConfig struct {
+		InternalNetworkCIDRs []string `json:"internalNetworkCIDRs"`
+	}
+	if err := json.Unmarshal(static.data, &staticConfig); err != nil {
+		return
+	}
+	for i, cidr := range staticConfig.InternalNetworkCIDRs {
+		_, internal, err := net.ParseCIDR(cidr)
+		if err != nil {
+			continue
+		}
+		path := fmt.Sprintf("internalNetworkCIDRs.%d", i)
+		if podErr == nil && subnetsOverlap(internal, podSubnet) {
+			l.report(static, path, LintSeverityError, LintRuleSubnetOverlap,
+				fmt.Sprintf("internalNetworkCIDRs %s overlaps podSubnetCIDR %s of ClusterConfiguration", cidr, clusterConfig.PodSubnetCIDR))
+		}
+		if serviceErr == nil && subnetsOverlap(internal, serviceSubnet) {
+			l.report(static, path, LintSeverityError, LintRuleSubnetOverlap,
+				fmt.Sprintf("internalNetworkCIDRs %s overlaps serviceSubnetCIDR %s of ClusterConfiguration", cidr, clusterConfig.ServiceSubnetCIDR))
+		}
+	}
+}
+
+func subnetsOverlap(a, b *net.IPNet) bool {
+	return a.Contains(b.IP) || b.Contains(a.IP)
+}
+
+func (l *linter) lintPublicDomainTemplate() {
+	global := l.first(ModuleConfigKind, "global")
+	if global == nil {
+		return
+	}
+
+	var mc struct {
+		Spec struct {
+			Settings struct {
+				Modules struct {
+					PublicDomainTemplate string `json:"publicDomainTemplate"`
+				} `json:"modules"`
+			} `json:"settings"`
+		} `json:"spec"`
+	}
+	if err := json.Unmarshal(global.data, &mc); err != nil {
+		return
+	}
+	template := mc.Spec.Settings.Modules.PublicDomainTemplate
+	if template == "" {
+		return
+	}
+	const path = "spec.settings.modules.publicDomainTemplate"
+
+	if strings.Count(template, "%s") != 1 {
+		l.report(global, path, LintSeverityError, LintRulePublicDomain,
+			fmt.Sprintf("publicDomainTemplate %q must contain exactly one %%s", template))
+		return
+	}
+	if errs := validation.IsDNS1123Subdomain(strings.Replace(template, "%s", "grafana", 1)); len(errs) > 0 {
+		l.report(global, path, LintSeverityError, LintRulePublicDomain,
+			fmt.Sprintf("publicDomainTemplate %q does not produce valid domain names: %s", template, strings.Join(errs, "; ")))
+	}
+
+	clusterDomain := defaultClusterDomain
+	if cluster := l.first(ClusterConfigurationKind, ""); cluster != nil {
+		var clusterConfig struct {
+			ClusterDomain string `json:"clusterDomain"`
+		}
+		if err := json.Unmarshal(cluster.data, &clusterConfig); err == nil && clusterConfig.ClusterDomain != "" {
+			clusterDomain = clusterConfig.ClusterDomain
+		}
+	}
+	if strings.Contains(template, clusterDomain) {
+		l.report(global, path, LintSeverityError, LintRulePublicDomain,
+			fmt.Sprintf("the publicDomainTemplate %q must not match clusterDomain %q", template, clusterDomain))
+	}
+}
+
+func (l *linter) lintCNIBootstrap() {
+	payload := make([]string, 0, len(l.docs))
+	for _, doc := range l.docs {
+		payload = append(payload, string(doc.data))
+	}
+
+	validationErr := validateCNIBootstrap(l.ctx, strings.Join(payload, "\n---\n"), l.store)
+	if validationErr == nil {
+		return
+	}
+
+	for _, e := range validationErr.Errors {
+		doc, path := l.first(ModuleConfigKind, e.Name), "spec"
+		if doc == nil {
+			doc, path = l.first(ClusterConfigurationKind, ""), ""
+		}
+		if doc == nil {
+			continue
+		}
+		l.report(doc, path, LintSeverityError, LintRuleCNIBootstrap, strings.Join(e.Messages, "; "))
+	}
+}
+
+func (l *linter) first(kind, name string) *lintDocument {
+	for _, doc := range l.docs {
+		if doc.index.Kind == kind && doc.index.Metadata.Name == name {
+			return doc
+		}
+	}
+	return nil
+}
+
+func (l *linter) report(doc *lintDocument, path string, severity LintSeverity, rule, message string) {
+	l.reportValue(doc, path, nil, severity, rule, message)
+}
+
+// reportValue is report for a diagnostic about the given value at path, it
+// helps to locate the value when path is ambiguous.
+func (l *linter) reportValue(doc *lintDocument, path string, value any, severity LintSeverity, rule, message string) {
+	l.diagnostics = append(l.diagnostics, LintDiagnostic{
+		File:     doc.file,
+		Range:    lintPathRange(doc.node, path, value),
+		Severity: severity,
+		Rule:     rule,
+		Message:  message,
+		Kind:     doc.index.Kind,
+		Name:     doc.index.Metadata.Name,
+		Path:     path,
+	})
+}
+
+// lintPathRange returns the range of the deepest node of path present in the
+// document. Both "a.0.b" (schema validator) and "a[0].b" paths are accepted.
+// The schema validator drops item indexes from some paths ("groups.name"),
+// then the first item the rest of the path leads to is taken, preferring the
+// one holding value, if it is known. Document-level problems and fields
+// missing from the top level point at the document's kind.
+func lintPathRange(node *yamlv3.Node, path string, value any) LintRange {
+	segments := strings.Split(strings.NewReplacer("[", ".", "]", "").Replace(path), ".")
+	if node.Kind == yamlv3.MappingNode && mappingKeyIndex(node, segments[0]) < 0 {
+		segments = []string{"kind"}
+	}
+
+	want := ""
+	switch value.(type) {
+	case string, bool, int, int32, int64, float32, float64, json.Number:
+		want = fmt.Sprint(value)
+	}
+
+	match := resolveLintPath(nil, node, segments, want)
+
+	start := match.value
+	if match.key != nil {
+		start = match.key
+	}
+	r := LintRange{
+		StartLine:   start.Line,
+		StartColumn: start.Column,
+		EndLine:     start.Line,
+		EndColumn:   start.Column + len(start.Value),
+	}
+	if v := match.value; v.Kind == yamlv3.ScalarNode && v.Line == start.Line && v.Style&(yamlv3.LiteralStyle|yamlv3.FoldedStyle) == 0 {
+		r.EndColumn = v.Column + len(v.Value)
+		if v.Style&(yamlv3.DoubleQuotedStyle|yamlv3.SingleQuotedStyle) != 0 {
+			r.EndColumn += 2
+		}
+	}
+	if r.EndColumn <= r.StartColumn {
+		r.EndColumn = r.StartColumn + 1
+	}
+	return r
+}
+
+type lintPathMatch struct {
+	key      *yamlv3.Node
+	value    *yamlv3.Node
+	complete bool
+}
+
+func resolveLintPath(key, node *yamlv3.Node, segments []string, want string) lintPathMatch {
+	for len(segments) > 0 && segments[0] == "" {
+		segments = segments[1:]
+	}
+	if len(segments) == 0 {
+		return lintPathMatch{key: key, value: node, complete: want == "" || node.Value == want}
+	}
+
+	partial := lintPathMatch{key: key, value: node}
+	segment := segments[0]
+
+	switch node.Kind {
+	case yamlv3.MappingNode:
+		if i := mappingKeyIndex(node, segment); i >= 0 {
+			return resolveLintPath(node.Content[i], node.Content[i+1], segments[1:], want)
+		}
+	case yamlv3.SequenceNode:
+		if i, err := strconv.Atoi(segment); err == nil {
+			if i >= 0 && i < len(node.Content) {
+				return resolveLintPath(nil, node.Content[i], segments[1:], want)
+			}
+			return partial
+		}
+		var first *lintPathMatch
+		for _, item := range node.Content {
+			m := resolveLintPath(nil, item, segments, want)
+			if m.complete {
+				return m
+			}
+			if first == nil && m.value != item {
+				first = &m
+			}
+		}
+		if first != nil {
+			return *first
+		}
+	}
+
+	return partial
+}
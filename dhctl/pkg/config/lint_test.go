@@ -0,0 +1,256 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/deckhouse/deckhouse/dhctl/pkg/app/options"
+)
+
+const testLintSchema = `
+kind: TestLintKind
+apiVersions:
+- apiVersion: test/v1
+  openAPISpec:
+    type: object
+    additionalProperties: false
+    required: [apiVersion, kind, replicas]
+    properties:
+      apiVersion:
+        type: string
+      kind:
+        type: string
+      replicas:
+        type: integer
+        minimum: 1
+      oldOption:
+        type: string
+        x-doc-deprecated: true
+      groups:
+        type: array
+        items:
+          type: object
+          properties:
+            name:
+              type: string
+              pattern: '^[a-z]+$'
+`
+
+func newLintTestStore(t *testing.T) *SchemaStore {
+	t.Helper()
+
+	store := newSchemaStore(&options.New().Global, []string{"/tmp"})
+	require.NoError(t, store.upload([]byte(testLintSchema)))
+	return store
+}
+
+type lintResult struct {
+	Line     int
+	Column   int
+	Severity LintSeverity
+	Rule     string
+	Path     string
+}
+
+func lintResults(diagnostics []LintDiagnostic) []lintResult {
+	results := make([]lintResult, 0, len(diagnostics))
+	for _, d := range diagnostics {
+		results = append(results, lintResult{
+			Line:     d.Range.StartLine,
+			Column:   d.Range.StartColumn,
+			Severity: d.Severity,
+			Rule:     d.Rule,
+			Path:     d.Path,
+		})
+	}
+	return results
+}
+
+func TestLintSchemaDiagnostics(t *testing.T) {
+	store := newLintTestStore(t)
+
+	diagnostics := Lint(context.Background(), store, []LintFile{{
+		Path: "config.yml",
+		Content: []byte(`apiVersion: test/v1
+kind: TestLintKind
+replicas: 0
+oldOption: value
+unknownField: true
+groups:
+  - name: valid
+  - name: Invalid
+---
+apiVersion: test/v1
+kind: TestLintKind
+`),
+	}})
+
+	require.Equal(t, []lintResult{
+		{Line: 3, Column: 1, Severity: LintSeverityError, Rule: LintRuleSchema, Path: "replicas"},
+		{Line: 4, Column: 1, Severity: LintSeverityWarning, Rule: LintRuleDeprecatedField, Path: "oldOption"},
+		{Line: 5, Column: 1, Severity: LintSeverityError, Rule: LintRuleSchema, Path: "unknownField"},
+		{Line: 8, Column: 5, Severity: LintSeverityError, Rule: LintRuleSchema, Path: "groups.name"},
+		{Line: 11, Column: 1, Severity: LintSeverityError, Rule: LintRuleSchema, Path: "replicas"},
+		{Line: 11, Column: 1, Severity: LintSeverityError, Rule: LintRuleDuplicateDocument, Path: ""},
+	}, lintResults(diagnostics))
+
+	require.True(t, LintHasErrors(diagnostics))
+	require.Equal(t, LintRange{StartLine: 8, StartColumn: 5, EndLine: 8, EndColumn: 18}, diagnostics[3].Range)
+}
+
+func TestLintDeprecatedField(t *testing.T) {
+	store := newLintTestStore(t)
+
+	diagnostics := Lint(context.Background(), store, []LintFile{{
+		Path: "config.yml",
+		Content: []byte(`apiVersion: test/v1
+kind: TestLintKind
+replicas: 1
+oldOption: value
+`),
+	}})
+
+	require.Equal(t, []lintResult{
+		{Line: 4, Column: 1, Severity: LintSeverityWarning, Rule: LintRuleDeprecatedField, Path: "oldOption"},
+	}, lintResults(diagnostics))
+	require.False(t, LintHasErrors(diagnostics))
+}
+
+func TestLintInvalidYAML(t *testing.T) {
+	store := newLintTestStore(t)
+
+	diagnostics := Lint(context.Background(), store, []LintFile{{
+		Path:    "broken.yml",
+		Content: []byte("apiVersion: test/v1\nkind: TestLintKind\nreplicas: [1\n"),
+	}, {
+		Path:    "nokind.yml",
+		Content: []byte("replicas: 1\n"),
+	}})
+
+	require.Len(t, diagnostics, 2)
+	require.Equal(t, LintRuleInvalidYAML, diagnostics[0].Rule)
+	require.Equal(t, "broken.yml", diagnostics[0].File)
+	require.Equal(t, LintRuleMissingKind, diagnostics[1].Rule)
+	require.Equal(t, "nokind.yml", diagnostics[1].File)
+}
+
+func TestLintCrossDocument(t *testing.T) {
+	store := newLintTestStore(t)
+
+	diagnostics := Lint(context.Background(), store, []LintFile{{
+		Path: "cluster.yml",
+		Content: []byte(`apiVersion: deckhouse.io/v1
+kind: ClusterConfiguration
+clusterType: Static
+clusterDomain: company.my
+podSubnetCIDR: 10.111.0.0/16
+serviceSubnetCIDR: 10.111.128.0/17
+---
+apiVersion: deckhouse.io/v1
+kind: StaticClusterConfiguration
+internalNetworkCIDRs:
+  - 192.168.0.0/24
+  - 10.111.0.0/24
+`),
+	}, {
+		Path: "modules.yml",
+		Content: []byte(`apiVersion: deckhouse.io/v1alpha1
+kind: ModuleConfig
+metadata:
+  name: global
+spec:
+  version: 2
+  settings:
+    modules:
+      publicDomainTemplate: "%s.kube.company.my"
+`),
+	}})
+
+	// the test store has no schemas for these kinds, only cross-document rules matter
+	var crossDocument []lintResult
+	for _, r := range lintResults(diagnostics) {
+		if r.Rule == LintRuleSubnetOverlap || r.Rule == LintRulePublicDomain {
+			crossDocument = append(crossDocument, r)
+		}
+	}
+
+	require.Equal(t, []lintResult{
+		{Line: 6, Column: 1, Severity: LintSeverityError, Rule: LintRuleSubnetOverlap, Path: "serviceSubnetCIDR"},
+		{Line: 12, Column: 5, Severity: LintSeverityError, Rule: LintRuleSubnetOverlap, Path: "internalNetworkCIDRs.1"},
+		{Line: 9, Column: 7, Severity: LintSeverityError, Rule: LintRulePublicDomain, Path: "spec.settings.modules.publicDomainTemplate"},
+	}, crossDocument)
+}
+
+func TestLintSARIF(t *testing.T) {
+	data, err := LintSARIF([]LintDiagnostic{{
+		File:     "dir/config.yml",
+		Range:    LintRange{StartLine: 3, StartColumn: 1, EndLine: 3, EndColumn: 12},
+		Severity: LintSeverityError,
+		Rule:     LintRuleSchema,
+		Message:  "replicas in body should be greater than or equal to 1",
+		Kind:     "TestLintKind",
+	}}, "v1.0.0")
+	require.NoError(t, err)
+
+	var log struct {
+		Version string `json:"version"`
+		Runs    []struct {
+			Tool struct {
+				Driver struct {
+					Name  string `json:"name"`
+					Rules []struct {
+						ID string `json:"id"`
+					} `json:"rules"`
+				} `json:"driver"`
+			} `json:"tool"`
+			Results []struct {
+				RuleID    string `json:"ruleId"`
+				RuleIndex int    `json:"ruleIndex"`
+				Level     string `json:"level"`
+				Message   struct {
+					Text string `json:"text"`
+				} `json:"message"`
+				Locations []struct {
+					PhysicalLocation struct {
+						ArtifactLocation struct {
+							URI string `json:"uri"`
+						} `json:"artifactLocation"`
+						Region LintRange `json:"region"`
+					} `json:"physicalLocation"`
+				} `json:"locations"`
+			} `json:"results"`
+		} `json:"runs"`
+	}
+	require.NoError(t, json.Unmarshal(data, &log))
+
+	require.Equal(t, "2.1.0", log.Version)
+	require.Len(t, log.Runs, 1)
+	require.Equal(t, "dhctl", log.Runs[0].Tool.Driver.Name)
+	require.Len(t, log.Runs[0].Tool.Driver.Rules, len(LintRules))
+
+	require.Len(t, log.Runs[0].Results, 1)
+	result := log.Runs[0].Results[0]
+	require.Equal(t, LintRuleSchema, result.RuleID)
+	require.Equal(t, LintRuleSchema, log.Runs[0].Tool.Driver.Rules[result.RuleIndex].ID)
+	require.Equal(t, "error", result.Level)
+	require.Equal(t, "TestLintKind: replicas in body should be greater than or equal to 1", result.Message.Text)
+	require.Equal(t, "dir/config.yml", result.Locations[0].PhysicalLocation.ArtifactLocation.URI)
+	require.Equal(t, LintRange{StartLine: 3, StartColumn: 1, EndLine: 3, EndColumn: 12}, result.Locations[0].PhysicalLocation.Region)
+}
@@ -51,6 +51,7 @@ type SchemaStore struct {
 	conversionsStore   *conversion.ConversionsStore
 	providerDigests    map[string]string
 	providerIndexes    map[string][]SchemaIndex
+	migrations         map[string][]MigrationRule
 }
 
 var (
@@ -68,6 +69,7 @@ type validateOptions struct {
 	skipSchemaValidation bool
 	operation            string
 	downloadRootDir      string
+	onDeprecatedField    func(path string)
 }
 
 type ValidateOption func(o *validateOptions)
@@ -138,6 +140,15 @@ func ValidateOptionDownloadRootDir(dir string) ValidateOption {
 	}
 }
 
+// ValidateOptionOnDeprecatedField reports deprecated fields set in the
+// document to fn instead of logging a warning. Paths are relative to the
+// validated object: the document itself or, for a ModuleConfig, its settings.
+func ValidateOptionOnDeprecatedField(fn func(path string)) ValidateOption {
+	return func(o *validateOptions) {
+		o.onDeprecatedField = fn
+	}
+}
+
 func NewSchemaStore(globalOptions *options.GlobalOptions, paths ...string) *SchemaStore {
 	// fallback to default value
 	candiDir := options.DefaultCandiDir
@@ -191,6 +202,7 @@ func newSchemaStore(globalOptions *options.GlobalOptions, schemasDir []string) *
 		modulesCache:       make(map[string]struct{}),
 		providerDigests:    make(map[string]string),
 		providerIndexes:    make(map[string][]SchemaIndex),
+		migrations:         make(map[string][]MigrationRule),
 	}
 
 	st.conversionsStore = conversion.NewConversionsStore()
@@ -449,7 +461,11 @@ func (s *SchemaStore) ValidateWithIndex(index *SchemaIndex, doc *[]byte, opts ..
 
 	schema = transformer.TransformSchema(schema, &transformer.AdditionalPropertiesTransformer{})
 
-	warnDeprecatedFields(ctx, index, extractMetadataName(*doc), docForValidate, schema)
+	if options.onDeprecatedField != nil {
+		walkDeprecatedFields("", docForValidate, schema, options.onDeprecatedField)
+	} else {
+		warnDeprecatedFields(ctx, index, extractMetadataName(*doc), docForValidate, schema)
+	}
 
 	isValid, err := openAPIValidate(&docForValidate, schema, options)
 	if !isValid {
@@ -474,7 +490,7 @@ func (s *SchemaStore) UploadByPath(path string) error {
 }
 
 func (s *SchemaStore) upload(fileContent []byte) error {
-	parsed, err := parseOpenAPISchemas(fileContent)
+	parsed, migrations, err := parseOpenAPISchemas(fileContent)
 	if err != nil {
 		return err
 	}
@@ -484,14 +500,28 @@ func (s *SchemaStore) upload(fileContent []byte) error {
 	for index, schema := range parsed {
 		s.cache[index] = schema
 	}
+	for kind, rules := range migrations {
+		s.migrations[kind] = rules
+	}
 
 	return nil
 }
 
-func parseOpenAPISchemas(fileContent []byte) (map[SchemaIndex]*spec.Schema, error) {
+func parseOpenAPISchemas(fileContent []byte) (map[SchemaIndex]*spec.Schema, map[string][]MigrationRule, error) {
 	openAPISchema := new(OpenAPISchema)
 	if err := yaml.UnmarshalStrict(fileContent, openAPISchema); err != nil {
-		return nil, fmt.Errorf("json unmarshal: %v", err)
+		return nil, nil, fmt.Errorf("json unmarshal: %v", err)
+	}
+
+	for i := range openAPISchema.Migrations {
+		if err := openAPISchema.Migrations[i].Validate(); err != nil {
+			return nil, nil, fmt.Errorf("%s migration #%d: %w", openAPISchema.Kind, i, err)
+		}
+	}
+
+	var migrations map[string][]MigrationRule
+	if len(openAPISchema.Migrations) > 0 {
+		migrations = map[string][]MigrationRule{openAPISchema.Kind: openAPISchema.Migrations}
 	}
 
 	result := make(map[SchemaIndex]*spec.Schema, len(openAPISchema.Versions))
@@ -500,15 +530,15 @@ func parseOpenAPISchemas(fileContent []byte) (map[SchemaIndex]*spec.Schema, erro
 
 		d, err := json.Marshal(parsedSchema.Schema)
 		if err != nil {
-			return nil, fmt.Errorf("expand the schema: %v", err)
+			return nil, nil, fmt.Errorf("expand the schema: %v", err)
 		}
 
 		if err := json.Unmarshal(d, schema); err != nil {
-			return nil, fmt.Errorf("json marshal: %v", err)
+			return nil, nil, fmt.Errorf("json marshal: %v", err)
 		}
 
 		if err := spec.ExpandSchema(schema, schema, nil); err != nil {
-			return nil, fmt.Errorf("expand the schema: %v", err)
+			return nil, nil, fmt.Errorf("expand the schema: %v", err)
 		}
 
 		schema = transformer.TransformSchema(
@@ -519,7 +549,7 @@ func parseOpenAPISchemas(fileContent []byte) (map[SchemaIndex]*spec.Schema, erro
 		result[SchemaIndex{Kind: openAPISchema.Kind, Version: parsedSchema.Version}] = schema
 	}
 
-	return result, nil
+	return result, migrations, nil
 }
 
 // schemaFileNames lists the OpenAPI schema files dhctl loads from candi trees
@@ -559,6 +589,7 @@ func (s *SchemaStore) LoadProviderDir(provider, digest, dir string) error {
 	}
 
 	parsed := make(map[SchemaIndex]*spec.Schema)
+	migrations := make(map[string][]MigrationRule)
 	walkFunc := func(path string, info os.FileInfo, err error) error {
 		if err != nil || info == nil {
 			return err
@@ -570,13 +601,16 @@ func (s *SchemaStore) LoadProviderDir(provider, digest, dir string) error {
 		if err != nil {
 			return fmt.Errorf("read schema file: %w", err)
 		}
-		schemas, err := parseOpenAPISchemas(content)
+		schemas, rules, err := parseOpenAPISchemas(content)
 		if err != nil {
 			return fmt.Errorf("parse schema file %s: %w", path, err)
 		}
 		for index, schema := range schemas {
 			parsed[index] = schema
 		}
+		for kind, kindRules := range rules {
+			migrations[kind] = kindRules
+		}
 		return nil
 	}
 	if err := filepath.Walk(dir, walkFunc); err != nil {
@@ -599,6 +633,9 @@ func (s *SchemaStore) LoadProviderDir(provider, digest, dir string) error {
 	for index, schema := range parsed {
 		s.cache[index] = schema
 	}
+	for kind, rules := range migrations {
+		s.migrations[kind] = rules
+	}
 	s.providerIndexes[provider] = indexes
 	s.providerDigests[provider] = digest
 	return nil
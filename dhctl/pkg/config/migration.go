@@ -0,0 +1,559 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	yamlv3 "gopkg.in/yaml.v3"
+	"sigs.k8s.io/yaml"
+
+	"github.com/deckhouse/deckhouse/dhctl/pkg/util/input"
+)
+
+// MigrationOperationType is the kind of rewrite a MigrationOperation performs.
+type MigrationOperationType string
+
+const (
+	// MigrationRename renames the last key of Path to To within the same object.
+	MigrationRename MigrationOperationType = "Rename"
+	// MigrationMove moves the value at Path to the absolute path To.
+	MigrationMove MigrationOperationType = "Move"
+	// MigrationConvert replaces the value at Path according to Values.
+	MigrationConvert MigrationOperationType = "Convert"
+	// MigrationDrop removes the value at Path.
+	MigrationDrop MigrationOperationType = "Drop"
+)
+
+// MigrationRule is a declarative migration shipped next to a kind's openapi
+// schema (the "migrations" list of the schema file). Rules are applied in the
+// order they are listed. A rule with FromVersion applies only to documents of
+// that apiVersion; after its operations run, the document's apiVersion is
+// bumped to ToVersion, if set, so rules for consecutive versions chain.
+type MigrationRule struct {
+	Name        string               `json:"name"`
+	FromVersion string               `json:"fromVersion,omitempty"`
+	ToVersion   string               `json:"toVersion,omitempty"`
+	Operations  []MigrationOperation `json:"operations,omitempty"`
+}
+
+// MigrationOperation is a single rewrite of a document. Path is a dotted path
+// from the document root; a segment suffixed with "[]" (e.g. "nodeGroups[]")
+// applies the rest of the path to every item of that array.
+type MigrationOperation struct {
+	Type   MigrationOperationType `json:"type"`
+	Path   string                 `json:"path"`
+	To     string                 `json:"to,omitempty"`
+	Values []MigrationValue       `json:"values,omitempty"`
+}
+
+// MigrationValue maps an old value to its replacement for MigrationConvert.
+type MigrationValue struct {
+	From any `json:"from"`
+	To   any `json:"to"`
+}
+
+// MigrationChange describes one rewrite actually applied to a document.
+type MigrationChange struct {
+	Index       SchemaIndex
+	Name        string
+	Rule        string
+	Description string
+}
+
+func (c MigrationChange) String() string {
+	target := c.Index.Kind
+	if c.Name != "" {
+		target = fmt.Sprintf("%s %s", target, c.Name)
+	}
+	if c.Rule == "" {
+		return fmt.Sprintf("%s: %s", target, c.Description)
+	}
+	return fmt.Sprintf("%s: %s (%s)", target, c.Description, c.Rule)
+}
+
+func (r *MigrationRule) Validate() error {
+	if r.Name == "" {
+		return errors.New("name is required")
+	}
+	if len(r.Operations) == 0 && r.ToVersion == "" {
+		return fmt.Errorf("rule %q: either operations or toVersion must be set", r.Name)
+	}
+	for i, op := range r.Operations {
+		if err := op.validate(); err != nil {
+			return fmt.Errorf("rule %q operation #%d: %w", r.Name, i, err)
+		}
+	}
+	return nil
+}
+
+func (o *MigrationOperation) validate() error {
+	segments, err := splitMigrationPath(o.Path)
+	if err != nil {
+		return err
+	}
+	last := segments[len(segments)-1]
+
+	switch o.Type {
+	case MigrationRename:
+		if o.To == "" || strings.ContainsAny(o.To, ".[]") {
+			return fmt.Errorf("rename of %q needs a plain field name in 'to'", o.Path)
+		}
+		if strings.HasSuffix(last, "[]") {
+			return fmt.Errorf("rename of %q: the last segment cannot iterate an array", o.Path)
+		}
+	case MigrationMove:
+		if o.To == "" {
+			return fmt.Errorf("move of %q needs a destination in 'to'", o.Path)
+		}
+		if _, err := splitMigrationPath(o.To); err != nil {
+			return err
+		}
+		if strings.Contains(o.Path, "[]") || strings.Contains(o.To, "[]") {
+			return fmt.Errorf("move of %q: paths cannot iterate arrays", o.Path)
+		}
+	case MigrationConvert:
+		if len(o.Values) == 0 {
+			return fmt.Errorf("convert of %q needs at least one value mapping", o.Path)
+		}
+	case MigrationDrop:
+		if strings.HasSuffix(last, "[]") {
+			return fmt.Errorf("drop of %q: the last segment cannot iterate an array", o.Path)
+		}
+	default:
+		return fmt.Errorf("unknown operation type %q", o.Type)
+	}
+
+	return nil
+}
+
+func splitMigrationPath(path string) ([]string, error) {
+	if path == "" {
+		return nil, errors.New("path is required")
+	}
+	segments := strings.Split(path, ".")
+	for _, segment := range segments {
+		if strings.TrimSuffix(segment, "[]") == "" {
+			return nil, fmt.Errorf("path %q has an empty segment", path)
+		}
+	}
+	return segments, nil
+}
+
+// Migrations returns the migration rules loaded for kind.
+func (s *SchemaStore) Migrations(kind string) []MigrationRule {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.migrations[kind]
+}
+
+// MigrateDocuments rewrites every document of a multi-document YAML with the
+// migration rules of its kind and, for ModuleConfigs, converts settings to
+// the latest version of the module. Documents nothing applies to are kept as
+// is, comments and key order of migrated documents are preserved. If no
+// change was made, data is returned unchanged.
+func (s *SchemaStore) MigrateDocuments(data []byte) ([]byte, []MigrationChange, error) {
+	docs := input.YAMLSplitRegexp.Split(strings.TrimSpace(string(data)), -1)
+
+	var changes []MigrationChange
+	result := make([]string, 0, len(docs))
+	for _, doc := range docs {
+		doc = strings.TrimSpace(doc)
+		if doc == "" {
+			continue
+		}
+
+		migrated, docChanges, err := s.migrateDocument([]byte(doc))
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(docChanges) == 0 {
+			result = append(result, doc)
+			continue
+		}
+
+		changes = append(changes, docChanges...)
+		result = append(result, strings.TrimSpace(string(migrated)))
+	}
+
+	if len(changes) == 0 {
+		return data, nil, nil
+	}
+
+	return []byte(strings.Join(result, "\n---\n") + "\n"), changes, nil
+}
+
+func (s *SchemaStore) migrateDocument(doc []byte) ([]byte, []MigrationChange, error) {
+	var index SchemaIndex
+	if err := yaml.Unmarshal(doc, &index); err != nil || !index.IsValid() {
+		// not a config document, e.g. a plain resource or a comment-only doc
+		return nil, nil, nil
+	}
+
+	var root yamlv3.Node
+	if err := yamlv3.Unmarshal(doc, &root); err != nil {
+		return nil, nil, fmt.Errorf("parse %s document: %w", index.String(), err)
+	}
+	if root.Kind != yamlv3.DocumentNode || len(root.Content) == 0 || root.Content[0].Kind != yamlv3.MappingNode {
+		return nil, nil, nil
+	}
+	obj := root.Content[0]
+
+	var (
+		changes []MigrationChange
+		err     error
+	)
+	if index.Kind == ModuleConfigKind {
+		changes, err = s.migrateModuleConfig(obj, index)
+	} else {
+		changes, err = s.applyMigrationRules(obj, index)
+	}
+	if err != nil || len(changes) == 0 {
+		return nil, nil, err
+	}
+
+	var buf bytes.Buffer
+	enc := yamlv3.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(&root); err != nil {
+		return nil, nil, fmt.Errorf("encode migrated %s document: %w", index.String(), err)
+	}
+	if err := enc.Close(); err != nil {
+		return nil, nil, fmt.Errorf("encode migrated %s document: %w", index.String(), err)
+	}
+
+	return buf.Bytes(), changes, nil
+}
+
+func (s *SchemaStore) applyMigrationRules(obj *yamlv3.Node, index SchemaIndex) ([]MigrationChange, error) {
+	var changes []MigrationChange
+	version := index.Version
+
+	for _, rule := range s.Migrations(index.Kind) {
+		if rule.FromVersion != "" && rule.FromVersion != version {
+			continue
+		}
+
+		newChange := func(description string) MigrationChange {
+			return MigrationChange{
+				Index:       SchemaIndex{Kind: index.Kind, Version: version},
+				Rule:        rule.Name,
+				Description: description,
+			}
+		}
+
+		for _, op := range rule.Operations {
+			descriptions, err := applyMigrationOperation(obj, op)
+			if err != nil {
+				return nil, fmt.Errorf("%s migration %q: %w", index.Kind, rule.Name, err)
+			}
+			for _, description := range descriptions {
+				changes = append(changes, newChange(description))
+			}
+		}
+
+		if rule.ToVersion == "" || rule.ToVersion == version {
+			continue
+		}
+		if s.Get(&SchemaIndex{Kind: index.Kind, Version: rule.ToVersion}) == nil {
+			return nil, fmt.Errorf("%s migration %q: no schema for target version %s", index.Kind, rule.Name, rule.ToVersion)
+		}
+		setMappingScalar(obj, "apiVersion", rule.ToVersion)
+		changes = append(changes, newChange(fmt.Sprintf("apiVersion %s -> %s", version, rule.ToVersion)))
+		version = rule.ToVersion
+	}
+
+	return changes, nil
+}
+
+func (s *SchemaStore) migrateModuleConfig(obj *yamlv3.Node, index SchemaIndex) ([]MigrationChange, error) {
+	name := ""
+	if metadata := mappingValue(obj, "metadata"); metadata != nil {
+		if n := mappingValue(metadata, "name"); n != nil {
+			name = n.Value
+		}
+	}
+	specNode := mappingValue(obj, "spec")
+	if name == "" || specNode == nil || specNode.Kind != yamlv3.MappingNode {
+		return nil, nil
+	}
+	versionNode := mappingValue(specNode, "version")
+	settingsNode := mappingValue(specNode, "settings")
+	if versionNode == nil || settingsNode == nil {
+		return nil, nil
+	}
+
+	version, err := strconv.Atoi(versionNode.Value)
+	if err != nil {
+		return nil, fmt.Errorf("ModuleConfig %s: spec.version %q is not a number", name, versionNode.Value)
+	}
+
+	converter := s.conversionsStore.Get(name)
+	latest := converter.LatestVersion()
+	if version < 1 || version >= latest {
+		return nil, nil
+	}
+
+	var settings map[string]any
+	if err := settingsNode.Decode(&settings); err != nil {
+		return nil, fmt.Errorf("ModuleConfig %s: decode settings: %w", name, err)
+	}
+	_, converted, err := converter.ConvertToLatest(version, settings)
+	if err != nil {
+		return nil, fmt.Errorf("ModuleConfig %s: convert settings from version %d: %w", name, version, err)
+	}
+
+	if err := replaceNodeValue(settingsNode, converted); err != nil {
+		return nil, fmt.Errorf("ModuleConfig %s: %w", name, err)
+	}
+	setMappingScalar(specNode, "version", strconv.Itoa(latest))
+
+	return []MigrationChange{{
+		Index:       index,
+		Name:        name,
+		Description: fmt.Sprintf("settings converted from version %d to %d", version, latest),
+	}}, nil
+}
+
+func applyMigrationOperation(obj *yamlv3.Node, op MigrationOperation) ([]string, error) {
+	segments, err := splitMigrationPath(op.Path)
+	if err != nil {
+		return nil, err
+	}
+	parents := resolveMigrationPath([]*yamlv3.Node{obj}, segments[:len(segments)-1])
+	last := segments[len(segments)-1]
+
+	var descriptions []string
+	switch op.Type {
+	case MigrationDrop:
+		for _, parent := range parents {
+			if i := mappingKeyIndex(parent, last); i >= 0 {
+				parent.Content = append(parent.Content[:i], parent.Content[i+2:]...)
+				descriptions = append(descriptions, fmt.Sprintf("dropped %s", op.Path))
+			}
+		}
+
+	case MigrationRename:
+		for _, parent := range parents {
+			i := mappingKeyIndex(parent, last)
+			if i < 0 {
+				continue
+			}
+			if mappingKeyIndex(parent, op.To) >= 0 {
+				return nil, fmt.Errorf("cannot rename %s: %s is already set", op.Path, op.To)
+			}
+			parent.Content[i].Value = op.To
+			descriptions = append(descriptions, fmt.Sprintf("renamed %s to %s", op.Path, op.To))
+		}
+
+	case MigrationMove:
+		// validate guarantees no array iteration, so there is at most one parent
+		if len(parents) == 0 {
+			return nil, nil
+		}
+		parent := parents[0]
+		i := mappingKeyIndex(parent, last)
+		if i < 0 {
+			return nil, nil
+		}
+
+		toSegments, err := splitMigrationPath(op.To)
+		if err != nil {
+			return nil, err
+		}
+		destination, err := ensureMappingPath(obj, toSegments[:len(toSegments)-1])
+		if err != nil {
+			return nil, fmt.Errorf("cannot move %s to %s: %w", op.Path, op.To, err)
+		}
+		toKey := toSegments[len(toSegments)-1]
+		if mappingKeyIndex(destination, toKey) >= 0 {
+			return nil, fmt.Errorf("cannot move %s: %s is already set", op.Path, op.To)
+		}
+
+		key, value := parent.Content[i], parent.Content[i+1]
+		parent.Content = append(parent.Content[:i], parent.Content[i+2:]...)
+		key.Value = toKey
+		destination.Content = append(destination.Content, key, value)
+		descriptions = append(descriptions, fmt.Sprintf("moved %s to %s", op.Path, op.To))
+
+	case MigrationConvert:
+		field, iterate := strings.CutSuffix(last, "[]")
+		for _, parent := range parents {
+			value := mappingValue(parent, field)
+			if value == nil {
+				continue
+			}
+			targets := []*yamlv3.Node{value}
+			if iterate {
+				if value.Kind != yamlv3.SequenceNode {
+					continue
+				}
+				targets = value.Content
+			}
+			for _, target := range targets {
+				converted, err := convertNodeValue(target, op.Values)
+				if err != nil {
+					return nil, fmt.Errorf("convert %s: %w", op.Path, err)
+				}
+				if converted != "" {
+					descriptions = append(descriptions, fmt.Sprintf("converted %s %s", op.Path, converted))
+				}
+			}
+		}
+
+	default:
+		return nil, fmt.Errorf("unknown operation type %q", op.Type)
+	}
+
+	return descriptions, nil
+}
+
+// resolveMigrationPath walks segments from nodes and returns the mappings
+// found at the end of the path. Missing fields are skipped rather than
+// reported: a rule only touches documents that actually use the old layout.
+func resolveMigrationPath(nodes []*yamlv3.Node, segments []string) []*yamlv3.Node {
+	for _, segment := range segments {
+		field, iterate := strings.CutSuffix(segment, "[]")
+
+		next := make([]*yamlv3.Node, 0, len(nodes))
+		for _, node := range nodes {
+			value := mappingValue(node, field)
+			if value == nil {
+				continue
+			}
+			if !iterate {
+				next = append(next, value)
+				continue
+			}
+			if value.Kind == yamlv3.SequenceNode {
+				next = append(next, value.Content...)
+			}
+		}
+
+		nodes = next
+	}
+
+	result := make([]*yamlv3.Node, 0, len(nodes))
+	for _, node := range nodes {
+		if node.Kind == yamlv3.MappingNode {
+			result = append(result, node)
+		}
+	}
+	return result
+}
+
+func ensureMappingPath(obj *yamlv3.Node, segments []string) (*yamlv3.Node, error) {
+	node := obj
+	for _, segment := range segments {
+		value := mappingValue(node, segment)
+		if value == nil {
+			value = &yamlv3.Node{Kind: yamlv3.MappingNode, Tag: "!!map"}
+			node.Content = append(node.Content,
+				&yamlv3.Node{Kind: yamlv3.ScalarNode, Tag: "!!str", Value: segment},
+				value,
+			)
+		}
+		if value.Kind != yamlv3.MappingNode {
+			return nil, fmt.Errorf("%s is not an object", segment)
+		}
+		node = value
+	}
+	return node, nil
+}
+
+func convertNodeValue(node *yamlv3.Node, values []MigrationValue) (string, error) {
+	var current any
+	if err := node.Decode(&current); err != nil {
+		return "", err
+	}
+	currentJSON, err := json.Marshal(current)
+	if err != nil {
+		return "", err
+	}
+
+	for _, value := range values {
+		fromJSON, err := json.Marshal(value.From)
+		if err != nil {
+			return "", err
+		}
+		if !bytes.Equal(currentJSON, fromJSON) {
+			continue
+		}
+
+		toJSON, err := json.Marshal(value.To)
+		if err != nil {
+			return "", err
+		}
+		if err := replaceNodeValue(node, value.To); err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%s -> %s", fromJSON, toJSON), nil
+	}
+
+	return "", nil
+}
+
+// replaceNodeValue swaps the content of node for value, keeping the comments
+// attached to the node.
+func replaceNodeValue(node *yamlv3.Node, value any) error {
+	var replacement yamlv3.Node
+	if err := replacement.Encode(value); err != nil {
+		return fmt.Errorf("encode value: %w", err)
+	}
+	replacement.HeadComment = node.HeadComment
+	replacement.LineComment = node.LineComment
+	replacement.FootComment = node.FootComment
+	*node = replacement
+	return nil
+}
+
+func setMappingScalar(node *yamlv3.Node, key, value string) {
+	if existing := mappingValue(node, key); existing != nil {
+		existing.Kind = yamlv3.ScalarNode
+		existing.Tag = ""
+		existing.Style = 0
+		existing.Value = value
+		return
+	}
+	node.Content = append(node.Content,
+		&yamlv3.Node{Kind: yamlv3.ScalarNode, Tag: "!!str", Value: key},
+		&yamlv3.Node{Kind: yamlv3.ScalarNode, Value: value},
+	)
+}
+
+func mappingKeyIndex(node *yamlv3.Node, key string) int {
+	if node == nil || node.Kind != yamlv3.MappingNode {
+		return -1
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return i
+		}
+	}
+	return -1
+}
+
+func mappingValue(node *yamlv3.Node, key string) *yamlv3.Node {
+	i := mappingKeyIndex(node, key)
+	if i < 0 {
+		return nil
+	}
+	return node.Content[i+1]
+}
@@ -0,0 +1,244 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/deckhouse/deckhouse/dhctl/pkg/app/options"
+)
+
+const testMigrationSchema = `
+kind: TestMigrationKind
+apiVersions:
+- apiVersion: test/v1alpha1
+  openAPISpec:
+    type: object
+- apiVersion: test/v1
+  openAPISpec:
+    type: object
+migrations:
+- name: rename-prefix
+  fromVersion: test/v1alpha1
+  operations:
+  - type: Rename
+    path: cloud.prefix
+    to: namePrefix
+  - type: Move
+    path: legacyPodSubnet
+    to: network.podSubnetCIDR
+  - type: Convert
+    path: nodeGroups[].diskType
+    values:
+    - from: slow
+      to: network-hdd
+  - type: Drop
+    path: nodeGroups[].obsolete
+- name: v1
+  fromVersion: test/v1alpha1
+  toVersion: test/v1
+`
+
+func newMigrationTestStore(t *testing.T) *SchemaStore {
+	t.Helper()
+
+	store := newSchemaStore(&options.New().Global, []string{"/tmp"})
+	require.NoError(t, store.upload([]byte(testMigrationSchema)))
+	return store
+}
+
+func TestMigrateDocuments(t *testing.T) {
+	store := newMigrationTestStore(t)
+
+	input := `apiVersion: test/v1alpha1
+kind: TestMigrationKind
+# cloud settings
+cloud:
+  prefix: demo # keep me
+legacyPodSubnet: 10.111.0.0/16
+nodeGroups:
+  - name: a
+    diskType: slow
+    obsolete: true
+  - name: b
+    diskType: fast
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: untouched
+`
+
+	migrated, changes, err := store.MigrateDocuments([]byte(input))
+	require.NoError(t, err)
+
+	require.Equal(t, `apiVersion: test/v1
+kind: TestMigrationKind
+# cloud settings
+cloud:
+  namePrefix: demo # keep me
+nodeGroups:
+  - name: a
+    diskType: network-hdd
+  - name: b
+    diskType: fast
+network:
+  podSubnetCIDR: 10.111.0.0/16
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: untouched
+`, string(migrated))
+
+	descriptions := make([]string, 0, len(changes))
+	for _, change := range changes {
+		descriptions = append(descriptions, change.Description)
+	}
+	require.Equal(t, []string{
+		"renamed cloud.prefix to namePrefix",
+		"moved legacyPodSubnet to network.podSubnetCIDR",
+		`converted nodeGroups[].diskType "slow" -> "network-hdd"`,
+		"dropped nodeGroups[].obsolete",
+		"apiVersion test/v1alpha1 -> test/v1",
+	}, descriptions)
+
+	again, changes, err := store.MigrateDocuments(migrated)
+	require.NoError(t, err)
+	require.Empty(t, changes)
+	require.Equal(t, string(migrated), string(again))
+}
+
+func TestMigrateDocumentsModuleConfig(t *testing.T) {
+	store := newMigrationTestStore(t)
+
+	conversionsDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(conversionsDir, "v2.yaml"), []byte(`
+version: 2
+conversions:
+  - if .publishAPI.enable != null then .publishAPI.enabled = .publishAPI.enable | del(.publishAPI.enable) end
+`), 0o644))
+	require.NoError(t, store.conversionsStore.Add("test-module", conversionsDir))
+
+	migrated, changes, err := store.MigrateDocuments([]byte(`apiVersion: deckhouse.io/v1alpha1
+kind: ModuleConfig
+metadata:
+  name: test-module
+spec:
+  version: 1
+  enabled: true
+  settings:
+    publishAPI:
+      enable: true
+`))
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	require.Equal(t, "ModuleConfig test-module: settings converted from version 1 to 2", changes[0].String())
+	require.Equal(t, `apiVersion: deckhouse.io/v1alpha1
+kind: ModuleConfig
+metadata:
+  name: test-module
+spec:
+  version: 2
+  enabled: true
+  settings:
+    publishAPI:
+      enabled: true
+`, string(migrated))
+}
+
+func TestMigrateDocumentsConflict(t *testing.T) {
+	store := newMigrationTestStore(t)
+
+	_, _, err := store.MigrateDocuments([]byte(`apiVersion: test/v1alpha1
+kind: TestMigrationKind
+cloud:
+  prefix: old
+  namePrefix: new
+`))
+	require.ErrorContains(t, err, "namePrefix is already set")
+}
+
+func TestMigrationRuleValidate(t *testing.T) {
+	tests := map[string]struct {
+		rule    MigrationRule
+		wantErr string
+	}{
+		"no name": {
+			rule:    MigrationRule{ToVersion: "v1"},
+			wantErr: "name is required",
+		},
+		"empty rule": {
+			rule:    MigrationRule{Name: "noop"},
+			wantErr: "either operations or toVersion",
+		},
+		"rename to path": {
+			rule: MigrationRule{Name: "r", Operations: []MigrationOperation{
+				{Type: MigrationRename, Path: "a.b", To: "c.d"},
+			}},
+			wantErr: "plain field name",
+		},
+		"move through array": {
+			rule: MigrationRule{Name: "m", Operations: []MigrationOperation{
+				{Type: MigrationMove, Path: "items[].a", To: "b"},
+			}},
+			wantErr: "cannot iterate arrays",
+		},
+		"unknown type": {
+			rule: MigrationRule{Name: "u", Operations: []MigrationOperation{
+				{Type: "Copy", Path: "a"},
+			}},
+			wantErr: "unknown operation type",
+		},
+		"valid": {
+			rule: MigrationRule{Name: "ok", Operations: []MigrationOperation{
+				{Type: MigrationDrop, Path: "items[].a"},
+			}},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tt.rule.Validate()
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestUploadRejectsInvalidMigration(t *testing.T) {
+	store := newSchemaStore(&options.New().Global, []string{"/tmp"})
+
+	err := store.upload([]byte(`
+kind: TestMigrationKind
+apiVersions:
+- apiVersion: test/v1
+  openAPISpec:
+    type: object
+migrations:
+- name: broken
+  operations:
+  - type: Rename
+    path: a
+`))
+	require.ErrorContains(t, err, "TestMigrationKind migration #0")
+}
@@ -51,8 +51,9 @@ func (i *SchemaIndex) String() string {
 }
 
 type OpenAPISchema struct {
-	Kind     string                 `json:"kind"`
-	Versions []OpenAPISchemaVersion `json:"apiVersions"`
+	Kind       string                 `json:"kind"`
+	Versions   []OpenAPISchemaVersion `json:"apiVersions"`
+	Migrations []MigrationRule        `json:"migrations,omitempty"`
 }
 
 type OpenAPISchemaVersion struct {
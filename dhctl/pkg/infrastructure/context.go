@@ -19,6 +19,7 @@ import (
 	"fmt"
 
 	"github.com/deckhouse/deckhouse/dhctl/pkg/config"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/infrastructure/plan"
 	dstate "github.com/deckhouse/deckhouse/dhctl/pkg/state"
 )
 
@@ -34,6 +35,12 @@ type Context struct {
 	// defaults" — interactive prompt for cache, no extra debug output.
 	useTfCache string
 	isDebug    bool
+
+	// savedPlans are applied by converge runners instead of the new plans, see WithSavedPlans.
+	savedPlans SavedPlans
+
+	// approvalPolicy is applied by bootstrap node runners, see WithApprovalPolicy.
+	approvalPolicy *plan.ApprovalPolicy
 }
 
 // WithUseTfCache sets how Runners constructed via this Context react to a
@@ -50,6 +57,20 @@ func (f *Context) WithDebug(isDebug bool) *Context {
 	return f
 }
 
+// WithSavedPlans makes converge runners apply reviewed plans saved by converge plan.
+// Converge runners without saved plan are not allowed to make any changes.
+func (f *Context) WithSavedPlans(plans SavedPlans) *Context {
+	f.savedPlans = plans
+	return f
+}
+
+// WithApprovalPolicy makes runners of the new nodes create them only if the policy approves it.
+// Other runners get the policy from AutomaticSettings.
+func (f *Context) WithApprovalPolicy(policy *plan.ApprovalPolicy) *Context {
+	f.approvalPolicy = policy
+	return f
+}
+
 // newRunner wraps NewRunnerFromConfig with per-Context defaults
 // (useTfCache, isDebug).
 func (f *Context) newRunner(metaConfig *config.MetaConfig, stateCache dstate.Cache, executor Executor) *Runner {
@@ -126,7 +147,8 @@ func applyAutomaticSettingsForChangesRunner(r *Runner, stateChecker StateChecker
 func applyAutomaticSettings(r *Runner, settings AutomaticSettings, stateChecker StateChecker) *Runner {
 	r.WithAutoDismissDestructiveChanges(settings.AutoDismissDestructive).
 		WithAutoApprove(settings.AutoApprove).
-		WithAutoDismissChanges(settings.AutoDismissChanges)
+		WithAutoDismissChanges(settings.AutoDismissChanges).
+		WithApprovalPolicy(settings.ApprovalPolicy)
 
 	if stateChecker != nil {
 		r.WithStateChecker(stateChecker)
@@ -157,6 +179,24 @@ func applyAutomaticApproveSettings(r *Runner, settings AutoApproveSettings, stat
 	return r
 }
 
+func (f *Context) applySavedPlan(r *Runner) *Runner {
+	if f.savedPlans == nil {
+		return r
+	}
+
+	// plan was reviewed before the apply, it is the approval itself
+	if savedPlan, ok := f.savedPlans[r.name]; ok && len(savedPlan.Plan) > 0 {
+		return r.WithSavedPlan(savedPlan).
+			WithAutoDismissDestructiveChanges(false).
+			WithAutoApprove(true).
+			WithAutoDismissChanges(false)
+	}
+
+	return r.WithAutoDismissDestructiveChanges(false).
+		WithAutoApprove(false).
+		WithAutoDismissChanges(true)
+}
+
 func addProviderAfterCleanupFuncForRunner(cloudProvider CloudProvider, group string, r RunnerInterface) {
 	targetGroup := fmt.Sprintf("stopExecutorFor:%s", group)
 	cloudProvider.AddAfterCleanupFunc(targetGroup, func() {
@@ -285,6 +325,7 @@ func (f *Context) GetConvergeBaseInfraRunner(ctx context.Context, metaConfig *co
 
 	r := f.newRunner(metaConfig, opts.StateCache, executor).
 		WithSkipChangesOnDeny(true).
+		WithApprovalLayer(string(BaseInfraStep)).
 		WithVariables(metaConfig.MarshalConfig())
 	if opts.ClusterState != nil {
 		r = r.WithState(opts.ClusterState)
@@ -293,7 +334,7 @@ func (f *Context) GetConvergeBaseInfraRunner(ctx context.Context, metaConfig *co
 	r.WithAdditionalStateSaverDestination(opts.AdditionalStateSaverDestinations...)
 
 	addProviderAfterCleanupFuncForRunner(cloudProvider, "base-infrastructure", r)
-	return applyAutomaticSettings(r, automaticSettings, f.stateChecker), nil
+	return f.applySavedPlan(applyAutomaticSettings(r, automaticSettings, f.stateChecker)), nil
 }
 
 type NodeRunnerOptions struct {
@@ -324,6 +365,7 @@ func (f *Context) GetConvergeNodeRunner(ctx context.Context, metaConfig *config.
 	r := f.newRunner(metaConfig, opts.StateCache, executor).
 		WithVariables(metaConfig.NodeGroupConfig(opts.NodeGroupName, opts.NodeIndex, opts.NodeCloudConfig)).
 		WithSkipChangesOnDeny(true).
+		WithApprovalLayer(opts.NodeGroupName).
 		WithName(opts.NodeName).
 		WithHook(opts.Hook)
 
@@ -334,7 +376,7 @@ func (f *Context) GetConvergeNodeRunner(ctx context.Context, metaConfig *config.
 	r.WithAdditionalStateSaverDestination(opts.AdditionalStateSaverDestinations...)
 
 	addProviderAfterCleanupFuncForRunner(cloudProvider, opts.NodeName, r)
-	return applyAutomaticSettings(r, automaticSettings, f.stateChecker), nil
+	return f.applySavedPlan(applyAutomaticSettings(r, automaticSettings, f.stateChecker)), nil
 }
 
 type NodeDeleteRunnerOptions struct {
@@ -367,6 +409,7 @@ func (f *Context) GetConvergeNodeDeleteRunner(ctx context.Context, metaConfig *c
 		WithName(opts.NodeName).
 		WithAllowedCachedState(true).
 		WithSkipChangesOnDeny(true).
+		WithApprovalLayer(opts.NodeGroupName).
 		WithHook(opts.Hook)
 
 	if opts.NodeState != nil {
@@ -422,6 +465,8 @@ func (f *Context) GetBootstrapNodeRunner(ctx context.Context, metaConfig *config
 	r := f.newRunner(metaConfig, stateCache, executor).
 		WithVariables(metaConfig.NodeGroupConfig(opts.NodeGroupName, opts.NodeIndex, opts.NodeCloudConfig)).
 		WithName(opts.NodeName).
+		WithApprovalLayer(opts.NodeGroupName).
+		WithApprovalPolicy(f.approvalPolicy).
 		WithAdditionalStateSaverDestination(opts.AdditionalStateSaverDestinations...)
 
 	addProviderAfterCleanupFuncForRunner(cloudProvider, opts.NodeName, r)
@@ -484,3 +529,47 @@ func (f *Context) GetDestroyNodeRunner(ctx context.Context, metaConfig *config.M
 	addProviderAfterCleanupFuncForRunner(cloudProvider, opts.NodeName, r)
 	return applyAutomaticApproveSettings(r, opts.AutoApproveSettings, f.stateChecker), nil
 }
+
+func (f *Context) GetImportBaseInfraRunner(ctx context.Context, metaConfig *config.MetaConfig, stateCache dstate.Cache) (ImportRunnerInterface, error) {
+	cloudProvider, err := f.getCloudProvider(ctx, metaConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	executor, err := cloudProvider.Executor(ctx, BaseInfraStep)
+	if err != nil {
+		return nil, err
+	}
+
+	r := f.newRunner(metaConfig, stateCache, executor).
+		WithVariables(metaConfig.MarshalConfig())
+
+	addProviderAfterCleanupFuncForRunner(cloudProvider, "base-infrastructure", r)
+	return r, nil
+}
+
+type ImportNodeRunnerOptions struct {
+	NodeName      string
+	NodeGroupName string
+	NodeGroupStep Step
+	NodeIndex     int
+}
+
+func (f *Context) GetImportNodeRunner(ctx context.Context, metaConfig *config.MetaConfig, stateCache dstate.Cache, opts ImportNodeRunnerOptions) (ImportRunnerInterface, error) {
+	cloudProvider, err := f.getCloudProvider(ctx, metaConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	executor, err := cloudProvider.Executor(ctx, opts.NodeGroupStep)
+	if err != nil {
+		return nil, err
+	}
+
+	r := f.newRunner(metaConfig, stateCache, executor).
+		WithVariables(metaConfig.NodeGroupConfig(opts.NodeGroupName, opts.NodeIndex, "")).
+		WithName(opts.NodeName)
+
+	addProviderAfterCleanupFuncForRunner(cloudProvider, opts.NodeName, r)
+	return r, nil
+}
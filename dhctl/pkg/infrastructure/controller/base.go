@@ -22,6 +22,9 @@ import (
 	"github.com/deckhouse/deckhouse/dhctl/pkg/state"
 )
 
+// BaseInfrastructureStateName is the key of the base infrastructure state in the state cache.
+const BaseInfrastructureStateName = "base-infrastructure.tfstate"
+
 type BaseInfraController struct {
 	metaConfig            *config.MetaConfig
 	stateCache            state.Cache
@@ -37,7 +40,7 @@ func NewBaseInfraController(metaConfig *config.MetaConfig, stateCache state.Cach
 }
 
 func (r *BaseInfraController) Destroy(ctx context.Context, clusterState []byte, autoApprove bool) error {
-	if err := saveInCacheIfNotExists(ctx, r.stateCache, "base-infrastructure.tfstate", clusterState); err != nil {
+	if err := saveInCacheIfNotExists(ctx, r.stateCache, BaseInfrastructureStateName, clusterState); err != nil {
 		return err
 	}
 
@@ -22,6 +22,7 @@ import (
 
 	"github.com/deckhouse/deckhouse/dhctl/pkg/app/options"
 	"github.com/deckhouse/deckhouse/dhctl/pkg/config"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/global"
 	"github.com/deckhouse/deckhouse/dhctl/pkg/infrastructure"
 	"github.com/deckhouse/deckhouse/dhctl/pkg/infrastructureprovider"
 	"github.com/deckhouse/deckhouse/dhctl/pkg/infrastructureprovider/cloud"
@@ -42,6 +43,39 @@ type BaseInfraControllerInterface interface {
 	Destroy(clusterState []byte, sanityCheck bool) error
 }
 
+// DestroyScope limits the destroyed part of the cluster infrastructure.
+// Zero value means the whole infrastructure.
+type DestroyScope struct {
+	// NodeGroup destroys nodes of the node group only, base infrastructure is kept.
+	NodeGroup string
+	// KeepBaseInfrastructure destroys nodes only and keeps base infrastructure (networks, NAT, etc.) for reuse.
+	KeepBaseInfrastructure bool
+	// AllowConfiguredNodeGroup allows destroying NodeGroup which is still present in the provider cluster configuration,
+	// converge creates it again in this case.
+	AllowConfiguredNodeGroup bool
+}
+
+func (s DestroyScope) Validate() error {
+	if s.NodeGroup == global.MasterNodeGroupName {
+		return fmt.Errorf("%s node group cannot be destroyed separately, destroy the whole cluster instead", global.MasterNodeGroupName)
+	}
+
+	return nil
+}
+
+// IsFull returns true if the whole cluster is destroyed.
+func (s DestroyScope) IsFull() bool {
+	return s.NodeGroup == "" && !s.KeepBaseInfrastructure
+}
+
+func (s DestroyScope) IncludesNodeGroup(name string) bool {
+	return s.NodeGroup == "" || s.NodeGroup == name
+}
+
+func (s DestroyScope) IncludesBaseInfrastructure() bool {
+	return s.NodeGroup == "" && !s.KeepBaseInfrastructure
+}
+
 type ClusterInfra struct {
 	stateLoader           StateLoader
 	cache                 state.Cache
@@ -50,6 +84,7 @@ type ClusterInfra struct {
 	tmpDir        string
 	isDebug       bool
 	globalOptions *options.GlobalOptions
+	scope         DestroyScope
 
 	PhasedExecutionContext phases.DefaultPhasedExecutionContext
 }
@@ -59,6 +94,7 @@ type ClusterInfraOptions struct {
 	TmpDir                 string
 	IsDebug                bool
 	GlobalOptions          *options.GlobalOptions
+	Scope                  DestroyScope
 }
 
 func NewClusterInfraWithOptions(terraState StateLoader, cache state.Cache, infrastructureContext *infrastructure.Context, opts ClusterInfraOptions) *ClusterInfra {
@@ -71,6 +107,7 @@ func NewClusterInfraWithOptions(terraState StateLoader, cache state.Cache, infra
 		tmpDir:                 opts.TmpDir,
 		isDebug:                opts.IsDebug,
 		globalOptions:          opts.GlobalOptions,
+		scope:                  opts.Scope,
 	}
 }
 
@@ -112,6 +149,12 @@ func (r *ClusterInfra) DestroyCluster(ctx context.Context, autoApprove bool) err
 		return err
 	}
 
+	if r.scope.NodeGroup != "" {
+		if _, ok := nodesState[r.scope.NodeGroup]; !ok {
+			return fmt.Errorf("Node group %s not found in infrastructure states", r.scope.NodeGroup)
+		}
+	}
+
 	if r.PhasedExecutionContext != nil {
 		if shouldStop, err := r.PhasedExecutionContext.StartPhase(ctx, phases.AllNodesPhase, true, r.cache); err != nil {
 			return err
@@ -121,6 +164,10 @@ func (r *ClusterInfra) DestroyCluster(ctx context.Context, autoApprove bool) err
 	}
 
 	for nodeGroupName, nodeGroupStates := range nodesState {
+		if !r.scope.IncludesNodeGroup(nodeGroupName) {
+			continue
+		}
+
 		ngController, err := NewNodesController(ctx, metaConfig, r.cache, nodeGroupName, nodeGroupStates.Settings, r.infrastructureContext)
 		if err != nil {
 			return err
@@ -133,6 +180,20 @@ func (r *ClusterInfra) DestroyCluster(ctx context.Context, autoApprove bool) err
 		}
 	}
 
+	if !r.scope.IncludesBaseInfrastructure() {
+		// state is kept in the cache for reuse of the base infrastructure
+		if err := saveInCacheIfNotExists(ctx, r.cache, BaseInfrastructureStateName, clusterState); err != nil {
+			return err
+		}
+
+		dhlog.FromContext(ctx).InfoContext(ctx, "Base infrastructure is kept")
+
+		if r.PhasedExecutionContext != nil {
+			return r.PhasedExecutionContext.CompletePhase(ctx, r.cache, nil)
+		}
+		return nil
+	}
+
 	if r.PhasedExecutionContext != nil {
 		if shouldStop, err := r.PhasedExecutionContext.SwitchPhase(ctx, phases.BaseInfraPhase, true, r.cache, nil); err != nil {
 			return err
@@ -96,6 +96,31 @@ type Executor interface {
 	Stop()
 }
 
+// SchemaExecutor is implemented by executors which can show schemas of the providers
+// in the "providers schema -json" format. Executor should be initialized before the call.
+type SchemaExecutor interface {
+	ProvidersSchema(ctx context.Context) ([]byte, error)
+}
+
+type ImportOpts struct {
+	StatePath     string
+	VariablesPath string
+	Address       string
+	ID            string
+}
+
+type RefreshOpts struct {
+	StatePath     string
+	VariablesPath string
+}
+
+// ImportExecutor is implemented by executors which can adopt existing resources into the state.
+type ImportExecutor interface {
+	Import(ctx context.Context, opts ImportOpts) error
+	// Refresh updates the state and outputs from the real resources without changing them.
+	Refresh(ctx context.Context, opts RefreshOpts) error
+}
+
 type fakeResponse struct {
 	err  error
 	code int
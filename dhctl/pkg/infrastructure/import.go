@@ -0,0 +1,209 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	otattribute "go.opentelemetry.io/otel/attribute"
+
+	dhlog "github.com/deckhouse/lib-dhctl/pkg/logger"
+
+	"github.com/deckhouse/deckhouse/dhctl/pkg/app/options"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/telemetry"
+)
+
+// ImportResource maps the resource address in the infrastructure configuration to the id of the existing cloud resource.
+type ImportResource struct {
+	Address string `json:"address"`
+	ID      string `json:"id"`
+}
+
+type ImportRunnerInterface interface {
+	RunnerInterface
+
+	Import(ctx context.Context, resources []ImportResource) error
+}
+
+var _ ImportRunnerInterface = &Runner{}
+
+// Import adopts existing resources into the runner state and refreshes the state outputs.
+// Resources which are already in the state are skipped, so import can be continued after a failure.
+func (r *Runner) Import(ctx context.Context, resources []ImportResource) error {
+	if r.stopped {
+		return ErrRunnerStopped
+	}
+
+	ctx, span := telemetry.StartSpan(ctx, "runner.Import")
+	defer span.End()
+	span.SetAttributes(
+		otattribute.String("runner.name", r.name),
+		otattribute.String("runner.step", string(r.infraExecutor.Step())),
+		otattribute.Int("runner.resources", len(resources)),
+	)
+
+	importExecutor, ok := r.infraExecutor.(ImportExecutor)
+	if !ok {
+		return fmt.Errorf("Infrastructure executor for %s does not support import of resources.", r.infraExecutor.Step())
+	}
+
+	return dhlog.RunProcess(ctx, dhlog.FromContext(ctx), "infrastructure import ...", func(ctx context.Context) error {
+		imported, err := r.stateResourceAddresses()
+		if err != nil {
+			return err
+		}
+
+		err = r.stateSaver.Start(ctx, r)
+		if err != nil {
+			return err
+		}
+		defer r.stateSaver.Stop(ctx)
+
+		for _, resource := range resources {
+			if _, ok := imported[resource.Address]; ok {
+				dhlog.FromContext(ctx).InfoContext(ctx, fmt.Sprintf("Resource %s is already in the infrastructure state, skipping.", resource.Address))
+				continue
+			}
+
+			_, err := r.execInfrastructureUtility(ctx, func(ctx context.Context) (int, error) {
+				return 0, importExecutor.Import(ctx, ImportOpts{
+					StatePath:     r.statePath,
+					VariablesPath: r.variablesPath,
+					Address:       resource.Address,
+					ID:            resource.ID,
+				})
+			})
+			if err != nil {
+				return fmt.Errorf("Cannot import resource %s with id %s: %w", resource.Address, resource.ID, err)
+			}
+		}
+
+		// outputs are not calculated by import, refresh saves them to the state
+		_, err = r.execInfrastructureUtility(ctx, func(ctx context.Context) (int, error) {
+			return 0, importExecutor.Refresh(ctx, RefreshOpts{
+				StatePath:     r.statePath,
+				VariablesPath: r.variablesPath,
+			})
+		})
+
+		return err
+	})
+}
+
+type stateWithResources struct {
+	Resources []struct {
+		Module    string `json:"module"`
+		Mode      string `json:"mode"`
+		Type      string `json:"type"`
+		Name      string `json:"name"`
+		Instances []struct {
+			IndexKey json.RawMessage `json:"index_key"`
+		} `json:"instances"`
+	} `json:"resources"`
+}
+
+func (r *Runner) stateResourceAddresses() (map[string]struct{}, error) {
+	st, err := os.ReadFile(r.statePath)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	return stateResourceAddresses(st)
+}
+
+// stateResourceAddresses returns addresses of all resource instances in the state
+// in the same format as they are passed to import.
+func stateResourceAddresses(st []byte) (map[string]struct{}, error) {
+	addresses := make(map[string]struct{})
+	if len(strings.TrimSpace(string(st))) == 0 {
+		return addresses, nil
+	}
+
+	var parsed stateWithResources
+	if err := json.Unmarshal(st, &parsed); err != nil {
+		return nil, fmt.Errorf("Cannot parse infrastructure state: %w", err)
+	}
+
+	for _, resource := range parsed.Resources {
+		address := fmt.Sprintf("%s.%s", resource.Type, resource.Name)
+		if resource.Mode == "data" {
+			address = "data." + address
+		}
+		if resource.Module != "" {
+			address = resource.Module + "." + address
+		}
+
+		for _, instance := range resource.Instances {
+			if len(instance.IndexKey) == 0 {
+				addresses[address] = struct{}{}
+				continue
+			}
+
+			addresses[fmt.Sprintf("%s[%s]", address, string(instance.IndexKey))] = struct{}{}
+		}
+	}
+
+	return addresses, nil
+}
+
+// ImportPipeline imports existing resources into the state and plans the infrastructure against the imported state.
+// Returned changes are plan.HasNoChanges if the imported resources match the configuration.
+func ImportPipeline(
+	ctx context.Context,
+	r ImportRunnerInterface,
+	name string,
+	resources []ImportResource,
+	globalOptions *options.GlobalOptions,
+	extractFn func(ctx context.Context, r RunnerInterface, globalOptions *options.GlobalOptions) (*PipelineOutputs, error),
+) (*PipelineOutputs, int, error) {
+	var extractedData *PipelineOutputs
+	var changes int
+
+	pipelineFunc := func(ctx context.Context) error {
+		ctx, span := telemetry.StartSpan(ctx, fmt.Sprintf("Infrastructure - ImportPipeline %s for %s", r.GetStep(), name))
+		defer span.End()
+
+		if err := r.Init(ctx); err != nil {
+			return err
+		}
+		span.AddEvent("Runner inited")
+
+		if err := r.Import(ctx, resources); err != nil {
+			return err
+		}
+		span.AddEvent("Import done")
+
+		if err := r.Plan(ctx, false, false); err != nil {
+			return err
+		}
+		span.AddEvent("Plan done")
+
+		changes = r.GetChangesInPlan()
+
+		var err error
+		extractedData, err = extractFn(ctx, r, globalOptions)
+		span.AddEvent("Extracted data")
+
+		return err
+	}
+
+	err := dhlog.RunProcess(ctx, dhlog.FromContext(ctx), fmt.Sprintf("Import pipeline %s for %s", r.GetStep(), name), pipelineFunc)
+
+	return extractedData, changes, err
+}
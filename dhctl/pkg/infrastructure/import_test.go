@@ -0,0 +1,109 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type fakeImportExecutor struct {
+	fakeExecutor
+
+	importErr error
+	imported  []string
+	refreshed bool
+}
+
+func (e *fakeImportExecutor) Import(_ context.Context, opts ImportOpts) error {
+	if e.importErr != nil {
+		return e.importErr
+	}
+
+	e.imported = append(e.imported, opts.Address+"="+opts.ID)
+	return nil
+}
+
+func (e *fakeImportExecutor) Refresh(_ context.Context, _ RefreshOpts) error {
+	e.refreshed = true
+	return nil
+}
+
+const importTestState = `{
+  "version": 4,
+  "resources": [
+    {"mode": "managed", "type": "test_network", "name": "kube", "instances": [{}]},
+    {"module": "module.nodes", "mode": "managed", "type": "test_instance", "name": "node", "instances": [{"index_key": 0}, {"index_key": "b"}]},
+    {"mode": "data", "type": "test_image", "name": "os", "instances": [{}]}
+  ]
+}`
+
+func TestStateResourceAddresses(t *testing.T) {
+	addresses, err := stateResourceAddresses([]byte(importTestState))
+	require.NoError(t, err)
+	require.Equal(t, map[string]struct{}{
+		"test_network.kube":                    {},
+		`module.nodes.test_instance.node[0]`:   {},
+		`module.nodes.test_instance.node["b"]`: {},
+		"data.test_image.os":                   {},
+	}, addresses)
+
+	addresses, err = stateResourceAddresses(nil)
+	require.NoError(t, err)
+	require.Empty(t, addresses)
+
+	_, err = stateResourceAddresses([]byte("{"))
+	require.Error(t, err)
+}
+
+func TestRunnerImport(t *testing.T) {
+	resources := []ImportResource{
+		{Address: "test_network.kube", ID: "net-1"},
+		{Address: "test_subnet.kube", ID: "subnet-1"},
+		{Address: `module.nodes.test_instance.node[0]`, ID: "vm-1"},
+	}
+
+	t.Run("skips resources from the state", func(t *testing.T) {
+		executor := &fakeImportExecutor{}
+		runner := newTestRunner(executor).WithState([]byte(importTestState))
+		defer os.Remove(runner.statePath)
+
+		require.NoError(t, runner.Import(t.Context(), resources))
+		require.Equal(t, []string{"test_subnet.kube=subnet-1"}, executor.imported)
+		require.True(t, executor.refreshed)
+	})
+
+	t.Run("import error", func(t *testing.T) {
+		executor := &fakeImportExecutor{importErr: errors.New("not found")}
+		runner := newTestRunner(executor).WithState(nil)
+		defer os.Remove(runner.statePath)
+
+		err := runner.Import(t.Context(), resources)
+		require.ErrorContains(t, err, "test_network.kube")
+		require.ErrorContains(t, err, "not found")
+		require.False(t, executor.refreshed)
+	})
+
+	t.Run("executor without import", func(t *testing.T) {
+		runner := newTestRunner(&fakeExecutor{}).WithState(nil)
+		defer os.Remove(runner.statePath)
+
+		require.Error(t, runner.Import(t.Context(), resources))
+	})
+}
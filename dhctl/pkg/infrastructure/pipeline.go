@@ -243,6 +243,18 @@ func CheckBaseInfrastructurePipeline(
 		if pdc := r.GetPlanDestructiveChanges(); pdc != nil {
 			getOrCreateDestructiveChanges().DestructiveChanges = *pdc
 		}
+
+		rawPlan, err := r.ShowPlan(ctx)
+		if err != nil {
+			return err
+		}
+
+		// plan is needed for the destructive changes too, it is shown to the user before apply
+		err = json.Unmarshal(rawPlan, &pl)
+		if err != nil {
+			return err
+		}
+
 		if isChange > plan.HasChanges {
 			return nil
 		}
@@ -273,21 +285,11 @@ func CheckBaseInfrastructurePipeline(
 			} `json:"output_changes"`
 		}
 
-		rawPlan, err := r.ShowPlan(ctx)
-		if err != nil {
-			return err
-		}
-
 		err = json.Unmarshal(rawPlan, &changes)
 		if err != nil {
 			return err
 		}
 
-		err = json.Unmarshal(rawPlan, &pl)
-		if err != nil {
-			return err
-		}
-
 		sort.Strings(changes.Output.Data.After.Zones)
 		sort.Strings(data.Zones)
 
@@ -0,0 +1,185 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"slices"
+
+	"sigs.k8s.io/yaml"
+)
+
+type ApprovalEffect string
+
+const (
+	ApprovalEffectAllow = ApprovalEffect("Allow")
+	ApprovalEffectDeny  = ApprovalEffect("Deny")
+)
+
+// ApprovalRule matches resource changes by action, resource type and layer.
+// Layer is the node group name or base-infrastructure. Empty selector matches everything,
+// resource types and layers are glob patterns.
+type ApprovalRule struct {
+	Name          string         `json:"name,omitempty"`
+	Effect        ApprovalEffect `json:"effect"`
+	Actions       []Action       `json:"actions,omitempty"`
+	ResourceTypes []string       `json:"resourceTypes,omitempty"`
+	Layers        []string       `json:"layers,omitempty"`
+}
+
+// ApprovalPolicy decides which infrastructure changes can be applied without the confirmation.
+// Rules are evaluated in order and the first matched rule wins, change without matched rule is denied.
+type ApprovalPolicy struct {
+	Rules []ApprovalRule `json:"rules"`
+}
+
+type DeniedChange struct {
+	Address string
+	Action  Action
+	// Rule is empty if change was not matched by any rule
+	Rule string
+}
+
+func (d DeniedChange) String() string {
+	if d.Rule == "" {
+		return fmt.Sprintf("%s (%s): no matching rule", d.Address, d.Action)
+	}
+
+	return fmt.Sprintf("%s (%s): denied by rule %q", d.Address, d.Action, d.Rule)
+}
+
+func LoadApprovalPolicy(policyPath string) (*ApprovalPolicy, error) {
+	content, err := os.ReadFile(policyPath)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read approval policy: %w", err)
+	}
+
+	return ParseApprovalPolicy(content)
+}
+
+func ParseApprovalPolicy(content []byte) (*ApprovalPolicy, error) {
+	policy := &ApprovalPolicy{}
+	if err := yaml.UnmarshalStrict(content, policy); err != nil {
+		return nil, fmt.Errorf("cannot parse approval policy: %w", err)
+	}
+
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+func (p *ApprovalPolicy) Validate() error {
+	var errs []error
+
+	for i, rule := range p.Rules {
+		if rule.Effect != ApprovalEffectAllow && rule.Effect != ApprovalEffectDeny {
+			errs = append(errs, fmt.Errorf("rule %d: unknown effect %q", i, rule.Effect))
+		}
+
+		for _, action := range rule.Actions {
+			switch action {
+			case ActionCreate, ActionUpdate, ActionDelete, ActionRecreate:
+			default:
+				errs = append(errs, fmt.Errorf("rule %d: unknown action %q", i, action))
+			}
+		}
+
+		for _, pattern := range append(append([]string{}, rule.ResourceTypes...), rule.Layers...) {
+			if _, err := path.Match(pattern, ""); err != nil {
+				errs = append(errs, fmt.Errorf("rule %d: bad pattern %q: %w", i, pattern, err))
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid approval policy: %w", errors.Join(errs...))
+	}
+
+	return nil
+}
+
+// Denied returns changes of the layer which cannot be applied without the confirmation.
+// No-op and read changes are always allowed.
+func (p *ApprovalPolicy) Denied(layer string, changes []ResourceChange) []DeniedChange {
+	var denied []DeniedChange
+
+	for _, change := range changes {
+		action := change.Action()
+		if action == ActionNoOp || action == ActionRead {
+			continue
+		}
+
+		rule, ok := p.match(layer, action, change.Type)
+		if ok && rule.Effect == ApprovalEffectAllow {
+			continue
+		}
+
+		deniedChange := DeniedChange{
+			Address: change.Address,
+			Action:  action,
+		}
+		if ok {
+			deniedChange.Rule = rule.name()
+		}
+
+		denied = append(denied, deniedChange)
+	}
+
+	return denied
+}
+
+func (p *ApprovalPolicy) match(layer string, action Action, resourceType string) (*ApprovalRule, bool) {
+	for i := range p.Rules {
+		rule := &p.Rules[i]
+		if len(rule.Actions) > 0 && !slices.Contains(rule.Actions, action) {
+			continue
+		}
+
+		if !matchAny(rule.ResourceTypes, resourceType) || !matchAny(rule.Layers, layer) {
+			continue
+		}
+
+		return rule, true
+	}
+
+	return nil, false
+}
+
+func (r *ApprovalRule) name() string {
+	if r.Name != "" {
+		return r.Name
+	}
+
+	return string(r.Effect)
+}
+
+func matchAny(patterns []string, value string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, value); ok {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,93 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testApprovalPolicy = `
+rules:
+- name: keep-disks
+  effect: Deny
+  actions: [delete, recreate]
+  resourceTypes: ["*_disk"]
+- name: keep-masters
+  effect: Deny
+  actions: [delete, recreate]
+  layers: [master]
+- effect: Allow
+  actions: [create, update]
+- name: recreate-workers
+  effect: Allow
+  actions: [recreate]
+  resourceTypes: ["*_instance"]
+`
+
+func testResourceChange(address, resourceType string, actions ...string) ResourceChange {
+	return ResourceChange{
+		Address: address,
+		Type:    resourceType,
+		Change:  ChangeOp{Actions: actions},
+	}
+}
+
+func TestApprovalPolicyDenied(t *testing.T) {
+	policy, err := ParseApprovalPolicy([]byte(testApprovalPolicy))
+	require.NoError(t, err)
+
+	resize := []ResourceChange{
+		testResourceChange("yandex_compute_instance.node", "yandex_compute_instance", "delete", "create"),
+		testResourceChange("yandex_compute_disk.kubernetes_data", "yandex_compute_disk", "no-op"),
+		testResourceChange("data.yandex_image.image", "yandex_image", "read"),
+	}
+	require.Empty(t, policy.Denied("worker", resize))
+
+	denied := policy.Denied("master", resize)
+	require.Equal(t, []DeniedChange{{
+		Address: "yandex_compute_instance.node",
+		Action:  ActionRecreate,
+		Rule:    "keep-masters",
+	}}, denied)
+
+	denied = policy.Denied("worker", []ResourceChange{
+		testResourceChange("yandex_compute_instance.node", "yandex_compute_instance", "update"),
+		testResourceChange("yandex_compute_disk.kubernetes_data", "yandex_compute_disk", "delete"),
+		testResourceChange("yandex_vpc_subnet.subnet", "yandex_vpc_subnet", "delete"),
+	})
+	require.Len(t, denied, 2)
+	require.Equal(t, "keep-disks", denied[0].Rule)
+	require.Equal(t, ActionDelete, denied[1].Action)
+	require.Empty(t, denied[1].Rule)
+	require.Equal(t, "yandex_vpc_subnet.subnet (delete): no matching rule", denied[1].String())
+}
+
+func TestParseApprovalPolicyInvalid(t *testing.T) {
+	_, err := ParseApprovalPolicy([]byte(`
+rules:
+- effect: Permit
+  actions: [destroy]
+  layers: ["[worker"]
+`))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), `unknown effect "Permit"`)
+	require.Contains(t, err.Error(), `unknown action "destroy"`)
+	require.Contains(t, err.Error(), `bad pattern "[worker"`)
+
+	_, err = ParseApprovalPolicy([]byte("rules:\n- effect: Allow\n  nodeGroups: [worker]\n"))
+	require.Error(t, err)
+}
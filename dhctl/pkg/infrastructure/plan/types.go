@@ -14,13 +14,23 @@
 
 package plan
 
-import "slices"
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+)
 
 type Action string
 
 const (
 	ActionCreate = Action("create")
 	ActionDelete = Action("delete")
+	ActionUpdate = Action("update")
+	ActionRead   = Action("read")
+	ActionNoOp   = Action("no-op")
+	// ActionRecreate is not reported by the infrastructure utility itself,
+	// it describes resource which is deleted and created in the same plan.
+	ActionRecreate = Action("recreate")
 )
 
 const (
@@ -50,6 +60,7 @@ type InfrastructurePlan struct {
 }
 
 type ResourceChange struct {
+	Address      string   `json:"address,omitempty"`
 	Change       ChangeOp `json:"change"`
 	Type         string   `json:"type"`
 	Name         string   `json:"name"`
@@ -61,8 +72,104 @@ func (r *ResourceChange) HasAction(findAction Action) bool {
 	return slices.Contains(r.Change.Actions, act)
 }
 
+// Action returns single action which describes the resource change.
+// Delete and create in the same change is a recreation of the resource.
+func (r *ResourceChange) Action() Action {
+	switch {
+	case r.HasAction(ActionDelete) && r.HasAction(ActionCreate):
+		return ActionRecreate
+	case len(r.Change.Actions) == 0:
+		return ActionNoOp
+	default:
+		return Action(r.Change.Actions[0])
+	}
+}
+
+// ParseInfrastructurePlan converts raw plan in the infrastructure utility show format to InfrastructurePlan.
+func ParseInfrastructurePlan(p Plan) (*InfrastructurePlan, error) {
+	res := &InfrastructurePlan{}
+	if p == nil {
+		return res, nil
+	}
+
+	raw, err := json.Marshal(p)
+	if err != nil {
+		return nil, fmt.Errorf("cannot marshal infrastructure plan: %w", err)
+	}
+
+	if err := json.Unmarshal(raw, res); err != nil {
+		return nil, fmt.Errorf("cannot unmarshal infrastructure plan: %w", err)
+	}
+
+	return res, nil
+}
+
 type ChangeOp struct {
 	Actions []string       `json:"actions"`
 	Before  map[string]any `json:"before,omitempty"`
 	After   map[string]any `json:"after,omitempty"`
+	// BeforeSensitive and AfterSensitive mirror Before and After with true in place of the values
+	// the provider marks sensitive, e.g. passwords and keys; a single true marks the whole object.
+	BeforeSensitive any `json:"before_sensitive,omitempty"`
+	AfterSensitive  any `json:"after_sensitive,omitempty"`
+}
+
+// SensitiveValue replaces sensitive values in reports, the infrastructure utility shows them the same way.
+const SensitiveValue = "(sensitive value)"
+
+// MaskedBefore returns Before with the sensitive values replaced by SensitiveValue.
+func (c *ChangeOp) MaskedBefore() map[string]any {
+	return maskSensitiveObject(c.Before, c.BeforeSensitive)
+}
+
+// MaskedAfter returns After with the sensitive values replaced by SensitiveValue.
+func (c *ChangeOp) MaskedAfter() map[string]any {
+	return maskSensitiveObject(c.After, c.AfterSensitive)
+}
+
+func maskSensitiveObject(object map[string]any, sensitive any) map[string]any {
+	if object == nil {
+		return nil
+	}
+
+	masked, _ := maskSensitive(object, sensitive).(map[string]any)
+	return masked
+}
+
+// maskSensitive returns a copy of value with every value marked true in sensitive replaced by SensitiveValue.
+func maskSensitive(value, sensitive any) any {
+	if marked, ok := sensitive.(bool); ok && marked {
+		if object, ok := value.(map[string]any); ok {
+			// keep the keys, so that the report still shows which attributes changed
+			masked := make(map[string]any, len(object))
+			for key := range object {
+				masked[key] = SensitiveValue
+			}
+			return masked
+		}
+		return SensitiveValue
+	}
+
+	switch v := value.(type) {
+	case map[string]any:
+		markers, _ := sensitive.(map[string]any)
+		masked := make(map[string]any, len(v))
+		for key, item := range v {
+			masked[key] = maskSensitive(item, markers[key])
+		}
+		return masked
+	case []any:
+		markers, _ := sensitive.([]any)
+		masked := make([]any, len(v))
+		for i, item := range v {
+			var marker any
+			if i < len(markers) {
+				marker = markers[i]
+			}
+			masked[i] = maskSensitive(item, marker)
+		}
+		return masked
+	default:
+		return value
+	}
 }
@@ -65,8 +65,9 @@ type (
 )
 
 type destructiveChangesReport struct {
-	changes      *plan.DestructiveChanges
-	hasVMChanges bool
+	changes         *plan.DestructiveChanges
+	hasVMChanges    bool
+	resourceChanges []plan.ResourceChange
 }
 
 type AutoApproveSettings struct {
@@ -78,6 +79,9 @@ type AutomaticSettings struct {
 
 	AutoDismissChanges     bool
 	AutoDismissDestructive bool
+
+	// ApprovalPolicy overrides the settings above for the plans with changes, see plan.ApprovalPolicy.
+	ApprovalPolicy *plan.ApprovalPolicy
 }
 
 type ChangeActionSettings struct {
@@ -100,6 +104,7 @@ type Runner struct {
 	changesInPlan          int
 	planDestructiveChanges *plan.DestructiveChanges
 	hasVMDestruction       bool
+	planResourceChanges    []plan.ResourceChange
 	stateCache             state.Cache
 
 	stateSaver   *StateSaver
@@ -125,6 +130,13 @@ type Runner struct {
 	// isDebug enables backup of intermediate state files written by the
 	// state saver fsnotify handler.
 	isDebug bool
+
+	// savedPlan is applied instead of the new plan, see WithSavedPlan.
+	savedPlan *SavedPlan
+
+	// approvalLayer is matched by layers of the approval policy rules:
+	// node group name or base-infrastructure.
+	approvalLayer string
 }
 
 // WithUseTfCache sets how the runner reacts to a cached infrastructure state.
@@ -273,6 +285,16 @@ func (r *Runner) WithSkipChangesOnDeny(flag bool) *Runner {
 	return r
 }
 
+func (r *Runner) WithApprovalPolicy(policy *plan.ApprovalPolicy) *Runner {
+	r.changeSettings.ApprovalPolicy = policy
+	return r
+}
+
+func (r *Runner) WithApprovalLayer(layer string) *Runner {
+	r.approvalLayer = layer
+	return r
+}
+
 // WithAdditionalStateSaverDestination
 // by default we use intermediate save state to cache destination
 func (r *Runner) WithAdditionalStateSaverDestination(destinations ...SaverDestination) *Runner {
@@ -413,6 +435,10 @@ func (r *Runner) runBeforeActionAndWaitReady(ctx context.Context) error {
 }
 
 func (r *Runner) isSkipChanges(ctx context.Context) (bool, error) {
+	if r.changesInPlan != plan.HasNoChanges && r.changeSettings.ApprovalPolicy != nil {
+		return r.isSkipChangesByPolicy(ctx)
+	}
+
 	// first verify destructive change
 	if r.changesInPlan == plan.HasDestructiveChanges && r.changeSettings.AutoDismissDestructive {
 		// skip plan
@@ -440,6 +466,44 @@ func (r *Runner) isSkipChanges(ctx context.Context) (bool, error) {
 	return false, r.runBeforeActionAndWaitReady(ctx)
 }
 
+func (r *Runner) isSkipChangesByPolicy(ctx context.Context) (bool, error) {
+	if !r.isApprovedByPolicy(ctx, r.planResourceChanges) {
+		if r.changeSettings.SkipChangesOnDeny {
+			return true, nil
+		}
+		return false, ErrInfrastructureApplyAborted
+	}
+
+	return false, r.runBeforeActionAndWaitReady(ctx)
+}
+
+// isApprovedByPolicy checks the plan resource changes against the approval policy and logs denied changes.
+// Changes are not approved if they are unknown, for example if the plan was made without the output.
+func (r *Runner) isApprovedByPolicy(ctx context.Context, changes []plan.ResourceChange) bool {
+	logger := dhlog.FromContext(ctx)
+
+	if changes == nil {
+		logger.WarnContext(ctx, fmt.Sprintf("Resource changes of %s are unknown, changes are not approved", r.name))
+		return false
+	}
+
+	denied := r.changeSettings.ApprovalPolicy.Denied(r.approvalLayer, changes)
+	if len(denied) > 0 {
+		msg := strings.Builder{}
+		msg.WriteString(fmt.Sprintf("Changes of %s are not approved by the approval policy:", r.name))
+		for _, change := range denied {
+			msg.WriteString("\n  " + change.String())
+		}
+
+		logger.WarnContext(ctx, msg.String())
+		return false
+	}
+
+	logger.InfoContext(ctx, fmt.Sprintf("Changes of %s are approved by the approval policy", r.name))
+
+	return true
+}
+
 func (r *Runner) Apply(ctx context.Context) error {
 	if r.stopped {
 		return ErrRunnerStopped
@@ -546,6 +610,12 @@ func (r *Runner) Plan(ctx context.Context, destroy, noout bool) error {
 	r.traceStateAndVars(span)
 
 	return dhlog.RunProcess(ctx, dhlog.FromContext(ctx), "infrastructure plan ...", func(ctx context.Context) error {
+		r.planResourceChanges = nil
+
+		if r.savedPlan != nil && !destroy {
+			return r.useSavedPlan(ctx)
+		}
+
 		tmpFile, err := os.CreateTemp(r.infraExecutor.GetStatesDir(), string(r.infraExecutor.Step())+deckhousePlanSuffix)
 		if err != nil {
 			return fmt.Errorf("Can't create temp file for plan: %w", err)
@@ -565,7 +635,9 @@ func (r *Runner) Plan(ctx context.Context, destroy, noout bool) error {
 		// todo need refactor
 		if exitCode == infraexec.HasChangesExitCode {
 			r.changesInPlan = plan.HasChanges
-			if noout {
+			// the approval policy is checked against the resource changes, so they are read from the plan
+			// even if the plan output is not needed, otherwise the policy would deny every change
+			if noout && r.changeSettings.ApprovalPolicy == nil {
 				destructiveChanged, err := r.planHasDestructiveChanges(ctx, tmpFile.Name())
 				if err != nil {
 					return err
@@ -578,6 +650,7 @@ func (r *Runner) Plan(ctx context.Context, destroy, noout bool) error {
 				if err != nil {
 					return err
 				}
+				r.planResourceChanges = report.resourceChanges
 				if report.changes != nil {
 					r.changesInPlan = plan.HasDestructiveChanges
 					r.planDestructiveChanges = report.changes
@@ -716,11 +789,24 @@ func (r *Runner) Destroy(ctx context.Context) error {
 		return nil
 	}
 
+	// destroy plan is saved only to check it against the approval policy
+	var planPath string
+	if r.changeSettings.ApprovalPolicy != nil {
+		tmpFile, err := os.CreateTemp(r.infraExecutor.GetStatesDir(), string(r.infraExecutor.Step())+deckhousePlanSuffix)
+		if err != nil {
+			return fmt.Errorf("Can't create temp file for destroy plan: %w", err)
+		}
+		planPath = tmpFile.Name()
+		_ = tmpFile.Close()
+		defer func() { _ = os.Remove(planPath) }()
+	}
+
 	_, err := r.execInfrastructureUtility(ctx, func(ctx context.Context) (int, error) {
 		_, err := r.infraExecutor.Plan(ctx, PlanOpts{
 			Destroy:       true,
 			StatePath:     r.statePath,
 			VariablesPath: r.variablesPath,
+			OutPath:       planPath,
 		})
 
 		return 0, err
@@ -729,7 +815,16 @@ func (r *Runner) Destroy(ctx context.Context) error {
 		return fmt.Errorf("Cannot prepare terraform destroy plan: %w", err)
 	}
 
-	if !r.changeSettings.AutoApprove {
+	switch {
+	case r.changeSettings.ApprovalPolicy != nil:
+		report, err := r.getPlanDestructiveChanges(ctx, planPath)
+		if err != nil {
+			return err
+		}
+		if !r.isApprovedByPolicy(ctx, report.resourceChanges) {
+			return fmt.Errorf("Infrastructure destroy aborted.")
+		}
+	case !r.changeSettings.AutoApprove:
 		if !r.confirm().WithMessage("Do you want to DELETE objects from the cloud?").Ask() {
 			return fmt.Errorf("Infrastructure destroy aborted.")
 		}
@@ -896,13 +991,13 @@ func (r *Runner) getPlanDestructiveChanges(ctx context.Context, planFile string)
 			if resource.HasAction(plan.ActionCreate) {
 				// recreate
 				getOrCreateDestructiveChanges().ResourcesRecreated = append(getOrCreateDestructiveChanges().ResourcesRecreated, plan.ValueChange{
-					CurrentValue: resource.Change.Before,
-					NextValue:    resource.Change.After,
+					CurrentValue: resource.Change.MaskedBefore(),
+					NextValue:    resource.Change.MaskedAfter(),
 					Type:         resource.Type,
 				})
 			} else {
 				getOrCreateDestructiveChanges().ResourcesDeleted = append(getOrCreateDestructiveChanges().ResourcesDeleted, plan.ValueChange{
-					CurrentValue: resource.Change.Before,
+					CurrentValue: resource.Change.MaskedBefore(),
 					Type:         resource.Type,
 				})
 			}
@@ -910,8 +1005,9 @@ func (r *Runner) getPlanDestructiveChanges(ctx context.Context, planFile string)
 	}
 	dhlog.FromContext(ctx).DebugContext(ctx, fmt.Sprintf("HasVMDestruction: %v", hasVMChange))
 	return &destructiveChangesReport{
-		changes:      destructiveChanges,
-		hasVMChanges: hasVMChange,
+		changes:         destructiveChanges,
+		hasVMChanges:    hasVMChange,
+		resourceChanges: append([]plan.ResourceChange{}, pl.ResourceChanges...),
 	}, nil
 }
 
@@ -36,5 +36,6 @@ type RunnerInterface interface {
 	GetStep() Step
 	GetChangesInPlan() int
 	GetPlanDestructiveChanges() *plan.DestructiveChanges
+	GetPlanPath() string
 	HasVMDestruction() bool
 }
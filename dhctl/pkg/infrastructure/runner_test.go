@@ -47,29 +47,37 @@ func newTestRunner(executor Executor) *Runner {
 
 func TestCheckPlanDestructiveChanges(t *testing.T) {
 	tests := []struct {
-		name    string
-		plan    string
-		changes *destructiveChangesReport
-		err     error
-		vm      string
+		name      string
+		plan      string
+		changes   *destructiveChangesReport
+		resources []string
+		err       error
+		vm        string
 	}{
 		{
-			name:    "Empty Changes",
-			plan:    "./mocks/checkplan/empty.json",
-			changes: &destructiveChangesReport{changes: (*plan.DestructiveChanges)(nil), hasVMChanges: false},
-			err:     nil,
+			name:      "Empty Changes",
+			plan:      "./mocks/checkplan/empty.json",
+			changes:   &destructiveChangesReport{changes: (*plan.DestructiveChanges)(nil), hasVMChanges: false},
+			resources: []string{},
+			err:       nil,
 		},
 		{
-			name:    "Has destructive changes",
-			plan:    "./mocks/checkplan/destructively_changed.json",
-			changes: destructiveChangesReportVar,
-			vm:      "yandex_compute_instance",
+			name:      "Has destructive changes",
+			plan:      "./mocks/checkplan/destructively_changed.json",
+			changes:   destructiveChangesReportVar,
+			resources: []string{"yandex_compute_disk.kubernetes_data", "yandex_compute_instance.master"},
+			vm:        "yandex_compute_instance",
 		},
 		{
 			name:    "Has destructive changes but without VM",
 			plan:    "./mocks/checkplan/destructively_changed_without_vm.json",
 			changes: destructiveChangesReportWithoutVM,
-			err:     nil,
+			resources: []string{
+				"yandex_compute_disk.kubernetes_data",
+				"yandex_compute_instance.master",
+				"yandex_vpc_address.kube_master_eip",
+			},
+			err: nil,
 		},
 	}
 
@@ -94,6 +102,13 @@ func TestCheckPlanDestructiveChanges(t *testing.T) {
 				require.NoError(t, err)
 			}
 
+			resources := make([]string, 0, len(changes.resourceChanges))
+			for _, change := range changes.resourceChanges {
+				resources = append(resources, change.Address)
+			}
+			require.Equal(t, tc.resources, resources)
+
+			changes.resourceChanges = nil
 			require.Equal(t, tc.changes, changes)
 		})
 	}
@@ -140,6 +155,26 @@ func TestRunnerCreatesStateSaver(t *testing.T) {
 	}
 }
 
+func newTestRunnerWithPolicy(layer string, actions ...string) *Runner {
+	r := newTestRunnerWithChanges().
+		WithAutoDismissDestructiveChanges(true).
+		WithSkipChangesOnDeny(true).
+		WithApprovalLayer(layer).
+		WithApprovalPolicy(&plan.ApprovalPolicy{Rules: []plan.ApprovalRule{
+			{Effect: plan.ApprovalEffectDeny, Layers: []string{"master"}},
+			{Effect: plan.ApprovalEffectAllow, Actions: []plan.Action{plan.ActionRecreate}, ResourceTypes: []string{"*_instance"}},
+		}})
+
+	r.changesInPlan = plan.HasDestructiveChanges
+	r.planResourceChanges = []plan.ResourceChange{{
+		Address: "test_instance.node",
+		Type:    "test_instance",
+		Change:  plan.ChangeOp{Actions: actions},
+	}}
+
+	return r
+}
+
 func TestCheckRunnerHandleChanges(t *testing.T) {
 	tests := []struct {
 		name   string
@@ -179,6 +214,38 @@ func TestCheckRunnerHandleChanges(t *testing.T) {
 			err:    ErrInfrastructureApplyAborted,
 			runner: newTestRunnerWithChanges(),
 		},
+		{
+			name:   "Destructive changes approved by policy must not skip",
+			skip:   false,
+			err:    nil,
+			runner: newTestRunnerWithPolicy("worker", "delete", "create"),
+		},
+		{
+			name:   "Changes denied by policy must skip",
+			skip:   true,
+			err:    nil,
+			runner: newTestRunnerWithPolicy("master", "delete", "create"),
+		},
+		{
+			name:   "Changes without matching rule must skip",
+			skip:   true,
+			err:    nil,
+			runner: newTestRunnerWithPolicy("worker", "delete"),
+		},
+		{
+			name: "Unknown resource changes must skip",
+			skip: true,
+			err:  nil,
+			runner: newTestRunnerWithChanges().
+				WithSkipChangesOnDeny(true).
+				WithApprovalPolicy(&plan.ApprovalPolicy{Rules: []plan.ApprovalRule{{Effect: plan.ApprovalEffectAllow}}}),
+		},
+		{
+			name:   "Changes denied by policy without skip on deny must abort",
+			skip:   false,
+			err:    ErrInfrastructureApplyAborted,
+			runner: newTestRunnerWithPolicy("master", "create").WithSkipChangesOnDeny(false),
+		},
 	}
 
 	for _, tc := range tests {
@@ -255,6 +322,84 @@ func TestRunnerPlan(t *testing.T) {
 	}
 }
 
+func TestRunnerPlanWithoutOutputReadsResourceChangesForApprovalPolicy(t *testing.T) {
+	data := mustReadFile(t, "./mocks/checkplan/destructively_changed.json")
+	policy := &plan.ApprovalPolicy{Rules: []plan.ApprovalRule{
+		{Effect: plan.ApprovalEffectAllow, Layers: []string{"worker"}},
+	}}
+
+	runner := newTestRunner(&fakeExecutor{
+		showResp: fakeResponse{resp: data},
+		planResp: fakeResponse{code: infraexec.HasChangesExitCode},
+	}).WithApprovalPolicy(policy)
+
+	require.NoError(t, runner.Plan(t.Context(), false, true))
+	require.Equal(t, plan.HasDestructiveChanges, runner.GetChangesInPlan())
+	require.NotEmpty(t, runner.planResourceChanges)
+
+	runner = newTestRunner(&fakeExecutor{
+		showResp: fakeResponse{resp: data},
+		planResp: fakeResponse{code: infraexec.HasChangesExitCode},
+	})
+
+	require.NoError(t, runner.Plan(t.Context(), false, true))
+	require.Equal(t, plan.HasChanges, runner.GetChangesInPlan())
+	require.Nil(t, runner.planResourceChanges)
+}
+
+func TestRunnerPlanWithSavedPlan(t *testing.T) {
+	savedPlanData := []byte("saved plan")
+	state := []byte(`{"version":4}`)
+
+	tests := []struct {
+		name                  string
+		state                 []byte
+		showResp              fakeResponse
+		expectedChangesInPlan int
+		expectedErr           error
+	}{
+		{
+			name:                  "no changes in saved plan",
+			state:                 state,
+			showResp:              fakeResponse{resp: []byte(`{"resource_changes":[{"type":"a","change":{"actions":["no-op"]}}]}`)},
+			expectedChangesInPlan: plan.HasNoChanges,
+		},
+		{
+			name:                  "destructive changes in saved plan",
+			state:                 state,
+			showResp:              fakeResponse{resp: mustReadFile(t, "./mocks/checkplan/destructively_changed.json")},
+			expectedChangesInPlan: plan.HasDestructiveChanges,
+		},
+		{
+			name:                  "state was changed after planning",
+			state:                 []byte(`{"version":4,"serial":2}`),
+			expectedChangesInPlan: plan.HasNoChanges,
+			expectedErr:           ErrSavedPlanStateChanged,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runner := newTestRunner(&fakeExecutor{
+				showResp: tc.showResp,
+				planResp: fakeResponse{err: errors.New("plan must not be called")},
+			}).
+				WithState(tc.state).
+				WithSavedPlan(SavedPlan{Plan: savedPlanData, StateHash: StateHash(state)})
+
+			err := runner.Plan(t.Context(), false, false)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, savedPlanData, mustReadFile(t, runner.GetPlanPath()))
+			}
+
+			require.Equal(t, tc.expectedChangesInPlan, runner.GetChangesInPlan())
+		})
+	}
+}
+
 func mustReadFile(t *testing.T, path string) []byte {
 	t.Helper()
 
@@ -264,6 +409,48 @@ func mustReadFile(t *testing.T, path string) []byte {
 	return data
 }
 
+func TestRunnerDestroyWithApprovalPolicy(t *testing.T) {
+	data, err := os.ReadFile("./mocks/checkplan/destructively_changed.json")
+	require.NoError(t, err)
+
+	policy := &plan.ApprovalPolicy{Rules: []plan.ApprovalRule{
+		{Effect: plan.ApprovalEffectAllow, Layers: []string{"worker"}},
+	}}
+
+	tests := []struct {
+		name  string
+		layer string
+		err   bool
+	}{
+		{
+			name:  "Destroy approved by policy must not ask confirmation",
+			layer: "worker",
+		},
+		{
+			name:  "Destroy denied by policy must abort",
+			layer: "master",
+			err:   true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runner := newTestRunner(&fakeExecutor{showResp: fakeResponse{resp: data}}).
+				WithName("test").
+				WithApprovalLayer(tc.layer).
+				WithApprovalPolicy(policy).
+				WithStatePath("./mocks/pipeline/not_empty_state.json")
+
+			err := DestroyPipeline(t.Context(), runner, "test")
+			if tc.err {
+				require.ErrorContains(t, err, "Infrastructure destroy aborted.")
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 type sleepExecutor struct {
 	cancelCh chan struct{}
 }
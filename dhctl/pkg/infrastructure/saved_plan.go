@@ -0,0 +1,141 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	dhlog "github.com/deckhouse/lib-dhctl/pkg/logger"
+
+	"github.com/deckhouse/deckhouse/dhctl/pkg/infrastructure/plan"
+)
+
+var ErrSavedPlanStateChanged = errors.New("infrastructure state was changed after the plan was saved")
+
+// SavedPlan is the plan file of the infrastructure utility saved for the later apply
+// together with the hash of the state it was made for.
+type SavedPlan struct {
+	// Plan is empty for layers which are going to be destroyed, they are only checked for the state changes.
+	Plan      []byte `json:"plan,omitempty"`
+	StateHash string `json:"state_hash"`
+}
+
+// SavedPlans are keyed by the runner name: base-infrastructure or node name.
+type SavedPlans map[string]SavedPlan
+
+func StateHash(state []byte) string {
+	sum := sha256.Sum256(state)
+	return hex.EncodeToString(sum[:])
+}
+
+// NewSavedPlan reads the plan file of the runner after Plan call.
+func NewSavedPlan(r RunnerInterface, state []byte) (*SavedPlan, error) {
+	planPath := r.GetPlanPath()
+	if planPath == "" {
+		return nil, fmt.Errorf("Plan for %s was not made", r.GetStep())
+	}
+
+	planData, err := os.ReadFile(planPath)
+	if err != nil {
+		return nil, fmt.Errorf("Can't read infrastructure plan %s: %w", planPath, err)
+	}
+
+	return &SavedPlan{
+		Plan:      planData,
+		StateHash: StateHash(state),
+	}, nil
+}
+
+// WithSavedPlan makes runner apply saved plan instead of making a new one.
+func (r *Runner) WithSavedPlan(savedPlan SavedPlan) *Runner {
+	r.savedPlan = &savedPlan
+	return r
+}
+
+func (r *Runner) useSavedPlan(ctx context.Context) (err error) {
+	st, err := os.ReadFile(r.statePath)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	if StateHash(st) != r.savedPlan.StateHash {
+		return fmt.Errorf("%w: %s", ErrSavedPlanStateChanged, r.name)
+	}
+
+	tmpFile, err := os.CreateTemp(r.infraExecutor.GetStatesDir(), string(r.infraExecutor.Step())+deckhousePlanSuffix)
+	if err != nil {
+		return fmt.Errorf("Can't create temp file for plan: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = os.Remove(tmpFile.Name())
+			r.planPath = ""
+		}
+	}()
+
+	_, err = tmpFile.Write(r.savedPlan.Plan)
+	if closeErr := tmpFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("Can't write saved plan for runner %s: %w", r.name, err)
+	}
+
+	r.planPath = tmpFile.Name()
+
+	dhlog.FromContext(ctx).InfoContext(ctx, fmt.Sprintf("Using saved infrastructure plan for %s", r.name))
+
+	rawPlan, err := r.ShowPlan(ctx)
+	if err != nil {
+		return err
+	}
+
+	var pl plan.InfrastructurePlan
+	if err := json.Unmarshal(rawPlan, &pl); err != nil {
+		return err
+	}
+
+	r.changesInPlan = plan.HasNoChanges
+	for _, resource := range pl.ResourceChanges {
+		if action := resource.Action(); action != plan.ActionNoOp && action != plan.ActionRead {
+			r.changesInPlan = plan.HasChanges
+			break
+		}
+	}
+
+	if r.changesInPlan == plan.HasNoChanges {
+		return nil
+	}
+
+	report, err := r.getPlanDestructiveChanges(ctx, r.planPath)
+	if err != nil {
+		return err
+	}
+
+	r.planResourceChanges = report.resourceChanges
+	if report.changes != nil {
+		r.changesInPlan = plan.HasDestructiveChanges
+		r.planDestructiveChanges = report.changes
+		r.hasVMDestruction = report.hasVMChanges
+	}
+
+	return nil
+}
@@ -259,6 +259,73 @@ func (e *Executor) Stop() {
 	_ = syscall.Kill(-e.cmd.Process.Pid, syscall.SIGINT)
 }
 
+func (e *Executor) Import(ctx context.Context, opts infrastructure.ImportOpts) error {
+	ctx, span := telemetry.StartSpan(ctx, "tofu.import")
+	defer span.End()
+	span.SetAttributes(
+		otattribute.String("pipeline_step", string(e.params.Step)),
+		otattribute.String("working_dir", e.params.WorkingDir),
+		otattribute.String("address", opts.Address),
+	)
+
+	args := []string{
+		"import",
+		"-input=false",
+		"-no-color",
+		"-lock=false",
+		fmt.Sprintf("-state=%s", opts.StatePath),
+		fmt.Sprintf("-state-out=%s", opts.StatePath),
+		fmt.Sprintf("-var-file=%s", opts.VariablesPath),
+		opts.Address,
+		opts.ID,
+	}
+
+	e.cmd = tofuCmd(ctx, e.params.RunExecutorParams, e.params.WorkingDir, args...)
+
+	_, err := infraexec.Exec(ctx, e.cmd, e.params.IsDebug)
+
+	return err
+}
+
+func (e *Executor) Refresh(ctx context.Context, opts infrastructure.RefreshOpts) error {
+	ctx, span := telemetry.StartSpan(ctx, "tofu.refresh")
+	defer span.End()
+	span.SetAttributes(
+		otattribute.String("pipeline_step", string(e.params.Step)),
+		otattribute.String("working_dir", e.params.WorkingDir),
+	)
+
+	args := []string{
+		"apply",
+		"-refresh-only",
+		"-input=false",
+		"-no-color",
+		"-lock=false",
+		"-auto-approve",
+		fmt.Sprintf("-state=%s", opts.StatePath),
+		fmt.Sprintf("-state-out=%s", opts.StatePath),
+		fmt.Sprintf("-var-file=%s", opts.VariablesPath),
+	}
+
+	e.cmd = tofuCmd(ctx, e.params.RunExecutorParams, e.params.WorkingDir, args...)
+
+	_, err := infraexec.Exec(ctx, e.cmd, e.params.IsDebug)
+
+	return err
+}
+
+func (e *Executor) ProvidersSchema(ctx context.Context) ([]byte, error) {
+	args := []string{
+		"providers",
+		"schema",
+		"-json",
+	}
+
+	e.cmd = tofuCmd(ctx, e.params.RunExecutorParams, e.params.WorkingDir, args...)
+
+	return e.cmd.Output()
+}
+
 func (e *Executor) GetActions(ctx context.Context, planPath string) ([]string, error) {
 	args := []string{
 		"show",
@@ -20,7 +20,10 @@ import (
 	"strings"
 	"time"
 
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	v1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	storagev1 "k8s.io/api/storage/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -59,16 +62,28 @@ func isCAPIClusterUnsupportedErr(err error) bool {
 		strings.Contains(msg, "no matches for kind")
 }
 
+// Functions named like validatingWebhookConfigurationsToDelete select objects deleted by the corresponding
+// Delete functions, they are also used to list resources to delete without deleting them, see ListResourcesToDelete.
+
+func validatingWebhookConfigurationsToDelete(ctx context.Context, kubeCl *client.KubernetesClient) ([]admissionregistrationv1.ValidatingWebhookConfiguration, error) {
+	vwcs, err := kubeCl.AdmissionregistrationV1().ValidatingWebhookConfigurations().List(ctx, metav1.ListOptions{
+		LabelSelector: "heritage=deckhouse",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return vwcs.Items, nil
+}
+
 func DeleteValidatingWebhookConfigurations(ctx context.Context, kubeCl *client.KubernetesClient) error {
 	return retry.NewLoop("Delete validating webhook configurations", 45, 5*time.Second).WithShowError(false).RunContext(ctx, func() error {
-		vwcs, err := kubeCl.AdmissionregistrationV1().ValidatingWebhookConfigurations().List(ctx, metav1.ListOptions{
-			LabelSelector: "heritage=deckhouse",
-		})
+		vwcs, err := validatingWebhookConfigurationsToDelete(ctx, kubeCl)
 		if err != nil {
 			return err
 		}
 
-		for _, vwc := range vwcs.Items {
+		for _, vwc := range vwcs {
 			err := kubeCl.AdmissionregistrationV1().ValidatingWebhookConfigurations().Delete(ctx, vwc.Name, metav1.DeleteOptions{})
 			if err != nil && !errors.IsNotFound(err) {
 				return err
@@ -117,26 +132,36 @@ func WaitForNodeControllerDeploymentDeletion(ctx context.Context, kubeCl *client
 	})
 }
 
-func DeleteClusters(ctx context.Context, kubeCl *client.KubernetesClient) error {
-	return retry.NewLoop("Delete Clusters", 45, 5*time.Second).WithShowError(false).RunContext(ctx, func() error {
-		capiGVRs, err := capi.Resolve(kubeCl.Discovery())
-		if err != nil {
-			if isCAPIClusterUnsupportedErr(err) {
-				return nil
-			}
-			return err
+// clustersToDelete returns CAPI Clusters and their resource, clusters are nil if CAPI is not supported by the cluster.
+func clustersToDelete(ctx context.Context, kubeCl *client.KubernetesClient) ([]unstructured.Unstructured, schema.GroupVersionResource, error) {
+	capiGVRs, err := capi.Resolve(kubeCl.Discovery())
+	if err != nil {
+		if isCAPIClusterUnsupportedErr(err) {
+			return nil, schema.GroupVersionResource{}, nil
 		}
+		return nil, schema.GroupVersionResource{}, err
+	}
 
-		clusters, err := kubeCl.Dynamic().Resource(capiGVRs.ClusterGVR).Namespace(deckhouseClusterNamespace).List(ctx, metav1.ListOptions{})
+	clusters, err := kubeCl.Dynamic().Resource(capiGVRs.ClusterGVR).Namespace(deckhouseClusterNamespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		if isCAPIClusterUnsupportedErr(err) {
+			return nil, capiGVRs.ClusterGVR, nil
+		}
+		return nil, capiGVRs.ClusterGVR, err
+	}
+
+	return clusters.Items, capiGVRs.ClusterGVR, nil
+}
+
+func DeleteClusters(ctx context.Context, kubeCl *client.KubernetesClient) error {
+	return retry.NewLoop("Delete Clusters", 45, 5*time.Second).WithShowError(false).RunContext(ctx, func() error {
+		clusters, clusterGVR, err := clustersToDelete(ctx, kubeCl)
 		if err != nil {
-			if isCAPIClusterUnsupportedErr(err) {
-				return nil
-			}
 			return err
 		}
 
-		for _, cluster := range clusters.Items {
-			err := kubeCl.Dynamic().Resource(capiGVRs.ClusterGVR).Namespace(cluster.GetNamespace()).Delete(ctx, cluster.GetName(), metav1.DeleteOptions{})
+		for _, cluster := range clusters {
+			err := kubeCl.Dynamic().Resource(clusterGVR).Namespace(cluster.GetNamespace()).Delete(ctx, cluster.GetName(), metav1.DeleteOptions{})
 			if err != nil && !errors.IsNotFound(err) {
 				return err
 			}
@@ -147,25 +172,37 @@ func DeleteClusters(ctx context.Context, kubeCl *client.KubernetesClient) error
 	})
 }
 
+func pdbsToDelete(ctx context.Context, kubeCl *client.KubernetesClient) ([]policyv1.PodDisruptionBudget, error) {
+	namespaces, err := kubeCl.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	var result []policyv1.PodDisruptionBudget
+	for _, ns := range namespaces.Items {
+		pdbs, err := kubeCl.PolicyV1().PodDisruptionBudgets(ns.Name).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			continue
+		}
+
+		result = append(result, pdbs.Items...)
+	}
+
+	return result, nil
+}
+
 func DeletePDBs(ctx context.Context, kubeCl *client.KubernetesClient) error {
 	return retry.NewLoop("Delete pdbs", 45, 5*time.Second).WithShowError(false).RunContext(ctx, func() error {
 		foregroundPolicy := metav1.DeletePropagationForeground
-		namespaces, err := kubeCl.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+		pdbs, err := pdbsToDelete(ctx, kubeCl)
 		if err != nil {
 			return err
 		}
 
-		for _, ns := range namespaces.Items {
-			pdbs, err := kubeCl.PolicyV1().PodDisruptionBudgets(ns.Name).List(ctx, metav1.ListOptions{})
+		for _, pdb := range pdbs {
+			err := kubeCl.PolicyV1().PodDisruptionBudgets(pdb.Namespace).Delete(ctx, pdb.Name, metav1.DeleteOptions{PropagationPolicy: &foregroundPolicy})
 			if err != nil {
-				continue
-			}
-
-			for _, pdb := range pdbs.Items {
-				err := kubeCl.PolicyV1().PodDisruptionBudgets(ns.Name).Delete(ctx, pdb.Name, metav1.DeleteOptions{PropagationPolicy: &foregroundPolicy})
-				if err != nil {
-					return err
-				}
+				return err
 			}
 		}
 
@@ -217,20 +254,29 @@ func DeleteAllD8StorageResources(ctx context.Context, kubeCl *client.KubernetesC
 	})
 }
 
+func storageClassesToDelete(ctx context.Context, kubeCl *client.KubernetesClient) ([]storagev1.StorageClass, error) {
+	list, err := kubeCl.StorageV1().StorageClasses().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return list.Items, nil
+}
+
 func DeleteStorageClasses(ctx context.Context, kubeCl *client.KubernetesClient) error {
 	return retry.NewLoop("Delete StorageClasses", 45, 5*time.Second).WithShowError(false).RunContext(ctx, func() error {
-		list, err := kubeCl.StorageV1().StorageClasses().List(ctx, metav1.ListOptions{})
+		storageClasses, err := storageClassesToDelete(ctx, kubeCl)
 		if err != nil {
 			return err
 		}
 
-		if len(list.Items) == 0 {
+		if len(storageClasses) == 0 {
 			return nil
 		}
 
 		var lastError error
 
-		for _, obj := range list.Items {
+		for _, obj := range storageClasses {
 			err = kubeCl.StorageV1().StorageClasses().Delete(ctx, obj.GetName(), metav1.DeleteOptions{})
 			if err != nil && !errors.IsNotFound(err) {
 				lastError = err
@@ -240,31 +286,34 @@ func DeleteStorageClasses(ctx context.Context, kubeCl *client.KubernetesClient)
 	})
 }
 
+func podsToDelete(ctx context.Context, kubeCl *client.KubernetesClient) ([]v1.Pod, error) {
+	pods, err := kubeCl.CoreV1().Pods(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]v1.Pod, 0)
+	for _, pod := range pods.Items {
+		// We have to delete only pods with pvc to trigger pv/pvc deletion
+		for _, volume := range pod.Spec.Volumes {
+			if volume.PersistentVolumeClaim != nil {
+				result = append(result, pod)
+				break
+			}
+		}
+	}
+
+	return result, nil
+}
+
 func DeletePods(ctx context.Context, kubeCl *client.KubernetesClient) error {
 	return retry.NewLoop("Delete Pods", 45, 5*time.Second).WithShowError(false).RunContext(ctx, func() error {
-		pods, err := kubeCl.CoreV1().Pods(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+		pods, err := podsToDelete(ctx, kubeCl)
 		if err != nil {
 			return err
 		}
 
-		for _, pod := range pods.Items {
-			// We have to delete only pods with pvc to trigger pv/pvc deletion
-			if len(pod.Spec.Volumes) == 0 {
-				continue
-			}
-
-			podWithPersistentVolumeClaim := false
-			for _, volume := range pod.Spec.Volumes {
-				if volume.PersistentVolumeClaim != nil {
-					podWithPersistentVolumeClaim = true
-					break
-				}
-			}
-
-			if !podWithPersistentVolumeClaim {
-				continue
-			}
-
+		for _, pod := range pods {
 			err := kubeCl.CoreV1().Pods(pod.Namespace).Delete(ctx, pod.Name, metav1.DeleteOptions{})
 			if err != nil {
 				dhlog.FromContext(ctx).ErrorContext(ctx, err.Error())
@@ -277,18 +326,30 @@ func DeletePods(ctx context.Context, kubeCl *client.KubernetesClient) error {
 	})
 }
 
+func servicesToDelete(ctx context.Context, kubeCl *client.KubernetesClient) ([]v1.Service, error) {
+	allServices, err := kubeCl.CoreV1().Services(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	var result []v1.Service
+	for _, service := range allServices.Items {
+		if service.Spec.Type == v1.ServiceTypeLoadBalancer {
+			result = append(result, service)
+		}
+	}
+
+	return result, nil
+}
+
 func DeleteServices(ctx context.Context, kubeCl *client.KubernetesClient) error {
 	return retry.NewLoop("Delete Services", 45, 5*time.Second).WithShowError(false).RunContext(ctx, func() error {
-		allServices, err := kubeCl.CoreV1().Services(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+		services, err := servicesToDelete(ctx, kubeCl)
 		if err != nil {
 			return err
 		}
 
-		for _, service := range allServices.Items {
-			if service.Spec.Type != v1.ServiceTypeLoadBalancer {
-				continue
-			}
-
+		for _, service := range services {
 			err := kubeCl.CoreV1().Services(service.Namespace).Delete(ctx, service.Name, metav1.DeleteOptions{})
 			if err != nil {
 				return err
@@ -299,14 +360,23 @@ func DeleteServices(ctx context.Context, kubeCl *client.KubernetesClient) error
 	})
 }
 
+func pvcsToDelete(ctx context.Context, kubeCl *client.KubernetesClient) ([]v1.PersistentVolumeClaim, error) {
+	volumeClaims, err := kubeCl.CoreV1().PersistentVolumeClaims(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return volumeClaims.Items, nil
+}
+
 func DeletePVC(ctx context.Context, kubeCl *client.KubernetesClient) error {
 	return retry.NewLoop("Delete PersistentVolumeClaims", 45, 5*time.Second).WithShowError(false).RunContext(ctx, func() error {
-		volumeClaims, err := kubeCl.CoreV1().PersistentVolumeClaims(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+		volumeClaims, err := pvcsToDelete(ctx, kubeCl)
 		if err != nil {
 			return err
 		}
 
-		for _, claim := range volumeClaims.Items {
+		for _, claim := range volumeClaims {
 			err := kubeCl.CoreV1().PersistentVolumeClaims(claim.Namespace).Delete(ctx, claim.Name, metav1.DeleteOptions{})
 			if err != nil {
 				return err
@@ -336,28 +406,15 @@ func WaitForDeckhouseDeploymentDeletion(ctx context.Context, kubeCl *client.Kube
 
 func WaitForClustersDeletion(ctx context.Context, kubeCl *client.KubernetesClient) error {
 	return retry.NewLoop("Wait for Clusters deletion", 45, 15*time.Second).WithShowError(false).RunContext(ctx, func() error {
-		capiGVRs, err := capi.Resolve(kubeCl.Discovery())
+		clusters, _, err := clustersToDelete(ctx, kubeCl)
 		if err != nil {
-			if isCAPIClusterUnsupportedErr(err) {
-				dhlog.FromContext(ctx).InfoContext(ctx, "All Clusters are deleted from the cluster")
-				return nil
-			}
 			return err
 		}
 
-		resources, err := kubeCl.Dynamic().Resource(capiGVRs.ClusterGVR).Namespace(deckhouseClusterNamespace).List(ctx, metav1.ListOptions{})
-		if err != nil {
-			if isCAPIClusterUnsupportedErr(err) {
-				dhlog.FromContext(ctx).InfoContext(ctx, "All Clusters are deleted from the cluster")
-				return nil
-			}
-			return err
-		}
-
-		count := len(resources.Items)
+		count := len(clusters)
 		if count != 0 {
 			builder := strings.Builder{}
-			for _, item := range resources.Items {
+			for _, item := range clusters {
 				fmt.Fprintf(&builder, "\t\t%s/%s\n", item.GetNamespace(), item.GetName())
 			}
 			return fmt.Errorf("%d Clusters left in the cluster\n%s", count, strings.TrimSuffix(builder.String(), "\n"))
@@ -370,18 +427,11 @@ func WaitForClustersDeletion(ctx context.Context, kubeCl *client.KubernetesClien
 
 func WaitForServicesDeletion(ctx context.Context, kubeCl *client.KubernetesClient) error {
 	return retry.NewLoop("Wait for Services deletion", 45, 15*time.Second).WithShowError(false).RunContext(ctx, func() error {
-		resources, err := kubeCl.CoreV1().Services(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+		filteredResources, err := servicesToDelete(ctx, kubeCl)
 		if err != nil {
 			return err
 		}
 
-		var filteredResources []v1.Service
-		for _, resource := range resources.Items {
-			if resource.Spec.Type == v1.ServiceTypeLoadBalancer {
-				filteredResources = append(filteredResources, resource)
-			}
-		}
-
 		count := len(filteredResources)
 		if count != 0 {
 			builder := strings.Builder{}
@@ -395,6 +445,12 @@ func WaitForServicesDeletion(ctx context.Context, kubeCl *client.KubernetesClien
 	})
 }
 
+// isPVDeletedWithClaim reports whether the volume is removed by the storage provisioner after deletion of its claim.
+func isPVDeletedWithClaim(volume v1.PersistentVolume) bool {
+	_, provisioned := volume.Annotations["pv.kubernetes.io/provisioned-by"]
+	return provisioned && volume.Spec.PersistentVolumeReclaimPolicy == v1.PersistentVolumeReclaimDelete
+}
+
 func WaitForPVDeletion(ctx context.Context, kubeCl *client.KubernetesClient) error {
 	return retry.NewLoop("Wait for PersistentVolumes deletion", 45, 15*time.Second).WithShowError(false).RunContext(ctx, func() error {
 		resources, err := kubeCl.CoreV1().PersistentVolumes().List(ctx, metav1.ListOptions{})
@@ -403,14 +459,13 @@ func WaitForPVDeletion(ctx context.Context, kubeCl *client.KubernetesClient) err
 		}
 
 		// Skip PV's provided manually or with reclaimPolicy other than Delete
-		annotationKey := "pv.kubernetes.io/provisioned-by"
 		var filteredResources []v1.PersistentVolume
 		var skipPVs []v1.PersistentVolume
 		for _, resource := range resources.Items {
-			if _, exists := resource.Annotations[annotationKey]; !exists || resource.Spec.PersistentVolumeReclaimPolicy != v1.PersistentVolumeReclaimDelete {
-				skipPVs = append(skipPVs, resource)
-			} else {
+			if isPVDeletedWithClaim(resource) {
 				filteredResources = append(filteredResources, resource)
+			} else {
+				skipPVs = append(skipPVs, resource)
 			}
 		}
 
@@ -485,14 +540,33 @@ func checkMachinesAPI(kubeCl *client.KubernetesClient, gv schema.GroupVersion) e
 	return nil
 }
 
+func mcmMachineDeploymentsToDelete(ctx context.Context, kubeCl *client.KubernetesClient) ([]unstructured.Unstructured, error) {
+	machineDeployments, err := kubeCl.Dynamic().Resource(sapcloud.MachineDeploymentGVR).Namespace(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("get machinedeployments: %v", err)
+	}
+
+	return machineDeployments.Items, nil
+}
+
+// mcmMachinesToDelete returns machines deleted with their machine deployments.
+func mcmMachinesToDelete(ctx context.Context, kubeCl *client.KubernetesClient) ([]unstructured.Unstructured, error) {
+	machines, err := kubeCl.Dynamic().Resource(sapcloud.MachineGVR).Namespace(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("get machines: %v", err)
+	}
+
+	return machines.Items, nil
+}
+
 func DeleteMCMMachineDeployments(ctx context.Context, kubeCl *client.KubernetesClient) error {
 	return retry.NewLoop("Delete MCM MachineDeployments", 45, 5*time.Second).RunContext(ctx, func() error {
-		allMachines, err := kubeCl.Dynamic().Resource(sapcloud.MachineGVR).Namespace(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+		allMachines, err := mcmMachinesToDelete(ctx, kubeCl)
 		if err != nil {
-			return fmt.Errorf("get machines: %v", err)
+			return err
 		}
 
-		for _, machine := range allMachines.Items {
+		for _, machine := range allMachines {
 			labels := machine.GetLabels()
 			// it needs for force delete machine (without drain)
 			labels["force-deletion"] = "True"
@@ -509,12 +583,12 @@ func DeleteMCMMachineDeployments(ctx context.Context, kubeCl *client.KubernetesC
 			}
 		}
 
-		allMachineDeployments, err := kubeCl.Dynamic().Resource(sapcloud.MachineDeploymentGVR).Namespace(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+		allMachineDeployments, err := mcmMachineDeploymentsToDelete(ctx, kubeCl)
 		if err != nil {
-			return fmt.Errorf("get machinedeployments: %v", err)
+			return err
 		}
 
-		for _, machineDeployment := range allMachineDeployments.Items {
+		for _, machineDeployment := range allMachineDeployments {
 			namespace := machineDeployment.GetNamespace()
 			name := machineDeployment.GetName()
 			err := kubeCl.Dynamic().Resource(sapcloud.MachineDeploymentGVR).Namespace(namespace).Delete(ctx, name, metav1.DeleteOptions{})
@@ -605,6 +679,45 @@ func checkCAPIMachinesAPI(kubeCl *client.KubernetesClient) error {
 	return err
 }
 
+// capiMachineDeploymentsToDelete skips the master machine deployment, it is deleted with the cluster infrastructure.
+func capiMachineDeploymentsToDelete(ctx context.Context, kubeCl *client.KubernetesClient, capiGVRs capi.GVRs) ([]unstructured.Unstructured, error) {
+	allMachineDeployments, err := kubeCl.Dynamic().Resource(capiGVRs.MachineDeploymentGVR).Namespace(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("get machinedeployments: %v", err)
+	}
+
+	machineDeployments := make([]unstructured.Unstructured, 0, len(allMachineDeployments.Items))
+	for _, machineDeployment := range allMachineDeployments.Items {
+		if machineDeployment.GetName() == "master" {
+			dhlog.FromContext(ctx).DebugContext(ctx, "Machine deployment 'master' was skipped. It will be deleted later.")
+			continue
+		}
+		machineDeployments = append(machineDeployments, machineDeployment)
+	}
+
+	return machineDeployments, nil
+}
+
+// capiMachinesToDelete skips machines of the master node group, they are deleted with the cluster infrastructure.
+func capiMachinesToDelete(ctx context.Context, kubeCl *client.KubernetesClient, capiGVRs capi.GVRs) ([]unstructured.Unstructured, error) {
+	resources, err := kubeCl.Dynamic().Resource(capiGVRs.MachineGVR).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	machines := make([]unstructured.Unstructured, 0, len(resources.Items))
+	for _, m := range resources.Items {
+		if m.GetLabels()["node-group"] == "master" {
+			dhlog.FromContext(ctx).DebugContext(ctx, fmt.Sprintf("Machine %s was skipped because it is in master ng. Continue.", m.GetName()))
+			continue
+		}
+
+		machines = append(machines, m)
+	}
+
+	return machines, nil
+}
+
 func DeleteCAPIMachineDeployments(ctx context.Context, kubeCl *client.KubernetesClient) error {
 	return retry.NewLoop("Delete CAPI MachineDeployments", 45, 5*time.Second).RunContext(ctx, func() error {
 		capiGVRs, err := capi.Resolve(kubeCl.Discovery())
@@ -633,18 +746,14 @@ func DeleteCAPIMachineDeployments(ctx context.Context, kubeCl *client.Kubernetes
 			dhlog.FromContext(ctx).DebugContext(ctx, fmt.Sprintf("Machine %s patched", machine.GetName()))
 		}
 
-		allMachineDeployments, err := kubeCl.Dynamic().Resource(capiGVRs.MachineDeploymentGVR).Namespace(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+		machineDeployments, err := capiMachineDeploymentsToDelete(ctx, kubeCl, capiGVRs)
 		if err != nil {
-			return fmt.Errorf("get machinedeployments: %v", err)
+			return err
 		}
 
-		for _, machineDeployment := range allMachineDeployments.Items {
+		for _, machineDeployment := range machineDeployments {
 			namespace := machineDeployment.GetNamespace()
 			name := machineDeployment.GetName()
-			if name == "master" {
-				dhlog.FromContext(ctx).InfoContext(ctx, "Machine deployment 'master' was skipped. It will be deleted later.")
-				continue
-			}
 			err := kubeCl.Dynamic().Resource(capiGVRs.MachineDeploymentGVR).Namespace(namespace).Delete(ctx, name, metav1.DeleteOptions{})
 			if err != nil {
 				return fmt.Errorf("Delete CAPI machinedeployments %s: %v", name, err)
@@ -662,25 +771,11 @@ func WaitForCAPIMachinesDeletion(ctx context.Context, kubeCl *client.KubernetesC
 			return err
 		}
 
-		resources, err := kubeCl.Dynamic().Resource(capiGVRs.MachineGVR).List(ctx, metav1.ListOptions{})
+		machines, err := capiMachinesToDelete(ctx, kubeCl, capiGVRs)
 		if err != nil {
 			return err
 		}
 
-		machines := make([]unstructured.Unstructured, 0, len(resources.Items))
-		for _, m := range resources.Items {
-			labels := m.GetLabels()
-			if labels != nil {
-				ng, ok := labels["node-group"]
-				if ok && ng == "master" {
-					dhlog.FromContext(ctx).DebugContext(ctx, fmt.Sprintf("Machine %s was skipped from delete check because it is in master ng. Continue.", m.GetName()))
-					continue
-				}
-			}
-
-			machines = append(machines, m)
-		}
-
 		count := len(machines)
 		if count != 0 {
 			builder := strings.Builder{}
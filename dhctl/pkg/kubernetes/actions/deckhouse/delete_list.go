@@ -0,0 +1,288 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deckhouse
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/deckhouse/lib-dhctl/pkg/retry"
+
+	"github.com/deckhouse/deckhouse/dhctl/pkg/apis/capi"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/apis/deckhouse/v1alpha1"
+	sapcloud "github.com/deckhouse/deckhouse/dhctl/pkg/apis/sapcloudio/v1alpha1"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/kubernetes/client"
+)
+
+// ResourceToDelete is the Kubernetes object removed from the cluster by the destroy operation.
+type ResourceToDelete struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+}
+
+func (r ResourceToDelete) String() string {
+	if r.Namespace == "" {
+		return fmt.Sprintf("%s/%s", r.Kind, r.Name)
+	}
+
+	return fmt.Sprintf("%s/%s/%s", r.Kind, r.Namespace, r.Name)
+}
+
+// ListResourcesToDelete returns objects which are deleted (directly or as a consequence,
+// like PersistentVolumes) by the destroy operation, in the order of deletion. Nothing is changed in the cluster.
+func ListResourcesToDelete(ctx context.Context, kubeCl *client.KubernetesClient) ([]ResourceToDelete, error) {
+	var resources []ResourceToDelete
+
+	err := retry.NewLoop("List resources to delete", 5, 5*time.Second).WithShowError(false).RunContext(ctx, func() error {
+		resources = make([]ResourceToDelete, 0)
+
+		listers := []func(context.Context, *client.KubernetesClient) ([]ResourceToDelete, error){
+			listValidatingWebhookConfigurationsToDelete,
+			listDeckhouseDeploymentToDelete,
+			listPDBsToDelete,
+			listServicesToDelete,
+			listD8StorageResourcesToDelete,
+			listStorageClassesToDelete,
+			listPVCsToDelete,
+			listPodsToDelete,
+			listPVsToDelete,
+			listNodeControllerDeploymentToDelete,
+			listMCMMachinesToDelete,
+			listCAPIMachinesToDelete,
+			listClustersToDelete,
+		}
+
+		for _, list := range listers {
+			listed, err := list(ctx, kubeCl)
+			if err != nil {
+				return err
+			}
+			resources = append(resources, listed...)
+		}
+
+		return nil
+	})
+
+	return resources, err
+}
+
+func listValidatingWebhookConfigurationsToDelete(ctx context.Context, kubeCl *client.KubernetesClient) ([]ResourceToDelete, error) {
+	vwcs, err := validatingWebhookConfigurationsToDelete(ctx, kubeCl)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]ResourceToDelete, 0, len(vwcs))
+	for _, vwc := range vwcs {
+		resources = append(resources, ResourceToDelete{Kind: "ValidatingWebhookConfiguration", Name: vwc.Name})
+	}
+
+	return resources, nil
+}
+
+func listDeckhouseDeploymentToDelete(ctx context.Context, kubeCl *client.KubernetesClient) ([]ResourceToDelete, error) {
+	return listDeploymentToDelete(ctx, kubeCl, deckhouseDeploymentNamespace, deckhouseDeploymentName)
+}
+
+func listNodeControllerDeploymentToDelete(ctx context.Context, kubeCl *client.KubernetesClient) ([]ResourceToDelete, error) {
+	return listDeploymentToDelete(ctx, kubeCl, nodeControllerDeploymentNamespace, nodeControllerDeploymentName)
+}
+
+func listDeploymentToDelete(ctx context.Context, kubeCl *client.KubernetesClient, namespace, name string) ([]ResourceToDelete, error) {
+	_, err := kubeCl.AppsV1().Deployments(namespace).Get(ctx, name, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return []ResourceToDelete{{Kind: "Deployment", Namespace: namespace, Name: name}}, nil
+}
+
+func listPDBsToDelete(ctx context.Context, kubeCl *client.KubernetesClient) ([]ResourceToDelete, error) {
+	pdbs, err := pdbsToDelete(ctx, kubeCl)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]ResourceToDelete, 0, len(pdbs))
+	for _, pdb := range pdbs {
+		resources = append(resources, ResourceToDelete{Kind: "PodDisruptionBudget", Namespace: pdb.Namespace, Name: pdb.Name})
+	}
+
+	return resources, nil
+}
+
+func listServicesToDelete(ctx context.Context, kubeCl *client.KubernetesClient) ([]ResourceToDelete, error) {
+	services, err := servicesToDelete(ctx, kubeCl)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]ResourceToDelete, 0, len(services))
+	for _, service := range services {
+		resources = append(resources, ResourceToDelete{Kind: "Service", Namespace: service.Namespace, Name: service.Name})
+	}
+
+	return resources, nil
+}
+
+func listD8StorageResourcesToDelete(ctx context.Context, kubeCl *client.KubernetesClient) ([]ResourceToDelete, error) {
+	resources := make([]ResourceToDelete, 0)
+	for _, cr := range v1alpha1.D8StoragesGVRs() {
+		storageCRs, err := ListD8StorageResources(ctx, kubeCl, cr)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return nil, fmt.Errorf("get %s: %v", cr, err)
+		}
+
+		resources = append(resources, unstructuredToDelete(storageCRs.Items, cr)...)
+	}
+
+	return resources, nil
+}
+
+func listStorageClassesToDelete(ctx context.Context, kubeCl *client.KubernetesClient) ([]ResourceToDelete, error) {
+	storageClasses, err := storageClassesToDelete(ctx, kubeCl)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]ResourceToDelete, 0, len(storageClasses))
+	for _, sc := range storageClasses {
+		resources = append(resources, ResourceToDelete{Kind: "StorageClass", Name: sc.Name})
+	}
+
+	return resources, nil
+}
+
+func listPVCsToDelete(ctx context.Context, kubeCl *client.KubernetesClient) ([]ResourceToDelete, error) {
+	claims, err := pvcsToDelete(ctx, kubeCl)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]ResourceToDelete, 0, len(claims))
+	for _, claim := range claims {
+		resources = append(resources, ResourceToDelete{Kind: "PersistentVolumeClaim", Namespace: claim.Namespace, Name: claim.Name})
+	}
+
+	return resources, nil
+}
+
+func listPodsToDelete(ctx context.Context, kubeCl *client.KubernetesClient) ([]ResourceToDelete, error) {
+	pods, err := podsToDelete(ctx, kubeCl)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]ResourceToDelete, 0, len(pods))
+	for _, pod := range pods {
+		resources = append(resources, ResourceToDelete{Kind: "Pod", Namespace: pod.Namespace, Name: pod.Name})
+	}
+
+	return resources, nil
+}
+
+// listPVsToDelete returns volumes removed by the storage provisioner after deletion of the claims,
+// other volumes are kept, see WaitForPVDeletion.
+func listPVsToDelete(ctx context.Context, kubeCl *client.KubernetesClient) ([]ResourceToDelete, error) {
+	volumes, err := kubeCl.CoreV1().PersistentVolumes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]ResourceToDelete, 0)
+	for _, volume := range volumes.Items {
+		if isPVDeletedWithClaim(volume) {
+			resources = append(resources, ResourceToDelete{Kind: "PersistentVolume", Name: volume.Name})
+		}
+	}
+
+	return resources, nil
+}
+
+// listMCMMachinesToDelete returns nothing if the MCM API is absent, DeleteMachinesIfResourcesExist skips it then.
+func listMCMMachinesToDelete(ctx context.Context, kubeCl *client.KubernetesClient) ([]ResourceToDelete, error) {
+	if err := checkMCMMachinesAPI(kubeCl); err != nil {
+		return nil, nil
+	}
+
+	machineDeployments, err := mcmMachineDeploymentsToDelete(ctx, kubeCl)
+	if err != nil {
+		return nil, err
+	}
+
+	machines, err := mcmMachinesToDelete(ctx, kubeCl)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := unstructuredToDelete(machineDeployments, sapcloud.MachineDeploymentGVR)
+	return append(resources, unstructuredToDelete(machines, sapcloud.MachineGVR)...), nil
+}
+
+// listCAPIMachinesToDelete returns nothing if the CAPI API is absent, DeleteMachinesIfResourcesExist skips it then.
+func listCAPIMachinesToDelete(ctx context.Context, kubeCl *client.KubernetesClient) ([]ResourceToDelete, error) {
+	capiGVRs, err := capi.Resolve(kubeCl.Discovery())
+	if err != nil {
+		return nil, nil
+	}
+
+	machineDeployments, err := capiMachineDeploymentsToDelete(ctx, kubeCl, capiGVRs)
+	if err != nil {
+		return nil, err
+	}
+
+	machines, err := capiMachinesToDelete(ctx, kubeCl, capiGVRs)
+	if err != nil {
+		return nil, fmt.Errorf("get machines: %v", err)
+	}
+
+	resources := unstructuredToDelete(machineDeployments, capiGVRs.MachineDeploymentGVR)
+	return append(resources, unstructuredToDelete(machines, capiGVRs.MachineGVR)...), nil
+}
+
+func listClustersToDelete(ctx context.Context, kubeCl *client.KubernetesClient) ([]ResourceToDelete, error) {
+	clusters, clusterGVR, err := clustersToDelete(ctx, kubeCl)
+	if err != nil {
+		return nil, err
+	}
+
+	return unstructuredToDelete(clusters, clusterGVR), nil
+}
+
+func unstructuredToDelete(items []unstructured.Unstructured, gvr schema.GroupVersionResource) []ResourceToDelete {
+	resources := make([]ResourceToDelete, 0, len(items))
+	for _, obj := range items {
+		kind := obj.GetKind()
+		if kind == "" {
+			kind = gvr.GroupResource().String()
+		}
+		resources = append(resources, ResourceToDelete{Kind: kind, Namespace: obj.GetNamespace(), Name: obj.GetName()})
+	}
+
+	return resources
+}
@@ -0,0 +1,100 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deckhouse
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/deckhouse/deckhouse/dhctl/pkg/apis/deckhouse/v1alpha1"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/kubernetes/client"
+)
+
+func TestListResourcesToDelete(t *testing.T) {
+	ctx := t.Context()
+
+	kinds := make(map[schema.GroupVersionResource]string)
+	for listKind, gvr := range v1alpha1.D8StoragesListsGVRs() {
+		kinds[gvr] = listKind
+	}
+	fakeClient := client.NewFakeKubernetesClientWithListGVR(kinds)
+
+	_, err := fakeClient.AppsV1().Deployments("d8-system").Create(ctx, &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "deckhouse", Namespace: "d8-system"},
+	}, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	for _, service := range []*v1.Service{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "ingress", Namespace: "d8-ingress-nginx"},
+			Spec:       v1.ServiceSpec{Type: v1.ServiceTypeLoadBalancer},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "kubernetes", Namespace: "default"},
+			Spec:       v1.ServiceSpec{Type: v1.ServiceTypeClusterIP},
+		},
+	} {
+		_, err := fakeClient.CoreV1().Services(service.Namespace).Create(ctx, service, metav1.CreateOptions{})
+		require.NoError(t, err)
+	}
+
+	for _, volume := range []*v1.PersistentVolume{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "provisioned",
+				Annotations: map[string]string{"pv.kubernetes.io/provisioned-by": "csi"},
+			},
+			Spec: v1.PersistentVolumeSpec{PersistentVolumeReclaimPolicy: v1.PersistentVolumeReclaimDelete},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "retained",
+				Annotations: map[string]string{"pv.kubernetes.io/provisioned-by": "csi"},
+			},
+			Spec: v1.PersistentVolumeSpec{PersistentVolumeReclaimPolicy: v1.PersistentVolumeReclaimRetain},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "manual"},
+			Spec:       v1.PersistentVolumeSpec{PersistentVolumeReclaimPolicy: v1.PersistentVolumeReclaimDelete},
+		},
+	} {
+		_, err := fakeClient.CoreV1().PersistentVolumes().Create(ctx, volume, metav1.CreateOptions{})
+		require.NoError(t, err)
+	}
+
+	_, err = fakeClient.CoreV1().PersistentVolumeClaims("default").Create(ctx, &v1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{Name: "data", Namespace: "default"},
+	}, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	resources, err := ListResourcesToDelete(ctx, fakeClient)
+	require.NoError(t, err)
+
+	require.Equal(t, []ResourceToDelete{
+		{Kind: "Deployment", Namespace: "d8-system", Name: "deckhouse"},
+		{Kind: "Service", Namespace: "d8-ingress-nginx", Name: "ingress"},
+		{Kind: "PersistentVolumeClaim", Namespace: "default", Name: "data"},
+		{Kind: "PersistentVolume", Name: "provisioned"},
+	}, resources)
+
+	// nothing is deleted
+	_, err = fakeClient.CoreV1().Services("d8-ingress-nginx").Get(ctx, "ingress", metav1.GetOptions{})
+	require.NoError(t, err)
+}
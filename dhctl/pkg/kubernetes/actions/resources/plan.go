@@ -0,0 +1,102 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/deckhouse/deckhouse/dhctl/pkg/kubernetes/client"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/template"
+)
+
+type PlannedAction string
+
+const (
+	PlannedActionCreate PlannedAction = "create"
+	PlannedActionUpdate PlannedAction = "update"
+	// PlannedActionPending is used for kinds the API server does not serve yet,
+	// e.g. custom resources whose CRD is installed by a Deckhouse module later.
+	PlannedActionPending PlannedAction = "pending"
+)
+
+// PlannedResource describes what CreateResourcesLoop would do with a single object.
+type PlannedResource struct {
+	APIVersion string        `json:"api_version"`
+	Kind       string        `json:"kind"`
+	Namespace  string        `json:"namespace,omitempty"`
+	Name       string        `json:"name"`
+	Action     PlannedAction `json:"action"`
+}
+
+func (r PlannedResource) String() string {
+	name := r.Name
+	if r.Namespace != "" {
+		name = r.Namespace + "/" + name
+	}
+	return fmt.Sprintf("%s %s %s (%s)", r.Action, r.Kind, name, r.APIVersion)
+}
+
+// PlanResources resolves every resource the same way Creator does and reports
+// whether it would be created or updated. It only reads from the cluster.
+func PlanResources(ctx context.Context, kubeCl *client.KubernetesClient, resources template.Resources) ([]PlannedResource, error) {
+	apiResourceGetter := newAPIResourceListGetter(kubeCl)
+	planned := make([]PlannedResource, 0, len(resources))
+
+	for _, resource := range resources {
+		entry := PlannedResource{
+			APIVersion: resource.GVK.GroupVersion().String(),
+			Kind:       resource.GVK.Kind,
+			Namespace:  resource.Object.GetNamespace(),
+			Name:       resource.Object.GetName(),
+			Action:     PlannedActionPending,
+		}
+
+		resourcesList, err := apiResourceGetter.Get(ctx, &resource.GVK)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, discoveredResource := range resourcesList.APIResources {
+			if discoveredResource.Kind != resource.GVK.Kind {
+				continue
+			}
+
+			gvr, docCopy := resourceToGVR(resource, discoveredResource)
+			entry.Namespace = docCopy.GetNamespace()
+
+			_, err := kubeCl.Dynamic().Resource(*gvr).
+				Namespace(entry.Namespace).
+				Get(ctx, docCopy.GetName(), metav1.GetOptions{})
+			switch {
+			case err == nil:
+				entry.Action = PlannedActionUpdate
+			case apierrors.IsNotFound(err):
+				entry.Action = PlannedActionCreate
+			default:
+				return nil, fmt.Errorf("can't get %s: %w", getUnstructuredName(docCopy), err)
+			}
+
+			break
+		}
+
+		planned = append(planned, entry)
+	}
+
+	return planned, nil
+}
@@ -189,7 +189,7 @@ func (r *Runner) ExecuteBundle(ctx context.Context, params ExecuteBundleParams)
 
 	if telemetry.IsEnabled() {
 		stopRelay, updateRelaySpan, err := relay.InitRelay(ctx, relay.RelayParams{
-			TracerName: "bashible",
+			TracerName: telemetry.BashibleTracerName,
 			Span:       span,
 			Node:       r.nodeInterface,
 			Logger:     r.logger,
@@ -378,6 +378,12 @@ func (b *ClusterBootstrapper) bootstrapLoadConfig(ctx context.Context, bctx *boo
 
 	configHash := state.ConfigHash(ctx, b.Options.Global.ConfigPaths)
 
+	journal, err := b.initJournal(ctx, stateCache, configHash)
+	if err != nil {
+		return err
+	}
+	b.PhasedExecutionContext.SetJournal(journal)
+
 	clusterUUID, err := generateClusterUUID(ctx, stateCache)
 	if err != nil {
 		return err
@@ -430,6 +436,15 @@ func (b *ClusterBootstrapper) bootstrapPreflight(ctx context.Context, bctx *boot
 		BuildInfo:     b.Options.BuildInfo,
 	})
 
+	pluginPreflightSuite, err := suites.NewPluginSuite(suites.PluginDeps{
+		SSHProviderInitializer: b.SSHProviderInitializer,
+		MetaConfig:             bctx.metaConfig,
+		PreflightOpts:          &b.Options.Preflight,
+	}, ctx)
+	if err != nil {
+		return err
+	}
+
 	if bctx.metaConfig.ClusterType == config.CloudClusterType {
 		sshProvider, err := b.SSHProviderInitializer.GetSSHProvider(ctx)
 		if err != nil {
@@ -449,11 +464,15 @@ func (b *ClusterBootstrapper) bootstrapPreflight(ctx context.Context, bctx *boot
 			LegacyMode:  b.SSHProviderInitializer.IsLegacyMode(),
 		})
 
-		preflightRunner := preflight.New(globalPreflightSuite, cloudPreflightSuite, postCloudPreflightSuite)
+		preflightRunner := preflight.New(globalPreflightSuite, cloudPreflightSuite, postCloudPreflightSuite, pluginPreflightSuite)
 		preflightRunner.UseCache(bctx.bootstrapState)
 		preflightRunner.SetCacheSalt(bctx.configHash)
 		preflightRunner.DisableChecks(b.Options.Preflight.DisabledChecks()...)
 		bctx.preflightRunner = preflightRunner
+		if b.resumed(ctx, phases.PreInfraPreflightsPhase, "") {
+			return nil
+		}
+
 		if err := preflightRunner.Run(ctx, preflight.PhasePreInfra); err != nil {
 			return err
 		}
@@ -469,11 +488,14 @@ func (b *ClusterBootstrapper) bootstrapPreflight(ctx context.Context, bctx *boot
 			return err
 		}
 
-		preflightRunner := preflight.New(globalPreflightSuite, staticPreflightSuite)
+		preflightRunner := preflight.New(globalPreflightSuite, staticPreflightSuite, pluginPreflightSuite)
 		preflightRunner.UseCache(bctx.bootstrapState)
 		preflightRunner.SetCacheSalt(bctx.configHash)
 		preflightRunner.DisableChecks(b.Options.Preflight.DisabledChecks()...)
 		bctx.preflightRunner = preflightRunner
+		if b.resumed(ctx, phases.PreInfraPreflightsPhase, "") {
+			return nil
+		}
 
 		if err := preflightRunner.Run(ctx, preflight.PhasePreInfra); err != nil {
 			return err
@@ -497,14 +519,26 @@ func (b *ClusterBootstrapper) bootstrapBaseInfra(ctx context.Context, bctx *boot
 			ctx, span := telemetry.StartSpan(ctx, "ClusterBootstrapper.Bootstrap.CloudInfra")
 			defer span.End()
 
-			baseRunner, err := b.InfrastructureContext.GetBootstrapBaseInfraRunner(ctx, bctx.metaConfig, bctx.stateCache)
+			baseOutputs := &infrastructure.PipelineOutputs{}
+			resumed, err := b.resumedOutputs(ctx, phases.BaseInfraPhase, phases.BaseInfraSubPhaseBaseInfra, baseOutputs)
 			if err != nil {
 				return err
 			}
 
-			baseOutputs, err := infrastructure.ApplyPipeline(ctx, baseRunner, "Kubernetes cluster", &b.Options.Global, infrastructure.GetBaseInfraResult)
-			if err != nil {
-				return err
+			if !resumed {
+				baseRunner, err := b.InfrastructureContext.GetBootstrapBaseInfraRunner(ctx, bctx.metaConfig, bctx.stateCache)
+				if err != nil {
+					return err
+				}
+
+				baseOutputs, err = infrastructure.ApplyPipeline(ctx, baseRunner, "Kubernetes cluster", &b.Options.Global, infrastructure.GetBaseInfraResult)
+				if err != nil {
+					return err
+				}
+
+				if err := b.setJournalOutputs(phases.BaseInfraPhase, phases.BaseInfraSubPhaseBaseInfra, baseOutputs); err != nil {
+					return err
+				}
 			}
 
 			dhlog.FromContext(ctx).DebugContext(ctx, "Base infrastructure was created")
@@ -521,20 +555,33 @@ func (b *ClusterBootstrapper) bootstrapBaseInfra(ctx context.Context, bctx *boot
 			}
 
 			masterNodeName := fmt.Sprintf("%s-master-0", bctx.metaConfig.ClusterPrefix)
-			masterRunner, err := b.Params.InfrastructureContext.GetBootstrapNodeRunner(ctx, bctx.metaConfig, bctx.stateCache, infrastructure.BootstrapNodeRunnerOptions{
-				NodeName:        masterNodeName,
-				NodeGroupStep:   infrastructure.MasterNodeStep,
-				NodeGroupName:   "master",
-				NodeIndex:       0,
-				NodeCloudConfig: "",
-			})
+
+			masterOutputs := &infrastructure.PipelineOutputs{}
+			resumed, err = b.resumedOutputs(ctx, phases.BaseInfraPhase, phases.BaseInfraSubPhaseFirstMaster, masterOutputs)
 			if err != nil {
 				return err
 			}
 
-			masterOutputs, err := infrastructure.ApplyPipeline(ctx, masterRunner, masterNodeName, &b.Options.Global, infrastructure.GetMasterNodeResult)
-			if err != nil {
-				return err
+			if !resumed {
+				masterRunner, err := b.Params.InfrastructureContext.GetBootstrapNodeRunner(ctx, bctx.metaConfig, bctx.stateCache, infrastructure.BootstrapNodeRunnerOptions{
+					NodeName:        masterNodeName,
+					NodeGroupStep:   infrastructure.MasterNodeStep,
+					NodeGroupName:   "master",
+					NodeIndex:       0,
+					NodeCloudConfig: "",
+				})
+				if err != nil {
+					return err
+				}
+
+				masterOutputs, err = infrastructure.ApplyPipeline(ctx, masterRunner, masterNodeName, &b.Options.Global, infrastructure.GetMasterNodeResult)
+				if err != nil {
+					return err
+				}
+
+				if err := b.setJournalOutputs(phases.BaseInfraPhase, phases.BaseInfraSubPhaseFirstMaster, masterOutputs); err != nil {
+					return err
+				}
 			}
 
 			dhlog.FromContext(ctx).DebugContext(ctx, "First control-plane node was created")
@@ -601,13 +648,19 @@ func (b *ClusterBootstrapper) bootstrapPostInfraPreflights(ctx context.Context,
 		return nil
 	}
 
+	runPreflights := !b.resumed(ctx, phases.PostInfraPreflightsPhase, "")
+
 	if bctx.metaConfig.ClusterType == config.CloudClusterType {
-		if err := bctx.preflightRunner.Run(ctx, preflight.PhasePostInfra); err != nil {
-			return err
+		if runPreflights {
+			if err := bctx.preflightRunner.Run(ctx, preflight.PhasePostInfra); err != nil {
+				return err
+			}
 		}
 	} else {
-		if err := bctx.preflightRunner.Run(ctx, preflight.PhasePostInfra); err != nil {
-			return err
+		if runPreflights {
+			if err := bctx.preflightRunner.Run(ctx, preflight.PhasePostInfra); err != nil {
+				return err
+			}
 		}
 
 		var static struct {
@@ -670,6 +723,10 @@ func (b *ClusterBootstrapper) bootstrapKubernetes(ctx context.Context, bctx *boo
 		return nil
 	}
 
+	if b.resumed(ctx, phases.InstallKubernetesPhase, "") {
+		return nil
+	}
+
 	ctx, bashibleBundleSpan := telemetry.StartSpan(ctx, "ClusterBootstrapper.Bootstrap.BashibleBundle")
 	defer bashibleBundleSpan.End()
 
@@ -737,9 +794,11 @@ func (b *ClusterBootstrapper) bootstrapDeckhouse(ctx context.Context, bctx *boot
 
 	b.PhasedExecutionContext.CompleteSubPhase(ctx, phases.InstallDeckhouseSubPhaseInstall)
 
-	err = WaitForFirstMasterNodeBecomeReady(ctx, &client.KubernetesClient{KubeClient: kubeCl})
-	if err != nil {
-		return err
+	if !b.resumed(ctx, phases.InstallDeckhousePhase, phases.InstallDeckhouseSubPhaseWait) {
+		err = WaitForFirstMasterNodeBecomeReady(ctx, &client.KubernetesClient{KubeClient: kubeCl})
+		if err != nil {
+			return err
+		}
 	}
 
 	b.PhasedExecutionContext.CompleteSubPhase(ctx, phases.InstallDeckhouseSubPhaseWait)
@@ -757,6 +816,10 @@ func (b *ClusterBootstrapper) bootstrapAdditionalNodes(ctx context.Context, bctx
 		ctx, additionalNodesSpan := telemetry.StartSpan(ctx, "ClusterBootstrapper.Bootstrap.AdditionalNodes")
 		defer additionalNodesSpan.End()
 
+		if b.resumed(ctx, phases.InstallAdditionalMastersAndStaticNodes, "") {
+			return nil
+		}
+
 		kubeCl, err := b.KubeProvider.Client(ctx)
 		if err != nil {
 			return err
@@ -813,6 +876,10 @@ func (b *ClusterBootstrapper) bootstrapCreateResources(ctx context.Context, bctx
 		return nil
 	}
 
+	if b.resumed(ctx, phases.CreateResourcesPhase, "") {
+		return nil
+	}
+
 	kubeCl, err := b.KubeProvider.Client(ctx)
 	if err != nil {
 		return err
@@ -839,6 +906,10 @@ func (b *ClusterBootstrapper) bootstrapPostBootstrap(ctx context.Context, bctx *
 		return nil
 	}
 
+	if b.resumed(ctx, phases.ExecPostBootstrapPhase, "") {
+		return nil
+	}
+
 	if b.SSHProviderInitializer.CheckHosts(ctx) && b.Options.Bootstrap.PostBootstrapScriptPath != "" {
 		ctx, postBootstrapSpan := telemetry.StartSpan(ctx, "ClusterBootstrapper.Bootstrap.PostBootstrap")
 		defer postBootstrapSpan.End()
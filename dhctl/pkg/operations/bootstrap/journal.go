@@ -0,0 +1,115 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bootstrap
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	dhlog "github.com/deckhouse/lib-dhctl/pkg/logger"
+
+	"github.com/deckhouse/deckhouse/dhctl/pkg/operations/phases"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/state"
+)
+
+// initJournal loads the journal of the interrupted bootstrap in resume mode or starts a new one.
+// In resume mode configuration must be the same as in the interrupted run.
+func (b *ClusterBootstrapper) initJournal(ctx context.Context, stateCache state.Cache, configHash string) (*phases.Journal, error) {
+	configContent := state.RedactedConfigContent(ctx, b.Options.Global.ConfigPaths)
+
+	journal, err := phases.LoadJournal(ctx, stateCache)
+	if err != nil && !errors.Is(err, phases.ErrJournalNotFound) {
+		return nil, err
+	}
+
+	if b.Options.Bootstrap.Resume {
+		if journal == nil {
+			return nil, fmt.Errorf("Nothing to resume: %w in the state cache %s", phases.ErrJournalNotFound, stateCache.Dir())
+		}
+
+		if err := journal.ValidateResume(phases.OperationBootstrap, configHash, configContent); err != nil {
+			return nil, err
+		}
+
+		phase, subPhase := journal.LastCompleted()
+		if phase == "" {
+			dhlog.FromContext(ctx).InfoContext(ctx, "Interrupted bootstrap did not complete any phase. Resuming from the beginning.")
+		} else {
+			dhlog.FromContext(ctx).InfoContext(ctx, fmt.Sprintf("Resuming bootstrap after %s", journalStepName(phase, subPhase)))
+		}
+
+		return journal, nil
+	}
+
+	if journal != nil {
+		if phase, subPhase := journal.LastCompleted(); phase != "" {
+			dhlog.FromContext(ctx).WarnContext(ctx, fmt.Sprintf(
+				"Found journal of a previous bootstrap interrupted after %s. It will be overwritten. Use --resume flag to continue the previous bootstrap.",
+				journalStepName(phase, subPhase),
+			))
+		}
+	}
+
+	journal = phases.NewJournal(stateCache, phases.OperationBootstrap, configHash, configContent)
+
+	return journal, journal.Save(ctx)
+}
+
+// resumed returns true if bootstrap runs in resume mode and the step was completed by the interrupted run.
+// Pass empty subPhase to check the whole phase.
+func (b *ClusterBootstrapper) resumed(ctx context.Context, phase phases.OperationPhase, subPhase phases.OperationSubPhase) bool {
+	if !b.Options.Bootstrap.Resume {
+		return false
+	}
+
+	if !b.PhasedExecutionContext.Journal().IsCompleted(phase, subPhase) {
+		return false
+	}
+
+	dhlog.FromContext(ctx).InfoContext(ctx, fmt.Sprintf("%s was completed by the interrupted bootstrap. Skipping.", journalStepName(phase, subPhase)))
+
+	return true
+}
+
+// resumedOutputs is like resumed but also restores outputs of the completed step into out.
+// Step without recorded outputs is considered as not completed, because it cannot be skipped safely.
+func (b *ClusterBootstrapper) resumedOutputs(ctx context.Context, phase phases.OperationPhase, subPhase phases.OperationSubPhase, out any) (bool, error) {
+	if !b.Options.Bootstrap.Resume {
+		return false, nil
+	}
+
+	ok, err := b.PhasedExecutionContext.Journal().Outputs(phase, subPhase, out)
+	if err != nil || !ok {
+		return false, err
+	}
+
+	dhlog.FromContext(ctx).InfoContext(ctx, fmt.Sprintf("%s was completed by the interrupted bootstrap. Using journaled outputs.", journalStepName(phase, subPhase)))
+
+	return true, nil
+}
+
+// setJournalOutputs records outputs of the step to restore them in resume mode.
+func (b *ClusterBootstrapper) setJournalOutputs(phase phases.OperationPhase, subPhase phases.OperationSubPhase, outputs any) error {
+	return b.PhasedExecutionContext.Journal().SetOutputs(phase, subPhase, outputs)
+}
+
+func journalStepName(phase phases.OperationPhase, subPhase phases.OperationSubPhase) string {
+	if subPhase == "" {
+		return fmt.Sprintf("phase %s", phase)
+	}
+
+	return fmt.Sprintf("sub phase %s of phase %s", subPhase, phase)
+}
@@ -52,6 +52,10 @@ const (
 type ClusterCheckResult struct {
 	Status             string                                               `json:"status,omitempty"`
 	DestructiveChanges *infrastructure.BaseInfrastructureDestructiveChanges `json:"destructive_changes,omitempty"`
+	// Plan is the raw base infrastructure plan, it is used to build ConvergePlan
+	Plan plan.Plan `json:"-"`
+	// SavedPlan is the plan file to apply it later without planning again
+	SavedPlan *infrastructure.SavedPlan `json:"-"`
 }
 
 type NodeCheckResult struct {
@@ -59,6 +63,10 @@ type NodeCheckResult struct {
 	Name               string                   `json:"name,omitempty"`
 	Status             string                   `json:"status,omitempty"`
 	DestructiveChanges *plan.DestructiveChanges `json:"destructive_changes,omitempty"`
+	// Plan is the raw node infrastructure plan, it is used to build ConvergePlan
+	Plan plan.Plan `json:"-"`
+	// SavedPlan is the plan file to apply it later without planning again
+	SavedPlan *infrastructure.SavedPlan `json:"-"`
 }
 
 type NodeGroupCheckResult struct {
@@ -121,6 +129,7 @@ type ClusterStateCheckResult struct {
 	Plan               plan.Plan
 	DestructiveChanges *infrastructure.BaseInfrastructureDestructiveChanges
 	IsTerraformState   bool
+	SavedPlan          *infrastructure.SavedPlan
 }
 
 func checkClusterState(
@@ -176,11 +185,17 @@ func checkClusterState(
 		return nil, err
 	}
 
+	savedPlan, err := infrastructure.NewSavedPlan(baseRunner, st)
+	if err != nil {
+		return nil, err
+	}
+
 	return &ClusterStateCheckResult{
 		Change:             change,
 		Plan:               pl,
 		DestructiveChanges: destructiveChanges,
 		IsTerraformState:   isTerraformState,
+		SavedPlan:          savedPlan,
 	}, nil
 }
 
@@ -238,6 +253,7 @@ type NodeStateCheckResult struct {
 	Plan               plan.Plan
 	DestructiveChanges *plan.DestructiveChanges
 	IsTerraformState   bool
+	SavedPlan          *infrastructure.SavedPlan
 }
 
 func checkNodeState(ctx context.Context, kubeCl *client.KubernetesClient, metaConfig *config.MetaConfig, nodeGroup *NodeGroupOptions, nodeName string, infrastructureContext *infrastructure.Context, opts CheckStateOptions, noout bool) (*NodeStateCheckResult, error) {
@@ -293,11 +309,17 @@ func checkNodeState(ctx context.Context, kubeCl *client.KubernetesClient, metaCo
 		return nil, err
 	}
 
+	savedPlan, err := infrastructure.NewSavedPlan(nodeRunner, st)
+	if err != nil {
+		return nil, err
+	}
+
 	return &NodeStateCheckResult{
 		Change:             change,
 		Plan:               pl,
 		DestructiveChanges: destructiveChanges,
 		IsTerraformState:   isTerraformState,
+		SavedPlan:          savedPlan,
 	}, nil
 }
 
@@ -341,8 +363,11 @@ func CheckState(
 	if baseRes != nil {
 		if baseRes.Plan != nil {
 			statistics.InfrastructurePlan = append(statistics.InfrastructurePlan, baseRes.Plan)
+			statistics.Cluster.Plan = baseRes.Plan
 		}
 
+		statistics.Cluster.SavedPlan = baseRes.SavedPlan
+
 		hasTerraformState = baseRes.IsTerraformState
 	}
 
@@ -455,6 +480,11 @@ func CheckState(
 				} else {
 					checkResult.Status = AbandonedStatus
 					checkResult.DestructiveChanges = destructiveChanges
+					checkResult.Plan = infrastructurePlan
+					// abandoned node is destroyed without plan file, only its state is checked before apply
+					checkResult.SavedPlan = &infrastructure.SavedPlan{
+						StateHash: infrastructure.StateHash(nodeGroupState.State[nodeName]),
+					}
 				}
 
 				statistics.Node = append(statistics.Node, checkResult)
@@ -495,6 +525,11 @@ func CheckState(
 				checkResult.DestructiveChanges = nodeRes.DestructiveChanges
 			}
 
+			if nodeRes != nil {
+				checkResult.Plan = nodeRes.Plan
+				checkResult.SavedPlan = nodeRes.SavedPlan
+			}
+
 			statistics.Node = append(statistics.Node, checkResult)
 			if nodeRes != nil {
 				if nodeRes.Plan != nil {
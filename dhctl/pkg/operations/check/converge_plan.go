@@ -0,0 +1,643 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package check
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/pmezard/go-difflib/difflib"
+	"sigs.k8s.io/yaml"
+
+	"github.com/deckhouse/deckhouse/dhctl/pkg/config"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/global"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/infrastructure"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/infrastructure/plan"
+	dhctlstate "github.com/deckhouse/deckhouse/dhctl/pkg/state"
+)
+
+const (
+	ConvergePlanLayerBaseInfrastructure = "base-infrastructure"
+	ConvergePlanLayerNode               = "node"
+)
+
+const (
+	SecretActionNone   = "none"
+	SecretActionCreate = "create"
+	SecretActionUpdate = "update"
+)
+
+var ErrConvergePlanOutdated = errors.New("converge plan is outdated, cluster was changed after planning")
+
+// ConvergePlan is the machine-readable document which describes all changes
+// converge is going to make in the infrastructure and in the Kubernetes cluster.
+type ConvergePlan struct {
+	// ID is the hash of all planned changes, plan with the same changes has the same ID
+	ID                  string                    `json:"id"`
+	Status              CheckStatus               `json:"status"`
+	DestructiveChangeID string                    `json:"destructive_change_id,omitempty"`
+	Summary             ConvergePlanSummary       `json:"summary"`
+	Infrastructure      []InfrastructureLayerPlan `json:"infrastructure"`
+	Kubernetes          KubernetesPlan            `json:"kubernetes"`
+	// ConfigHash is the hash of the configuration converge is going to apply
+	ConfigHash string `json:"config_hash"`
+	// SavedPlans are the plan files of the infrastructure utility, they are stored only in the plan file
+	// because they contain sensitive data, see Save
+	SavedPlans infrastructure.SavedPlans `json:"saved_plans,omitempty"`
+}
+
+type ConvergePlanSummary struct {
+	Create   int `json:"create"`
+	Update   int `json:"update"`
+	Delete   int `json:"delete"`
+	Recreate int `json:"recreate"`
+}
+
+// InfrastructureLayerPlan is the plan of base infrastructure or of the single node.
+type InfrastructureLayerPlan struct {
+	Layer              string                   `json:"layer"`
+	NodeGroup          string                   `json:"node_group,omitempty"`
+	Node               string                   `json:"node,omitempty"`
+	Status             string                   `json:"status"`
+	Resources          []ResourcePlan           `json:"resources,omitempty"`
+	DestructiveChanges *plan.DestructiveChanges `json:"destructive_changes,omitempty"`
+	OutputZonesChanged *plan.ValueChange        `json:"output_zones_changed,omitempty"`
+	OutputBrokenReason string                   `json:"output_broken_reason,omitempty"`
+}
+
+type ResourcePlan struct {
+	Address      string         `json:"address"`
+	Type         string         `json:"type"`
+	Name         string         `json:"name"`
+	ProviderName string         `json:"provider_name,omitempty"`
+	Action       plan.Action    `json:"action"`
+	Before       map[string]any `json:"before,omitempty"`
+	After        map[string]any `json:"after,omitempty"`
+}
+
+type KubernetesPlan struct {
+	Secrets    []SecretPlan    `json:"secrets"`
+	NodeGroups []NodeGroupPlan `json:"node_groups"`
+}
+
+// SecretPlan describes the change of the secret with cluster configuration.
+// Diff is the unified diff between in-cluster and desired configuration. The values of the provider
+// cluster configuration are replaced by their checksums (see state.RedactConfig): it contains cloud
+// credentials, so the diff only shows which settings changed.
+type SecretPlan struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	Key       string `json:"key"`
+	Action    string `json:"action"`
+	Diff      string `json:"diff,omitempty"`
+}
+
+type NodeGroupPlan struct {
+	Name            string `json:"name"`
+	CurrentReplicas int    `json:"current_replicas"`
+	DesiredReplicas int    `json:"desired_replicas"`
+	NodesToCreate   int    `json:"nodes_to_create"`
+	NodesToDelete   int    `json:"nodes_to_delete"`
+	TemplateStatus  string `json:"template_status,omitempty"`
+}
+
+// NewConvergePlan builds converge plan from CheckState statistics.
+// desiredConfig is the configuration converge is going to apply, inClusterConfig is the configuration
+// stored in the cluster secrets. They are the same if converge uses in-cluster configuration.
+func NewConvergePlan(ctx context.Context, statistics *Statistics, desiredConfig, inClusterConfig *config.MetaConfig) (*ConvergePlan, error) {
+	if statistics == nil {
+		return nil, fmt.Errorf("check statistics is required to build converge plan")
+	}
+
+	p := &ConvergePlan{
+		Status:         CheckStatusInSync,
+		Infrastructure: make([]InfrastructureLayerPlan, 0, len(statistics.Node)+1),
+		Kubernetes: KubernetesPlan{
+			NodeGroups: make([]NodeGroupPlan, 0),
+		},
+	}
+
+	// converge does not manage infrastructure and nodes of static clusters
+	if desiredConfig.ClusterType == config.CloudClusterType {
+		baseLayer, err := baseInfrastructureLayerPlan(statistics.Cluster)
+		if err != nil {
+			return nil, err
+		}
+		p.addLayer(baseLayer)
+		p.addSavedPlan(string(infrastructure.BaseInfraStep), statistics.Cluster.SavedPlan)
+
+		// nodes in statistics are not ordered, but plan with the same changes should have the same ID
+		nodes := append([]NodeCheckResult(nil), statistics.Node...)
+		sort.SliceStable(nodes, func(i, j int) bool {
+			if nodes[i].Group != nodes[j].Group {
+				return nodes[i].Group < nodes[j].Group
+			}
+			return nodes[i].Name < nodes[j].Name
+		})
+
+		for _, node := range nodes {
+			layer, err := nodeLayerPlan(node)
+			if err != nil {
+				return nil, err
+			}
+			p.addLayer(layer)
+			p.addSavedPlan(node.Name, node.SavedPlan)
+		}
+
+		p.Kubernetes.NodeGroups = nodeGroupsPlan(statistics, desiredConfig)
+		for _, ng := range p.Kubernetes.NodeGroups {
+			if ng.TemplateStatus != "" {
+				p.Status = p.Status.CombineStatus(resolveStatisticsStatus(ng.TemplateStatus))
+			}
+		}
+	}
+
+	secrets, err := configurationSecretsPlan(ctx, desiredConfig, inClusterConfig)
+	if err != nil {
+		return nil, err
+	}
+	for _, secret := range secrets {
+		if secret.Action != SecretActionNone {
+			p.Status = p.Status.CombineStatus(CheckStatusOutOfSync)
+		}
+	}
+	p.Kubernetes.Secrets = secrets
+
+	if p.Status == CheckStatusDestructiveOutOfSync {
+		p.DestructiveChangeID, err = DestructiveChangeID(statistics)
+		if err != nil {
+			return nil, fmt.Errorf("unable to generate destructive change id: %w", err)
+		}
+	}
+
+	p.ID, err = p.hash()
+	if err != nil {
+		return nil, err
+	}
+
+	p.ConfigHash, err = ConfigHash(ctx, desiredConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// LoadConvergePlan reads the plan saved with Save.
+func LoadConvergePlan(path string) (*ConvergePlan, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read converge plan: %w", err)
+	}
+
+	var p ConvergePlan
+	if err := json.Unmarshal(data, &p); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal converge plan %s: %w", path, err)
+	}
+
+	if p.ID == "" || p.ConfigHash == "" {
+		return nil, fmt.Errorf("file %s is not a converge plan", path)
+	}
+
+	return &p, nil
+}
+
+// Save writes plan with the plan files of the infrastructure utility to apply it later.
+// File contains sensitive data from infrastructure state, so it is readable by owner only.
+func (p *ConvergePlan) Save(path string) error {
+	data, err := json.MarshalIndent(p, "", "  ")
+	if err != nil {
+		return fmt.Errorf("cannot marshal converge plan: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		return fmt.Errorf("unable to write converge plan: %w", err)
+	}
+
+	return nil
+}
+
+// Format data according to the specified format ("json"|"yaml") and
+// hides plan files of the infrastructure utility from result
+func (p *ConvergePlan) Format(outputFormat string) ([]byte, error) {
+	printablePlan := *p
+	printablePlan.SavedPlans = nil
+
+	switch outputFormat {
+	case "yaml":
+		return yaml.Marshal(printablePlan)
+	case "json":
+		return json.MarshalIndent(printablePlan, "", "  ")
+	default:
+		return nil, fmt.Errorf("unknown output format %s", outputFormat)
+	}
+}
+
+// Verify returns ErrConvergePlanOutdated if cluster configuration or any infrastructure state
+// was changed after planning. State of the base infrastructure is required for cloud clusters only.
+func (p *ConvergePlan) Verify(ctx context.Context, inClusterConfig *config.MetaConfig, clusterState []byte, nodesState map[string]dhctlstate.NodeGroupInfrastructureState) error {
+	configHash, err := ConfigHash(ctx, inClusterConfig)
+	if err != nil {
+		return err
+	}
+
+	if configHash != p.ConfigHash {
+		return fmt.Errorf("%w: cluster configuration was changed", ErrConvergePlanOutdated)
+	}
+
+	if inClusterConfig.ClusterType != config.CloudClusterType {
+		return nil
+	}
+
+	baseName := string(infrastructure.BaseInfraStep)
+	if savedPlan, ok := p.SavedPlans[baseName]; !ok || savedPlan.StateHash != infrastructure.StateHash(clusterState) {
+		return fmt.Errorf("%w: base infrastructure state was changed", ErrConvergePlanOutdated)
+	}
+
+	nodesInState := make(map[string]struct{})
+	for _, nodeGroupState := range nodesState {
+		for nodeName, state := range nodeGroupState.State {
+			nodesInState[nodeName] = struct{}{}
+
+			savedPlan, ok := p.SavedPlans[nodeName]
+			if !ok {
+				return fmt.Errorf("%w: node %s was created", ErrConvergePlanOutdated, nodeName)
+			}
+
+			if savedPlan.StateHash != infrastructure.StateHash(state) {
+				return fmt.Errorf("%w: infrastructure state of node %s was changed", ErrConvergePlanOutdated, nodeName)
+			}
+		}
+	}
+
+	for name := range p.SavedPlans {
+		if _, ok := nodesInState[name]; !ok && name != baseName {
+			return fmt.Errorf("%w: node %s was deleted", ErrConvergePlanOutdated, name)
+		}
+	}
+
+	return nil
+}
+
+// ConfigHash returns the hash of the configuration stored in the cluster secrets.
+func ConfigHash(ctx context.Context, metaConfig *config.MetaConfig) (string, error) {
+	secrets, err := configurationSecrets(ctx, metaConfig)
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.New()
+	for _, secret := range secrets {
+		content, err := normalizedConfigYAML(secret, metaConfig)
+		if err != nil {
+			return "", err
+		}
+
+		fmt.Fprintf(hash, "%s/%s\n%s\n", secret.name, secret.key, content)
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+func (p *ConvergePlan) addSavedPlan(name string, savedPlan *infrastructure.SavedPlan) {
+	if savedPlan == nil {
+		return
+	}
+
+	if p.SavedPlans == nil {
+		p.SavedPlans = make(infrastructure.SavedPlans)
+	}
+
+	p.SavedPlans[name] = *savedPlan
+}
+
+func (p *ConvergePlan) addLayer(layer InfrastructureLayerPlan) {
+	p.Status = p.Status.CombineStatus(resolveStatisticsStatus(layer.Status))
+
+	for _, resource := range layer.Resources {
+		switch resource.Action {
+		case plan.ActionCreate:
+			p.Summary.Create++
+		case plan.ActionUpdate:
+			p.Summary.Update++
+		case plan.ActionDelete:
+			p.Summary.Delete++
+		case plan.ActionRecreate:
+			p.Summary.Recreate++
+		}
+	}
+
+	p.Infrastructure = append(p.Infrastructure, layer)
+}
+
+// hash returns the hash of planned changes. Status and ids are not included,
+// because they are derived from the changes.
+func (p *ConvergePlan) hash() (string, error) {
+	data, err := json.Marshal(struct {
+		Infrastructure []InfrastructureLayerPlan `json:"infrastructure"`
+		Kubernetes     KubernetesPlan            `json:"kubernetes"`
+	}{
+		Infrastructure: p.Infrastructure,
+		Kubernetes:     p.Kubernetes,
+	})
+	if err != nil {
+		return "", fmt.Errorf("cannot marshal converge plan: %w", err)
+	}
+
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func baseInfrastructureLayerPlan(cluster ClusterCheckResult) (InfrastructureLayerPlan, error) {
+	layer := InfrastructureLayerPlan{
+		Layer:  ConvergePlanLayerBaseInfrastructure,
+		Status: cluster.Status,
+	}
+
+	if cluster.DestructiveChanges != nil {
+		layer.DestructiveChanges = &cluster.DestructiveChanges.DestructiveChanges
+		layer.OutputBrokenReason = cluster.DestructiveChanges.OutputBrokenReason
+		if zones := cluster.DestructiveChanges.OutputZonesChanged; zones.CurrentValue != nil || zones.NextValue != nil {
+			layer.OutputZonesChanged = &zones
+		}
+	}
+
+	resources, err := resourcesPlan(cluster.Plan)
+	if err != nil {
+		return layer, fmt.Errorf("base infrastructure: %w", err)
+	}
+	layer.Resources = resources
+
+	return layer, nil
+}
+
+func nodeLayerPlan(node NodeCheckResult) (InfrastructureLayerPlan, error) {
+	layer := InfrastructureLayerPlan{
+		Layer:              ConvergePlanLayerNode,
+		NodeGroup:          node.Group,
+		Node:               node.Name,
+		Status:             node.Status,
+		DestructiveChanges: node.DestructiveChanges,
+	}
+
+	resources, err := resourcesPlan(node.Plan)
+	if err != nil {
+		return layer, fmt.Errorf("node %s: %w", node.Name, err)
+	}
+	layer.Resources = resources
+
+	return layer, nil
+}
+
+// resourcesPlan returns changed resources from the raw infrastructure plan. Unchanged and read-only resources are skipped,
+// values marked sensitive by the provider are masked, because the plan is meant to be published.
+func resourcesPlan(rawPlan plan.Plan) ([]ResourcePlan, error) {
+	infrastructurePlan, err := plan.ParseInfrastructurePlan(rawPlan)
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []ResourcePlan
+	for _, change := range infrastructurePlan.ResourceChanges {
+		action := change.Action()
+		if action == plan.ActionNoOp || action == plan.ActionRead {
+			continue
+		}
+
+		resources = append(resources, ResourcePlan{
+			Address:      change.Address,
+			Type:         change.Type,
+			Name:         change.Name,
+			ProviderName: change.ProviderName,
+			Action:       action,
+			Before:       change.Change.MaskedBefore(),
+			After:        change.Change.MaskedAfter(),
+		})
+	}
+
+	return resources, nil
+}
+
+type configurationSecret struct {
+	name string
+	key  string
+	data func(*config.MetaConfig) ([]byte, error)
+	// redact hides the values in the diff, the secret contains credentials
+	redact bool
+}
+
+func configurationSecrets(ctx context.Context, metaConfig *config.MetaConfig) ([]configurationSecret, error) {
+	secrets := []configurationSecret{
+		{
+			name: "d8-cluster-configuration",
+			key:  "cluster-configuration.yaml",
+			data: (*config.MetaConfig).ClusterConfigYAML,
+		},
+	}
+
+	specific, err := config.DoByClusterType(ctx, metaConfig, &configurationSecretProvider{})
+	if err != nil {
+		return nil, err
+	}
+
+	if specific != nil {
+		secrets = append(secrets, *specific)
+	}
+
+	return secrets, nil
+}
+
+type configurationSecretProvider struct{}
+
+func (*configurationSecretProvider) Cloud(_ context.Context, _ *config.MetaConfig) (*configurationSecret, error) {
+	return &configurationSecret{
+		name:   config.LegacyProviderClusterConfigSecret,
+		key:    "cloud-provider-cluster-configuration.yaml",
+		data:   (*config.MetaConfig).ProviderClusterConfigYAML,
+		redact: true,
+	}, nil
+}
+
+func (*configurationSecretProvider) Static(_ context.Context, _ *config.MetaConfig) (*configurationSecret, error) {
+	return &configurationSecret{
+		name: "d8-static-cluster-configuration",
+		key:  "static-cluster-configuration.yaml",
+		data: (*config.MetaConfig).StaticClusterConfigYAML,
+	}, nil
+}
+
+func (*configurationSecretProvider) Incorrect(_ context.Context, _ *config.MetaConfig) (*configurationSecret, error) {
+	// managed clusters have cluster configuration only
+	return nil, nil
+}
+
+func configurationSecretsPlan(ctx context.Context, desiredConfig, inClusterConfig *config.MetaConfig) ([]SecretPlan, error) {
+	secrets, err := configurationSecrets(ctx, desiredConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]SecretPlan, 0, len(secrets))
+	for _, secret := range secrets {
+		desired, err := normalizedConfigYAML(secret, desiredConfig)
+		if err != nil {
+			return nil, err
+		}
+
+		current, err := normalizedConfigYAML(secret, inClusterConfig)
+		if err != nil {
+			return nil, err
+		}
+
+		if desired == "" && current == "" {
+			continue
+		}
+
+		secretPlan := SecretPlan{
+			Namespace: global.ConfigsNS,
+			Name:      secret.name,
+			Key:       secret.key,
+			Action:    SecretActionNone,
+		}
+
+		switch {
+		case desired == current:
+		case current == "":
+			secretPlan.Action = SecretActionCreate
+		default:
+			secretPlan.Action = SecretActionUpdate
+		}
+
+		if secretPlan.Action != SecretActionNone {
+			if secret.redact {
+				current, desired = redactConfig(current), redactConfig(desired)
+			}
+
+			secretPlan.Diff, err = difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+				A:        difflib.SplitLines(current),
+				B:        difflib.SplitLines(desired),
+				FromFile: "in-cluster",
+				ToFile:   "desired",
+				Context:  3,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("cannot render diff for secret %s: %w", secret.name, err)
+			}
+		}
+
+		result = append(result, secretPlan)
+	}
+
+	return result, nil
+}
+
+func redactConfig(content string) string {
+	if content == "" {
+		return ""
+	}
+
+	return dhctlstate.RedactConfig(content) + "\n"
+}
+
+// normalizedConfigYAML returns configuration with sorted keys, so that only meaningful changes are shown in the diff
+func normalizedConfigYAML(secret configurationSecret, metaConfig *config.MetaConfig) (string, error) {
+	if metaConfig == nil {
+		return "", nil
+	}
+
+	data, err := secret.data(metaConfig)
+	if err != nil {
+		return "", fmt.Errorf("unable to get configuration for secret %s: %w", secret.name, err)
+	}
+
+	if len(data) == 0 {
+		return "", nil
+	}
+
+	var content map[string]any
+	if err := yaml.Unmarshal(data, &content); err != nil {
+		return "", fmt.Errorf("cannot unmarshal configuration for secret %s: %w", secret.name, err)
+	}
+
+	if len(content) == 0 {
+		return "", nil
+	}
+
+	normalized, err := yaml.Marshal(content)
+	if err != nil {
+		return "", fmt.Errorf("cannot marshal configuration for secret %s: %w", secret.name, err)
+	}
+
+	return string(normalized), nil
+}
+
+func nodeGroupsPlan(statistics *Statistics, desiredConfig *config.MetaConfig) []NodeGroupPlan {
+	groups := make(map[string]*NodeGroupPlan)
+	getOrCreate := func(name string) *NodeGroupPlan {
+		if ng, ok := groups[name]; ok {
+			return ng
+		}
+
+		ng := &NodeGroupPlan{
+			Name:            name,
+			DesiredReplicas: desiredConfig.GetReplicasByNodeGroupName(name),
+		}
+		groups[name] = ng
+
+		return ng
+	}
+
+	getOrCreate(global.MasterNodeGroupName)
+	for _, group := range desiredConfig.GetTerraNodeGroups() {
+		getOrCreate(group.Name)
+	}
+
+	for _, node := range statistics.Node {
+		ng := getOrCreate(node.Group)
+
+		switch node.Status {
+		case AbsentStatus:
+			ng.NodesToCreate++
+			continue
+		case AbandonedStatus:
+			ng.NodesToDelete++
+		}
+
+		ng.CurrentReplicas++
+	}
+
+	for _, template := range statistics.NodeTemplates {
+		getOrCreate(template.Name).TemplateStatus = template.Status
+	}
+
+	names := make([]string, 0, len(groups))
+	for name := range groups {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	result := make([]NodeGroupPlan, 0, len(names))
+	for _, name := range names {
+		result = append(result, *groups[name])
+	}
+
+	return result
+}
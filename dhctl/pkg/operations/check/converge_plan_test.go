@@ -0,0 +1,296 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package check
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/deckhouse/deckhouse/dhctl/pkg/config"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/infrastructure"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/infrastructure/plan"
+	dhctlstate "github.com/deckhouse/deckhouse/dhctl/pkg/state"
+)
+
+func testPlanMetaConfig(podSubnet string, workerReplicas int) *config.MetaConfig {
+	return &config.MetaConfig{
+		ClusterType: config.CloudClusterType,
+		MasterNodeGroupSpec: config.MasterNodeGroupSpec{
+			Replicas: 1,
+		},
+		TerraNodeGroupSpecs: []config.TerraNodeGroupSpec{
+			{Name: "worker", Replicas: workerReplicas},
+		},
+		ClusterConfig: map[string]json.RawMessage{
+			"kind":          json.RawMessage(`"ClusterConfiguration"`),
+			"podSubnetCIDR": json.RawMessage(`"` + podSubnet + `"`),
+		},
+		ProviderClusterConfig: map[string]json.RawMessage{
+			"kind": json.RawMessage(`"YandexClusterConfiguration"`),
+		},
+	}
+}
+
+func testRawPlan(t *testing.T, resourceChanges string) plan.Plan {
+	var p plan.Plan
+	require.NoError(t, json.Unmarshal([]byte(`{"format_version":"1.2","resource_changes":`+resourceChanges+`}`), &p))
+	return p
+}
+
+func TestNewConvergePlan(t *testing.T) {
+	statistics := &Statistics{
+		Cluster: ClusterCheckResult{
+			Status: ChangedStatus,
+			Plan: testRawPlan(t, `[
+				{"address":"yandex_vpc_network.kube","type":"yandex_vpc_network","name":"kube","change":{"actions":["no-op"]}},
+				{"address":"yandex_vpc_subnet.kube_a","type":"yandex_vpc_subnet","name":"kube_a","change":{"actions":["update"],"before":{"v4_cidr_blocks":["10.0.0.0/24"]},"after":{"v4_cidr_blocks":["10.1.0.0/24"]}}}
+			]`),
+		},
+		Node: []NodeCheckResult{
+			{
+				Group:  "worker",
+				Name:   "test-worker-1",
+				Status: AbsentStatus,
+			},
+			{
+				Group:  "master",
+				Name:   "test-master-0",
+				Status: DestructiveStatus,
+				DestructiveChanges: &plan.DestructiveChanges{
+					ResourcesRecreated: []plan.ValueChange{{Type: "yandex_compute_instance"}},
+				},
+				Plan: testRawPlan(t, `[
+					{"address":"yandex_compute_instance.master","type":"yandex_compute_instance","name":"master","change":{"actions":["delete","create"],"before":{"zone":"ru-central1-a"},"after":{"zone":"ru-central1-b"}}}
+				]`),
+			},
+			{
+				Group:  "worker",
+				Name:   "test-worker-0",
+				Status: OKStatus,
+			},
+		},
+		NodeTemplates: []NodeGroupCheckResult{
+			{Name: "master", Status: OKStatus},
+			{Name: "worker", Status: ChangedStatus},
+		},
+	}
+
+	convergePlan, err := NewConvergePlan(
+		t.Context(),
+		statistics,
+		testPlanMetaConfig("10.222.0.0/16", 2),
+		testPlanMetaConfig("10.111.0.0/16", 2),
+	)
+	require.NoError(t, err)
+
+	require.Equal(t, CheckStatusDestructiveOutOfSync, convergePlan.Status)
+	require.NotEmpty(t, convergePlan.DestructiveChangeID)
+	require.NotEmpty(t, convergePlan.ID)
+	require.Equal(t, ConvergePlanSummary{Update: 1, Recreate: 1}, convergePlan.Summary)
+
+	require.Len(t, convergePlan.Infrastructure, 4)
+	base := convergePlan.Infrastructure[0]
+	require.Equal(t, ConvergePlanLayerBaseInfrastructure, base.Layer)
+	require.Equal(t, []ResourcePlan{{
+		Address: "yandex_vpc_subnet.kube_a",
+		Type:    "yandex_vpc_subnet",
+		Name:    "kube_a",
+		Action:  plan.ActionUpdate,
+		Before:  map[string]any{"v4_cidr_blocks": []any{"10.0.0.0/24"}},
+		After:   map[string]any{"v4_cidr_blocks": []any{"10.1.0.0/24"}},
+	}}, base.Resources)
+
+	master := convergePlan.Infrastructure[1]
+	require.Equal(t, "test-master-0", master.Node)
+	require.Equal(t, plan.ActionRecreate, master.Resources[0].Action)
+	require.NotNil(t, master.DestructiveChanges)
+	require.Equal(t, "test-worker-0", convergePlan.Infrastructure[2].Node)
+	require.Equal(t, "test-worker-1", convergePlan.Infrastructure[3].Node)
+
+	require.Equal(t, []NodeGroupPlan{
+		{Name: "master", CurrentReplicas: 1, DesiredReplicas: 1, TemplateStatus: OKStatus},
+		{Name: "worker", CurrentReplicas: 1, DesiredReplicas: 2, NodesToCreate: 1, TemplateStatus: ChangedStatus},
+	}, convergePlan.Kubernetes.NodeGroups)
+
+	require.Len(t, convergePlan.Kubernetes.Secrets, 2)
+	clusterSecret := convergePlan.Kubernetes.Secrets[0]
+	require.Equal(t, "d8-cluster-configuration", clusterSecret.Name)
+	require.Equal(t, SecretActionUpdate, clusterSecret.Action)
+	require.Contains(t, clusterSecret.Diff, "-podSubnetCIDR: 10.111.0.0/16")
+	require.Contains(t, clusterSecret.Diff, "+podSubnetCIDR: 10.222.0.0/16")
+
+	providerSecret := convergePlan.Kubernetes.Secrets[1]
+	require.Equal(t, config.LegacyProviderClusterConfigSecret, providerSecret.Name)
+	require.Equal(t, SecretActionNone, providerSecret.Action)
+	require.Empty(t, providerSecret.Diff)
+
+	_, err = convergePlan.Format("json")
+	require.NoError(t, err)
+}
+
+func TestNewConvergePlanHidesSensitiveValues(t *testing.T) {
+	statistics := &Statistics{
+		Cluster: ClusterCheckResult{
+			Status: ChangedStatus,
+			Plan: testRawPlan(t, `[
+				{"address":"openstack_db_user.admin","type":"openstack_db_user","name":"admin","change":{"actions":["update"],
+					"before":{"name":"admin","password":"old-password","keys":[{"id":"a","secret":"old-key"}]},
+					"after":{"name":"admin","password":"new-password","keys":[{"id":"a","secret":"new-key"}]},
+					"before_sensitive":{"password":true,"keys":[{"secret":true}]},
+					"after_sensitive":{"password":true,"keys":[{"secret":true}]}}},
+				{"address":"openstack_secret.token","type":"openstack_secret","name":"token","change":{"actions":["create"],
+					"after":{"payload":"token-value"},"after_sensitive":true}}
+			]`),
+		},
+	}
+
+	desired := testPlanMetaConfig("10.111.0.0/16", 1)
+	desired.ProviderClusterConfig["provider"] = json.RawMessage(`{"password":"new-cloud-password"}`)
+	current := testPlanMetaConfig("10.111.0.0/16", 1)
+	current.ProviderClusterConfig["provider"] = json.RawMessage(`{"password":"old-cloud-password"}`)
+
+	convergePlan, err := NewConvergePlan(t.Context(), statistics, desired, current)
+	require.NoError(t, err)
+
+	resources := convergePlan.Infrastructure[0].Resources
+	require.Equal(t, map[string]any{
+		"name":     "admin",
+		"password": plan.SensitiveValue,
+		"keys":     []any{map[string]any{"id": "a", "secret": plan.SensitiveValue}},
+	}, resources[0].After)
+	require.Equal(t, map[string]any{"payload": plan.SensitiveValue}, resources[1].After)
+
+	providerSecret := convergePlan.Kubernetes.Secrets[1]
+	require.Equal(t, SecretActionUpdate, providerSecret.Action)
+	require.Contains(t, providerSecret.Diff, "-  password: <redacted:")
+	require.Contains(t, providerSecret.Diff, "+  password: <redacted:")
+
+	formatted, err := convergePlan.Format("json")
+	require.NoError(t, err)
+	for _, secret := range []string{"old-password", "new-password", "old-key", "new-key", "token-value", "cloud-password"} {
+		require.NotContains(t, string(formatted), secret)
+	}
+}
+
+func TestNewConvergePlanIDIsStable(t *testing.T) {
+	nodes := []NodeCheckResult{
+		{Group: "worker", Name: "test-worker-0", Status: OKStatus},
+		{Group: "worker", Name: "test-worker-1", Status: AbandonedStatus},
+	}
+	reversed := []NodeCheckResult{nodes[1], nodes[0]}
+
+	metaConfig := testPlanMetaConfig("10.111.0.0/16", 1)
+
+	first, err := NewConvergePlan(t.Context(), &Statistics{Cluster: ClusterCheckResult{Status: OKStatus}, Node: nodes}, metaConfig, metaConfig)
+	require.NoError(t, err)
+
+	second, err := NewConvergePlan(t.Context(), &Statistics{Cluster: ClusterCheckResult{Status: OKStatus}, Node: reversed}, metaConfig, metaConfig)
+	require.NoError(t, err)
+
+	require.Equal(t, first.ID, second.ID)
+	require.Equal(t, CheckStatusDestructiveOutOfSync, first.Status)
+	require.Equal(t, 1, first.Kubernetes.NodeGroups[1].NodesToDelete)
+
+	changed, err := NewConvergePlan(t.Context(), &Statistics{Cluster: ClusterCheckResult{Status: OKStatus}, Node: nodes}, metaConfig, testPlanMetaConfig("10.222.0.0/16", 1))
+	require.NoError(t, err)
+	require.NotEqual(t, first.ID, changed.ID)
+}
+
+func TestResourceChangeAction(t *testing.T) {
+	tests := map[plan.Action][]string{
+		plan.ActionCreate:   {"create"},
+		plan.ActionDelete:   {"delete"},
+		plan.ActionUpdate:   {"update"},
+		plan.ActionRecreate: {"create", "delete"},
+		plan.ActionNoOp:     nil,
+	}
+
+	for expected, actions := range tests {
+		change := plan.ResourceChange{Change: plan.ChangeOp{Actions: actions}}
+		require.Equal(t, expected, change.Action())
+	}
+
+	recreate := plan.ResourceChange{Change: plan.ChangeOp{Actions: []string{"delete", "create"}}}
+	require.Equal(t, plan.ActionRecreate, recreate.Action())
+}
+
+func TestConvergePlanSaveAndVerify(t *testing.T) {
+	clusterState := []byte(`{"serial":1}`)
+	nodeState := []byte(`{"serial":2}`)
+	nodesState := map[string]dhctlstate.NodeGroupInfrastructureState{
+		"worker": {State: map[string][]byte{"test-worker-0": nodeState}},
+	}
+
+	metaConfig := testPlanMetaConfig("10.111.0.0/16", 2)
+	statistics := &Statistics{
+		Cluster: ClusterCheckResult{
+			Status:    OKStatus,
+			SavedPlan: &infrastructure.SavedPlan{Plan: []byte("base plan"), StateHash: infrastructure.StateHash(clusterState)},
+		},
+		Node: []NodeCheckResult{
+			{
+				Group:     "worker",
+				Name:      "test-worker-0",
+				Status:    OKStatus,
+				SavedPlan: &infrastructure.SavedPlan{Plan: []byte("node plan"), StateHash: infrastructure.StateHash(nodeState)},
+			},
+			{Group: "worker", Name: "test-worker-1", Status: AbsentStatus},
+		},
+	}
+
+	convergePlan, err := NewConvergePlan(t.Context(), statistics, metaConfig, metaConfig)
+	require.NoError(t, err)
+	require.Len(t, convergePlan.SavedPlans, 2)
+
+	printable, err := convergePlan.Format("json")
+	require.NoError(t, err)
+	require.NotContains(t, string(printable), "saved_plans")
+
+	path := filepath.Join(t.TempDir(), "plan.json")
+	require.NoError(t, convergePlan.Save(path))
+
+	loaded, err := LoadConvergePlan(path)
+	require.NoError(t, err)
+	require.Equal(t, convergePlan, loaded)
+
+	require.NoError(t, loaded.Verify(t.Context(), metaConfig, clusterState, nodesState))
+
+	err = loaded.Verify(t.Context(), testPlanMetaConfig("10.222.0.0/16", 2), clusterState, nodesState)
+	require.ErrorIs(t, err, ErrConvergePlanOutdated)
+	require.ErrorContains(t, err, "configuration")
+
+	err = loaded.Verify(t.Context(), metaConfig, []byte(`{"serial":3}`), nodesState)
+	require.ErrorIs(t, err, ErrConvergePlanOutdated)
+	require.ErrorContains(t, err, "base infrastructure")
+
+	err = loaded.Verify(t.Context(), metaConfig, clusterState, map[string]dhctlstate.NodeGroupInfrastructureState{
+		"worker": {State: map[string][]byte{"test-worker-0": []byte(`{"serial":3}`)}},
+	})
+	require.ErrorIs(t, err, ErrConvergePlanOutdated)
+	require.ErrorContains(t, err, "test-worker-0")
+
+	err = loaded.Verify(t.Context(), metaConfig, clusterState, map[string]dhctlstate.NodeGroupInfrastructureState{
+		"worker": {State: map[string][]byte{"test-worker-0": nodeState, "test-worker-1": nodeState}},
+	})
+	require.ErrorIs(t, err, ErrConvergePlanOutdated)
+	require.ErrorContains(t, err, "test-worker-1 was created")
+
+	err = loaded.Verify(t.Context(), metaConfig, clusterState, nil)
+	require.ErrorIs(t, err, ErrConvergePlanOutdated)
+	require.ErrorContains(t, err, "test-worker-0 was deleted")
+}
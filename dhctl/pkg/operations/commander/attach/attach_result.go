@@ -36,4 +36,5 @@ type AttachResult struct {
 	Status      AttachStatus       `json:"status"`
 	ScanResult  *ScanResult        `json:"scan_result"`
 	CheckResult *check.CheckResult `json:"check_result,omitempty"`
+	Report      *AttachReport      `json:"report,omitempty"`
 }
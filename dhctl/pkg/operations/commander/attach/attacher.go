@@ -52,8 +52,11 @@ type Params struct {
 	OnProgressFunc        phases.OnProgressFunc
 	AttachResources       AttachResources
 	ScanOnly              *bool
-	TmpDir                string
-	IsDebug               bool
+	// DryRun stops after the scan, runs a read-only check and returns
+	// an AttachReport instead of capturing the cluster.
+	DryRun  bool
+	TmpDir  string
+	IsDebug bool
 
 	// Options carries the per-operation parsed configuration. RPC handlers
 	// must populate this with a fresh *options.Options to avoid sharing global
@@ -139,6 +142,10 @@ func (i *Attacher) Attach(ctx context.Context) (*AttachResult, error) {
 		return nil, fmt.Errorf("unable to scan cluster: %w", err)
 	}
 
+	if i.Params.DryRun {
+		return i.dryRun(ctx, kubeClient, metaConfig, scanResult)
+	}
+
 	if ptr.Deref(i.Params.ScanOnly, true) {
 		if err = i.PhasedExecutionContext.CompletePhaseAndPipeline(ctx, stateCache, PhaseData{
 			ScanResult: scanResult,
@@ -196,6 +203,58 @@ func (i *Attacher) Attach(ctx context.Context) (*AttachResult, error) {
 	}, nil
 }
 
+func (i *Attacher) dryRun(
+	ctx context.Context,
+	kubeClient *client.KubernetesClient,
+	metaConfig *config.MetaConfig,
+	scanResult *ScanResult,
+) (*AttachResult, error) {
+	stateCache := cache.Global()
+
+	// capture phase is skipped: nothing is created in the cluster in dry-run mode
+	if shouldStop, err := i.PhasedExecutionContext.SwitchPhase(
+		ctx,
+		phases.CommanderAttachCheckPhase,
+		false,
+		stateCache,
+		PhaseData{ScanResult: scanResult},
+	); err != nil {
+		return nil, fmt.Errorf("unable to switch phase: %w", err)
+	} else if shouldStop {
+		return &AttachResult{Status: StatusScanned, ScanResult: scanResult}, nil
+	}
+
+	checkResult, err := i.check(ctx, i.Params.KubeProvider, scanResult)
+	if err != nil {
+		// check is optional
+		dhlog.FromContext(ctx).WarnContext(ctx, fmt.Sprintf("Can't check cluster: %s", err))
+	}
+
+	var report *AttachReport
+	err = dhlog.RunProcess(ctx, dhlog.FromContext(ctx), "Build attach report", func(ctx context.Context) error {
+		var err error
+		report, err = i.report(ctx, kubeClient, metaConfig, scanResult, checkResult)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to build attach report: %w", err)
+	}
+
+	if err = i.PhasedExecutionContext.CompletePhaseAndPipeline(ctx, stateCache, PhaseData{
+		ScanResult:  scanResult,
+		CheckResult: checkResult,
+	}); err != nil {
+		return nil, fmt.Errorf("unable to complete phase: %w", err)
+	}
+
+	return &AttachResult{
+		Status:      StatusScanned,
+		ScanResult:  scanResult,
+		CheckResult: checkResult,
+		Report:      report,
+	}, nil
+}
+
 func (i *Attacher) prepare(ctx context.Context) (*client.KubernetesClient, *config.MetaConfig, error) {
 	var (
 		kubeClient *client.KubernetesClient
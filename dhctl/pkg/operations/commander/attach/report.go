@@ -0,0 +1,155 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package attach
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/deckhouse/deckhouse/dhctl/pkg/config"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/kubernetes/actions/resources"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/kubernetes/client"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/operations/check"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/template"
+)
+
+const (
+	deckhouseVersionAnnotation = "core.deckhouse.io/version"
+	deckhouseEditionAnnotation = "core.deckhouse.io/edition"
+)
+
+const (
+	LayerConfiguration      = "configuration"
+	LayerBaseInfrastructure = "base-infrastructure"
+	LayerNodeTemplate       = "node-template"
+	LayerNode               = "node"
+)
+
+// AttachReport is produced by a dry-run attach. It describes the cluster as
+// seen by the scan and check phases and lists objects the capture phase would
+// apply, without changing anything in the cluster.
+type AttachReport struct {
+	ClusterType                          string                      `json:"cluster_type"`
+	Provider                             string                      `json:"provider,omitempty"`
+	ClusterConfiguration                 string                      `json:"cluster_configuration"`
+	ProviderSpecificClusterConfiguration string                      `json:"provider_specific_cluster_configuration,omitempty"`
+	Deckhouse                            DeckhouseInfo               `json:"deckhouse"`
+	InfrastructureStatus                 check.CheckStatus           `json:"infrastructure_status,omitempty"`
+	Infrastructure                       []LayerStatus               `json:"infrastructure,omitempty"`
+	PlannedResources                     []resources.PlannedResource `json:"planned_resources"`
+}
+
+type DeckhouseInfo struct {
+	Version string `json:"version,omitempty"`
+	Edition string `json:"edition,omitempty"`
+}
+
+type LayerStatus struct {
+	Layer  string `json:"layer"`
+	Name   string `json:"name,omitempty"`
+	Status string `json:"status"`
+}
+
+func (i *Attacher) report(
+	ctx context.Context,
+	kubeClient *client.KubernetesClient,
+	metaConfig *config.MetaConfig,
+	scanResult *ScanResult,
+	checkResult *check.CheckResult,
+) (*AttachReport, error) {
+	res := &AttachReport{
+		ClusterType:                          metaConfig.ClusterType,
+		Provider:                             metaConfig.ProviderName,
+		ClusterConfiguration:                 scanResult.ClusterConfiguration,
+		ProviderSpecificClusterConfiguration: scanResult.ProviderSpecificClusterConfiguration,
+		Infrastructure:                       infrastructureLayers(checkResult),
+	}
+
+	if checkResult != nil {
+		res.InfrastructureStatus = checkResult.Status
+	}
+
+	var err error
+	res.Deckhouse, err = getDeckhouseInfo(ctx, kubeClient)
+	if err != nil {
+		return nil, err
+	}
+
+	attachResources, err := template.ParseResourcesContent(
+		ctx,
+		i.Params.AttachResources.Template,
+		i.Params.AttachResources.Values,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse resources: %w", err)
+	}
+
+	res.PlannedResources, err = resources.PlanResources(ctx, kubeClient, attachResources)
+	if err != nil {
+		return nil, fmt.Errorf("unable to plan resources: %w", err)
+	}
+
+	return res, nil
+}
+
+func getDeckhouseInfo(ctx context.Context, kubeClient *client.KubernetesClient) (DeckhouseInfo, error) {
+	deployment, err := kubeClient.AppsV1().Deployments("d8-system").Get(ctx, "deckhouse", metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return DeckhouseInfo{}, nil
+		}
+		return DeckhouseInfo{}, fmt.Errorf("unable to get deckhouse deployment: %w", err)
+	}
+
+	annotations := deployment.GetAnnotations()
+
+	return DeckhouseInfo{
+		Version: annotations[deckhouseVersionAnnotation],
+		Edition: annotations[deckhouseEditionAnnotation],
+	}, nil
+}
+
+// infrastructureLayers flattens the check statistics into one status per layer.
+// Check is optional during attach, so a nil result yields an empty list.
+func infrastructureLayers(checkResult *check.CheckResult) []LayerStatus {
+	if checkResult == nil {
+		return nil
+	}
+
+	details := checkResult.StatusDetails
+
+	layers := make([]LayerStatus, 0, 2+len(details.NodeTemplates)+len(details.Node))
+
+	if details.ConfigurationStatus != "" {
+		layers = append(layers, LayerStatus{Layer: LayerConfiguration, Status: string(details.ConfigurationStatus)})
+	}
+
+	if details.Cluster.Status != "" {
+		layers = append(layers, LayerStatus{Layer: LayerBaseInfrastructure, Status: details.Cluster.Status})
+	}
+
+	for _, ng := range details.NodeTemplates {
+		layers = append(layers, LayerStatus{Layer: LayerNodeTemplate, Name: ng.Name, Status: ng.Status})
+	}
+
+	for _, node := range details.Node {
+		layers = append(layers, LayerStatus{Layer: LayerNode, Name: fmt.Sprintf("%s/%s", node.Group, node.Name), Status: node.Status})
+	}
+
+	return layers
+}
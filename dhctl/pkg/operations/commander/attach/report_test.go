@@ -0,0 +1,77 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package attach
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/deckhouse/deckhouse/dhctl/pkg/kubernetes/client"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/operations/check"
+)
+
+func TestInfrastructureLayers(t *testing.T) {
+	require.Nil(t, infrastructureLayers(nil))
+
+	res := &check.CheckResult{
+		Status: check.CheckStatusOutOfSync,
+		StatusDetails: check.StatusDetails{
+			ConfigurationStatus: check.CheckStatusInSync,
+			Statistics: check.Statistics{
+				Cluster:       check.ClusterCheckResult{Status: check.OKStatus},
+				NodeTemplates: []check.NodeGroupCheckResult{{Name: "worker", Status: check.OKStatus}},
+				Node: []check.NodeCheckResult{
+					{Group: "master", Name: "test-master-0", Status: check.OKStatus},
+					{Group: "worker", Name: "test-worker-0", Status: check.ChangedStatus},
+				},
+			},
+		},
+	}
+
+	require.Equal(t, []LayerStatus{
+		{Layer: LayerConfiguration, Status: string(check.CheckStatusInSync)},
+		{Layer: LayerBaseInfrastructure, Status: check.OKStatus},
+		{Layer: LayerNodeTemplate, Name: "worker", Status: check.OKStatus},
+		{Layer: LayerNode, Name: "master/test-master-0", Status: check.OKStatus},
+		{Layer: LayerNode, Name: "worker/test-worker-0", Status: check.ChangedStatus},
+	}, infrastructureLayers(res))
+}
+
+func TestGetDeckhouseInfo(t *testing.T) {
+	kubeCl := client.NewFakeKubernetesClient()
+
+	info, err := getDeckhouseInfo(t.Context(), kubeCl)
+	require.NoError(t, err)
+	require.Equal(t, DeckhouseInfo{}, info)
+
+	_, err = kubeCl.AppsV1().Deployments("d8-system").Create(t.Context(), &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "deckhouse",
+			Namespace: "d8-system",
+			Annotations: map[string]string{
+				deckhouseVersionAnnotation: "v1.70.3",
+				deckhouseEditionAnnotation: "EE",
+			},
+		},
+	}, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	info, err = getDeckhouseInfo(t.Context(), kubeCl)
+	require.NoError(t, err)
+	require.Equal(t, DeckhouseInfo{Version: "v1.70.3", Edition: "EE"}, info)
+}
@@ -0,0 +1,178 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+	"sigs.k8s.io/yaml"
+
+	dhlog "github.com/deckhouse/lib-dhctl/pkg/logger"
+
+	"github.com/deckhouse/deckhouse/dhctl/pkg/app/options"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/config"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/kubernetes/client"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/util/input"
+)
+
+// MigrateOptions controls how a migrated configuration is saved.
+type MigrateOptions struct {
+	// DryRun prints the changes and the diff without saving anything.
+	DryRun bool
+	// SkipConfirmation saves the migrated configuration without asking.
+	SkipConfirmation bool
+	// Out receives the diff of the migrated configuration, os.Stdout if nil.
+	Out io.Writer
+}
+
+func (o MigrateOptions) out() io.Writer {
+	if o.Out == nil {
+		return os.Stdout
+	}
+	return o.Out
+}
+
+// MigrateConfigFiles rewrites the configuration files in place with the
+// migration rules shipped with the schemas.
+func MigrateConfigFiles(ctx context.Context, paths []string, globalOptions *options.GlobalOptions, opts MigrateOptions) error {
+	schemaStore := config.NewSchemaStore(globalOptions)
+
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("read config %s: %w", path, err)
+		}
+
+		migrated, save, err := migrateConfig(ctx, schemaStore, path, data, opts)
+		if err != nil {
+			return fmt.Errorf("migrate config %s: %w", path, err)
+		}
+		if !save {
+			continue
+		}
+
+		info, err := os.Stat(path)
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(path, migrated, info.Mode().Perm()); err != nil {
+			return fmt.Errorf("write migrated config %s: %w", path, err)
+		}
+		dhlog.FromContext(ctx).InfoContext(ctx, fmt.Sprintf("Config %s migrated", path))
+	}
+
+	return nil
+}
+
+// MigrationTransform returns an EditOptions.Transform that migrates the
+// configuration stored in a cluster Secret instead of opening an editor.
+// Schemas are loaded on the first call, after SecretEdit has prepared the
+// candi directory of the cluster.
+func MigrationTransform(name string, globalOptions *options.GlobalOptions, opts MigrateOptions) func(ctx context.Context, data []byte) ([]byte, error) {
+	return func(ctx context.Context, data []byte) ([]byte, error) {
+		migrated, save, err := migrateConfig(ctx, config.NewSchemaStore(globalOptions), name, data, opts)
+		if err != nil {
+			return nil, err
+		}
+		if !save {
+			return data, nil
+		}
+		return migrated, nil
+	}
+}
+
+// RejectMigrationOfAbsentSecret is the EditOptions.OnAbsent guard for
+// migrations: there is nothing to migrate in a Secret that does not exist.
+func RejectMigrationOfAbsentSecret(_ context.Context, _ *client.KubernetesClient) error {
+	return errors.New("configuration secret was not found in the cluster, nothing to migrate")
+}
+
+// migrateConfig migrates data, prints what changed and asks for the
+// confirmation. It reports whether the migrated data should be saved.
+func migrateConfig(ctx context.Context, schemaStore *config.SchemaStore, name string, data []byte, opts MigrateOptions) ([]byte, bool, error) {
+	logger := dhlog.FromContext(ctx)
+
+	migrated, changes, err := schemaStore.MigrateDocuments(data)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(changes) == 0 {
+		logger.InfoContext(ctx, fmt.Sprintf("%s is up to date, nothing to migrate", name))
+		return nil, false, nil
+	}
+
+	if err := validateMigratedConfig(schemaStore, migrated); err != nil {
+		return nil, false, fmt.Errorf("migrated configuration is invalid: %w", err)
+	}
+
+	for _, change := range changes {
+		logger.InfoContext(ctx, change.String())
+	}
+
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(data)),
+		B:        difflib.SplitLines(string(migrated)),
+		FromFile: name,
+		ToFile:   name + " (migrated)",
+		Context:  3,
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	if _, err := fmt.Fprint(opts.out(), diff); err != nil {
+		return nil, false, err
+	}
+
+	if opts.DryRun {
+		logger.InfoContext(ctx, "Dry run, migrated configuration was not saved")
+		return nil, false, nil
+	}
+
+	if !opts.SkipConfirmation {
+		if !input.NewConfirmation().WithMessage(fmt.Sprintf("Save migrated %s?", name)).Ask() {
+			return nil, false, fmt.Errorf("migration of %s was canceled", name)
+		}
+	}
+
+	return migrated, true, nil
+}
+
+func validateMigratedConfig(schemaStore *config.SchemaStore, data []byte) error {
+	for _, doc := range input.YAMLSplitRegexp.Split(strings.TrimSpace(string(data)), -1) {
+		doc = strings.TrimSpace(doc)
+		if doc == "" {
+			continue
+		}
+
+		docData := []byte(doc)
+		var index config.SchemaIndex
+		if err := yaml.Unmarshal(docData, &index); err != nil || !index.IsValid() {
+			continue
+		}
+
+		err := schemaStore.ValidateWithIndex(&index, &docData, config.ValidateOptionValidateExtensions(true))
+		if err != nil && !errors.Is(err, config.ErrSchemaNotFound) {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -154,6 +154,7 @@ func (c *Context) InfrastructureContext(metaConfig *config.MetaConfig) *infrastr
 	}
 
 	ctx.WithStateChecker(c.stateChecker)
+	ctx.WithApprovalPolicy(c.changeParams.ApprovalPolicy)
 
 	return ctx
 }
@@ -53,6 +53,11 @@ type Params struct {
 	OnCheckResult              func(context.Context, *check.CheckResult) error
 	ApproveDestructiveChangeID string
 
+	// SavedPlan is the reviewed converge plan, converge applies exactly its infrastructure plans
+	// and fails if the cluster was changed after planning.
+	// InfrastructureContext should be created with the saved plans, see infrastructure.Context.WithSavedPlans.
+	SavedPlan *check.ConvergePlan
+
 	InfrastructureContext *infrastructure.Context
 	ProviderGetter        infrastructure.CloudProviderGetter
 
@@ -282,6 +287,21 @@ func (c *Converger) Converge(ctx context.Context) (*ConvergeResult, error) {
 
 	c.PhasedExecutionContext.SetClusterConfig(phases.ClusterConfig{ClusterType: metaConfig.ClusterType})
 
+	if c.SavedPlan != nil {
+		if c.CommanderMode {
+			return nil, fmt.Errorf("Saved converge plan is not supported in commander mode")
+		}
+
+		kubeCl, err := convergeCtx.KubeClientCtx(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := VerifySavedPlan(ctx, kubeCl, metaConfig, c.SavedPlan); err != nil {
+			return nil, err
+		}
+	}
+
 	if c.CommanderMode {
 		c.Checker.SetExternalPhasedContext(c.PhasedExecutionContext)
 
@@ -472,10 +492,16 @@ func (c *Converger) AutoConverge(ctx context.Context, listenAddress string, chec
 
 	convergeCtx.SetClientSwitcher(switcher)
 
+	skipPhases := []phases.OperationPhase{phases.DeckhouseConfigurationPhase}
+	// nodes are converged only if the approval policy decides which node changes can be applied
+	if c.Params.ChangesSettings.ApprovalPolicy == nil {
+		skipPhases = append(skipPhases, phases.AllNodesPhase)
+	}
+
 	r := newRunner(inLockRunner, switcher).
 		WithCommanderUUID(c.CommanderUUID).
 		WithExcludedNodes([]string{c.Options.AutoConverge.RunningNodeName}).
-		WithSkipPhases([]phases.OperationPhase{phases.AllNodesPhase, phases.DeckhouseConfigurationPhase})
+		WithSkipPhases(skipPhases)
 
 	converger := NewAutoConverger(r, AutoConvergerParams{
 		ListenAddress: listenAddress,
@@ -0,0 +1,127 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package converge
+
+import (
+	"context"
+	"fmt"
+
+	dhlog "github.com/deckhouse/lib-dhctl/pkg/logger"
+
+	"github.com/deckhouse/deckhouse/dhctl/pkg/app/options"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/config"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/infrastructure"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/infrastructureprovider"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/kubernetes/actions/entity"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/kubernetes/client"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/operations/check"
+	dhctlstate "github.com/deckhouse/deckhouse/dhctl/pkg/state"
+	infrastructurestate "github.com/deckhouse/deckhouse/dhctl/pkg/state/infrastructure"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/telemetry"
+)
+
+type PlanParams struct {
+	KubeCl         *client.KubernetesClient
+	ProviderGetter infrastructure.CloudProviderGetter
+
+	// ConfigPaths are paths to the desired cluster configuration.
+	// In-cluster configuration is used if they are empty.
+	ConfigPaths []string
+
+	Options *options.Options
+}
+
+// BuildPlan walks base infrastructure and all node groups without applying anything
+// and returns all changes converge is going to make.
+func BuildPlan(ctx context.Context, params PlanParams) (*check.ConvergePlan, error) {
+	ctx, span := telemetry.StartSpan(ctx, "BuildConvergePlan")
+	defer span.End()
+
+	globalOptions := &params.Options.Global
+
+	inClusterConfig, err := entity.GetMetaConfig(ctx, params.KubeCl, globalOptions, infrastructureprovider.DhctlOperationConverge)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get in-cluster configuration: %w", err)
+	}
+
+	desiredConfig := inClusterConfig
+	if len(params.ConfigPaths) > 0 {
+		desiredConfig, err = config.ParseConfig(ctx, params.ConfigPaths, infrastructureprovider.MetaConfigValidatorProvider(), globalOptions)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse desired configuration: %w", err)
+		}
+
+		desiredConfig.UUID = inClusterConfig.UUID
+	}
+
+	statistics := &check.Statistics{
+		Cluster: check.ClusterCheckResult{Status: check.OKStatus},
+	}
+
+	if desiredConfig.ClusterType == config.CloudClusterType {
+		provider, err := params.ProviderGetter(ctx, desiredConfig)
+		if err != nil {
+			return nil, err
+		}
+
+		defer func() {
+			if err := provider.Cleanup(); err != nil {
+				dhlog.FromContext(ctx).ErrorContext(ctx, fmt.Sprintf("Error cleaning up provider: %v", err))
+			}
+		}()
+
+		infrastructureContext := infrastructure.NewContextWithProvider(params.ProviderGetter).
+			WithUseTfCache(params.Options.Cache.UseTfCache).
+			WithDebug(globalOptions.IsDebug)
+
+		statistics, _, err = check.CheckState(ctx, params.KubeCl, desiredConfig, infrastructureContext, check.CheckStateOptions{}, false, globalOptions)
+		if err != nil {
+			return nil, fmt.Errorf("unable to check infrastructure state: %w", err)
+		}
+	}
+
+	return check.NewConvergePlan(ctx, statistics, desiredConfig, inClusterConfig)
+}
+
+// VerifySavedPlan fails if cluster configuration or infrastructure state was changed after the plan was saved.
+func VerifySavedPlan(ctx context.Context, kubeCl *client.KubernetesClient, metaConfig *config.MetaConfig, savedPlan *check.ConvergePlan) error {
+	ctx, span := telemetry.StartSpan(ctx, "VerifySavedConvergePlan")
+	defer span.End()
+
+	var clusterState []byte
+	var nodesState map[string]dhctlstate.NodeGroupInfrastructureState
+
+	if metaConfig.ClusterType == config.CloudClusterType {
+		var err error
+
+		clusterState, err = infrastructurestate.GetClusterStateFromCluster(ctx, kubeCl)
+		if err != nil {
+			return fmt.Errorf("infrastructure cluster state in Kubernetes cluster not found: %w", err)
+		}
+
+		nodesState, err = infrastructurestate.GetNodesStateFromCluster(ctx, kubeCl)
+		if err != nil {
+			return fmt.Errorf("infrastructure nodes state in Kubernetes cluster not found: %w", err)
+		}
+	}
+
+	if err := savedPlan.Verify(ctx, metaConfig, clusterState, nodesState); err != nil {
+		return err
+	}
+
+	dhlog.FromContext(ctx).InfoContext(ctx, fmt.Sprintf("Cluster was not changed after planning, applying converge plan %s", savedPlan.ID))
+
+	return nil
+}
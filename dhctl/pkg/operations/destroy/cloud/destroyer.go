@@ -18,15 +18,25 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"sort"
 
 	"github.com/name212/govalue"
 
+	dhlog "github.com/deckhouse/lib-dhctl/pkg/logger"
+
 	"github.com/deckhouse/deckhouse/dhctl/pkg/infrastructure/controller"
 	"github.com/deckhouse/deckhouse/dhctl/pkg/kubernetes"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/kubernetes/actions/entity"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/kubernetes/actions/manifests"
+	infra_utils "github.com/deckhouse/deckhouse/dhctl/pkg/operations/converge/infrastructure/utils"
 	"github.com/deckhouse/deckhouse/dhctl/pkg/operations/converge/lock"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/operations/destroy/inventory"
 	"github.com/deckhouse/deckhouse/dhctl/pkg/operations/destroy/kube"
+	infrastructurestate "github.com/deckhouse/deckhouse/dhctl/pkg/state/infrastructure"
 )
 
+const baseInfrastructureStateName = "base-infrastructure"
+
 type ClusterInfraDestroyer interface {
 	DestroyCluster(ctx context.Context, autoApprove bool) error
 }
@@ -42,6 +52,9 @@ type DestroyerParams struct {
 	CommanderMode bool
 	SkipResources bool
 
+	// Scope should be the same as passed to ClusterInfra.
+	Scope controller.DestroyScope
+
 	// SSHUser is recorded into the converge lock lease as the holder identity
 	// (informational only).
 	SSHUser string
@@ -105,6 +118,13 @@ func (d *Destroyer) AfterResourcesDelete(ctx context.Context) error {
 }
 
 func (d *Destroyer) CleanupBeforeDestroy(ctx context.Context) error {
+	if d.params.Scope.NodeGroup != "" {
+		// control-plane nodes are kept, we need connection to the cluster
+		// for deleting the node group after destroying its nodes
+		d.params.Logger.DebugContext(ctx, "Cleanup before destroy skipped for single node group destroy")
+		return nil
+	}
+
 	// why only unwatch lock without request unlock
 	// user may not delete resources and converge still working in cluster
 	// all node groups removing may still in long time run and
@@ -122,7 +142,175 @@ func (d *Destroyer) DestroyCluster(ctx context.Context, autoApprove bool) error
 		return fmt.Errorf("Internal error. Cluster infra destroy is nil")
 	}
 
-	return d.params.ClusterInfra.DestroyCluster(ctx, autoApprove)
+	nodeGroup := d.params.Scope.NodeGroup
+	if nodeGroup == "" {
+		return d.params.ClusterInfra.DestroyCluster(ctx, autoApprove)
+	}
+
+	if err := d.checkNodeGroupRemovedFromConfiguration(ctx, nodeGroup); err != nil {
+		return err
+	}
+
+	_, nodesState, err := d.params.StateLoader.PopulateClusterState(ctx)
+	if err != nil {
+		return err
+	}
+
+	nodeNames := make([]string, 0, len(nodesState[nodeGroup].State))
+	for name := range nodesState[nodeGroup].State {
+		nodeNames = append(nodeNames, name)
+	}
+	sort.Strings(nodeNames)
+
+	if err := d.drainNodeGroupNodes(ctx, nodeGroup, nodeNames); err != nil {
+		return err
+	}
+
+	if err := d.params.ClusterInfra.DestroyCluster(ctx, autoApprove); err != nil {
+		return err
+	}
+
+	if err := d.deleteNodeGroupFromCluster(ctx, nodeGroup, nodeNames); err != nil {
+		return err
+	}
+
+	d.unlockConverge(true)
+	d.params.KubeProvider.Cleanup(ctx, false)
+
+	return nil
+}
+
+// AddToInventory adds resources from the infrastructure states to the inventory
+// in the order of destruction: nodes first, base infrastructure last.
+func (d *Destroyer) AddToInventory(ctx context.Context, inv *inventory.Inventory) error {
+	clusterState, nodesState, err := d.params.StateLoader.PopulateClusterState(ctx)
+	if err != nil {
+		return err
+	}
+
+	scope := d.params.Scope
+	if scope.NodeGroup != "" {
+		if _, ok := nodesState[scope.NodeGroup]; !ok {
+			return fmt.Errorf("Node group %s not found in infrastructure states", scope.NodeGroup)
+		}
+	}
+
+	add := func(stateName, nodeGroup string, st []byte, destroyed bool) error {
+		resources, err := infrastructurestate.ListStateResources(st)
+		if err != nil {
+			return fmt.Errorf("Cannot list resources of %s infrastructure state: %w", stateName, err)
+		}
+
+		for _, r := range resources {
+			resource := inventory.CloudResource{State: stateName, NodeGroup: nodeGroup, StateResource: r}
+			if destroyed {
+				inv.CloudResources = append(inv.CloudResources, resource)
+			} else {
+				inv.KeptCloudResources = append(inv.KeptCloudResources, resource)
+			}
+		}
+
+		return nil
+	}
+
+	nodeGroups := make([]string, 0, len(nodesState))
+	for name := range nodesState {
+		nodeGroups = append(nodeGroups, name)
+	}
+	sort.Strings(nodeGroups)
+
+	for _, nodeGroup := range nodeGroups {
+		nodes := make([]string, 0, len(nodesState[nodeGroup].State))
+		for name := range nodesState[nodeGroup].State {
+			nodes = append(nodes, name)
+		}
+		sort.Strings(nodes)
+
+		for _, node := range nodes {
+			err := add(node, nodeGroup, nodesState[nodeGroup].State[node], scope.IncludesNodeGroup(nodeGroup))
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return add(baseInfrastructureStateName, "", clusterState, scope.IncludesBaseInfrastructure())
+}
+
+// checkNodeGroupRemovedFromConfiguration fails if the node group is still present in the provider cluster configuration,
+// because converge would create its nodes again, unless it is allowed explicitly.
+func (d *Destroyer) checkNodeGroupRemovedFromConfiguration(ctx context.Context, nodeGroup string) error {
+	metaConfig, err := d.params.StateLoader.PopulateMetaConfig(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if metaConfig.FindTerraNodeGroup(ctx, nodeGroup) == nil {
+		return nil
+	}
+
+	if !d.params.Scope.AllowConfiguredNodeGroup {
+		return fmt.Errorf(
+			"NodeGroup %s is still present in the provider cluster configuration and converge will create it again. "+
+				"Remove it from the configuration first or pass --allow-configured-node-group",
+			nodeGroup,
+		)
+	}
+
+	d.params.Logger.WarnContext(ctx, fmt.Sprintf("NodeGroup %s is still present in the provider cluster configuration, converge will create it again", nodeGroup))
+
+	return nil
+}
+
+// drainNodeGroupNodes cordons and drains nodes of the node group, so workloads are moved
+// to other nodes before the node group infrastructure is destroyed.
+func (d *Destroyer) drainNodeGroupNodes(ctx context.Context, nodeGroup string, nodeNames []string) error {
+	logger := d.params.Logger
+
+	if d.params.SkipResources {
+		logger.WarnContext(ctx, fmt.Sprintf("Draining nodes of NodeGroup %s skipped because resources should skip", nodeGroup))
+		return nil
+	}
+
+	kubeCl, err := d.params.KubeProvider.KubeClientCtx(ctx)
+	if err != nil {
+		return err
+	}
+
+	return dhlog.RunProcess(ctx, logger, fmt.Sprintf("Drain nodes of NodeGroup %s", nodeGroup), func(ctx context.Context) error {
+		for _, name := range nodeNames {
+			err := infra_utils.TryToDrainNode(ctx, kubeCl, name, infra_utils.GetDrainConfirmation(d.params.CommanderMode), infra_utils.DrainOptions{Force: false})
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (d *Destroyer) deleteNodeGroupFromCluster(ctx context.Context, nodeGroup string, nodeNames []string) error {
+	logger := d.params.Logger
+
+	if d.params.SkipResources {
+		logger.WarnContext(ctx, fmt.Sprintf("Deleting NodeGroup %s from the Kubernetes cluster skipped because resources should skip", nodeGroup))
+		return nil
+	}
+
+	return dhlog.RunProcess(ctx, logger, fmt.Sprintf("Delete NodeGroup %s from the Kubernetes cluster", nodeGroup), func(ctx context.Context) error {
+		for _, name := range nodeNames {
+			if err := entity.DeleteNode(ctx, d.params.KubeProvider, name); err != nil {
+				return err
+			}
+
+			err := infrastructurestate.DeleteInfrastructureState(ctx, d.params.KubeProvider, manifests.SecretNameForNodeInfrastructureState(name))
+			if err != nil {
+				return err
+			}
+		}
+
+		return entity.DeleteNodeGroup(ctx, d.params.KubeProvider, nodeGroup)
+	})
 }
 
 func (d *Destroyer) unlockConverge(fullUnlock bool) {
@@ -136,6 +136,30 @@ func (d *Destroyer) Finalize(ctx context.Context) error {
 	return nil
 }
 
+// ListResources returns resources which will be deleted by CheckAndDeleteResources.
+func (d *Destroyer) ListResources(ctx context.Context) ([]deckhouse.ResourceToDelete, error) {
+	if d.isSkipResources(ctx, "ListResources") {
+		return nil, nil
+	}
+
+	resourcesDestroyed, err := d.State.IsResourcesDestroyed(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if resourcesDestroyed {
+		d.logger().DebugContext(ctx, "Resources was destroyed in previous run. Nothing to list")
+		return nil, nil
+	}
+
+	kubeCl, err := d.KubeProvider.KubeClientCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return deckhouse.ListResourcesToDelete(ctx, kubeCl)
+}
+
 func (d *Destroyer) deleteResources(ctx context.Context, logger *slog.Logger) error {
 	resourcesDestroyed, err := d.State.IsResourcesDestroyed(ctx)
 	if err != nil {
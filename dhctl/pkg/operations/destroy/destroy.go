@@ -33,6 +33,7 @@ import (
 	"github.com/deckhouse/deckhouse/dhctl/pkg/operations/commander"
 	"github.com/deckhouse/deckhouse/dhctl/pkg/operations/destroy/cloud"
 	"github.com/deckhouse/deckhouse/dhctl/pkg/operations/destroy/deckhouse"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/operations/destroy/inventory"
 	"github.com/deckhouse/deckhouse/dhctl/pkg/operations/destroy/kube"
 	"github.com/deckhouse/deckhouse/dhctl/pkg/operations/phases"
 	dhctlstate "github.com/deckhouse/deckhouse/dhctl/pkg/state"
@@ -49,6 +50,7 @@ type infraDestroyer interface {
 	AfterResourcesDelete(ctx context.Context) error
 	Prepare(ctx context.Context) error
 	CleanupBeforeDestroy(ctx context.Context) error
+	AddToInventory(ctx context.Context, inv *inventory.Inventory) error
 }
 
 type metaConfigPopulator interface {
@@ -67,6 +69,10 @@ type Params struct {
 
 	SkipResources bool
 
+	// Scope limits destroy to a single node group or keeps base infrastructure.
+	// Kubernetes resources are not deleted while destroying a single node group.
+	Scope controller.DestroyScope
+
 	CommanderMode bool
 	CommanderUUID uuid.UUID
 	*commander.CommanderModeParams
@@ -161,6 +167,7 @@ type ClusterDestroyer struct {
 	d8Destroyer   *deckhouse.Destroyer
 	infraProvider *infraDestroyerProvider
 	globalOptions *options.GlobalOptions
+	scope         controller.DestroyScope
 }
 
 // NewClusterDestroyer
@@ -170,6 +177,10 @@ func NewClusterDestroyer(ctx context.Context, params *Params) (*ClusterDestroyer
 		return nil, fmt.Errorf("State cache is required")
 	}
 
+	if err := params.Scope.Validate(); err != nil {
+		return nil, err
+	}
+
 	if params.Options != nil && params.Options.Global.ProgressFilePath != "" {
 		params.OnProgressFunc = phases.WriteProgress(params.Options.Global.ProgressFilePath)
 	}
@@ -211,6 +222,7 @@ func NewClusterDestroyer(ctx context.Context, params *Params) (*ClusterDestroyer
 
 		commanderMode: params.CommanderMode,
 		skipResources: params.SkipResources,
+		scope:         params.Scope,
 		cloudStateProvider: func() (controller.StateLoader, cloud.ClusterInfraDestroyer, error) {
 			return terraStateLoader, controller.NewClusterInfraWithOptions(
 				terraStateLoader,
@@ -221,6 +233,7 @@ func NewClusterDestroyer(ctx context.Context, params *Params) (*ClusterDestroyer
 					TmpDir:                 params.TmpDir,
 					IsDebug:                params.IsDebug,
 					GlobalOptions:          &params.Options.Global,
+					Scope:                  params.Scope,
 				},
 			), nil
 		},
@@ -239,6 +252,7 @@ func NewClusterDestroyer(ctx context.Context, params *Params) (*ClusterDestroyer
 		d8Destroyer:   d8Destroyer,
 		infraProvider: infraProvider,
 		globalOptions: &params.Options.Global,
+		scope:         params.Scope,
 	}, nil
 }
 
@@ -273,18 +287,25 @@ func (d *ClusterDestroyer) destroy(ctx context.Context, autoApprove bool) error
 		return err
 	}
 
-	if err := d.d8Destroyer.CheckAndDeleteResources(ctx); err != nil {
-		return err
+	// cluster is kept while destroying a single node group
+	deleteResources := d.scope.NodeGroup == ""
+
+	if deleteResources {
+		if err := d.d8Destroyer.CheckAndDeleteResources(ctx); err != nil {
+			return err
+		}
 	}
 
 	if err := destroyer.AfterResourcesDelete(ctx); err != nil {
 		return err
 	}
 
-	// only after load and save all states into cache
-	// set resources as deleted
-	if err := d.d8Destroyer.Finalize(ctx); err != nil {
-		return err
+	if deleteResources {
+		// only after load and save all states into cache
+		// set resources as deleted
+		if err := d.d8Destroyer.Finalize(ctx); err != nil {
+			return err
+		}
 	}
 
 	// Stop proxy because we have already got all info from kubernetes-api
@@ -297,7 +318,44 @@ func (d *ClusterDestroyer) destroy(ctx context.Context, autoApprove bool) error
 		return err
 	}
 
+	if d.scope.KeepBaseInfrastructure && d.scope.NodeGroup == "" {
+		// keep base infrastructure state for reuse
+		d.stateCache.CleanWithExceptions(ctx, controller.BaseInfrastructureStateName)
+		dhlog.FromContext(ctx).InfoContext(ctx, fmt.Sprintf("Base infrastructure was kept. Its state is saved to %s", d.stateCache.GetPath(controller.BaseInfrastructureStateName)))
+		return nil
+	}
+
 	d.stateCache.Clean(ctx)
 
 	return nil
 }
+
+// Inventory returns everything DestroyCluster removes: Kubernetes resources, resources
+// from infrastructure states and static hosts. Nothing is changed in the cluster and in the infrastructure.
+func (d *ClusterDestroyer) Inventory(ctx context.Context) (*inventory.Inventory, error) {
+	metaConfig, err := d.configPreparator.PopulateMetaConfig(ctx, d.globalOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	destroyer, err := config.DoByClusterType(ctx, metaConfig, d.infraProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	inv := inventory.New(metaConfig.ClusterType)
+
+	if d.scope.NodeGroup == "" {
+		resources, err := d.d8Destroyer.ListResources(ctx)
+		if err != nil {
+			return nil, err
+		}
+		inv.KubernetesResources = append(inv.KubernetesResources, resources...)
+	}
+
+	if err := destroyer.AddToInventory(ctx, inv); err != nil {
+		return nil, err
+	}
+
+	return inv, nil
+}
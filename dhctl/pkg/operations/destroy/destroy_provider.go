@@ -40,6 +40,7 @@ type infraDestroyerProvider struct {
 
 	commanderMode      bool
 	skipResources      bool
+	scope              controller.DestroyScope
 	cloudStateProvider func() (controller.StateLoader, cloud.ClusterInfraDestroyer, error)
 
 	sshClientProvider libcon.SSHProvider
@@ -80,6 +81,7 @@ func (f *infraDestroyerProvider) Cloud(context.Context, *config.MetaConfig) (inf
 
 		CommanderMode: f.commanderMode,
 		SkipResources: f.skipResources,
+		Scope:         f.scope,
 		SSHUser:       f.sshUser,
 	}), nil
 }
@@ -93,6 +95,10 @@ func (f *infraDestroyerProvider) Static(context.Context, *config.MetaConfig) (in
 		return nil, fmt.Errorf("SSH client provider should be provided to infraDestroyerProvider")
 	}
 
+	if !f.scope.IsFull() {
+		return nil, fmt.Errorf("Destroying a node group or keeping base infrastructure is supported for cloud clusters only")
+	}
+
 	return static.NewDestroyer(&static.DestroyerParams{
 		SSHClientProvider:    f.sshClientProvider,
 		KubeProvider:         f.kubeProvider,
@@ -0,0 +1,111 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inventory
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/deckhouse/deckhouse/dhctl/pkg/kubernetes/actions/deckhouse"
+	infrastructurestate "github.com/deckhouse/deckhouse/dhctl/pkg/state/infrastructure"
+)
+
+// Inventory lists everything the destroy operation removes. Nothing is removed while building it.
+type Inventory struct {
+	ClusterType         string                       `json:"clusterType"`
+	KubernetesResources []deckhouse.ResourceToDelete `json:"kubernetesResources"`
+	CloudResources      []CloudResource              `json:"cloudResources"`
+	// KeptCloudResources are the resources from the states which are not destroyed
+	// because of the selective destroy.
+	KeptCloudResources []CloudResource `json:"keptCloudResources,omitempty"`
+	StaticHosts        []StaticHost    `json:"staticHosts"`
+}
+
+func New(clusterType string) *Inventory {
+	return &Inventory{
+		ClusterType:         clusterType,
+		KubernetesResources: make([]deckhouse.ResourceToDelete, 0),
+		CloudResources:      make([]CloudResource, 0),
+		StaticHosts:         make([]StaticHost, 0),
+	}
+}
+
+// CloudResource is the resource from the infrastructure state.
+type CloudResource struct {
+	// State is base-infrastructure or node name.
+	State     string `json:"state"`
+	NodeGroup string `json:"nodeGroup,omitempty"`
+	infrastructurestate.StateResource
+}
+
+// StaticHost is the host cleaned up by the static destroyer.
+type StaticHost struct {
+	Name string `json:"name,omitempty"`
+	Host string `json:"host"`
+}
+
+func (i *Inventory) String() string {
+	b := strings.Builder{}
+
+	fmt.Fprintf(&b, "Cluster type: %s\n", i.ClusterType)
+
+	fmt.Fprintf(&b, "\nKubernetes resources to delete (%d):\n", len(i.KubernetesResources))
+	for _, r := range i.KubernetesResources {
+		fmt.Fprintf(&b, "\t%s\n", r)
+	}
+
+	if len(i.CloudResources) > 0 || len(i.KeptCloudResources) > 0 {
+		fmt.Fprintf(&b, "\nCloud resources to destroy (%d):\n", len(i.CloudResources))
+		writeCloudResources(&b, i.CloudResources)
+	}
+
+	if len(i.KeptCloudResources) > 0 {
+		fmt.Fprintf(&b, "\nCloud resources to keep (%d):\n", len(i.KeptCloudResources))
+		writeCloudResources(&b, i.KeptCloudResources)
+	}
+
+	if len(i.StaticHosts) > 0 {
+		fmt.Fprintf(&b, "\nStatic hosts to clean up (%d):\n", len(i.StaticHosts))
+		for _, h := range i.StaticHosts {
+			if h.Name != "" && h.Name != h.Host {
+				fmt.Fprintf(&b, "\t%s (%s)\n", h.Host, h.Name)
+				continue
+			}
+			fmt.Fprintf(&b, "\t%s\n", h.Host)
+		}
+	}
+
+	return b.String()
+}
+
+func writeCloudResources(b *strings.Builder, resources []CloudResource) {
+	state := ""
+	for _, r := range resources {
+		if r.State != state {
+			state = r.State
+			if r.NodeGroup != "" {
+				fmt.Fprintf(b, "\t%s (node group %s):\n", r.State, r.NodeGroup)
+			} else {
+				fmt.Fprintf(b, "\t%s:\n", r.State)
+			}
+		}
+
+		if r.ID != "" {
+			fmt.Fprintf(b, "\t\t%s (%s)\n", r.Address, r.ID)
+			continue
+		}
+		fmt.Fprintf(b, "\t\t%s\n", r.Address)
+	}
+}
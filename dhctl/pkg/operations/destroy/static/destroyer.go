@@ -35,6 +35,7 @@ import (
 
 	v1 "github.com/deckhouse/deckhouse/dhctl/pkg/apis/deckhouse/v1"
 	"github.com/deckhouse/deckhouse/dhctl/pkg/kubernetes/actions/entity"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/operations/destroy/inventory"
 	"github.com/deckhouse/deckhouse/dhctl/pkg/operations/destroy/kube"
 	"github.com/deckhouse/deckhouse/dhctl/pkg/operations/phases"
 	"github.com/deckhouse/deckhouse/dhctl/pkg/util/input"
@@ -148,6 +149,61 @@ func (d *Destroyer) DestroyCluster(ctx context.Context, autoApprove bool) error
 	})
 }
 
+// AddToInventory adds control-plane hosts which will be cleaned up to the inventory.
+// Additional control-plane hosts are taken from the cache of the previous run or from the cluster,
+// node user is not created.
+func (d *Destroyer) AddToInventory(ctx context.Context, inv *inventory.Inventory) error {
+	if govalue.IsNil(d.params.SSHClientProvider) {
+		return errors.New("Internal error. SSH provider was not passed")
+	}
+
+	sshClient, err := d.params.SSHClientProvider.Client(ctx)
+	if err != nil {
+		return err
+	}
+
+	masterHosts := sshClient.Session().AvailableHosts()
+
+	var ips []entity.NodeIP
+	if nodesWithCredentials, err := d.params.State.NodeUser(ctx); err == nil {
+		ips = nodesWithCredentials.IPs
+	} else {
+		if !errors.Is(err, errNotFoundCredentials) {
+			return fmt.Errorf("Error getting node user from cache: %w", err)
+		}
+
+		ips, err = entity.GetMasterNodesIPs(ctx, d.params.KubeProvider, d.params.Loops.GetMastersIPs)
+		if err != nil {
+			return err
+		}
+	}
+
+	// additional control-plane hosts are cleaned up before the passed ones
+	if isSingleMaster(ips) {
+		ips = nil
+	}
+
+	for _, ip := range ips {
+		passed := false
+		for _, host := range masterHosts {
+			if host.Host == ip.ExternalIP || host.Host == ip.InternalIP {
+				passed = true
+				break
+			}
+		}
+
+		if !passed {
+			inv.StaticHosts = append(inv.StaticHosts, inventory.StaticHost{Name: ip.Name(), Host: ip.InternalIP})
+		}
+	}
+
+	for _, host := range masterHosts {
+		inv.StaticHosts = append(inv.StaticHosts, inventory.StaticHost{Name: host.Name, Host: host.Host})
+	}
+
+	return nil
+}
+
 func (d *Destroyer) destroyCluster(ctx context.Context, autoApprove bool) error {
 	if !autoApprove {
 		if !input.NewConfirmation().WithMessage("Do you really want to cleanup control-plane nodes?").Ask() {
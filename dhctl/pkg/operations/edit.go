@@ -42,6 +42,9 @@ type EditOptions struct {
 	// missing Secret is sometimes not an invitation to create one (see
 	// RejectLegacyProviderEditOnMcFlow).
 	OnAbsent func(ctx context.Context, kubeCl *client.KubernetesClient) error
+	// Transform, when set, replaces the interactive editor: SecretEdit saves
+	// whatever it returns for the current data (see MigrationTransform).
+	Transform func(ctx context.Context, data []byte) ([]byte, error)
 }
 
 // OnAbsentFor returns the guard for the Secret being edited, or nil when a
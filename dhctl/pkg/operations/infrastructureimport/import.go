@@ -0,0 +1,204 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructureimport
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	dhlog "github.com/deckhouse/lib-dhctl/pkg/logger"
+
+	"github.com/deckhouse/deckhouse/dhctl/pkg/app/options"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/config"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/global"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/infrastructure"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/infrastructure/plan"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/kubernetes/client"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/operations"
+	dstate "github.com/deckhouse/deckhouse/dhctl/pkg/state"
+	infrastructurestate "github.com/deckhouse/deckhouse/dhctl/pkg/state/infrastructure"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/telemetry"
+)
+
+type Params struct {
+	KubeCl                *client.KubernetesClient
+	MetaConfig            *config.MetaConfig
+	Mapping               *Mapping
+	InfrastructureContext *infrastructure.Context
+	StateCache            dstate.Cache
+	GlobalOptions         *options.GlobalOptions
+
+	// AllowChanges saves imported states even if the infrastructure plan is not empty after import.
+	// Differences are applied by the next converge.
+	AllowChanges bool
+}
+
+// layerResult is the imported state of the one layer which is saved to the cluster
+// after all layers were imported.
+type layerResult struct {
+	name      string
+	nodeGroup string
+	changes   int
+	outputs   *infrastructure.PipelineOutputs
+}
+
+// Import adopts existing cloud resources into the infrastructure states of the cluster.
+// All layers are imported and planned first, states are saved to the cluster only if
+// plans of all layers are empty or AllowChanges is set. Imported states are kept
+// in the state cache, so failed import can be continued.
+func Import(ctx context.Context, params Params) error {
+	ctx, span := telemetry.StartSpan(ctx, "InfrastructureImport")
+	defer span.End()
+
+	metaConfig := params.MetaConfig
+	if metaConfig.ClusterType != config.CloudClusterType {
+		return fmt.Errorf("import of infrastructure is supported only for %s clusters", config.CloudClusterType)
+	}
+
+	if err := params.Mapping.Validate(metaConfig); err != nil {
+		return err
+	}
+
+	if err := checkNoStatesInCluster(ctx, params); err != nil {
+		return err
+	}
+
+	results := make([]layerResult, 0)
+
+	if len(params.Mapping.BaseInfrastructure) > 0 {
+		runner, err := params.InfrastructureContext.GetImportBaseInfraRunner(ctx, metaConfig, params.StateCache)
+		if err != nil {
+			return err
+		}
+
+		outputs, changes, err := infrastructure.ImportPipeline(ctx, runner, "Kubernetes cluster", params.Mapping.BaseInfrastructure, params.GlobalOptions, infrastructure.GetBaseInfraResult)
+		if err != nil {
+			return err
+		}
+
+		results = append(results, layerResult{name: string(infrastructure.BaseInfraStep), changes: changes, outputs: outputs})
+	}
+
+	for _, ng := range params.Mapping.Layers() {
+		step := infrastructure.GetStepByNodeGroupName(ng.Name)
+		extractFn := infrastructure.OnlyState
+		if step == infrastructure.MasterNodeStep {
+			extractFn = infrastructure.GetMasterNodeResult
+		}
+
+		for _, node := range ng.Nodes {
+			nodeName := operations.NodeName(metaConfig, ng.Name, node.Index)
+
+			runner, err := params.InfrastructureContext.GetImportNodeRunner(ctx, metaConfig, params.StateCache, infrastructure.ImportNodeRunnerOptions{
+				NodeName:      nodeName,
+				NodeGroupName: ng.Name,
+				NodeGroupStep: step,
+				NodeIndex:     node.Index,
+			})
+			if err != nil {
+				return err
+			}
+
+			outputs, changes, err := infrastructure.ImportPipeline(ctx, runner, nodeName, node.Resources, params.GlobalOptions, extractFn)
+			if err != nil {
+				return err
+			}
+
+			results = append(results, layerResult{name: nodeName, nodeGroup: ng.Name, changes: changes, outputs: outputs})
+		}
+	}
+
+	if err := checkChanges(ctx, results, params.AllowChanges); err != nil {
+		return err
+	}
+
+	return dhlog.RunProcess(ctx, dhlog.FromContext(ctx), "Save imported infrastructure states", func(ctx context.Context) error {
+		for _, result := range results {
+			if err := saveLayerState(ctx, params, result); err != nil {
+				return fmt.Errorf("cannot save infrastructure state of %s: %w", result.name, err)
+			}
+		}
+
+		return nil
+	})
+}
+
+func checkNoStatesInCluster(ctx context.Context, params Params) error {
+	if len(params.Mapping.BaseInfrastructure) > 0 {
+		clusterState, err := infrastructurestate.GetClusterStateFromCluster(ctx, params.KubeCl)
+		if err != nil {
+			return err
+		}
+
+		if len(clusterState) > 0 {
+			return fmt.Errorf("cluster already has base infrastructure state, use converge to manage it")
+		}
+	}
+
+	for _, ng := range params.Mapping.NodeGroups {
+		for _, node := range ng.Nodes {
+			nodeName := operations.NodeName(params.MetaConfig, ng.Name, node.Index)
+
+			exists, err := infrastructurestate.HasNodeStateInCluster(ctx, params.KubeCl, infrastructurestate.HasNodeStateInClusterParams{
+				NodeGroup: ng.Name,
+				Name:      nodeName,
+			})
+			if err != nil {
+				return err
+			}
+
+			if exists {
+				return fmt.Errorf("cluster already has infrastructure state of node %s, use converge to manage it", nodeName)
+			}
+		}
+	}
+
+	return nil
+}
+
+func checkChanges(ctx context.Context, results []layerResult, allowChanges bool) error {
+	withChanges := make([]string, 0)
+	for _, result := range results {
+		if result.changes != plan.HasNoChanges {
+			withChanges = append(withChanges, result.name)
+		}
+	}
+
+	if len(withChanges) == 0 {
+		dhlog.FromContext(ctx).InfoContext(ctx, "Imported resources match the cluster configuration")
+		return nil
+	}
+
+	msg := fmt.Sprintf("Infrastructure plans are not empty after import for: %s. See the differences above.", strings.Join(withChanges, ", "))
+	if allowChanges {
+		dhlog.FromContext(ctx).WarnContext(ctx, msg+" Differences will be applied by the next converge.")
+		return nil
+	}
+
+	return fmt.Errorf("%s Fix the resources mapping or the cluster configuration and run import again. Imported states are kept in the cache", msg)
+}
+
+func saveLayerState(ctx context.Context, params Params, result layerResult) error {
+	switch {
+	case result.nodeGroup == "":
+		return infrastructurestate.SaveClusterInfrastructureState(ctx, params.KubeCl, params.MetaConfig, result.outputs)
+	case result.nodeGroup == global.MasterNodeGroupName:
+		return infrastructurestate.SaveMasterNodeInfrastructureState(ctx, params.KubeCl, result.name, result.outputs.InfrastructureState, []byte(result.outputs.KubeDataDevicePath))
+	default:
+		settings := params.MetaConfig.FindTerraNodeGroup(ctx, result.nodeGroup)
+		return infrastructurestate.SaveNodeInfrastructureState(ctx, params.KubeCl, result.name, result.nodeGroup, result.outputs.InfrastructureState, settings)
+	}
+}
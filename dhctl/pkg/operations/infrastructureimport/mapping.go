@@ -0,0 +1,153 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructureimport
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/deckhouse/deckhouse/dhctl/pkg/config"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/global"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/infrastructure"
+)
+
+// Mapping binds resource addresses of every infrastructure layer to ids of the existing cloud resources.
+type Mapping struct {
+	BaseInfrastructure []infrastructure.ImportResource `json:"baseInfrastructure,omitempty"`
+	NodeGroups         []NodeGroupMapping              `json:"nodeGroups,omitempty"`
+}
+
+type NodeGroupMapping struct {
+	Name  string        `json:"name"`
+	Nodes []NodeMapping `json:"nodes"`
+}
+
+type NodeMapping struct {
+	Index     int                             `json:"index"`
+	Resources []infrastructure.ImportResource `json:"resources"`
+}
+
+func LoadMapping(mappingPath string) (*Mapping, error) {
+	content, err := os.ReadFile(mappingPath)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read resources mapping: %w", err)
+	}
+
+	return ParseMapping(content)
+}
+
+func ParseMapping(content []byte) (*Mapping, error) {
+	mapping := &Mapping{}
+	if err := yaml.UnmarshalStrict(content, mapping); err != nil {
+		return nil, fmt.Errorf("cannot parse resources mapping: %w", err)
+	}
+
+	return mapping, nil
+}
+
+// Validate checks the mapping against node groups of the cluster configuration.
+func (m *Mapping) Validate(metaConfig *config.MetaConfig) error {
+	var errs []error
+
+	errs = append(errs, validateResources(string(infrastructure.BaseInfraStep), m.BaseInfrastructure)...)
+
+	seenGroups := make(map[string]struct{})
+	for _, ng := range m.NodeGroups {
+		if _, ok := seenGroups[ng.Name]; ok {
+			errs = append(errs, fmt.Errorf("node group %s: duplicated", ng.Name))
+			continue
+		}
+		seenGroups[ng.Name] = struct{}{}
+
+		if ng.Name != global.MasterNodeGroupName && !hasTerraNodeGroup(metaConfig, ng.Name) {
+			errs = append(errs, fmt.Errorf("node group %s: not found in the cluster configuration", ng.Name))
+			continue
+		}
+
+		replicas := metaConfig.GetReplicasByNodeGroupName(ng.Name)
+		seenNodes := make(map[int]struct{})
+		for _, node := range ng.Nodes {
+			layer := fmt.Sprintf("node group %s node %d", ng.Name, node.Index)
+
+			if node.Index < 0 || node.Index >= replicas {
+				errs = append(errs, fmt.Errorf("%s: index is out of replicas count %d", layer, replicas))
+				continue
+			}
+
+			if _, ok := seenNodes[node.Index]; ok {
+				errs = append(errs, fmt.Errorf("%s: duplicated", layer))
+				continue
+			}
+			seenNodes[node.Index] = struct{}{}
+
+			errs = append(errs, validateResources(layer, node.Resources)...)
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid resources mapping: %w", errors.Join(errs...))
+	}
+
+	return nil
+}
+
+// Layers returns node groups of the mapping, master node group goes first
+// to import nodes in the same order as they are bootstrapped.
+func (m *Mapping) Layers() []NodeGroupMapping {
+	groups := make([]NodeGroupMapping, 0, len(m.NodeGroups))
+	for _, ng := range m.NodeGroups {
+		if ng.Name == global.MasterNodeGroupName {
+			groups = append([]NodeGroupMapping{ng}, groups...)
+			continue
+		}
+
+		groups = append(groups, ng)
+	}
+
+	return groups
+}
+
+func validateResources(layer string, resources []infrastructure.ImportResource) []error {
+	var errs []error
+
+	seen := make(map[string]struct{})
+	for i, resource := range resources {
+		if resource.Address == "" || resource.ID == "" {
+			errs = append(errs, fmt.Errorf("%s: resource %d: address and id are required", layer, i))
+			continue
+		}
+
+		if _, ok := seen[resource.Address]; ok {
+			errs = append(errs, fmt.Errorf("%s: resource %s is duplicated", layer, resource.Address))
+			continue
+		}
+		seen[resource.Address] = struct{}{}
+	}
+
+	return errs
+}
+
+func hasTerraNodeGroup(metaConfig *config.MetaConfig, name string) bool {
+	for _, ng := range metaConfig.GetTerraNodeGroups() {
+		if ng.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,86 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructureimport
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/deckhouse/deckhouse/dhctl/pkg/config"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/infrastructure"
+)
+
+func TestMapping(t *testing.T) {
+	metaConfig := &config.MetaConfig{
+		MasterNodeGroupSpec: config.MasterNodeGroupSpec{Replicas: 1},
+		TerraNodeGroupSpecs: []config.TerraNodeGroupSpec{{Name: "system", Replicas: 2}},
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		mapping, err := ParseMapping([]byte(`
+baseInfrastructure:
+- address: test_network.kube
+  id: net-1
+nodeGroups:
+- name: system
+  nodes:
+  - index: 1
+    resources:
+    - address: test_instance.node
+      id: vm-2
+- name: master
+  nodes:
+  - index: 0
+    resources:
+    - address: test_instance.node
+      id: vm-1
+`))
+		require.NoError(t, err)
+		require.NoError(t, mapping.Validate(metaConfig))
+
+		layers := mapping.Layers()
+		require.Len(t, layers, 2)
+		require.Equal(t, "master", layers[0].Name)
+		require.Equal(t, "system", layers[1].Name)
+	})
+
+	t.Run("unknown field", func(t *testing.T) {
+		_, err := ParseMapping([]byte(`nodes: []`))
+		require.Error(t, err)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		mapping := &Mapping{
+			BaseInfrastructure: []infrastructure.ImportResource{
+				{Address: "test_network.kube", ID: "net-1"},
+				{Address: "test_network.kube", ID: "net-2"},
+				{Address: "test_subnet.kube"},
+			},
+			NodeGroups: []NodeGroupMapping{
+				{Name: "unknown"},
+				{Name: "master", Nodes: []NodeMapping{{Index: 1}}},
+				{Name: "system", Nodes: []NodeMapping{{Index: 0}, {Index: 0}}},
+			},
+		}
+
+		err := mapping.Validate(metaConfig)
+		require.ErrorContains(t, err, "test_network.kube is duplicated")
+		require.ErrorContains(t, err, "resource 2: address and id are required")
+		require.ErrorContains(t, err, "node group unknown: not found")
+		require.ErrorContains(t, err, "node group master node 1: index is out of replicas count 1")
+		require.ErrorContains(t, err, "node group system node 0: duplicated")
+	})
+}
@@ -0,0 +1,82 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructurestate
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	dhlog "github.com/deckhouse/lib-dhctl/pkg/logger"
+
+	"github.com/deckhouse/deckhouse/dhctl/pkg/kubernetes/client"
+	infrastructurestate "github.com/deckhouse/deckhouse/dhctl/pkg/state/infrastructure"
+)
+
+type ExportParams struct {
+	KubeCl       *client.KubernetesClient
+	ArchivePath  string
+	SigningKey   []byte
+	HistoryLimit int
+}
+
+// Export writes all infrastructure states of the cluster to the signed archive
+// and saves them as the new revision of the states history in the cluster.
+func Export(ctx context.Context, params ExportParams) error {
+	snapshot, err := infrastructurestate.GetStatesSnapshotFromCluster(ctx, params.KubeCl)
+	if err != nil {
+		return err
+	}
+
+	clusterUUID, err := infrastructurestate.GetClusterUUID(ctx, params.KubeCl)
+	if err != nil {
+		return err
+	}
+
+	if err := writeArchive(params.ArchivePath, params.SigningKey, clusterUUID, snapshot); err != nil {
+		return err
+	}
+
+	dhlog.FromContext(ctx).InfoContext(ctx, fmt.Sprintf("%d infrastructure states exported to %s", len(snapshot.States()), params.ArchivePath))
+
+	revision, err := infrastructurestate.SaveStateHistoryRevision(ctx, params.KubeCl, "export", params.HistoryLimit)
+	if err != nil {
+		return fmt.Errorf("cannot save infrastructure states history: %w", err)
+	}
+
+	dhlog.FromContext(ctx).InfoContext(ctx, fmt.Sprintf("Infrastructure states history revision %s saved", revision.ID))
+
+	return nil
+}
+
+func writeArchive(path string, key []byte, clusterUUID string, snapshot *infrastructurestate.StatesSnapshot) error {
+	tmpFile, err := os.CreateTemp(filepath.Dir(path), ".infrastructure-state-*")
+	if err != nil {
+		return fmt.Errorf("cannot create infrastructure state archive: %w", err)
+	}
+	defer os.Remove(tmpFile.Name())
+
+	if err := infrastructurestate.WriteStateArchive(tmpFile, key, clusterUUID, snapshot); err != nil {
+		_ = tmpFile.Close()
+		return fmt.Errorf("cannot write infrastructure state archive: %w", err)
+	}
+
+	if err := tmpFile.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmpFile.Name(), path)
+}
@@ -0,0 +1,205 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructurestate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	dhlog "github.com/deckhouse/lib-dhctl/pkg/logger"
+
+	"github.com/deckhouse/deckhouse/dhctl/pkg/infrastructure"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/kubernetes/client"
+	infrastructurestate "github.com/deckhouse/deckhouse/dhctl/pkg/state/infrastructure"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/util/input"
+)
+
+type ImportParams struct {
+	KubeCl *client.KubernetesClient
+
+	// ArchivePath is the path to the archive created with export. Ignored if Revision is set.
+	ArchivePath string
+	SigningKey  []byte
+	// Revision is the id of the states history revision to roll back to.
+	Revision string
+
+	// CloudProvider is used for validation of the states against providers schemas.
+	// Validation is skipped if CloudProvider is nil.
+	CloudProvider infrastructure.CloudProvider
+
+	HistoryLimit     int
+	SkipConfirmation bool
+}
+
+// Import replaces infrastructure states of the cluster with states from the archive or from the history revision.
+// Current states are saved as the new revision of the states history before replacing.
+func Import(ctx context.Context, params ImportParams) error {
+	logger := dhlog.FromContext(ctx)
+
+	snapshot, source, err := loadImportSnapshot(ctx, params)
+	if err != nil {
+		return err
+	}
+
+	if err := validateSnapshot(ctx, params.CloudProvider, snapshot); err != nil {
+		return err
+	}
+
+	current, err := infrastructurestate.GetStatesSnapshotFromCluster(ctx, params.KubeCl)
+	if err != nil {
+		return err
+	}
+
+	if missing := missingStates(current, snapshot); len(missing) > 0 {
+		logger.WarnContext(ctx, fmt.Sprintf("States %s are present in the cluster but not in %s, they will be kept as is", strings.Join(missing, ", "), source))
+	}
+
+	if !params.SkipConfirmation {
+		msg := fmt.Sprintf("%d infrastructure states of the cluster will be replaced with states from %s. Continue?", len(snapshot.States()), source)
+		if !input.NewConfirmation().WithMessage(msg).Ask() {
+			return fmt.Errorf("import of infrastructure states was canceled")
+		}
+	}
+
+	revision, err := infrastructurestate.SaveStateHistoryRevision(ctx, params.KubeCl, "import", params.HistoryLimit)
+	if err != nil {
+		return fmt.Errorf("cannot save infrastructure states history: %w", err)
+	}
+
+	logger.InfoContext(ctx, fmt.Sprintf("Current infrastructure states saved to history revision %s", revision.ID))
+
+	if err := infrastructurestate.SaveStatesSnapshotToCluster(ctx, params.KubeCl, snapshot); err != nil {
+		return fmt.Errorf("cannot save infrastructure states, use revision %s to roll back: %w", revision.ID, err)
+	}
+
+	logger.InfoContext(ctx, fmt.Sprintf("%d infrastructure states imported from %s", len(snapshot.States()), source))
+
+	return nil
+}
+
+func loadImportSnapshot(ctx context.Context, params ImportParams) (*infrastructurestate.StatesSnapshot, string, error) {
+	if params.Revision != "" {
+		snapshot, err := infrastructurestate.GetStateHistoryRevision(ctx, params.KubeCl, params.Revision)
+		if err != nil {
+			return nil, "", err
+		}
+
+		return snapshot, fmt.Sprintf("history revision %s", params.Revision), nil
+	}
+
+	file, err := os.Open(params.ArchivePath)
+	if err != nil {
+		return nil, "", fmt.Errorf("cannot open infrastructure state archive: %w", err)
+	}
+	defer file.Close()
+
+	manifest, snapshot, err := infrastructurestate.ReadStateArchive(file, params.SigningKey)
+	if err != nil {
+		return nil, "", err
+	}
+
+	clusterUUID, err := infrastructurestate.GetClusterUUID(ctx, params.KubeCl)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if manifest.ClusterUUID != clusterUUID {
+		return nil, "", fmt.Errorf("infrastructure state archive was exported from cluster %s, current cluster is %s", manifest.ClusterUUID, clusterUUID)
+	}
+
+	return snapshot, fmt.Sprintf("archive %s", params.ArchivePath), nil
+}
+
+func validateSnapshot(ctx context.Context, cloudProvider infrastructure.CloudProvider, snapshot *infrastructurestate.StatesSnapshot) error {
+	logger := dhlog.FromContext(ctx)
+
+	if cloudProvider == nil {
+		logger.WarnContext(ctx, "Validation of infrastructure states against providers schemas was skipped")
+		return nil
+	}
+
+	schemas := make(map[infrastructure.Step]*infrastructurestate.ProvidersSchema)
+	var errs []error
+
+	for _, info := range snapshot.States() {
+		step := infrastructure.BaseInfraStep
+		if info.Node != "" {
+			step = infrastructure.GetStepByNodeGroupName(info.NodeGroup)
+		}
+
+		schema, ok := schemas[step]
+		if !ok {
+			var err error
+			schema, err = providersSchema(ctx, cloudProvider, step)
+			if err != nil {
+				return err
+			}
+			schemas[step] = schema
+		}
+
+		if schema == nil {
+			continue
+		}
+
+		st, _ := snapshot.State(info.Name())
+		if err := schema.ValidateState(st); err != nil {
+			errs = append(errs, fmt.Errorf("state %s: %w", info.Name(), err))
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("infrastructure states do not match providers schemas:\n%w", err)
+	}
+
+	return nil
+}
+
+func providersSchema(ctx context.Context, cloudProvider infrastructure.CloudProvider, step infrastructure.Step) (*infrastructurestate.ProvidersSchema, error) {
+	executor, err := cloudProvider.Executor(ctx, step)
+	if err != nil {
+		return nil, err
+	}
+
+	schemaExecutor, ok := executor.(infrastructure.SchemaExecutor)
+	if !ok {
+		dhlog.FromContext(ctx).WarnContext(ctx, fmt.Sprintf("Executor for %s does not provide providers schemas, validation skipped", step))
+		return nil, nil
+	}
+
+	if err := executor.Init(ctx); err != nil {
+		return nil, err
+	}
+
+	content, err := schemaExecutor.ProvidersSchema(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get providers schema for %s: %w", step, err)
+	}
+
+	return infrastructurestate.ParseProvidersSchema(content)
+}
+
+func missingStates(current, imported *infrastructurestate.StatesSnapshot) []string {
+	missing := make([]string, 0)
+	for _, info := range current.States() {
+		if _, ok := imported.State(info.Name()); !ok {
+			missing = append(missing, info.Name())
+		}
+	}
+
+	return missing
+}
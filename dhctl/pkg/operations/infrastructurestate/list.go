@@ -0,0 +1,83 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructurestate
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/deckhouse/deckhouse/dhctl/pkg/kubernetes/client"
+	infrastructurestate "github.com/deckhouse/deckhouse/dhctl/pkg/state/infrastructure"
+)
+
+type ListResult struct {
+	States  []StateInfo                                `json:"states"`
+	History []infrastructurestate.StateHistoryRevision `json:"history"`
+}
+
+type StateInfo struct {
+	Name string `json:"name"`
+	infrastructurestate.SnapshotStateInfo
+}
+
+// List returns infrastructure states of the cluster and revisions of the states history.
+func List(ctx context.Context, kubeCl *client.KubernetesClient) (*ListResult, error) {
+	snapshot, err := infrastructurestate.GetStatesSnapshotFromCluster(ctx, kubeCl)
+	if err != nil {
+		return nil, err
+	}
+
+	history, err := infrastructurestate.ListStateHistoryRevisions(ctx, kubeCl)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ListResult{
+		States:  make([]StateInfo, 0),
+		History: history,
+	}
+
+	for _, info := range snapshot.States() {
+		result.States = append(result.States, StateInfo{
+			Name:              info.Name(),
+			SnapshotStateInfo: info,
+		})
+	}
+
+	return result, nil
+}
+
+// Show returns the state by name: base-infrastructure or node name.
+// State is taken from the history revision if revision is passed.
+func Show(ctx context.Context, kubeCl *client.KubernetesClient, name, revision string) ([]byte, error) {
+	var snapshot *infrastructurestate.StatesSnapshot
+	var err error
+
+	if revision != "" {
+		snapshot, err = infrastructurestate.GetStateHistoryRevision(ctx, kubeCl, revision)
+	} else {
+		snapshot, err = infrastructurestate.GetStatesSnapshotFromCluster(ctx, kubeCl)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	st, ok := snapshot.State(name)
+	if !ok {
+		return nil, fmt.Errorf("infrastructure state %s not found", name)
+	}
+
+	return st, nil
+}
@@ -16,12 +16,31 @@ package phases
 
 import (
 	"context"
+	"slices"
+	"strings"
 
 	"github.com/deckhouse/deckhouse/dhctl/pkg/state"
 )
 
 type DhctlState map[string][]byte
 
+const infrastructureStateSuffix = ".tfstate"
+
+// InfrastructureStates returns sorted names of the infrastructure states saved in the dhctl state,
+// e.g. base-infrastructure.tfstate or state of the node.
+func (s DhctlState) InfrastructureStates() []string {
+	names := make([]string, 0)
+	for name := range s {
+		if strings.HasSuffix(name, infrastructureStateSuffix) {
+			names = append(names, name)
+		}
+	}
+
+	slices.Sort(names)
+
+	return names
+}
+
 func ExtractDhctlState(ctx context.Context, stateCache state.Cache) (DhctlState, error) {
 	var res DhctlState
 
@@ -0,0 +1,340 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package phases
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pmezard/go-difflib/difflib"
+
+	dstate "github.com/deckhouse/deckhouse/dhctl/pkg/state"
+)
+
+// JournalCacheKey is the state cache key the operation journal is persisted under.
+const JournalCacheKey = "operation-journal"
+
+var (
+	ErrJournalNotFound      = errors.New("operation journal not found")
+	ErrJournalConfigChanged = errors.New("configuration changed since the journaled run")
+)
+
+type JournalEvent string
+
+const (
+	JournalEventStarted   JournalEvent = "Started"
+	JournalEventCompleted JournalEvent = "Completed"
+)
+
+// JournalRecord is a single append-only entry of the operation journal.
+// Records without SubPhase describe the phase itself.
+type JournalRecord struct {
+	Phase      OperationPhase    `json:"phase"`
+	SubPhase   OperationSubPhase `json:"subPhase,omitempty"`
+	Event      JournalEvent      `json:"event"`
+	InputsHash string            `json:"inputsHash"`
+	Outputs    json.RawMessage   `json:"outputs,omitempty"`
+	Time       time.Time         `json:"time"`
+}
+
+type JournalData struct {
+	Operation  Operation `json:"operation"`
+	ConfigHash string    `json:"configHash"`
+	// Config is the redacted configuration (see state.RedactConfig), kept to show what changed on resume.
+	Config  string          `json:"config"`
+	Records []JournalRecord `json:"records"`
+}
+
+// Journal records every phase and sub-phase reported by PhasedExecutionContext
+// into the state cache, so an interrupted operation could be continued from the
+// last completed step instead of being started from scratch.
+// All methods are safe to call on nil Journal.
+type Journal struct {
+	mx    sync.Mutex
+	cache dstate.Cache
+	data  JournalData
+
+	pendingOutputs map[journalStepKey]json.RawMessage
+}
+
+type journalStepKey struct {
+	phase    OperationPhase
+	subPhase OperationSubPhase
+}
+
+func NewJournal(stateCache dstate.Cache, operation Operation, configHash, config string) *Journal {
+	return &Journal{
+		cache: stateCache,
+		data: JournalData{
+			Operation:  operation,
+			ConfigHash: configHash,
+			Config:     config,
+		},
+	}
+}
+
+// LoadJournal loads journal from the state cache. Returns ErrJournalNotFound if there is no journal in the cache.
+func LoadJournal(ctx context.Context, stateCache dstate.Cache) (*Journal, error) {
+	inCache, err := stateCache.InCache(ctx, JournalCacheKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if !inCache {
+		return nil, ErrJournalNotFound
+	}
+
+	raw, err := stateCache.Load(ctx, JournalCacheKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load operation journal: %w", err)
+	}
+
+	journal := &Journal{cache: stateCache}
+	if err := json.Unmarshal(raw, &journal.data); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal operation journal: %w", err)
+	}
+
+	return journal, nil
+}
+
+// ValidateResume checks that journal belongs to the operation and was written with the same configuration.
+// If configuration was changed, returned error wraps ErrJournalConfigChanged and contains unified diff.
+func (j *Journal) ValidateResume(operation Operation, configHash, config string) error {
+	if j == nil {
+		return ErrJournalNotFound
+	}
+
+	j.mx.Lock()
+	defer j.mx.Unlock()
+
+	if j.data.Operation != operation {
+		return fmt.Errorf("operation journal was written by %s operation, cannot resume %s", j.data.Operation, operation)
+	}
+
+	if j.data.ConfigHash == configHash {
+		return nil
+	}
+
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(j.data.Config),
+		B:        difflib.SplitLines(config),
+		FromFile: fmt.Sprintf("journaled (%s)", j.data.ConfigHash),
+		ToFile:   fmt.Sprintf("current (%s)", configHash),
+		Context:  3,
+	})
+	if err != nil {
+		return fmt.Errorf("%w: unable to render diff: %v", ErrJournalConfigChanged, err)
+	}
+
+	return fmt.Errorf("%w, refusing to resume:\n%s", ErrJournalConfigChanged, diff)
+}
+
+func (j *Journal) Data() JournalData {
+	if j == nil {
+		return JournalData{}
+	}
+
+	j.mx.Lock()
+	defer j.mx.Unlock()
+
+	data := j.data
+	data.Records = append([]JournalRecord(nil), j.data.Records...)
+
+	return data
+}
+
+// Save persists journal into the state cache.
+func (j *Journal) Save(ctx context.Context) error {
+	if j == nil {
+		return nil
+	}
+
+	j.mx.Lock()
+	defer j.mx.Unlock()
+
+	return j.save(ctx)
+}
+
+// StartPhase records the start of phase with the state snapshot the phase has been started with.
+func (j *Journal) StartPhase(ctx context.Context, phase OperationPhase, state DhctlState) error {
+	return j.append(ctx, phase, "", JournalEventStarted, state)
+}
+
+// CompletePhase records the completion of phase.
+func (j *Journal) CompletePhase(ctx context.Context, phase OperationPhase, state DhctlState) error {
+	return j.append(ctx, phase, "", JournalEventCompleted, state)
+}
+
+// CompleteSubPhase records the completion of sub phase of phase.
+func (j *Journal) CompleteSubPhase(ctx context.Context, phase OperationPhase, subPhase OperationSubPhase, state DhctlState) error {
+	return j.append(ctx, phase, subPhase, JournalEventCompleted, state)
+}
+
+// SetOutputs attaches outputs to the next completion record of the step.
+// Pass empty subPhase to set outputs of the phase itself.
+func (j *Journal) SetOutputs(phase OperationPhase, subPhase OperationSubPhase, outputs any) error {
+	if j == nil {
+		return nil
+	}
+
+	raw, err := json.Marshal(outputs)
+	if err != nil {
+		return fmt.Errorf("unable to marshal outputs of %s: %w", stepName(phase, subPhase), err)
+	}
+
+	j.mx.Lock()
+	defer j.mx.Unlock()
+
+	if j.pendingOutputs == nil {
+		j.pendingOutputs = make(map[journalStepKey]json.RawMessage)
+	}
+	j.pendingOutputs[journalStepKey{phase: phase, subPhase: subPhase}] = raw
+
+	return nil
+}
+
+// Outputs unmarshals outputs of the last completion record of the step into out.
+// Returns false if step was not completed or has no outputs.
+func (j *Journal) Outputs(phase OperationPhase, subPhase OperationSubPhase, out any) (bool, error) {
+	record, ok := j.lastCompleted(phase, subPhase)
+	if !ok || len(record.Outputs) == 0 {
+		return false, nil
+	}
+
+	if err := json.Unmarshal(record.Outputs, out); err != nil {
+		return false, fmt.Errorf("unable to unmarshal outputs of %s: %w", stepName(phase, subPhase), err)
+	}
+
+	return true, nil
+}
+
+// IsCompleted returns true if the step was completed at least once.
+// Pass empty subPhase to check the phase itself.
+func (j *Journal) IsCompleted(phase OperationPhase, subPhase OperationSubPhase) bool {
+	_, ok := j.lastCompleted(phase, subPhase)
+	return ok
+}
+
+// LastCompleted returns the last completed phase and sub phase.
+// Sub phase is empty if the last completed step is a whole phase.
+func (j *Journal) LastCompleted() (OperationPhase, OperationSubPhase) {
+	if j == nil {
+		return "", ""
+	}
+
+	j.mx.Lock()
+	defer j.mx.Unlock()
+
+	for i := len(j.data.Records) - 1; i >= 0; i-- {
+		if j.data.Records[i].Event == JournalEventCompleted {
+			return j.data.Records[i].Phase, j.data.Records[i].SubPhase
+		}
+	}
+
+	return "", ""
+}
+
+func (j *Journal) lastCompleted(phase OperationPhase, subPhase OperationSubPhase) (JournalRecord, bool) {
+	if j == nil {
+		return JournalRecord{}, false
+	}
+
+	j.mx.Lock()
+	defer j.mx.Unlock()
+
+	for i := len(j.data.Records) - 1; i >= 0; i-- {
+		record := j.data.Records[i]
+		if record.Event == JournalEventCompleted && record.Phase == phase && record.SubPhase == subPhase {
+			return record, true
+		}
+	}
+
+	return JournalRecord{}, false
+}
+
+func (j *Journal) append(ctx context.Context, phase OperationPhase, subPhase OperationSubPhase, event JournalEvent, state DhctlState) error {
+	if j == nil || phase == "" {
+		return nil
+	}
+
+	j.mx.Lock()
+	defer j.mx.Unlock()
+
+	record := JournalRecord{
+		Phase:      phase,
+		SubPhase:   subPhase,
+		Event:      event,
+		InputsHash: inputsHash(j.data.ConfigHash, state),
+		Time:       time.Now().UTC(),
+	}
+
+	if event == JournalEventCompleted {
+		key := journalStepKey{phase: phase, subPhase: subPhase}
+		record.Outputs = j.pendingOutputs[key]
+		delete(j.pendingOutputs, key)
+	}
+
+	j.data.Records = append(j.data.Records, record)
+
+	return j.save(ctx)
+}
+
+func (j *Journal) save(ctx context.Context) error {
+	raw, err := json.Marshal(j.data)
+	if err != nil {
+		return fmt.Errorf("unable to marshal operation journal: %w", err)
+	}
+
+	return j.cache.Save(ctx, JournalCacheKey, raw)
+}
+
+// inputsHash returns hash of step inputs: configuration hash and state snapshot the step has been started with.
+func inputsHash(configHash string, state DhctlState) string {
+	keys := make([]string, 0, len(state))
+	for k := range state {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	h := sha256.New()
+	h.Write([]byte(configHash))
+	for _, k := range keys {
+		// do not hash journal itself, it changes on every step
+		if k == JournalCacheKey {
+			continue
+		}
+
+		sum := sha256.Sum256(state[k])
+		h.Write([]byte(k))
+		h.Write(sum[:])
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func stepName(phase OperationPhase, subPhase OperationSubPhase) string {
+	if subPhase == "" {
+		return string(phase)
+	}
+
+	return fmt.Sprintf("%s/%s", phase, subPhase)
+}
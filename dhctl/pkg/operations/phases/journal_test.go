@@ -0,0 +1,95 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package phases_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/deckhouse/deckhouse/dhctl/pkg/operations/phases"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/util/cache"
+)
+
+type testOutputs struct {
+	MasterIP string `json:"masterIP"`
+}
+
+func TestJournalRecordsPhasesAndSubPhases(t *testing.T) {
+	ctx := t.Context()
+	stateCache := cache.NewTestCache()
+
+	pec := phases.NewDefaultPhasedExecutionContext(phases.OperationBootstrap, nil, nil)
+	journal := phases.NewJournal(stateCache, phases.OperationBootstrap, "hash", "kind: ClusterConfiguration\n")
+	pec.SetJournal(journal)
+
+	require.NoError(t, pec.InitPipeline(ctx, stateCache))
+
+	_, err := pec.StartPhase(ctx, phases.PreInfraPreflightsPhase, true, stateCache)
+	require.NoError(t, err)
+
+	_, err = pec.SwitchPhase(ctx, phases.BaseInfraPhase, false, stateCache, nil)
+	require.NoError(t, err)
+
+	require.NoError(t, journal.SetOutputs(phases.BaseInfraPhase, phases.BaseInfraSubPhaseFirstMaster, testOutputs{MasterIP: "10.0.0.1"}))
+	pec.CompleteSubPhase(ctx, phases.BaseInfraSubPhaseBaseInfra)
+	pec.CompleteSubPhase(ctx, phases.BaseInfraSubPhaseFirstMaster)
+
+	loaded, err := phases.LoadJournal(ctx, stateCache)
+	require.NoError(t, err)
+
+	require.True(t, loaded.IsCompleted(phases.PreInfraPreflightsPhase, ""))
+	require.False(t, loaded.IsCompleted(phases.BaseInfraPhase, ""))
+	require.True(t, loaded.IsCompleted(phases.BaseInfraPhase, phases.BaseInfraSubPhaseBaseInfra))
+
+	phase, subPhase := loaded.LastCompleted()
+	require.Equal(t, phases.BaseInfraPhase, phase)
+	require.Equal(t, phases.BaseInfraSubPhaseFirstMaster, subPhase)
+
+	var outputs testOutputs
+	ok, err := loaded.Outputs(phases.BaseInfraPhase, phases.BaseInfraSubPhaseFirstMaster, &outputs)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, "10.0.0.1", outputs.MasterIP)
+
+	ok, err = loaded.Outputs(phases.BaseInfraPhase, phases.BaseInfraSubPhaseBaseInfra, &outputs)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	for _, record := range loaded.Data().Records {
+		require.NotEmpty(t, record.InputsHash)
+	}
+}
+
+func TestJournalLoadNotFound(t *testing.T) {
+	_, err := phases.LoadJournal(t.Context(), cache.NewTestCache())
+	require.ErrorIs(t, err, phases.ErrJournalNotFound)
+}
+
+func TestJournalValidateResume(t *testing.T) {
+	journal := phases.NewJournal(cache.NewTestCache(), phases.OperationBootstrap, "aaaa", "kind: ClusterConfiguration\npodSubnetCIDR: 10.111.0.0/16\n")
+
+	require.NoError(t, journal.ValidateResume(phases.OperationBootstrap, "aaaa", "kind: ClusterConfiguration\npodSubnetCIDR: 10.111.0.0/16\n"))
+	require.Error(t, journal.ValidateResume(phases.OperationConverge, "aaaa", ""))
+
+	err := journal.ValidateResume(phases.OperationBootstrap, "bbbb", "kind: ClusterConfiguration\npodSubnetCIDR: 10.222.0.0/16\n")
+	require.ErrorIs(t, err, phases.ErrJournalConfigChanged)
+	require.Contains(t, err.Error(), "-podSubnetCIDR: 10.111.0.0/16")
+	require.Contains(t, err.Error(), "+podSubnetCIDR: 10.222.0.0/16")
+
+	var nilJournal *phases.Journal
+	require.ErrorIs(t, nilJournal.ValidateResume(phases.OperationBootstrap, "aaaa", ""), phases.ErrJournalNotFound)
+	require.False(t, nilJournal.IsCompleted(phases.BaseInfraPhase, ""))
+}
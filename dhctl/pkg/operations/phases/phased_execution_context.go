@@ -20,9 +20,13 @@ import (
 	"fmt"
 	"strings"
 
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
 	dhlog "github.com/deckhouse/lib-dhctl/pkg/logger"
 
 	dstate "github.com/deckhouse/deckhouse/dhctl/pkg/state"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/telemetry"
 )
 
 type OnPhaseFuncData[OperationPhaseDataT any] struct {
@@ -49,6 +53,8 @@ type (
 		CompletePhaseAndPipeline(ctx context.Context, stateCache dstate.Cache, completedPhaseData OperationPhaseDataT) error
 		GetLastState() DhctlState
 		SetClusterConfig(cfg ClusterConfig)
+		SetJournal(journal *Journal)
+		Journal() *Journal
 	}
 
 	DefaultPhasedExecutionContext PhasedExecutionContext[DefaultContextType]
@@ -65,6 +71,9 @@ type phasedExecutionContext[OperationPhaseDataT any] struct {
 	pipelineCompletionCounter int
 
 	progressTracker *ProgressTracker
+	journal         *Journal
+	// phaseSpan covers the current phase and is used to get per-phase timings from traces
+	phaseSpan trace.Span
 }
 
 func NewDefaultPhasedExecutionContext(
@@ -138,6 +147,17 @@ func (pec *phasedExecutionContext[OperationPhaseDataT]) SetClusterConfig(cfg Clu
 	pec.progressTracker.SetClusterConfig(cfg)
 }
 
+// SetJournal sets the operation journal which records every started and completed phase and sub phase.
+// Journal is optional, nil journal disables journaling.
+func (pec *phasedExecutionContext[OperationPhaseDataT]) SetJournal(journal *Journal) {
+	pec.journal = journal
+}
+
+// Journal returns the operation journal set by SetJournal or nil.
+func (pec *phasedExecutionContext[OperationPhaseDataT]) Journal() *Journal {
+	return pec.journal
+}
+
 // InitPipeline initializes phasedExecutionContext before usage.
 // It is not possible to use phasedExecutionContext before InitPipeline called.
 func (pec *phasedExecutionContext[OperationPhaseDataT]) InitPipeline(ctx context.Context, stateCache dstate.Cache) error {
@@ -153,6 +173,8 @@ func (pec *phasedExecutionContext[OperationPhaseDataT]) InitPipeline(ctx context
 //
 // It is not possible to use phasedExecutionContext after Finalize called.
 func (pec *phasedExecutionContext[OperationPhaseDataT]) Finalize(ctx context.Context, stateCache dstate.Cache) error {
+	pec.endPhaseSpan()
+
 	if pec.stopOperationCondition {
 		return nil
 	}
@@ -177,6 +199,11 @@ func (pec *phasedExecutionContext[OperationPhaseDataT]) StartPhase(ctx context.C
 	}
 
 	pec.currentPhase = phase
+	pec.startPhaseSpan(ctx, phase)
+	if err := pec.journal.StartPhase(ctx, phase, pec.lastState); err != nil {
+		return false, fmt.Errorf("unable to journal start of phase %s: %w", phase, err)
+	}
+
 	return pec.callOnPhase(ctx, pec.completedPhase, pec.lastState, pec.completedPhaseData, phase, isCritical, stateCache)
 }
 
@@ -187,7 +214,33 @@ func (pec *phasedExecutionContext[OperationPhaseDataT]) CompletePhase(ctx contex
 	}
 	pec.completedPhase = pec.currentPhase
 	pec.completedPhaseData = completedPhaseData
-	return pec.setLastState(ctx, stateCache)
+	pec.endPhaseSpan()
+	if err := pec.setLastState(ctx, stateCache); err != nil {
+		return err
+	}
+
+	if err := pec.journal.CompletePhase(ctx, pec.completedPhase, pec.lastState); err != nil {
+		return fmt.Errorf("unable to journal completion of phase %s: %w", pec.completedPhase, err)
+	}
+
+	return nil
+}
+
+func (pec *phasedExecutionContext[OperationPhaseDataT]) startPhaseSpan(ctx context.Context, phase OperationPhase) {
+	pec.endPhaseSpan()
+	_, pec.phaseSpan = telemetry.StartSpan(
+		ctx,
+		fmt.Sprintf("phase %s", phase),
+		trace.WithAttributes(attribute.String(telemetry.PhaseAttribute, string(phase))),
+	)
+}
+
+func (pec *phasedExecutionContext[OperationPhaseDataT]) endPhaseSpan() {
+	if pec.phaseSpan == nil {
+		return
+	}
+	pec.phaseSpan.End()
+	pec.phaseSpan = nil
 }
 
 // CompleteSubPhase completes specified sub phase.
@@ -196,6 +249,11 @@ func (pec *phasedExecutionContext[OperationPhaseDataT]) CompleteSubPhase(ctx con
 	if err != nil {
 		dhlog.FromContext(ctx).ErrorContext(ctx, strings.TrimRight(fmt.Sprintf("Failed to write progress for sub phase %v: %v", completedSubPhase, err), "\n"))
 	}
+
+	err = pec.journal.CompleteSubPhase(ctx, pec.currentPhase, completedSubPhase, pec.lastState)
+	if err != nil {
+		dhlog.FromContext(ctx).ErrorContext(ctx, strings.TrimRight(fmt.Sprintf("Failed to journal sub phase %v: %v", completedSubPhase, err), "\n"))
+	}
 }
 
 // CompletePipeline stops whole phased process execution pipeline (onPhaseFunc will be called).
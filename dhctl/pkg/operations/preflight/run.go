@@ -0,0 +1,215 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package preflight
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/deckhouse/deckhouse/dhctl/pkg/app/options"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/config"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/infrastructureprovider"
+	preflightnew "github.com/deckhouse/deckhouse/dhctl/pkg/preflight"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/preflight/suites"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/system/providerinitializer"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/telemetry"
+)
+
+var defaultPhases = []preflightnew.Phase{
+	preflightnew.PhasePreInfra,
+	preflightnew.PhasePostInfra,
+}
+
+type Params struct {
+	SSHProviderInitializer *providerinitializer.SSHProviderInitializer
+	Options                *options.Options
+}
+
+// Run runs preflight checks of the bootstrap configuration outside the bootstrap
+// and returns the report with the result of every check.
+func Run(ctx context.Context, params Params) (*preflightnew.Report, error) {
+	ctx, span := telemetry.StartSpan(ctx, "Preflight.Run")
+	defer span.End()
+
+	opts := params.Options
+
+	metaConfig, err := config.LoadConfigFromFile(
+		ctx,
+		opts.Global.ConfigPaths,
+		infrastructureprovider.MetaConfigValidatorProvider(),
+		&opts.Global,
+		config.ValidateOptionValidateExtensions(true),
+		config.ValidateOptionOperation(infrastructureprovider.DhctlOperationBootstrap),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	installConfig, err := config.PrepareDeckhouseInstallConfig(ctx, metaConfig, &opts.Global)
+	if err != nil {
+		return nil, err
+	}
+
+	suiteNames := opts.Preflight.Suites
+	if len(suiteNames) == 0 {
+		suiteNames = defaultSuites(metaConfig)
+	}
+
+	runner := preflightnew.New()
+	for _, name := range suiteNames {
+		suite, err := newSuite(ctx, name, params, metaConfig, installConfig)
+		if err != nil {
+			return nil, fmt.Errorf("unable to prepare preflight suite %s: %w", name, err)
+		}
+		runner.AddSuite(suite)
+	}
+
+	pluginSuite, err := suites.NewPluginSuite(suites.PluginDeps{
+		SSHProviderInitializer: params.SSHProviderInitializer,
+		MetaConfig:             metaConfig,
+		PreflightOpts:          &opts.Preflight,
+	}, ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load preflight plugins: %w", err)
+	}
+	runner.AddSuite(pluginSuite)
+
+	runner.DisableChecks(opts.Preflight.DisabledChecks()...)
+	runner.OnlyChecks(opts.Preflight.OnlyChecks...)
+
+	phases := defaultPhases
+	if len(opts.Preflight.Phases) > 0 {
+		phases = make([]preflightnew.Phase, 0, len(opts.Preflight.Phases))
+		for _, phase := range opts.Preflight.Phases {
+			phases = append(phases, preflightnew.Phase(phase))
+		}
+	}
+
+	return runner.RunReport(ctx, phases...)
+}
+
+// WriteReport writes report to the paths from options or prints JSON report to stdout if no path is set.
+func WriteReport(report *preflightnew.Report, opts *options.PreflightOptions) error {
+	if opts.ReportJSONPath == "" && opts.ReportJUnitPath == "" {
+		return report.WriteJSON(os.Stdout)
+	}
+
+	if opts.ReportJSONPath != "" {
+		if err := writeReportFile(opts.ReportJSONPath, report.WriteJSON); err != nil {
+			return err
+		}
+	}
+
+	if opts.ReportJUnitPath != "" {
+		if err := writeReportFile(opts.ReportJUnitPath, report.WriteJUnit); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func writeReportFile(path string, write func(w io.Writer) error) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("unable to create preflight report: %w", err)
+	}
+
+	if err := write(f); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("unable to write preflight report %s: %w", path, err)
+	}
+
+	return f.Close()
+}
+
+func defaultSuites(metaConfig *config.MetaConfig) []string {
+	if metaConfig.ClusterType == config.CloudClusterType {
+		return []string{options.PreflightSuiteGlobal, options.PreflightSuiteCloud}
+	}
+
+	return []string{options.PreflightSuiteGlobal, options.PreflightSuiteStatic}
+}
+
+func newSuite(ctx context.Context, name string, params Params, metaConfig *config.MetaConfig, installConfig *config.DeckhouseInstaller) (preflightnew.Suite, error) {
+	opts := params.Options
+	sshProviderInitializer := params.SSHProviderInitializer
+
+	switch name {
+	case options.PreflightSuiteGlobal:
+		return suites.NewGlobalSuite(suites.GlobalDeps{
+			MetaConfig:    metaConfig,
+			InstallConfig: installConfig,
+			BuildInfo:     opts.BuildInfo,
+		}), nil
+	case options.PreflightSuiteCloud:
+		if metaConfig.ClusterType != config.CloudClusterType {
+			return nil, fmt.Errorf("suite is available for cloud clusters only")
+		}
+
+		return suites.NewCloudSuite(suites.CloudDeps{
+			InstallConfig:          installConfig,
+			MetaConfig:             metaConfig,
+			SSHProviderInitializer: sshProviderInitializer,
+		}), nil
+	case options.PreflightSuiteCloudPost:
+		if metaConfig.ClusterType != config.CloudClusterType {
+			return nil, fmt.Errorf("suite is available for cloud clusters only")
+		}
+		if sshProviderInitializer == nil {
+			return nil, fmt.Errorf("suite requires --ssh-host of the first master node")
+		}
+
+		sshProvider, err := sshProviderInitializer.GetSSHProvider(ctx)
+		if err != nil && !errors.Is(err, providerinitializer.ErrHostsFromCacheNotFound) {
+			return nil, err
+		}
+
+		return suites.NewPostCloudSuite(suites.PostCloudDeps{
+			MetaConfig:  metaConfig,
+			SSHProvider: sshProvider,
+			LegacyMode:  sshProviderInitializer.IsLegacyMode(),
+		}), nil
+	case options.PreflightSuiteStatic:
+		if metaConfig.ClusterType != config.StaticClusterType {
+			return nil, fmt.Errorf("suite is available for static clusters only")
+		}
+		if sshProviderInitializer == nil {
+			return nil, fmt.Errorf("suite requires --ssh-host")
+		}
+
+		return suites.NewStaticSuite(suites.StaticDeps{
+			SSHProviderInitializer: sshProviderInitializer,
+			MetaConfig:             metaConfig,
+			InstallConfig:          installConfig,
+			LegacyMode:             sshProviderInitializer.IsLegacyMode(),
+			GlobalOpts:             &opts.Global,
+		}, ctx)
+	case options.PreflightSuiteStaticAbort:
+		if metaConfig.ClusterType != config.StaticClusterType {
+			return nil, fmt.Errorf("suite is available for static clusters only")
+		}
+		if sshProviderInitializer == nil {
+			return nil, fmt.Errorf("suite requires --ssh-host")
+		}
+
+		return suites.NewStaticAbortSuite(suites.StaticAbortDeps{SSHProviderInitializer: sshProviderInitializer}, ctx)
+	}
+
+	return nil, fmt.Errorf("unknown preflight suite")
+}
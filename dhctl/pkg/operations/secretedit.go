@@ -93,7 +93,13 @@ func SecretEdit(
 	if err != nil {
 		return err
 	}
-	tomb.WithoutInterruptions(func() { modifiedData, err = abstractEditing(ctx, configData, globalOptions, editOpts) })
+	edit := abstractEditing
+	if editOpts.Transform != nil {
+		edit = func(ctx context.Context, data []byte, _ *options.GlobalOptions, opts EditOptions) ([]byte, error) {
+			return opts.Transform(ctx, data)
+		}
+	}
+	tomb.WithoutInterruptions(func() { modifiedData, err = edit(ctx, configData, globalOptions, editOpts) })
 	if err != nil {
 		return err
 	}
@@ -17,6 +17,8 @@ package checks
 import (
 	"context"
 	"fmt"
+	"net/netip"
+	"strings"
 
 	"gopkg.in/yaml.v3"
 
@@ -64,12 +66,13 @@ func (c StaticInstancesIPDuplicationCheck) Run(ctx context.Context) error {
 
 			spec := result["spec"].(map[string]any)
 			address := spec["address"].(string)
+			key := normalizeStaticInstanceAddress(address)
 
-			instName, ok := instances[address]
+			instName, ok := instances[key]
 			if ok {
 				return fmt.Errorf("Duplicate address for %s: %s and %s\n", address, instName, name)
 			} else {
-				instances[address] = name
+				instances[key] = name
 			}
 		}
 	}
@@ -77,6 +80,18 @@ func (c StaticInstancesIPDuplicationCheck) Run(ctx context.Context) error {
 	return nil
 }
 
+// normalizeStaticInstanceAddress returns the canonical form of a StaticInstance address,
+// so that different spellings of the same IPv6 address or DNS name are detected as duplicates.
+func normalizeStaticInstanceAddress(address string) string {
+	address = strings.TrimSpace(address)
+
+	if ip, err := netip.ParseAddr(address); err == nil {
+		return ip.Unmap().String()
+	}
+
+	return strings.ToLower(strings.TrimSuffix(address, "."))
+}
+
 func StaticInstancesIPDuplication(meta *config.MetaConfig) preflight.Check {
 	check := StaticInstancesIPDuplicationCheck{MetaConfig: meta}
 	return preflight.Check{
@@ -125,6 +125,101 @@ spec:
   credentialsRef:
     kind: SSHCredentials
     name: credentials
+`,
+			}},
+			wantErr: func(t assert.TestingT, err error, i ...any) bool {
+				return assert.ErrorContains(t, err, "Duplicate address")
+			},
+		},
+		{
+			name: "happy path: IPv4, IPv6 and DNS name addresses",
+			fields: fields{metaConfig: &config.MetaConfig{
+				ResourcesYAML: `---
+apiVersion: deckhouse.io/v1alpha2
+kind: StaticInstance
+metadata:
+  name: static-0
+spec:
+  address: 10.128.0.22
+  credentialsRef:
+    kind: SSHCredentials
+    name: credentials
+---
+apiVersion: deckhouse.io/v1alpha2
+kind: StaticInstance
+metadata:
+  name: static-1
+spec:
+  address: "2001:db8::22"
+  credentialsRef:
+    kind: SSHCredentials
+    name: credentials
+---
+apiVersion: deckhouse.io/v1alpha2
+kind: StaticInstance
+metadata:
+  name: static-2
+spec:
+  address: static-2.example.com
+  credentialsRef:
+    kind: SSHCredentials
+    name: credentials
+`,
+			}},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "intersects IPv6 addresses written differently",
+			fields: fields{metaConfig: &config.MetaConfig{
+				ResourcesYAML: `---
+apiVersion: deckhouse.io/v1alpha2
+kind: StaticInstance
+metadata:
+  name: static-0
+spec:
+  address: "2001:db8::22"
+  credentialsRef:
+    kind: SSHCredentials
+    name: credentials
+---
+apiVersion: deckhouse.io/v1alpha2
+kind: StaticInstance
+metadata:
+  name: static-1
+spec:
+  address: "2001:DB8:0:0:0:0:0:22"
+  credentialsRef:
+    kind: SSHCredentials
+    name: credentials
+`,
+			}},
+			wantErr: func(t assert.TestingT, err error, i ...any) bool {
+				return assert.ErrorContains(t, err, "Duplicate address")
+			},
+		},
+		{
+			name: "intersects DNS names written differently",
+			fields: fields{metaConfig: &config.MetaConfig{
+				ResourcesYAML: `---
+apiVersion: deckhouse.io/v1alpha2
+kind: StaticInstance
+metadata:
+  name: static-0
+spec:
+  address: static-0.example.com
+  credentialsRef:
+    kind: SSHCredentials
+    name: credentials
+---
+apiVersion: deckhouse.io/v1alpha2
+kind: StaticInstance
+metadata:
+  name: static-1
+spec:
+  address: Static-0.Example.com.
+  credentialsRef:
+    kind: SSHCredentials
+    name: credentials
 `,
 			}},
 			wantErr: func(t assert.TestingT, err error, i ...any) bool {
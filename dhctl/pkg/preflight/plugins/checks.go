@@ -0,0 +1,245 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugins
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+
+	libcon "github.com/deckhouse/lib-connection/pkg"
+	"github.com/deckhouse/lib-connection/pkg/ssh/local"
+	"github.com/deckhouse/lib-connection/pkg/ssh/session"
+	dhlog "github.com/deckhouse/lib-dhctl/pkg/logger"
+
+	"github.com/deckhouse/deckhouse/dhctl/pkg/app/options"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/config"
+	preflight "github.com/deckhouse/deckhouse/dhctl/pkg/preflight"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/system/providerinitializer"
+)
+
+// CheckNamePrefix is added to the names of the plugin checks to separate them from the builtin ones.
+const CheckNamePrefix = options.PreflightPluginCheckPrefix
+
+type Deps struct {
+	Dir                    string
+	MetaConfig             *config.MetaConfig
+	SSHProviderInitializer *providerinitializer.SSHProviderInitializer
+	// DisableAll disables every plugin check, plugins are still described to report skipped checks.
+	DisableAll bool
+}
+
+// LoadChecks describes every plugin of the directory and returns their checks.
+func LoadChecks(ctx context.Context, deps Deps) ([]preflight.Check, error) {
+	plugins, err := Discover(deps.Dir)
+	if err != nil {
+		return nil, err
+	}
+
+	checks := make([]preflight.Check, 0)
+	names := make(map[preflight.CheckName]string)
+
+	for _, plugin := range plugins {
+		specs, err := plugin.Describe(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, spec := range specs {
+			check, err := newCheck(plugin, spec, deps)
+			if err != nil {
+				return nil, fmt.Errorf("plugin %s: %w", plugin.Name, err)
+			}
+
+			if other, ok := names[check.Name]; ok {
+				return nil, fmt.Errorf("plugin %s: check %s is already provided by plugin %s", plugin.Name, check.Name, other)
+			}
+			names[check.Name] = plugin.Name
+
+			checks = append(checks, check)
+		}
+
+		dhlog.FromContext(ctx).DebugContext(ctx, fmt.Sprintf("Loaded %d preflight checks from plugin %s", len(specs), plugin.Name))
+	}
+
+	return checks, nil
+}
+
+type pluginCheck struct {
+	plugin                 *Plugin
+	spec                   CheckSpec
+	metaConfig             *config.MetaConfig
+	sshProviderInitializer *providerinitializer.SSHProviderInitializer
+}
+
+func newCheck(plugin *Plugin, spec CheckSpec, deps Deps) (preflight.Check, error) {
+	name := preflight.CheckName(CheckNamePrefix + spec.Name)
+	if err := name.Validate(); err != nil {
+		return preflight.Check{}, err
+	}
+
+	if spec.Target == "" {
+		spec.Target = TargetLocal
+	}
+
+	var phase preflight.Phase
+	switch spec.Target {
+	case TargetLocal:
+		phase = preflight.PhasePreInfra
+	case TargetHosts:
+		phase = preflight.PhasePostInfra
+	default:
+		return preflight.Check{}, fmt.Errorf("check %s: unknown target %q", spec.Name, spec.Target)
+	}
+
+	if spec.Phase != "" {
+		phase = preflight.Phase(spec.Phase)
+	}
+
+	switch phase {
+	case preflight.PhasePreInfra:
+		if spec.Target == TargetHosts {
+			return preflight.Check{}, fmt.Errorf("check %s: checks on hosts can't be run in %s phase", spec.Name, phase)
+		}
+	case preflight.PhasePostInfra:
+	default:
+		return preflight.Check{}, fmt.Errorf("check %s: unknown phase %q", spec.Name, spec.Phase)
+	}
+
+	check := pluginCheck{
+		plugin:                 plugin,
+		spec:                   spec,
+		metaConfig:             deps.MetaConfig,
+		sshProviderInitializer: deps.SSHProviderInitializer,
+	}
+
+	description := spec.Description
+	if description == "" {
+		description = fmt.Sprintf("%s check of plugin %s", spec.Name, plugin.Name)
+	}
+
+	return preflight.Check{
+		Name:        name,
+		Description: description,
+		Phase:       phase,
+		Retry:       retryPolicy(spec.Retry),
+		Run:         check.Run,
+		Disabled:    deps.DisableAll,
+	}, nil
+}
+
+// retryPolicy runs plugin checks once by default, their results are not expected to change between attempts.
+func retryPolicy(spec *RetrySpec) preflight.RetryPolicy {
+	if spec == nil || spec.Attempts <= 0 {
+		return preflight.RetryPolicy{Attempts: 1}
+	}
+
+	policy := preflight.RetryPolicy{Attempts: spec.Attempts}
+	if spec.IntervalSeconds > 0 {
+		policy.Options = []backoff.ExponentialBackOffOpts{
+			backoff.WithInitialInterval(time.Duration(spec.IntervalSeconds) * time.Second),
+			backoff.WithMaxElapsedTime(0),
+		}
+	}
+
+	return policy
+}
+
+func (c pluginCheck) Run(ctx context.Context) error {
+	if c.spec.Target == TargetHosts {
+		return c.runOnHosts(ctx)
+	}
+
+	return c.plugin.Check(ctx, c.input(""))
+}
+
+func (c pluginCheck) input(host string) CheckInput {
+	input := CheckInput{
+		Check: c.spec.Name,
+		Host:  host,
+	}
+
+	if c.metaConfig != nil {
+		input.ClusterType = c.metaConfig.ClusterType
+		input.ProviderName = c.metaConfig.ProviderName
+		input.Layout = c.metaConfig.Layout
+		input.ClusterConfiguration = c.metaConfig.ClusterConfig
+		input.StaticClusterConfiguration = c.metaConfig.StaticClusterConfig
+	}
+
+	return input
+}
+
+// runOnHosts runs the check on every ssh host one by one and returns errors of all failed hosts.
+// Without ssh hosts the check is run on the local node like other checks do.
+func (c pluginCheck) runOnHosts(ctx context.Context) error {
+	if c.sshProviderInitializer == nil {
+		return fmt.Errorf("check %s requires ssh connection to hosts", c.spec.Name)
+	}
+
+	sshProvider, err := c.sshProviderInitializer.GetSSHProvider(ctx)
+	if errors.Is(err, providerinitializer.ErrHostsFromCacheNotFound) {
+		return c.runLocally(ctx)
+	}
+	if err != nil {
+		return fmt.Errorf("check %s: get ssh provider: %w", c.spec.Name, err)
+	}
+
+	sourceClient, err := sshProvider.Client(ctx)
+	if err != nil {
+		return err
+	}
+
+	hosts := sourceClient.Session().AvailableHosts()
+	if len(hosts) == 0 {
+		return c.runLocally(ctx)
+	}
+	if len(hosts) == 1 {
+		return c.plugin.CheckOnNode(ctx, sourceClient, c.input(hosts[0].Host), c.spec.Sudo)
+	}
+
+	var errs []error
+	for _, host := range hosts {
+		if err := c.runOnHost(ctx, sshProvider, sourceClient, host); err != nil {
+			errs = append(errs, fmt.Errorf("host %s: %w", host.Host, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (c pluginCheck) runLocally(ctx context.Context) error {
+	return c.plugin.CheckOnNode(ctx, local.NewNodeInterface(c.sshProviderInitializer.GetSettings()), c.input("localhost"), c.spec.Sudo)
+}
+
+func (c pluginCheck) runOnHost(ctx context.Context, sshProvider libcon.SSHProvider, sourceClient libcon.SSHClient, host session.Host) error {
+	hostSession := sourceClient.Session().Copy()
+	hostSession.SetAvailableHosts([]session.Host{host})
+
+	client, err := sshProvider.NewStandaloneClient(ctx, hostSession, sourceClient.PrivateKeys())
+	if err != nil {
+		return fmt.Errorf("Cannot create SSH client: %w", err)
+	}
+
+	if err := client.Start(ctx); err != nil {
+		return fmt.Errorf("Cannot connect to SSH host %s: %w", host.Host, err)
+	}
+	defer client.Stop()
+
+	return c.plugin.CheckOnNode(ctx, client, c.input(host.Host), c.spec.Sudo)
+}
@@ -0,0 +1,234 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugins
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"al.essio.dev/pkg/shellescape"
+	libcon "github.com/deckhouse/lib-connection/pkg"
+	dhlog "github.com/deckhouse/lib-dhctl/pkg/logger"
+
+	preflight "github.com/deckhouse/deckhouse/dhctl/pkg/preflight"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/telemetry"
+)
+
+// remoteDirPrefix is the prefix of the per-run directory on the target host where the plugin
+// is uploaded for the check.
+const remoteDirPrefix = "/tmp/dhctl-preflight-plugin."
+
+// createRemoteDirScript creates a fresh private directory with mktemp and prints it
+// with the uid of the connected user and the uid of the directory owner.
+const createRemoteDirScript = `umask 0077 && d=$(mktemp -d ` + remoteDirPrefix + `XXXXXXXXXX) && ` +
+	`printf '%s %s %s\n' "$d" "$(id -u)" "$(stat -c %u "$d")"`
+
+type Plugin struct {
+	Name string
+	Path string
+}
+
+// Discover returns executable files of the plugins directory sorted by name.
+// Hidden files and subdirectories are ignored.
+func Discover(dir string) ([]*Plugin, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("read preflight plugins directory: %w", err)
+	}
+
+	plugins := make([]*Plugin, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return nil, err
+		}
+		if !info.Mode().IsRegular() || info.Mode().Perm()&0o111 == 0 {
+			continue
+		}
+
+		plugins = append(plugins, &Plugin{
+			Name: entry.Name(),
+			Path: filepath.Join(dir, entry.Name()),
+		})
+	}
+
+	sort.Slice(plugins, func(i, j int) bool {
+		return plugins[i].Name < plugins[j].Name
+	})
+
+	return plugins, nil
+}
+
+func (p *Plugin) Describe(ctx context.Context) ([]CheckSpec, error) {
+	stdout, err := p.run(ctx, SubcommandDescribe, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp DescribeResponse
+	if err := json.Unmarshal(stdout, &resp); err != nil {
+		return nil, fmt.Errorf("plugin %s: parse describe response: %w", p.Name, err)
+	}
+
+	return resp.Checks, nil
+}
+
+// Check runs the check on the machine where dhctl is running.
+func (p *Plugin) Check(ctx context.Context, input CheckInput) error {
+	payload, err := json.Marshal(CheckRequest{Input: input})
+	if err != nil {
+		return fmt.Errorf("marshal %s request: %w", SubcommandCheck, err)
+	}
+
+	stdout, err := p.run(ctx, SubcommandCheck, payload)
+	if err != nil {
+		return err
+	}
+
+	return p.parseCheckResponse(stdout)
+}
+
+// CheckOnNode uploads the plugin with the request to the node and runs the check there.
+// The uploaded files are removed after the check.
+func (p *Plugin) CheckOnNode(ctx context.Context, nodeInterface libcon.Interface, input CheckInput, sudo bool) error {
+	ctx, span := telemetry.StartSpan(ctx, "plugins.CheckOnNode")
+	defer span.End()
+
+	binary, err := os.ReadFile(p.Path)
+	if err != nil {
+		return fmt.Errorf("read plugin %s: %w", p.Name, err)
+	}
+
+	payload, err := json.Marshal(CheckRequest{Input: input})
+	if err != nil {
+		return fmt.Errorf("marshal %s request: %w", SubcommandCheck, err)
+	}
+
+	stdout, _, err := nodeInterface.Command("sh", "-c", createRemoteDirScript).Output(ctx)
+	if err != nil {
+		return fmt.Errorf("create directory for plugin %s: %w", p.Name, err)
+	}
+	dir, err := parseRemoteDir(string(stdout))
+	if err != nil {
+		if dir != "" {
+			// Do not reuse a directory we do not own, but do not leave our own mktemp result behind either.
+			_ = nodeInterface.Command("rmdir", dir).Run(ctx)
+		}
+		return fmt.Errorf("create directory for plugin %s: %w", p.Name, err)
+	}
+	defer func() {
+		if err := nodeInterface.Command("rm", "-rf", dir).Run(ctx); err != nil {
+			dhlog.FromContext(ctx).DebugContext(ctx, fmt.Sprintf("Cannot remove plugin directory %s: %v", dir, err))
+		}
+	}()
+
+	remoteBinary := path.Join(dir, p.Name)
+	remoteRequest := path.Join(dir, "request.json")
+
+	if err := nodeInterface.File().UploadBytes(ctx, binary, remoteBinary); err != nil {
+		return fmt.Errorf("upload plugin %s: %w", p.Name, err)
+	}
+	if err := nodeInterface.File().UploadBytes(ctx, payload, remoteRequest); err != nil {
+		return fmt.Errorf("upload %s request of plugin %s: %w", SubcommandCheck, p.Name, err)
+	}
+
+	// The plugin and check names come from the plugin manifest, so the paths are quoted for the shell.
+	cmd := nodeInterface.Command("sh", "-c", fmt.Sprintf("chmod 0700 %[1]s && %[1]s %[2]s < %[3]s",
+		shellescape.Quote(remoteBinary), SubcommandCheck, shellescape.Quote(remoteRequest)))
+	if sudo {
+		cmd.Sudo(ctx)
+	}
+	cmd.WithTimeout(preflight.DefaultPreflightCheckTimeout)
+
+	stdout, stderr, err := cmd.Output(ctx)
+	if err != nil {
+		if len(stderr) > 0 {
+			return fmt.Errorf("plugin %s %s: %w\n%s", p.Name, SubcommandCheck, err, string(stderr))
+		}
+		return fmt.Errorf("plugin %s %s: %w", p.Name, SubcommandCheck, err)
+	}
+
+	return p.parseCheckResponse(stdout)
+}
+
+// parseRemoteDir parses the output of createRemoteDirScript and checks that the directory
+// was created by mktemp and is owned by the connected user.
+// The directory is returned along with the error when it exists but cannot be used.
+func parseRemoteDir(output string) (string, error) {
+	fields := strings.Fields(output)
+	if len(fields) != 3 {
+		return "", fmt.Errorf("unexpected mktemp output %q", output)
+	}
+
+	dir, uid, owner := fields[0], fields[1], fields[2]
+	if !strings.HasPrefix(dir, remoteDirPrefix) || path.Clean(dir) != dir || strings.Contains(dir, "..") {
+		return "", fmt.Errorf("unexpected directory %q created by mktemp", dir)
+	}
+	if uid != owner {
+		return dir, fmt.Errorf("directory %s is owned by uid %s, not by the connected user uid %s", dir, owner, uid)
+	}
+
+	return dir, nil
+}
+
+func (p *Plugin) parseCheckResponse(stdout []byte) error {
+	// A conformant plugin always emits a JSON object, empty stdout means a broken plugin.
+	var resp CheckResponse
+	if err := json.Unmarshal(stdout, &resp); err != nil {
+		return fmt.Errorf("plugin %s: parse %s response: %w", p.Name, SubcommandCheck, err)
+	}
+	if resp.Error != "" {
+		return errors.New(resp.Error)
+	}
+	return nil
+}
+
+func (p *Plugin) run(ctx context.Context, subcommand string, stdin []byte) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, preflight.DefaultPreflightCheckTimeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, p.Path, subcommand)
+	cmd.Stdin = bytes.NewReader(stdin)
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if stderr.Len() > 0 {
+			return nil, fmt.Errorf("plugin %s %s: %w\n%s", p.Name, subcommand, err, stderr.String())
+		}
+		return nil, fmt.Errorf("plugin %s %s: %w", p.Name, subcommand, err)
+	}
+
+	if stderr.Len() > 0 {
+		dhlog.FromContext(ctx).DebugContext(ctx, fmt.Sprintf("plugin %s %s stderr: %s", p.Name, subcommand, stderr.String()))
+	}
+
+	return stdout.Bytes(), nil
+}
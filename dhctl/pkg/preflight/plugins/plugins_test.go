@@ -0,0 +1,164 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugins
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/deckhouse/deckhouse/dhctl/pkg/config"
+	preflight "github.com/deckhouse/deckhouse/dhctl/pkg/preflight"
+)
+
+const testPlugin = `#!/bin/sh
+case "$1" in
+describe)
+  echo '{"checks":[{"name":"audit-daemon","description":"auditd is running"},{"name":"kernel-hardening","target":"hosts","retry":{"attempts":2}}]}'
+  ;;
+check)
+  request=$(cat)
+  case "$request" in
+  *'"clusterType":"Static"'*) echo '{}' ;;
+  *) echo '{"error":"auditd is not running"}' ;;
+  esac
+  ;;
+*)
+  echo "unknown subcommand $1" >&2
+  exit 1
+  ;;
+esac
+`
+
+func writePlugin(t *testing.T, dir, name, content string, mode os.FileMode) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), mode))
+}
+
+func TestDiscover(t *testing.T) {
+	dir := t.TempDir()
+	writePlugin(t, dir, "security", testPlugin, 0o755)
+	writePlugin(t, dir, "README.md", "not a plugin", 0o644)
+	writePlugin(t, dir, ".hidden", testPlugin, 0o755)
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "subdir"), 0o755))
+
+	plugins, err := Discover(dir)
+	require.NoError(t, err)
+	require.Len(t, plugins, 1)
+	require.Equal(t, "security", plugins[0].Name)
+
+	_, err = Discover(filepath.Join(dir, "not-exists"))
+	require.Error(t, err)
+}
+
+func TestLoadChecks(t *testing.T) {
+	dir := t.TempDir()
+	writePlugin(t, dir, "security", testPlugin, 0o755)
+
+	checks, err := LoadChecks(t.Context(), Deps{
+		Dir:        dir,
+		MetaConfig: &config.MetaConfig{ClusterType: config.StaticClusterType},
+	})
+	require.NoError(t, err)
+	require.Len(t, checks, 2)
+
+	require.Equal(t, preflight.CheckName("plugin-audit-daemon"), checks[0].Name)
+	require.Equal(t, "auditd is running", checks[0].Description)
+	require.Equal(t, preflight.PhasePreInfra, checks[0].Phase)
+	require.Equal(t, 1, checks[0].Retry.Attempts)
+	require.False(t, checks[0].Disabled)
+	require.NoError(t, checks[0].Run(t.Context()))
+
+	require.Equal(t, preflight.CheckName("plugin-kernel-hardening"), checks[1].Name)
+	require.Equal(t, preflight.PhasePostInfra, checks[1].Phase)
+	require.Equal(t, 2, checks[1].Retry.Attempts)
+
+	checks, err = LoadChecks(t.Context(), Deps{
+		Dir:        dir,
+		MetaConfig: &config.MetaConfig{ClusterType: config.CloudClusterType},
+		DisableAll: true,
+	})
+	require.NoError(t, err)
+	require.True(t, checks[0].Disabled)
+	require.EqualError(t, checks[0].Run(t.Context()), "auditd is not running")
+}
+
+func TestLoadChecksInvalidSpec(t *testing.T) {
+	tests := map[string]string{
+		"invalid name":         `{"checks":[{"name":"Audit_Daemon"}]}`,
+		"unknown target":       `{"checks":[{"name":"audit","target":"cluster"}]}`,
+		"hosts in pre-infra":   `{"checks":[{"name":"audit","target":"hosts","phase":"pre-infra"}]}`,
+		"duplicated check":     `{"checks":[{"name":"audit"},{"name":"audit"}]}`,
+		"invalid describe out": `checks: []`,
+	}
+
+	for name, describe := range tests {
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+			writePlugin(t, dir, "broken", "#!/bin/sh\necho '"+describe+"'\n", 0o755)
+
+			_, err := LoadChecks(t.Context(), Deps{Dir: dir})
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestCreateRemoteDir(t *testing.T) {
+	out, err := exec.Command("sh", "-c", createRemoteDirScript).Output()
+	require.NoError(t, err)
+
+	dir, err := parseRemoteDir(string(out))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = os.RemoveAll(dir) })
+
+	info, err := os.Stat(dir)
+	require.NoError(t, err)
+	require.True(t, info.IsDir())
+	require.Equal(t, os.FileMode(0o700), info.Mode().Perm())
+
+	other, err := exec.Command("sh", "-c", createRemoteDirScript).Output()
+	require.NoError(t, err)
+	otherDir, err := parseRemoteDir(string(other))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = os.RemoveAll(otherDir) })
+	require.NotEqual(t, dir, otherDir)
+}
+
+func TestParseRemoteDir(t *testing.T) {
+	dir, err := parseRemoteDir("/tmp/dhctl-preflight-plugin.a1B2c3D4e5 1000 0\n")
+	require.EqualError(t, err, "directory /tmp/dhctl-preflight-plugin.a1B2c3D4e5 is owned by uid 0, not by the connected user uid 1000")
+	require.Equal(t, "/tmp/dhctl-preflight-plugin.a1B2c3D4e5", dir)
+
+	_, err = parseRemoteDir("/var/tmp/x 1000 1000\n")
+	require.ErrorContains(t, err, "unexpected directory")
+
+	_, err = parseRemoteDir("mktemp: failed")
+	require.ErrorContains(t, err, "unexpected mktemp output")
+}
+
+func TestPluginCrash(t *testing.T) {
+	dir := t.TempDir()
+	writePlugin(t, dir, "crash", "#!/bin/sh\necho 'segfault' >&2\nexit 2\n", 0o755)
+
+	plugins, err := Discover(dir)
+	require.NoError(t, err)
+
+	err = plugins[0].Check(t.Context(), CheckInput{Check: "audit"})
+	require.ErrorContains(t, err, "plugin crash check")
+	require.ErrorContains(t, err, "segfault")
+}
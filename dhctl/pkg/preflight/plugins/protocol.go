@@ -0,0 +1,84 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package plugins loads preflight checks from executable plugins placed in the plugins directory.
+// dhctl talks to a plugin over the stdin/stdout JSON protocol described in PROTOCOL.md,
+// in the same manner as with the provider validator (see go_lib/dhctl-provider-protocol).
+package plugins
+
+import "encoding/json"
+
+const (
+	// SubcommandDescribe lists checks provided by the plugin. It is always run locally.
+	SubcommandDescribe = "describe"
+	// SubcommandCheck runs one check of the plugin locally or on the target host.
+	SubcommandCheck = "check"
+)
+
+const (
+	// TargetLocal checks are run on the machine where dhctl is running.
+	TargetLocal = "local"
+	// TargetHosts checks are run on every host passed with --ssh-host.
+	TargetHosts = "hosts"
+)
+
+// DescribeResponse is the JSON object written to stdout for the describe subcommand.
+type DescribeResponse struct {
+	Checks []CheckSpec `json:"checks"`
+}
+
+// CheckSpec describes one check of the plugin.
+type CheckSpec struct {
+	// Name must match the preflight check name pattern, dhctl adds the "plugin-" prefix to it.
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	// Phase is one of "pre-infra" or "post-infra". It defaults to "pre-infra" for local checks
+	// and to "post-infra" for checks on hosts, hosts checks can't be run before the infrastructure.
+	Phase string `json:"phase,omitempty"`
+	// Target is one of TargetLocal (default) or TargetHosts.
+	Target string `json:"target,omitempty"`
+	// Sudo runs the check on hosts with sudo.
+	Sudo  bool       `json:"sudo,omitempty"`
+	Retry *RetrySpec `json:"retry,omitempty"`
+}
+
+type RetrySpec struct {
+	Attempts        int `json:"attempts"`
+	IntervalSeconds int `json:"intervalSeconds,omitempty"`
+}
+
+// CheckInput is the input payload for the check subcommand.
+// Provider cluster configuration is never passed to plugins because it contains cloud credentials.
+type CheckInput struct {
+	// Check is the name of the check from CheckSpec without the prefix.
+	Check string `json:"check"`
+	// Host is set for checks on hosts only.
+	Host                       string                     `json:"host,omitempty"`
+	ClusterType                string                     `json:"clusterType"`
+	ProviderName               string                     `json:"providerName,omitempty"`
+	Layout                     string                     `json:"layout,omitempty"`
+	ClusterConfiguration       map[string]json.RawMessage `json:"clusterConfiguration,omitempty"`
+	StaticClusterConfiguration map[string]json.RawMessage `json:"staticClusterConfiguration,omitempty"`
+}
+
+// CheckRequest is the JSON object written to stdin for the check subcommand.
+type CheckRequest struct {
+	Input CheckInput `json:"input"`
+}
+
+// CheckResponse is the JSON object written to stdout after check. The plugin always
+// writes a JSON object and exits 0; a non-empty Error means the check failed.
+type CheckResponse struct {
+	Error string `json:"error,omitempty"`
+}
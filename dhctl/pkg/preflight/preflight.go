@@ -17,6 +17,8 @@ package preflightnew
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/cenkalti/backoff/v4"
@@ -27,6 +29,7 @@ import (
 type Preflight struct {
 	suites    []Suite
 	disabled  map[CheckName]struct{}
+	only      map[CheckName]struct{}
 	cache     cache
 	cacheSalt string
 }
@@ -40,6 +43,7 @@ func New(suites ...Suite) *Preflight {
 	return &Preflight{
 		suites:   append([]Suite(nil), suites...),
 		disabled: make(map[CheckName]struct{}),
+		only:     make(map[CheckName]struct{}),
 	}
 }
 
@@ -68,6 +72,13 @@ func (p *Preflight) DisableChecks(names ...string) {
 	}
 }
 
+// OnlyChecks limits checks to the given names, other checks are not run and not reported.
+func (p *Preflight) OnlyChecks(names ...string) {
+	for _, name := range names {
+		p.only[CheckName(name)] = struct{}{}
+	}
+}
+
 func (p *Preflight) IsDisabled(name string) bool {
 	return p.isDisabled(CheckName(name))
 }
@@ -107,7 +118,7 @@ func (p *Preflight) runCheck(ctx context.Context, check Check) error {
 		}
 	}
 
-	if err := p.retry(ctx, check); err != nil {
+	if _, err := p.retry(ctx, check); err != nil {
 		return fmt.Errorf("preflight check %q failed.\nreason: %w", check.Name, err)
 	}
 	dhlog.FromContext(ctx).InfoContext(ctx, fmt.Sprintf("✓ %s: %s", check.Name, check.Description))
@@ -133,6 +144,9 @@ func (p *Preflight) prepareChecks(phase Phase) ([]Check, error) {
 			if check.Phase != phase {
 				continue
 			}
+			if len(p.only) > 0 && !p.isOnly(check.Name) {
+				continue
+			}
 			if p.isDisabled(check.Name) {
 				check.Disable()
 			}
@@ -147,7 +161,40 @@ func (p *Preflight) isDisabled(name CheckName) bool {
 	return ok
 }
 
-func (p *Preflight) retry(ctx context.Context, check Check) error {
+// validateOnlyChecks fails if a check selected with OnlyChecks is not registered in any suite:
+// a mistyped name would select nothing and the run would succeed without checking anything.
+func (p *Preflight) validateOnlyChecks() error {
+	registered := make(map[CheckName]struct{})
+	for _, suite := range p.suites {
+		if suite == nil {
+			continue
+		}
+		for _, check := range suite.Checks() {
+			registered[check.Name] = struct{}{}
+		}
+	}
+
+	var unknown []string
+	for name := range p.only {
+		if _, ok := registered[name]; !ok {
+			unknown = append(unknown, string(name))
+		}
+	}
+	if len(unknown) == 0 {
+		return nil
+	}
+	slices.Sort(unknown)
+
+	return fmt.Errorf("unknown preflight checks selected to run: %s", strings.Join(unknown, ", "))
+}
+
+func (p *Preflight) isOnly(name CheckName) bool {
+	_, ok := p.only[name]
+	return ok
+}
+
+// retry returns the number of attempts made together with the error of the last one
+func (p *Preflight) retry(ctx context.Context, check Check) (int, error) {
 	attempts := check.Retry.Attempts
 	if attempts <= 0 {
 		attempts = 1
@@ -157,7 +204,7 @@ func (p *Preflight) retry(ctx context.Context, check Check) error {
 	bo = backoff.WithContext(bo, ctx)
 	attempt := 0
 	printedHeader := false
-	return backoff.RetryNotify(
+	err := backoff.RetryNotify(
 		func() error { attempt++; return check.Run(ctx) },
 		bo,
 		func(err error, next time.Duration) {
@@ -172,6 +219,7 @@ func (p *Preflight) retry(ctx context.Context, check Check) error {
 			dhlog.FromContext(ctx).DebugContext(ctx, fmt.Sprintf("retry %d/%d in %s\nreason: %v", attempt, attempts, next, err))
 		},
 	)
+	return attempt, err
 }
 
 func (p *Preflight) cacheKey(name CheckName) string {
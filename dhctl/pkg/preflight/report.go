@@ -0,0 +1,259 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package preflightnew
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"time"
+
+	dhlog "github.com/deckhouse/lib-dhctl/pkg/logger"
+)
+
+type CheckStatus string
+
+const (
+	CheckStatusPassed  CheckStatus = "passed"
+	CheckStatusFailed  CheckStatus = "failed"
+	CheckStatusSkipped CheckStatus = "skipped"
+)
+
+type CheckResult struct {
+	Name        CheckName   `json:"name"`
+	Description string      `json:"description"`
+	Phase       Phase       `json:"phase"`
+	Status      CheckStatus `json:"status"`
+	Attempts    int         `json:"attempts"`
+	Duration    float64     `json:"duration_seconds"`
+	Error       string      `json:"error,omitempty"`
+}
+
+type ReportSummary struct {
+	Passed  int `json:"passed"`
+	Failed  int `json:"failed"`
+	Skipped int `json:"skipped"`
+}
+
+// Report is the result of the standalone preflight run, see Preflight.RunReport.
+type Report struct {
+	StartedAt time.Time     `json:"started_at"`
+	Duration  float64       `json:"duration_seconds"`
+	Summary   ReportSummary `json:"summary"`
+	Checks    []CheckResult `json:"checks"`
+}
+
+func (r *Report) Failed() bool {
+	return r.Summary.Failed > 0
+}
+
+func (r *Report) add(result CheckResult) {
+	switch result.Status {
+	case CheckStatusPassed:
+		r.Summary.Passed++
+	case CheckStatusFailed:
+		r.Summary.Failed++
+	case CheckStatusSkipped:
+		r.Summary.Skipped++
+	}
+
+	r.Checks = append(r.Checks, result)
+}
+
+// RunReport runs checks of the given phases one by one without stopping on the failed check.
+// Cached results are neither used nor saved, every check is run again.
+func (p *Preflight) RunReport(ctx context.Context, phases ...Phase) (*Report, error) {
+	if err := p.validateOnlyChecks(); err != nil {
+		return nil, err
+	}
+
+	report := &Report{
+		StartedAt: time.Now(),
+		Checks:    make([]CheckResult, 0),
+	}
+
+	for _, phase := range phases {
+		checks, err := p.prepareChecks(phase)
+		if err != nil {
+			return nil, err
+		}
+
+		err = dhlog.RunProcess(ctx, dhlog.FromContext(ctx), phase.FormatString(), func(ctx context.Context) error {
+			for _, check := range checks {
+				report.add(p.reportCheck(ctx, check))
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	report.Duration = time.Since(report.StartedAt).Seconds()
+
+	return report, nil
+}
+
+func (p *Preflight) reportCheck(ctx context.Context, check Check) CheckResult {
+	result := CheckResult{
+		Name:        check.Name,
+		Description: check.Description,
+		Phase:       check.Phase,
+		Status:      CheckStatusSkipped,
+	}
+
+	if check.Disabled {
+		dhlog.FromContext(ctx).InfoContext(ctx, fmt.Sprintf("✓ %s: %s (skipped)", check.Name, check.Description))
+		return result
+	}
+
+	start := time.Now()
+	attempts, err := p.retry(ctx, check)
+	result.Duration = time.Since(start).Seconds()
+	result.Attempts = attempts
+
+	if err != nil {
+		result.Status = CheckStatusFailed
+		result.Error = err.Error()
+		dhlog.FromContext(ctx).ErrorContext(ctx, fmt.Sprintf("✗ %s: %s\nreason: %v", check.Name, check.Description, err))
+		return result
+	}
+
+	result.Status = CheckStatusPassed
+	dhlog.FromContext(ctx).InfoContext(ctx, fmt.Sprintf("✓ %s: %s", check.Name, check.Description))
+
+	return result
+}
+
+func (r *Report) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(r)
+}
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Skipped  int              `xml:"skipped,attr"`
+	Time     string           `xml:"time,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Skipped   int             `xml:"skipped,attr"`
+	Time      string          `xml:"time,attr"`
+	Timestamp string          `xml:"timestamp,attr"`
+	Cases     []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name       string          `xml:"name,attr"`
+	ClassName  string          `xml:"classname,attr"`
+	Time       string          `xml:"time,attr"`
+	Properties []junitProperty `xml:"properties>property,omitempty"`
+	Failure    *junitFailure   `xml:"failure,omitempty"`
+	Skipped    *struct{}       `xml:"skipped,omitempty"`
+}
+
+type junitProperty struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// WriteJUnit writes report in JUnit XML format, every phase is a separate test suite.
+func (r *Report) WriteJUnit(w io.Writer) error {
+	suites := junitTestSuites{
+		Name:     "dhctl preflight",
+		Tests:    len(r.Checks),
+		Failures: r.Summary.Failed,
+		Skipped:  r.Summary.Skipped,
+		Time:     junitTime(r.Duration),
+	}
+
+	suiteIndex := make(map[Phase]int)
+	suiteTime := make(map[Phase]float64)
+	for _, check := range r.Checks {
+		i, ok := suiteIndex[check.Phase]
+		if !ok {
+			i = len(suites.Suites)
+			suiteIndex[check.Phase] = i
+			suites.Suites = append(suites.Suites, junitTestSuite{
+				Name:      string(check.Phase),
+				Timestamp: r.StartedAt.UTC().Format(time.RFC3339),
+			})
+		}
+
+		suite := &suites.Suites[i]
+		suite.Tests++
+		suiteTime[check.Phase] += check.Duration
+
+		testCase := junitTestCase{
+			Name:      check.Name.String(),
+			ClassName: fmt.Sprintf("preflight.%s", check.Phase),
+			Time:      junitTime(check.Duration),
+			Properties: []junitProperty{
+				{Name: "description", Value: check.Description},
+				{Name: "attempts", Value: fmt.Sprint(check.Attempts)},
+			},
+		}
+
+		switch check.Status {
+		case CheckStatusFailed:
+			suite.Failures++
+			testCase.Failure = &junitFailure{
+				Message: fmt.Sprintf("preflight check %q failed", check.Name),
+				Text:    check.Error,
+			}
+		case CheckStatusSkipped:
+			suite.Skipped++
+			testCase.Skipped = &struct{}{}
+		}
+
+		suite.Cases = append(suite.Cases, testCase)
+	}
+
+	for phase, i := range suiteIndex {
+		suites.Suites[i].Time = junitTime(suiteTime[phase])
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(suites); err != nil {
+		return err
+	}
+
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+func junitTime(seconds float64) string {
+	return fmt.Sprintf("%.3f", seconds)
+}
@@ -0,0 +1,117 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package preflightnew
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func testReportPreflight() *Preflight {
+	fastRetry := RetryPolicy{
+		Attempts: 3,
+		Options:  []backoff.ExponentialBackOffOpts{backoff.WithInitialInterval(time.Millisecond)},
+	}
+
+	p := New(NewSuite(
+		Check{
+			Name:  "passed-check",
+			Phase: PhasePreInfra,
+			Run:   func(context.Context) error { return nil },
+		},
+		Check{
+			Name:  "failed-check",
+			Phase: PhasePreInfra,
+			Run:   func(context.Context) error { return errors.New("port 22 is closed") },
+			Retry: fastRetry,
+		},
+		Check{
+			Name:  "disabled-check",
+			Phase: PhasePreInfra,
+			Run:   func(context.Context) error { return errors.New("must not be run") },
+		},
+		Check{
+			Name:  "post-infra-check",
+			Phase: PhasePostInfra,
+			Run:   func(context.Context) error { return nil },
+		},
+	))
+	p.DisableCheck("disabled-check")
+
+	return p
+}
+
+func TestRunReport(t *testing.T) {
+	report, err := testReportPreflight().RunReport(t.Context(), PhasePreInfra, PhasePostInfra)
+	require.NoError(t, err)
+
+	require.True(t, report.Failed())
+	require.Equal(t, ReportSummary{Passed: 2, Failed: 1, Skipped: 1}, report.Summary)
+	require.Len(t, report.Checks, 4)
+
+	failed := report.Checks[1]
+	require.Equal(t, CheckName("failed-check"), failed.Name)
+	require.Equal(t, CheckStatusFailed, failed.Status)
+	require.Equal(t, 3, failed.Attempts)
+	require.Equal(t, "port 22 is closed", failed.Error)
+
+	require.Equal(t, CheckStatusSkipped, report.Checks[2].Status)
+	require.Equal(t, 0, report.Checks[2].Attempts)
+	require.Equal(t, PhasePostInfra, report.Checks[3].Phase)
+
+	var out bytes.Buffer
+	require.NoError(t, report.WriteJSON(&out))
+
+	var decoded Report
+	require.NoError(t, json.Unmarshal(out.Bytes(), &decoded))
+	require.Equal(t, report.Summary, decoded.Summary)
+
+	out.Reset()
+	require.NoError(t, report.WriteJUnit(&out))
+	junit := out.String()
+	require.Contains(t, junit, `<testsuites name="dhctl preflight" tests="4" failures="1" skipped="1"`)
+	require.Contains(t, junit, `<testsuite name="pre-infra" tests="3" failures="1" skipped="1"`)
+	require.Contains(t, junit, `<testsuite name="post-infra" tests="1" failures="0" skipped="0"`)
+	require.Contains(t, junit, `<failure message="preflight check &#34;failed-check&#34; failed">port 22 is closed</failure>`)
+	require.Contains(t, junit, `<property name="attempts" value="3"></property>`)
+}
+
+func TestRunReportOnlyChecks(t *testing.T) {
+	p := testReportPreflight()
+	p.OnlyChecks("passed-check", "post-infra-check")
+
+	report, err := p.RunReport(t.Context(), PhasePreInfra)
+	require.NoError(t, err)
+
+	require.False(t, report.Failed())
+	require.Len(t, report.Checks, 1)
+	require.Equal(t, CheckName("passed-check"), report.Checks[0].Name)
+	require.Equal(t, 1, report.Checks[0].Attempts)
+}
+
+func TestRunReportUnknownOnlyChecks(t *testing.T) {
+	p := testReportPreflight()
+	p.OnlyChecks("passed-check", "pased-check", "another-typo")
+
+	_, err := p.RunReport(t.Context(), PhasePreInfra)
+	require.EqualError(t, err, "unknown preflight checks selected to run: another-typo, pased-check")
+}
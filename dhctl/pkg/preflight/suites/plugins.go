@@ -0,0 +1,51 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package suites
+
+import (
+	"context"
+
+	"github.com/deckhouse/deckhouse/dhctl/pkg/app/options"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/config"
+	preflight "github.com/deckhouse/deckhouse/dhctl/pkg/preflight"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/preflight/plugins"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/system/providerinitializer"
+)
+
+type PluginDeps struct {
+	SSHProviderInitializer *providerinitializer.SSHProviderInitializer
+	MetaConfig             *config.MetaConfig
+	PreflightOpts          *options.PreflightOptions
+}
+
+// NewPluginSuite loads checks of the executable plugins from --preflight-plugins-dir.
+// The suite is empty if the directory is not set.
+func NewPluginSuite(deps PluginDeps, ctx context.Context) (preflight.Suite, error) {
+	if deps.PreflightOpts.PluginsDir == "" {
+		return preflight.NewSuite(), nil
+	}
+
+	pluginChecks, err := plugins.LoadChecks(ctx, plugins.Deps{
+		Dir:                    deps.PreflightOpts.PluginsDir,
+		MetaConfig:             deps.MetaConfig,
+		SSHProviderInitializer: deps.SSHProviderInitializer,
+		DisableAll:             deps.PreflightOpts.SkipAll,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return preflight.NewSuite(pluginChecks...), nil
+}
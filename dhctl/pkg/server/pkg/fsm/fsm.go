@@ -88,29 +88,38 @@ func (f *FiniteStateMachine) State() State {
 }
 
 func (f *FiniteStateMachine) Event(event Event) error {
+	_, err := f.EventWithSource(event)
+	return err
+}
+
+// EventWithSource makes transition like Event and returns the state the transition was made from.
+// It is useful when the event has several sources and the caller should act depending on the source.
+func (f *FiniteStateMachine) EventWithSource(event Event) (State, error) {
 	f.m.Lock()
 	defer f.m.Unlock()
 
+	source := f.state
+
 	if _, ok := f.events[event]; !ok {
-		return fmt.Errorf("%w: unknown event %q", ErrTransitionError, event)
+		return source, fmt.Errorf("%w: unknown event %q", ErrTransitionError, event)
 	}
 
 	tr, ok := f.transitions[state{
 		event:  event,
-		source: f.state,
+		source: source,
 	}]
 	if !ok {
-		return fmt.Errorf("%w: event %q inappropriate in current state %q", ErrTransitionError, event, f.state)
+		return source, fmt.Errorf("%w: event %q inappropriate in current state %q", ErrTransitionError, event, source)
 	}
 
 	if tr.callback != nil {
-		err := tr.callback(f.state, tr.destination)
+		err := tr.callback(source, tr.destination)
 		if err != nil {
-			return fmt.Errorf("%w: %w", ErrCallbackError, err)
+			return source, fmt.Errorf("%w: %w", ErrCallbackError, err)
 		}
 	}
 
 	f.state = tr.destination
 
-	return nil
+	return source, nil
 }
@@ -123,3 +123,43 @@ func TestFiniteStateMachine(t *testing.T) {
 		})
 	}
 }
+
+func TestEventWithSource(t *testing.T) {
+	f := fsm.New("running", []fsm.Transition{
+		{
+			Event:       "pause",
+			Sources:     []fsm.State{"running"},
+			Destination: "pausing",
+		},
+		{
+			Event:       "wait",
+			Sources:     []fsm.State{"pausing"},
+			Destination: "paused",
+		},
+		{
+			Event:       "resume",
+			Sources:     []fsm.State{"pausing", "paused"},
+			Destination: "running",
+		},
+	})
+
+	source, err := f.EventWithSource("pause")
+	assert.NoError(t, err)
+	assert.Equal(t, "running", source)
+
+	source, err = f.EventWithSource("resume")
+	assert.NoError(t, err)
+	assert.Equal(t, "pausing", source)
+
+	assert.NoError(t, f.Event("pause"))
+	assert.NoError(t, f.Event("wait"))
+
+	source, err = f.EventWithSource("resume")
+	assert.NoError(t, err)
+	assert.Equal(t, "paused", source)
+	assert.Equal(t, "running", f.State())
+
+	source, err = f.EventWithSource("resume")
+	assert.ErrorContains(t, err, "event \"resume\" inappropriate in current state \"running\"")
+	assert.Equal(t, "running", source)
+}
@@ -0,0 +1,423 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package history
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/deckhouse/deckhouse/dhctl/pkg/server/pb/dhctl"
+)
+
+const (
+	operationFile = "operation.json"
+	logsFile      = "logs"
+	resultFile    = "result.json"
+)
+
+var ErrNotFound = errors.New("operation not found")
+
+// Store keeps history of dhctl operations on the local disk.
+// Every operation is a directory with the operation metadata, the log stream and the final result.
+type Store struct {
+	dir string
+}
+
+// New constructor for Store
+func New(dir string) (*Store, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("creating operations directory: %w", err)
+	}
+
+	return &Store{dir: dir}, nil
+}
+
+// Create starts recording of the new operation
+func (s *Store) Create(method string) (*Recorder, error) {
+	id := uuid.NewString()
+
+	if err := os.MkdirAll(s.operationDir(id), 0o700); err != nil {
+		return nil, fmt.Errorf("creating operation directory: %w", err)
+	}
+
+	logs, err := os.OpenFile(s.path(id, logsFile), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("creating operation logs: %w", err)
+	}
+
+	r := &Recorder{
+		store: s,
+		logs:  logs,
+		operation: &pb.Operation{
+			Id:        id,
+			Method:    method,
+			Status:    pb.OperationStatus_OPERATION_STATUS_RUNNING,
+			StartedAt: timestamppb.Now(),
+		},
+	}
+
+	if err := r.save(); err != nil {
+		_ = logs.Close()
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// List returns operations matching the filter, the newest first
+func (s *Store) List(method string, status pb.OperationStatus, limit int) ([]*pb.Operation, error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, fmt.Errorf("reading operations directory: %w", err)
+	}
+
+	operations := make([]*pb.Operation, 0, len(entries))
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+
+		operation, err := s.Get(entry.Name())
+		if err != nil {
+			// operation directory is just created or is being deleted
+			if errors.Is(err, ErrNotFound) {
+				continue
+			}
+			return nil, err
+		}
+
+		if method != "" && operation.Method != method {
+			continue
+		}
+		if status != pb.OperationStatus_OPERATION_STATUS_UNSPECIFIED && operation.Status != status {
+			continue
+		}
+
+		operations = append(operations, operation)
+	}
+
+	sortNewestFirst(operations)
+
+	if limit > 0 && len(operations) > limit {
+		operations = operations[:limit]
+	}
+
+	return operations, nil
+}
+
+// Get returns operation by id
+func (s *Store) Get(id string) (*pb.Operation, error) {
+	if !validID(id) {
+		return nil, ErrNotFound
+	}
+
+	data, err := os.ReadFile(s.path(id, operationFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("reading operation %s: %w", id, err)
+	}
+
+	operation := &pb.Operation{}
+	if err := protojson.Unmarshal(data, operation); err != nil {
+		return nil, fmt.Errorf("decoding operation %s: %w", id, err)
+	}
+
+	if stat, err := os.Stat(s.path(id, logsFile)); err == nil {
+		operation.LogsSize = stat.Size()
+	}
+
+	return operation, nil
+}
+
+// Result returns the final result of the operation in JSON,
+// empty result is returned if the operation is not finished or has no result
+func (s *Store) Result(id string) (string, error) {
+	if !validID(id) {
+		return "", ErrNotFound
+	}
+
+	data, err := os.ReadFile(s.path(id, resultFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", nil
+		}
+		return "", fmt.Errorf("reading operation %s result: %w", id, err)
+	}
+
+	return string(data), nil
+}
+
+// ReadLogs reads complete log lines starting from the byte offset and returns the offset of the next line.
+// Not more than maxLines lines are returned.
+func (s *Store) ReadLogs(id string, offset int64, maxLines int) ([]string, int64, error) {
+	if !validID(id) {
+		return nil, offset, ErrNotFound
+	}
+
+	f, err := os.Open(s.path(id, logsFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, offset, ErrNotFound
+		}
+		return nil, offset, fmt.Errorf("opening operation %s logs: %w", id, err)
+	}
+	defer f.Close()
+
+	if _, err := f.Seek(offset, io.SeekStart); err != nil {
+		return nil, offset, fmt.Errorf("seeking operation %s logs: %w", id, err)
+	}
+
+	reader := bufio.NewReader(f)
+	lines := make([]string, 0)
+	for len(lines) < maxLines {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			// the last line can be written partially, it will be read next time
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, offset, fmt.Errorf("reading operation %s logs: %w", id, err)
+		}
+
+		offset += int64(len(line))
+		lines = append(lines, strings.TrimSuffix(line, "\n"))
+	}
+
+	return lines, offset, nil
+}
+
+// MarkInterrupted marks all running operations as interrupted.
+// It should be called on the server start when no operation can be running.
+func (s *Store) MarkInterrupted() error {
+	operations, err := s.List("", pb.OperationStatus_OPERATION_STATUS_RUNNING, 0)
+	if err != nil {
+		return err
+	}
+
+	for _, operation := range operations {
+		operation.Status = pb.OperationStatus_OPERATION_STATUS_INTERRUPTED
+		operation.FinishedAt = timestamppb.Now()
+		operation.LogsSize = 0
+
+		if err := s.saveOperation(operation); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Prune deletes the oldest finished operations so that not more than keep finished operations are left
+func (s *Store) Prune(keep int) error {
+	operations, err := s.List("", pb.OperationStatus_OPERATION_STATUS_UNSPECIFIED, 0)
+	if err != nil {
+		return err
+	}
+
+	operations = slices.DeleteFunc(operations, func(operation *pb.Operation) bool {
+		return operation.Status == pb.OperationStatus_OPERATION_STATUS_RUNNING
+	})
+
+	if len(operations) <= keep {
+		return nil
+	}
+
+	for _, operation := range operations[keep:] {
+		if err := os.RemoveAll(s.operationDir(operation.Id)); err != nil {
+			return fmt.Errorf("deleting operation %s: %w", operation.Id, err)
+		}
+	}
+
+	return nil
+}
+
+func (s *Store) saveOperation(operation *pb.Operation) error {
+	data, err := protojson.Marshal(operation)
+	if err != nil {
+		return fmt.Errorf("encoding operation %s: %w", operation.Id, err)
+	}
+
+	return writeFileAtomic(s.path(operation.Id, operationFile), data)
+}
+
+func (s *Store) operationDir(id string) string {
+	return filepath.Join(s.dir, id)
+}
+
+func (s *Store) path(id, name string) string {
+	return filepath.Join(s.dir, id, name)
+}
+
+// Recorder writes progress of the single operation to the store
+type Recorder struct {
+	mx        sync.Mutex
+	store     *Store
+	logs      *os.File
+	operation *pb.Operation
+	finished  bool
+	hasResult bool
+}
+
+func (r *Recorder) ID() string {
+	return r.operation.Id
+}
+
+func (r *Recorder) SetCommanderUUID(commanderUUID string) error {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	r.operation.CommanderUuid = commanderUUID
+	return r.save()
+}
+
+// AppendLogs writes log lines to the operation log stream, multiline logs are split into lines
+func (r *Recorder) AppendLogs(logs []string) error {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	if r.finished || len(logs) == 0 {
+		return nil
+	}
+
+	var b strings.Builder
+	for _, log := range logs {
+		b.WriteString(strings.TrimSuffix(log, "\n"))
+		b.WriteString("\n")
+	}
+
+	if _, err := r.logs.WriteString(b.String()); err != nil {
+		return fmt.Errorf("writing operation %s logs: %w", r.operation.Id, err)
+	}
+
+	return nil
+}
+
+func (r *Recorder) SetProgress(progress *pb.Progress) error {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	r.operation.Progress = proto.CloneOf(progress)
+	return r.save()
+}
+
+func (r *Recorder) AddCompletedPhase(phase string) error {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	if phase == "" {
+		return nil
+	}
+
+	r.operation.CompletedPhases = append(r.operation.CompletedPhases, phase)
+	return r.save()
+}
+
+// SetResult saves the final result of the operation, errMsg is the error from the result message
+func (r *Recorder) SetResult(result proto.Message, errMsg string) error {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	data, err := protojson.Marshal(result)
+	if err != nil {
+		return fmt.Errorf("encoding operation %s result: %w", r.operation.Id, err)
+	}
+
+	if err := writeFileAtomic(r.store.path(r.operation.Id, resultFile), data); err != nil {
+		return err
+	}
+
+	r.hasResult = true
+	r.operation.Err = errMsg
+	return r.save()
+}
+
+// Finish sets the final status of the operation and closes the log stream.
+// Operation without result is considered as interrupted.
+func (r *Recorder) Finish(handlerErr error) error {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	if r.finished {
+		return nil
+	}
+	r.finished = true
+
+	switch {
+	case r.operation.Err != "":
+		r.operation.Status = pb.OperationStatus_OPERATION_STATUS_FAILED
+	case handlerErr != nil:
+		r.operation.Status = pb.OperationStatus_OPERATION_STATUS_FAILED
+		r.operation.Err = handlerErr.Error()
+	case !r.hasResult:
+		r.operation.Status = pb.OperationStatus_OPERATION_STATUS_INTERRUPTED
+	default:
+		r.operation.Status = pb.OperationStatus_OPERATION_STATUS_SUCCEEDED
+	}
+	r.operation.FinishedAt = timestamppb.Now()
+
+	return errors.Join(r.save(), r.logs.Close())
+}
+
+func (r *Recorder) save() error {
+	return r.store.saveOperation(r.operation)
+}
+
+func writeFileAtomic(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*")
+	if err != nil {
+		return fmt.Errorf("creating temp file for %s: %w", path, err)
+	}
+
+	_, err = tmp.Write(data)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(tmp.Name())
+		return fmt.Errorf("writing %s: %w", path, err)
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		_ = os.Remove(tmp.Name())
+		return fmt.Errorf("renaming %s: %w", path, err)
+	}
+
+	return nil
+}
+
+func sortNewestFirst(operations []*pb.Operation) {
+	slices.SortStableFunc(operations, func(a, b *pb.Operation) int {
+		return b.GetStartedAt().AsTime().Compare(a.GetStartedAt().AsTime())
+	})
+}
+
+func validID(id string) bool {
+	_, err := uuid.Parse(id)
+	return err == nil
+}
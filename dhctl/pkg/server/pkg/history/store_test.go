@@ -0,0 +1,160 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package history
+
+import (
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/deckhouse/deckhouse/dhctl/pkg/server/pb/dhctl"
+)
+
+func TestRecorder(t *testing.T) {
+	store, err := New(t.TempDir())
+	require.NoError(t, err)
+
+	r, err := store.Create("Bootstrap")
+	require.NoError(t, err)
+
+	// logs may contain secrets, they must be readable only by the owner
+	info, err := os.Stat(store.operationDir(r.ID()))
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0o700), info.Mode().Perm())
+	info, err = os.Stat(store.path(r.ID(), logsFile))
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	require.NoError(t, r.SetCommanderUUID("commander"))
+	require.NoError(t, r.AppendLogs([]string{"first", "second\n"}))
+	require.NoError(t, r.SetProgress(&pb.Progress{Operation: "Bootstrap", Progress: 0.5, CurrentPhase: "base-infra"}))
+	require.NoError(t, r.AddCompletedPhase("base-infra"))
+
+	operation, err := store.Get(r.ID())
+	require.NoError(t, err)
+	require.Equal(t, pb.OperationStatus_OPERATION_STATUS_RUNNING, operation.Status)
+	require.Equal(t, "commander", operation.CommanderUuid)
+	require.Equal(t, []string{"base-infra"}, operation.CompletedPhases)
+	require.Equal(t, 0.5, operation.Progress.Progress)
+	require.Equal(t, int64(len("first\nsecond\n")), operation.LogsSize)
+
+	lines, offset, err := store.ReadLogs(r.ID(), 0, 1)
+	require.NoError(t, err)
+	require.Equal(t, []string{"first"}, lines)
+
+	require.NoError(t, r.AppendLogs([]string{"third"}))
+
+	lines, offset, err = store.ReadLogs(r.ID(), offset, 100)
+	require.NoError(t, err)
+	require.Equal(t, []string{"second", "third"}, lines)
+
+	lines, _, err = store.ReadLogs(r.ID(), offset, 100)
+	require.NoError(t, err)
+	require.Empty(t, lines)
+
+	require.NoError(t, r.SetResult(&pb.BootstrapResult{State: "{}", Err: "boom"}, "boom"))
+	require.NoError(t, r.Finish(nil))
+
+	operation, err = store.Get(r.ID())
+	require.NoError(t, err)
+	require.Equal(t, pb.OperationStatus_OPERATION_STATUS_FAILED, operation.Status)
+	require.Equal(t, "boom", operation.Err)
+	require.NotNil(t, operation.FinishedAt)
+
+	result, err := store.Result(r.ID())
+	require.NoError(t, err)
+	require.JSONEq(t, `{"state":"{}","err":"boom"}`, result)
+}
+
+func TestFinishStatus(t *testing.T) {
+	store, err := New(t.TempDir())
+	require.NoError(t, err)
+
+	succeeded, err := store.Create("Check")
+	require.NoError(t, err)
+	require.NoError(t, succeeded.SetResult(&pb.CheckResult{}, ""))
+	require.NoError(t, succeeded.Finish(nil))
+
+	failed, err := store.Create("Check")
+	require.NoError(t, err)
+	require.NoError(t, failed.Finish(errors.New("stream closed")))
+
+	interrupted, err := store.Create("Check")
+	require.NoError(t, err)
+	require.NoError(t, interrupted.Finish(nil))
+
+	for id, status := range map[string]pb.OperationStatus{
+		succeeded.ID():   pb.OperationStatus_OPERATION_STATUS_SUCCEEDED,
+		failed.ID():      pb.OperationStatus_OPERATION_STATUS_FAILED,
+		interrupted.ID(): pb.OperationStatus_OPERATION_STATUS_INTERRUPTED,
+	} {
+		operation, err := store.Get(id)
+		require.NoError(t, err)
+		require.Equal(t, status, operation.Status)
+	}
+}
+
+func TestListAndPrune(t *testing.T) {
+	store, err := New(t.TempDir())
+	require.NoError(t, err)
+
+	ids := make([]string, 0, 4)
+	for _, method := range []string{"Check", "Converge", "Check", "Check"} {
+		r, err := store.Create(method)
+		require.NoError(t, err)
+		ids = append(ids, r.ID())
+
+		// started_at must differ for the stable order
+		time.Sleep(2 * time.Millisecond)
+
+		if method == "Converge" {
+			continue
+		}
+		require.NoError(t, r.SetResult(&pb.CheckResult{}, ""))
+		require.NoError(t, r.Finish(nil))
+	}
+
+	checks, err := store.List("Check", pb.OperationStatus_OPERATION_STATUS_UNSPECIFIED, 2)
+	require.NoError(t, err)
+	require.Len(t, checks, 2)
+	require.Equal(t, ids[3], checks[0].Id)
+	require.Equal(t, ids[2], checks[1].Id)
+
+	require.NoError(t, store.Prune(1))
+
+	all, err := store.List("", pb.OperationStatus_OPERATION_STATUS_UNSPECIFIED, 0)
+	require.NoError(t, err)
+	require.Len(t, all, 2)
+	require.Equal(t, ids[3], all[0].Id)
+	require.Equal(t, ids[1], all[1].Id)
+
+	require.NoError(t, store.MarkInterrupted())
+
+	running, err := store.Get(ids[1])
+	require.NoError(t, err)
+	require.Equal(t, pb.OperationStatus_OPERATION_STATUS_INTERRUPTED, running.Status)
+
+	_, err = store.Get(ids[0])
+	require.ErrorIs(t, err, ErrNotFound)
+
+	_, err = store.Get("../" + ids[3])
+	require.ErrorIs(t, err, ErrNotFound)
+
+	_, err = os.Stat(store.operationDir(ids[0]))
+	require.True(t, os.IsNotExist(err))
+}
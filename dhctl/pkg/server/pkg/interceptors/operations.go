@@ -0,0 +1,154 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interceptors
+
+import (
+	"log/slog"
+	"path"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	pb "github.com/deckhouse/deckhouse/dhctl/pkg/server/pb/dhctl"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/server/pkg/history"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/server/pkg/logger"
+)
+
+// OperationIDHeader is the response header with id of the operation in the operations history
+const OperationIDHeader = "dhctl-operation-id"
+
+// StreamOperationRecorder saves request metadata, progress, logs and result of every stream
+// with the prefix to the operations history. Nil store disables recording.
+func StreamOperationRecorder(store *history.Store, prefix string) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if store == nil || !strings.HasPrefix(info.FullMethod, prefix) {
+			return handler(srv, ss)
+		}
+
+		log := logger.L(ss.Context())
+
+		recorder, err := store.Create(path.Base(info.FullMethod))
+		if err != nil {
+			log.Error("failed to create operation record", logger.Err(err))
+			return handler(srv, ss)
+		}
+
+		log = log.With(slog.String("operation_id", recorder.ID()))
+		if err = ss.SetHeader(metadata.Pairs(OperationIDHeader, recorder.ID())); err != nil {
+			log.Warn("failed to set operation id header", logger.Err(err))
+		}
+
+		handlerErr := handler(srv, &recordingStream{ServerStream: ss, recorder: recorder, log: log})
+
+		if err = recorder.Finish(handlerErr); err != nil {
+			log.Error("failed to finish operation record", logger.Err(err))
+		}
+
+		return handlerErr
+	}
+}
+
+type recordingStream struct {
+	grpc.ServerStream
+	recorder *history.Recorder
+	log      *slog.Logger
+}
+
+func (s *recordingStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	msg, ok := m.(proto.Message)
+	if !ok {
+		return nil
+	}
+
+	start, ok := setMessageField(msg.ProtoReflect(), "start")
+	if !ok {
+		return nil
+	}
+
+	startOptions, ok := setMessageField(start, "options")
+	if !ok {
+		return nil
+	}
+
+	if commanderUUID := stringField(startOptions, "commander_uuid"); commanderUUID != "" {
+		s.handleErr(s.recorder.SetCommanderUUID(commanderUUID))
+	}
+
+	return nil
+}
+
+func (s *recordingStream) SendMsg(m any) error {
+	if msg, ok := m.(proto.Message); ok {
+		s.record(msg.ProtoReflect())
+	}
+
+	return s.ServerStream.SendMsg(m)
+}
+
+func (s *recordingStream) record(msg protoreflect.Message) {
+	if logs, ok := setMessageField(msg, "logs"); ok {
+		if logs, ok := logs.Interface().(*pb.Logs); ok {
+			s.handleErr(s.recorder.AppendLogs(logs.GetLogs()))
+		}
+		return
+	}
+
+	if progress, ok := setMessageField(msg, "progress"); ok {
+		if progress, ok := progress.Interface().(*pb.Progress); ok {
+			s.handleErr(s.recorder.SetProgress(progress))
+		}
+		return
+	}
+
+	if phaseEnd, ok := setMessageField(msg, "phase_end"); ok {
+		s.handleErr(s.recorder.AddCompletedPhase(stringField(phaseEnd, "completed_phase")))
+		return
+	}
+
+	if result, ok := setMessageField(msg, "result"); ok {
+		s.handleErr(s.recorder.SetResult(result.Interface(), stringField(result, "err")))
+	}
+}
+
+func (s *recordingStream) handleErr(err error) {
+	if err != nil {
+		s.log.Error("failed to record operation", logger.Err(err))
+	}
+}
+
+func setMessageField(msg protoreflect.Message, name protoreflect.Name) (protoreflect.Message, bool) {
+	field := msg.Descriptor().Fields().ByName(name)
+	if field == nil || field.Message() == nil || !msg.Has(field) {
+		return nil, false
+	}
+
+	return msg.Get(field).Message(), true
+}
+
+func stringField(msg protoreflect.Message, name protoreflect.Name) string {
+	field := msg.Descriptor().Fields().ByName(name)
+	if field == nil || field.Kind() != protoreflect.StringKind {
+		return ""
+	}
+
+	return msg.Get(field).String()
+}
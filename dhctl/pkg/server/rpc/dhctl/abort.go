@@ -116,6 +116,7 @@ connectionProcessor:
 						sendProgress: pt.sendProgress(ctx),
 						sendCh:       sendCh,
 					})
+					result.FinalState = phaseSwitcher.finalState(result.State)
 					_ = sendResponse(server.Context(), sendCh, &pb.AbortResponse{Message: &pb.AbortResponse_Result{Result: result}})
 				}()
 
@@ -138,7 +139,13 @@ connectionProcessor:
 				}
 
 			case *pb.AbortRequest_Cancel:
-				cancel()
+				phaseSwitcher.cancel(ctx, cancel)
+
+			case *pb.AbortRequest_Pause:
+				phaseSwitcher.pause(ctx)
+
+			case *pb.AbortRequest_Resume:
+				phaseSwitcher.resume(ctx)
 
 			default:
 				logger.L(ctx).Error("got unprocessable message",
@@ -275,7 +282,7 @@ func (s *Service) abort(ctx context.Context, p *abortParams) *pb.AbortResult {
 }
 
 func (s *Service) abortServerTransitions() []fsm.Transition {
-	return []fsm.Transition{
+	return append([]fsm.Transition{
 		{
 			Event:       "start",
 			Sources:     []fsm.State{"initial"},
@@ -291,7 +298,7 @@ func (s *Service) abortServerTransitions() []fsm.Transition {
 			Sources:     []fsm.State{"waiting"},
 			Destination: "running",
 		},
-	}
+	}, pauseTransitions()...)
 }
 
 func (s *Service) abortSwitchPhaseData(onPhaseData phases.OnPhaseFuncData[any]) (*pb.AbortResponse, error) {
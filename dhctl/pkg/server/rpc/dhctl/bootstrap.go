@@ -119,6 +119,7 @@ connectionProcessor:
 						sendProgress: pt.sendProgress(ctx),
 						sendCh:       sendCh,
 					})
+					result.FinalState = phaseSwitcher.finalState(result.State)
 					_ = sendResponse(server.Context(), sendCh, &pb.BootstrapResponse{Message: &pb.BootstrapResponse_Result{Result: result}})
 				}()
 
@@ -141,7 +142,13 @@ connectionProcessor:
 				}
 
 			case *pb.BootstrapRequest_Cancel:
-				cancel()
+				phaseSwitcher.cancel(ctx, cancel)
+
+			case *pb.BootstrapRequest_Pause:
+				phaseSwitcher.pause(ctx)
+
+			case *pb.BootstrapRequest_Resume:
+				phaseSwitcher.resume(ctx)
 
 			default:
 				logger.L(ctx).Error("got unprocessable message",
@@ -314,7 +321,7 @@ func (s *Service) bootstrap(ctx context.Context, p *bootstrapParams) *pb.Bootstr
 }
 
 func (s *Service) bootstrapServerTransitions() []fsm.Transition {
-	return []fsm.Transition{
+	return append([]fsm.Transition{
 		{
 			Event:       "start",
 			Sources:     []fsm.State{"initial"},
@@ -330,7 +337,7 @@ func (s *Service) bootstrapServerTransitions() []fsm.Transition {
 			Sources:     []fsm.State{"waiting"},
 			Destination: "running",
 		},
-	}
+	}, pauseTransitions()...)
 }
 
 func (s *Service) bootstrapSwitchPhaseData(onPhaseData phases.OnPhaseFuncData[any]) (*pb.BootstrapResponse, error) {
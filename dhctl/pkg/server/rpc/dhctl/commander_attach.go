@@ -116,6 +116,7 @@ connectionProcessor:
 						sendProgress: pt.sendProgress(ctx),
 						sendCh:       sendCh,
 					})
+					result.FinalState = phaseSwitcher.finalState(result.State)
 					_ = sendResponse(server.Context(), sendCh, &pb.CommanderAttachResponse{Message: &pb.CommanderAttachResponse_Result{Result: result}})
 				}()
 
@@ -138,7 +139,13 @@ connectionProcessor:
 				}
 
 			case *pb.CommanderAttachRequest_Cancel:
-				cancel()
+				phaseSwitcher.cancel(ctx, cancel)
+
+			case *pb.CommanderAttachRequest_Pause:
+				phaseSwitcher.pause(ctx)
+
+			case *pb.CommanderAttachRequest_Resume:
+				phaseSwitcher.resume(ctx)
 
 			default:
 				logger.L(ctx).Error("got unprocessable message",
@@ -224,6 +231,7 @@ func (s *Service) commanderAttach(ctx context.Context, p *attachParams) *pb.Comm
 			Values:   p.request.ResourcesValues.AsMap(),
 		},
 		ScanOnly: p.request.ScanOnly,
+		DryRun:   p.request.DryRun,
 		TmpDir:   s.params.TmpDir,
 		IsDebug:  s.params.IsDebug,
 		Options:  opts,
@@ -239,7 +247,7 @@ func (s *Service) commanderAttach(ctx context.Context, p *attachParams) *pb.Comm
 }
 
 func (s *Service) commanderAttachServerTransitions() []fsm.Transition {
-	return []fsm.Transition{
+	return append([]fsm.Transition{
 		{
 			Event:       "start",
 			Sources:     []fsm.State{"initial"},
@@ -255,7 +263,7 @@ func (s *Service) commanderAttachServerTransitions() []fsm.Transition {
 			Sources:     []fsm.State{"waiting"},
 			Destination: "running",
 		},
-	}
+	}, pauseTransitions()...)
 }
 
 //nolint:musttag
@@ -126,6 +126,7 @@ connectionProcessor:
 						sendProgress: pt.sendProgress(ctx),
 						sendCh:       sendCh,
 					})
+					result.FinalState = phaseSwitcher.finalState(result.State)
 					_ = sendResponse(server.Context(), sendCh, &pb.ConvergeResponse{Message: &pb.ConvergeResponse_Result{Result: result}})
 				}()
 
@@ -148,7 +149,13 @@ connectionProcessor:
 				}
 
 			case *pb.ConvergeRequest_Cancel:
-				cancel()
+				phaseSwitcher.cancel(ctx, cancel)
+
+			case *pb.ConvergeRequest_Pause:
+				phaseSwitcher.pause(ctx)
+
+			case *pb.ConvergeRequest_Resume:
+				phaseSwitcher.resume(ctx)
 
 			default:
 				logger.L(ctx).Error("got unprocessable message",
@@ -351,7 +358,7 @@ func (s *Service) converge(ctx context.Context, p *convergeParams) *pb.ConvergeR
 }
 
 func (s *Service) convergeServerTransitions() []fsm.Transition {
-	return []fsm.Transition{
+	return append([]fsm.Transition{
 		{
 			Event:       "start",
 			Sources:     []fsm.State{"initial"},
@@ -367,7 +374,7 @@ func (s *Service) convergeServerTransitions() []fsm.Transition {
 			Sources:     []fsm.State{"waiting"},
 			Destination: "running",
 		},
-	}
+	}, pauseTransitions()...)
 }
 
 func (s *Service) convergeSwitchPhaseData(onPhaseData phases.OnPhaseFuncData[any]) (*pb.ConvergeResponse, error) {
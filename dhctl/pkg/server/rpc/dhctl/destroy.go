@@ -122,6 +122,7 @@ connectionProcessor:
 						sendProgress: pt.sendProgress(ctx),
 						sendCh:       sendCh,
 					})
+					result.FinalState = phaseSwitcher.finalState(result.State)
 					_ = sendResponse(server.Context(), sendCh, &pb.DestroyResponse{Message: &pb.DestroyResponse_Result{Result: result}})
 				}()
 
@@ -144,7 +145,13 @@ connectionProcessor:
 				}
 
 			case *pb.DestroyRequest_Cancel:
-				cancel()
+				phaseSwitcher.cancel(ctx, cancel)
+
+			case *pb.DestroyRequest_Pause:
+				phaseSwitcher.pause(ctx)
+
+			case *pb.DestroyRequest_Resume:
+				phaseSwitcher.resume(ctx)
 
 			default:
 				logger.L(ctx).Error("got unprocessable message",
@@ -311,7 +318,7 @@ func (s *Service) destroy(ctx context.Context, p *destroyParams) *pb.DestroyResu
 }
 
 func (s *Service) destroyServerTransitions() []fsm.Transition {
-	return []fsm.Transition{
+	return append([]fsm.Transition{
 		{
 			Event:       "start",
 			Sources:     []fsm.State{"initial"},
@@ -327,7 +334,7 @@ func (s *Service) destroyServerTransitions() []fsm.Transition {
 			Sources:     []fsm.State{"waiting"},
 			Destination: "running",
 		},
-	}
+	}, pauseTransitions()...)
 }
 
 func (s *Service) destroySwitchPhaseData(onPhaseData phases.OnPhaseFuncData[any]) (*pb.DestroyResponse, error) {
@@ -22,6 +22,7 @@ import (
 	"io"
 	"log/slog"
 	"runtime/debug"
+	"sync"
 	"time"
 
 	"github.com/gogo/protobuf/proto"
@@ -202,27 +203,79 @@ func sendPhaseSwitch(ctx context.Context, next chan<- error, err error) {
 	}
 }
 
+// pauseTransitions are transitions of the operations with phases which allow to pause the operation.
+// Pause is applied at the next phase boundary: the paused operation accepts neither continue messages
+// nor phase switches until it is resumed or canceled. Resumed operation waits for continue again.
+func pauseTransitions() []fsm.Transition {
+	return []fsm.Transition{
+		{
+			Event:       "pause",
+			Sources:     []fsm.State{"running"},
+			Destination: "pausing",
+		},
+		{
+			Event:       "pause",
+			Sources:     []fsm.State{"waiting"},
+			Destination: "paused",
+		},
+		{
+			Event:       "wait",
+			Sources:     []fsm.State{"pausing"},
+			Destination: "paused",
+		},
+		{
+			Event:       "resume",
+			Sources:     []fsm.State{"pausing"},
+			Destination: "running",
+		},
+		{
+			Event:       "resume",
+			Sources:     []fsm.State{"paused"},
+			Destination: "waiting",
+		},
+	}
+}
+
 type fsmPhaseSwitcher[T proto.Message, OperationPhaseDataT any] struct {
 	f        *fsm.FiniteStateMachine
 	dataFunc func(data phases.OnPhaseFuncData[OperationPhaseDataT]) (T, error)
 	sendCh   chan T
 	next     chan error
+
+	mx             sync.Mutex
+	completedPhase phases.OperationPhase
+	pendingSwitch  T
+	canceled       bool
 }
 
 func (b *fsmPhaseSwitcher[T, OperationPhaseDataT]) switchPhase(ctx context.Context) func(
 	onPhaseData phases.OnPhaseFuncData[OperationPhaseDataT],
 ) error {
 	return func(onPhaseData phases.OnPhaseFuncData[OperationPhaseDataT]) error {
+		if onPhaseData.CompletedPhase != "" {
+			b.mx.Lock()
+			b.completedPhase = onPhaseData.CompletedPhase
+			b.mx.Unlock()
+		}
+
 		err := b.f.Event("wait")
 		if err != nil {
 			return fmt.Errorf("changing state to waiting: %w", err)
 		}
 
+		if b.f.State() == "paused" {
+			logger.L(ctx).Info("operation paused", slog.String("completed_phase", string(onPhaseData.CompletedPhase)))
+		}
+
 		data, err := b.dataFunc(onPhaseData)
 		if err != nil {
 			return fmt.Errorf("switch phase data func error: %w", err)
 		}
 
+		b.mx.Lock()
+		b.pendingSwitch = data
+		b.mx.Unlock()
+
 		err = sendResponse(ctx, b.sendCh, data)
 		if err != nil {
 			return fmt.Errorf("sending phase switch data: %w: %w", phases.ErrStopOperationCondition, err)
@@ -246,6 +299,77 @@ func (b *fsmPhaseSwitcher[T, OperationPhaseDataT]) switchPhase(ctx context.Conte
 	}
 }
 
+// pause pauses the operation at the next phase boundary or right away if the operation is waiting for continue.
+func (b *fsmPhaseSwitcher[T, OperationPhaseDataT]) pause(ctx context.Context) {
+	source, err := b.f.EventWithSource("pause")
+	if err != nil {
+		logger.L(ctx).Error("got unprocessable pause message", logger.Err(err))
+		return
+	}
+
+	if source == "waiting" {
+		logger.L(ctx).Info("operation paused")
+		return
+	}
+
+	logger.L(ctx).Info("operation will be paused at the next phase boundary")
+}
+
+// resume discards the pending pause or, if the operation is paused, sends the pending phase switch again
+// and lets the operation wait for the client decision to continue or stop.
+func (b *fsmPhaseSwitcher[T, OperationPhaseDataT]) resume(ctx context.Context) {
+	source, err := b.f.EventWithSource("resume")
+	if err != nil {
+		logger.L(ctx).Error("got unprocessable resume message", logger.Err(err))
+		return
+	}
+
+	if source == "paused" {
+		logger.L(ctx).Info("operation resumed, waiting for continue")
+
+		b.mx.Lock()
+		pendingSwitch := b.pendingSwitch
+		b.mx.Unlock()
+
+		if err := sendResponse(ctx, b.sendCh, pendingSwitch); err != nil {
+			logger.L(ctx).Error("sending pending phase switch", logger.Err(err))
+		}
+		return
+	}
+
+	logger.L(ctx).Info("pending pause discarded")
+}
+
+func (b *fsmPhaseSwitcher[T, OperationPhaseDataT]) cancel(ctx context.Context, cancel context.CancelFunc) {
+	b.mx.Lock()
+	b.canceled = true
+	b.mx.Unlock()
+
+	logger.L(ctx).Info("operation canceled")
+	cancel()
+}
+
+// finalState describes where the operation has stopped: the last completed phase
+// and the infrastructure states saved in the operation result state.
+func (b *fsmPhaseSwitcher[T, OperationPhaseDataT]) finalState(state string) *pb.OperationFinalState {
+	b.mx.Lock()
+	defer b.mx.Unlock()
+
+	finalState := &pb.OperationFinalState{
+		CompletedPhase:       string(b.completedPhase),
+		Canceled:             b.canceled,
+		Paused:               b.f.State() == "paused",
+		InfrastructureStates: make([]string, 0),
+	}
+
+	var dhctlState phases.DhctlState
+	if state != "" && json.Unmarshal([]byte(state), &dhctlState) == nil {
+		finalState.InfrastructureStates = dhctlState.InfrastructureStates()
+	}
+
+	return finalState
+}
+
 func onCheckResult(ctx context.Context, checkRes *check.CheckResult) error {
 	printableCheckRes := *checkRes
 	printableCheckRes.StatusDetails.InfrastructurePlan = nil
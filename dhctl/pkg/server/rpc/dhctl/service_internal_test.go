@@ -25,7 +25,9 @@ import (
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/metadata"
 
+	"github.com/deckhouse/deckhouse/dhctl/pkg/operations/phases"
 	pb "github.com/deckhouse/deckhouse/dhctl/pkg/server/pb/dhctl"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/server/pkg/fsm"
 )
 
 const testNoEventTimeout = 50 * time.Millisecond
@@ -210,6 +212,99 @@ func TestStartReceiverReturnsWhenContextCanceledWhileDeliveringRequest(t *testin
 	}
 }
 
+func TestPhaseSwitcherPauseAndResume(t *testing.T) {
+	t.Parallel()
+
+	s := &Service{}
+	f := fsm.New("initial", s.bootstrapServerTransitions())
+	require.NoError(t, f.Event("start"))
+
+	sendCh := make(chan *pb.BootstrapResponse, 1)
+	switcher := &fsmPhaseSwitcher[*pb.BootstrapResponse, any]{
+		f: f, dataFunc: s.bootstrapSwitchPhaseData, sendCh: sendCh, next: make(chan error),
+	}
+
+	switcher.pause(t.Context())
+	require.Equal(t, "pausing", f.State())
+
+	switchErr := make(chan error, 1)
+	go func() {
+		switchErr <- switcher.switchPhase(t.Context())(phases.OnPhaseFuncData[any]{
+			CompletedPhase: phases.BaseInfraPhase,
+			NextPhase:      phases.InstallKubernetesPhase,
+		})
+	}()
+
+	select {
+	case response := <-sendCh:
+		require.Equal(t, string(phases.BaseInfraPhase), response.GetPhaseEnd().GetCompletedPhase())
+	case <-time.After(time.Second):
+		t.Fatal("timeout waiting for phase end")
+	}
+
+	require.Equal(t, "paused", f.State())
+	require.Error(t, f.Event("toNextPhase"))
+
+	select {
+	case err := <-switchErr:
+		t.Fatalf("paused operation switched phase: %v", err)
+	case <-time.After(testNoEventTimeout):
+	}
+
+	finalState := switcher.finalState(`{"base-infrastructure.tfstate":"e30=","uuid":"MQ=="}`)
+	require.Equal(t, string(phases.BaseInfraPhase), finalState.CompletedPhase)
+	require.True(t, finalState.Paused)
+	require.False(t, finalState.Canceled)
+	require.Equal(t, []string{"base-infrastructure.tfstate"}, finalState.InfrastructureStates)
+
+	switcher.resume(t.Context())
+
+	select {
+	case response := <-sendCh:
+		require.Equal(t, string(phases.BaseInfraPhase), response.GetPhaseEnd().GetCompletedPhase())
+	case <-time.After(time.Second):
+		t.Fatal("timeout waiting for resent phase end")
+	}
+	require.Equal(t, "waiting", f.State())
+
+	select {
+	case err := <-switchErr:
+		t.Fatalf("resumed operation switched phase without continue: %v", err)
+	case <-time.After(testNoEventTimeout):
+	}
+
+	require.NoError(t, f.Event("toNextPhase"))
+	sendPhaseSwitch(t.Context(), switcher.next, phases.ErrStopOperationCondition)
+
+	select {
+	case err := <-switchErr:
+		require.ErrorIs(t, err, phases.ErrStopOperationCondition)
+	case <-time.After(time.Second):
+		t.Fatal("timeout waiting for phase switch")
+	}
+	require.Equal(t, "running", f.State())
+}
+
+func TestPhaseSwitcherResumeDiscardsPendingPause(t *testing.T) {
+	t.Parallel()
+
+	s := &Service{}
+	f := fsm.New("running", s.convergeServerTransitions())
+	switcher := &fsmPhaseSwitcher[*pb.ConvergeResponse, any]{f: f, next: make(chan error)}
+
+	switcher.pause(t.Context())
+	switcher.resume(t.Context())
+	require.Equal(t, "running", f.State())
+
+	_, cancel := context.WithCancel(t.Context())
+	switcher.cancel(t.Context(), cancel)
+
+	finalState := switcher.finalState("")
+	require.True(t, finalState.Canceled)
+	require.False(t, finalState.Paused)
+	require.Empty(t, finalState.InfrastructureStates)
+}
+
 type testServerStream[Request proto.Message, Response proto.Message] struct {
 	ctx    context.Context
 	sendFn func(Response) error
@@ -0,0 +1,38 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operations
+
+import (
+	"context"
+
+	pb "github.com/deckhouse/deckhouse/dhctl/pkg/server/pb/dhctl"
+)
+
+func (s *Service) GetOperation(_ context.Context, request *pb.GetOperationRequest) (*pb.GetOperationResponse, error) {
+	operation, err := s.store.Get(request.Id)
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	result, err := s.store.Result(request.Id)
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	return &pb.GetOperationResponse{
+		Operation: operation,
+		Result:    result,
+	}, nil
+}
@@ -0,0 +1,30 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operations
+
+import (
+	"context"
+
+	pb "github.com/deckhouse/deckhouse/dhctl/pkg/server/pb/dhctl"
+)
+
+func (s *Service) ListOperations(_ context.Context, request *pb.ListOperationsRequest) (*pb.ListOperationsResponse, error) {
+	operations, err := s.store.List(request.Method, request.Status, int(request.Limit))
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	return &pb.ListOperationsResponse{Operations: operations}, nil
+}
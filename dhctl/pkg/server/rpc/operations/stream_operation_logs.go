@@ -0,0 +1,74 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operations
+
+import (
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/deckhouse/deckhouse/dhctl/pkg/server/pb/dhctl"
+)
+
+// StreamOperationLogs sends logs of the operation starting from the offset.
+// With follow the stream is kept open until the operation is finished and all its logs are sent.
+func (s *Service) StreamOperationLogs(request *pb.StreamOperationLogsRequest, server pb.Operations_StreamOperationLogsServer) error {
+	if request.Offset < 0 {
+		return status.Error(codes.InvalidArgument, "offset must not be negative")
+	}
+
+	ctx := server.Context()
+	offset := request.Offset
+
+	for {
+		// operation status is read before logs so that no logs written before the finish are lost
+		operation, err := s.store.Get(request.Id)
+		if err != nil {
+			return storeError(err)
+		}
+
+		lines, nextOffset, err := s.store.ReadLogs(request.Id, offset, logsBatchSize)
+		if err != nil {
+			return storeError(err)
+		}
+
+		if len(lines) > 0 {
+			err = server.Send(&pb.StreamOperationLogsResponse{
+				Logs:       &pb.Logs{Logs: lines},
+				NextOffset: nextOffset,
+			})
+			if err != nil {
+				return err
+			}
+
+			offset = nextOffset
+
+			if len(lines) == logsBatchSize {
+				continue
+			}
+		}
+
+		if !request.Follow || operation.Status != pb.OperationStatus_OPERATION_STATUS_RUNNING {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case <-time.After(logsFollowInterval):
+		}
+	}
+}
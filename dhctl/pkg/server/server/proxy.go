@@ -54,6 +54,7 @@ type StreamDirector struct {
 type StreamDirectorParams struct {
 	MethodsPrefix string
 	TmpDir        string
+	OperationsDir string
 }
 
 func (params *StreamDirectorParams) Validate() error {
@@ -112,14 +113,18 @@ func (d *StreamDirector) Director() proxy.StreamDirector {
 			slog.String("address", address),
 		)
 
-		cmd := exec.Command(
-			os.Args[0],
+		args := []string{
 			"_server",
 			"--server-network=unix",
 			"--do-not-write-debug-log-file",
 			fmt.Sprintf("--server-address=%s", address),
 			fmt.Sprintf("--tmp-dir=%s", tmpDirForInstance),
-		)
+		}
+		if d.params.OperationsDir != "" {
+			args = append(args, fmt.Sprintf("--server-operations-dir=%s", d.params.OperationsDir))
+		}
+
+		cmd := exec.Command(os.Args[0], args...)
 
 		// Add parent envs to child envs
 		cmd.Env = append(cmd.Env, os.Environ()...)
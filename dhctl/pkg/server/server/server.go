@@ -18,6 +18,8 @@ import (
 	"context"
 	"log/slog"
 	"net"
+	"path/filepath"
+	"time"
 
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/logging"
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/recovery"
@@ -29,9 +31,11 @@ import (
 
 	"github.com/deckhouse/deckhouse/dhctl/pkg/config"
 	pbdhctl "github.com/deckhouse/deckhouse/dhctl/pkg/server/pb/dhctl"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/server/pkg/history"
 	"github.com/deckhouse/deckhouse/dhctl/pkg/server/pkg/interceptors"
 	"github.com/deckhouse/deckhouse/dhctl/pkg/server/pkg/logger"
 	rc "github.com/deckhouse/deckhouse/dhctl/pkg/server/pkg/requests_counter"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/server/rpc/operations"
 	"github.com/deckhouse/deckhouse/dhctl/pkg/server/rpc/status"
 	"github.com/deckhouse/deckhouse/dhctl/pkg/server/rpc/validation"
 	"github.com/deckhouse/deckhouse/dhctl/pkg/server/server/settings"
@@ -40,6 +44,8 @@ import (
 
 const SinglethreadedMethodsPrefix = "/dhctl.DHCTL" // full method example: /dhctl.DHCTL/Check
 
+const operationsPrunePeriod = 10 * time.Minute
+
 // Serve starts GRPC server
 func Serve(ctx context.Context, params settings.ServerParams) error {
 	if err := params.Validate(); err != nil {
@@ -50,9 +56,25 @@ func Serve(ctx context.Context, params settings.ServerParams) error {
 	lvl.Set(slog.LevelDebug)
 	log := logger.NewLogger(lvl).With(slog.String("component", "server"))
 
+	operationsDir := params.OperationsDir
+	if operationsDir == "" {
+		operationsDir = filepath.Join(params.TmpDir, "operations")
+	}
+
+	operationsStore, err := history.New(operationsDir)
+	if err != nil {
+		return err
+	}
+
+	// dhctl instances are not running yet, so running operations were left by the previous server
+	if err = operationsStore.MarkInterrupted(); err != nil {
+		return err
+	}
+
 	dhctlProxy, err := NewStreamDirector(StreamDirectorParams{
 		MethodsPrefix: SinglethreadedMethodsPrefix,
 		TmpDir:        params.TmpDir,
+		OperationsDir: operationsDir,
 	})
 
 	if err != nil {
@@ -67,11 +89,14 @@ func Serve(ctx context.Context, params settings.ServerParams) error {
 	requestsCounter := rc.New(params.RequestsCounterMaxDuration, sem)
 	requestsCounter.Run(ctx)
 
+	go pruneOperations(ctx, log, operationsStore, params.OperationsHistoryLimit)
+
 	log.Info(
 		"starting grpc server",
 		slog.String("network", params.Network),
 		slog.String("address", params.Address),
 		slog.String("tmp_dir", params.TmpDir),
+		slog.String("operations_dir", operationsDir),
 	)
 	tomb.RegisterOnShutdown("server", func() {
 		log.Info("stopping grpc server")
@@ -109,10 +134,12 @@ func Serve(ctx context.Context, params settings.ServerParams) error {
 	// init services
 	validationService := validation.New(config.NewSchemaStore(params.GlobalOptions), params.GlobalOptions)
 	statusService := status.New(requestsCounter)
+	operationsService := operations.New(operationsStore)
 
 	// register services
 	pbdhctl.RegisterValidationServer(s, validationService)
 	pbdhctl.RegisterStatusServer(s, statusService)
+	pbdhctl.RegisterOperationsServer(s, operationsService)
 
 	go func() {
 		<-ctx.Done()
@@ -137,3 +164,20 @@ func Serve(ctx context.Context, params settings.ServerParams) error {
 
 	return nil
 }
+
+func pruneOperations(ctx context.Context, log *slog.Logger, store *history.Store, limit int) {
+	ticker := time.NewTicker(operationsPrunePeriod)
+	defer ticker.Stop()
+
+	for {
+		if err := store.Prune(limit); err != nil {
+			log.Error("failed to prune operations history", logger.Err(err))
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
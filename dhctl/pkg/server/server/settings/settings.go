@@ -26,6 +26,7 @@ type ServerGeneralParams struct {
 	Network       string
 	Address       string
 	TmpDir        string
+	OperationsDir string // operations history is not recorded if empty
 	GlobalOptions *options.GlobalOptions
 }
 
@@ -57,9 +58,14 @@ type ServerParams struct {
 
 	ParallelTasksLimit         int
 	RequestsCounterMaxDuration time.Duration
+	OperationsHistoryLimit     int
 }
 
 func (p *ServerParams) Validate() error {
+	if p.OperationsHistoryLimit < 0 {
+		return fmt.Errorf("operations history limit should not be negative")
+	}
+
 	return p.ServerGeneralParams.Validate()
 }
 
@@ -252,6 +252,20 @@ func TestServerParams_Validate(t *testing.T) {
 			},
 			expectError: false,
 		},
+		{
+			name: "negative operations history limit",
+			params: ServerParams{
+				ServerGeneralParams: ServerGeneralParams{
+					Network: "tcp",
+					Address: "localhost:8080",
+					TmpDir:  "/tmp/dhctl",
+				},
+				ParallelTasksLimit:     10,
+				OperationsHistoryLimit: -1,
+			},
+			expectError: true,
+			errorMsg:    "operations history limit should not be negative",
+		},
 	}
 
 	for _, tt := range tests {
@@ -32,6 +32,7 @@ import (
 
 	"github.com/deckhouse/deckhouse/dhctl/pkg/config"
 	pbdhctl "github.com/deckhouse/deckhouse/dhctl/pkg/server/pb/dhctl"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/server/pkg/history"
 	"github.com/deckhouse/deckhouse/dhctl/pkg/server/pkg/interceptors"
 	"github.com/deckhouse/deckhouse/dhctl/pkg/server/pkg/logger"
 	"github.com/deckhouse/deckhouse/dhctl/pkg/server/rpc/dhctl"
@@ -70,6 +71,14 @@ func Serve(ctx context.Context, params settings.ServerSingleshotParams) error {
 		return fmt.Errorf("failed to init grpc server: %w", err)
 	}
 
+	var operationsStore *history.Store
+	if params.OperationsDir != "" {
+		operationsStore, err = history.New(params.OperationsDir)
+		if err != nil {
+			return fmt.Errorf("failed to init grpc server: %w", err)
+		}
+	}
+
 	log.Info(
 		"starting grpc server",
 		slog.String("network", params.Network),
@@ -90,6 +99,7 @@ func Serve(ctx context.Context, params settings.ServerSingleshotParams) error {
 			logging.StreamServerInterceptor(interceptors.Logger()),
 			recovery.StreamServerInterceptor(recovery.WithRecoveryHandlerContext(interceptors.PanicRecoveryHandler())),
 			interceptors.StreamParallelTasksLimiter(sem, server.SinglethreadedMethodsPrefix),
+			interceptors.StreamOperationRecorder(operationsStore, server.SinglethreadedMethodsPrefix),
 		),
 	)
 
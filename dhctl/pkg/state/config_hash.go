@@ -15,14 +15,19 @@
 package state
 
 import (
+	"bytes"
 	"context"
 	"crypto/sha256"
 	"encoding/hex"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"sort"
+	"strings"
 
 	dhlog "github.com/deckhouse/lib-dhctl/pkg/logger"
+	"gopkg.in/yaml.v3"
 
 	"github.com/deckhouse/deckhouse/dhctl/pkg/util/fs"
 )
@@ -57,3 +62,82 @@ func ConfigHash(ctx context.Context, paths []string) string {
 
 	return hash
 }
+
+// RedactedConfigContent returns the content of all config files joined as one multi-document YAML
+// in the order the paths were passed, with every value replaced by RedactConfig. It is used to show
+// what changed between two runs when ConfigHash differs and is safe to keep in the state cache.
+func RedactedConfigContent(ctx context.Context, paths []string) string {
+	resolvedPaths := fs.RevealWildcardPaths(paths)
+
+	docs := make([]string, 0, len(resolvedPaths))
+	for _, path := range resolvedPaths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			dhlog.FromContext(ctx).WarnContext(ctx, fmt.Sprintf("cannot read config file %s: %v", path, err))
+			continue
+		}
+		docs = append(docs, RedactConfig(strings.TrimSuffix(string(data), "\n")))
+	}
+
+	return strings.Join(docs, "\n---\n") + "\n"
+}
+
+// RedactConfig replaces every value of YAML content with a short checksum of it. Keys and the structure
+// are kept, so a diff of two redacted configs still shows which settings changed without revealing
+// registry or provider credentials. The content is parsed, so multi-line and flow-style values are
+// redacted as a whole; comments are dropped. Content that is not valid YAML is redacted as one value.
+func RedactConfig(content string) string {
+	var out bytes.Buffer
+	encoder := yaml.NewEncoder(&out)
+	encoder.SetIndent(2)
+
+	decoder := yaml.NewDecoder(strings.NewReader(content))
+	for {
+		var doc yaml.Node
+		err := decoder.Decode(&doc)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return redactedValue(content)
+		}
+
+		redactNode(&doc)
+		if err := encoder.Encode(&doc); err != nil {
+			return redactedValue(content)
+		}
+	}
+	if err := encoder.Close(); err != nil {
+		return redactedValue(content)
+	}
+
+	return strings.TrimSuffix(out.String(), "\n")
+}
+
+// redactNode replaces every scalar value under node, keeping mapping keys.
+func redactNode(node *yaml.Node) {
+	node.Style = 0
+	node.HeadComment, node.LineComment, node.FootComment = "", "", ""
+
+	switch node.Kind {
+	case yaml.ScalarNode:
+		node.Tag = "!!str"
+		node.Value = redactedValue(node.Value)
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key := node.Content[i]
+			key.Style = 0
+			key.HeadComment, key.LineComment, key.FootComment = "", "", ""
+			redactNode(node.Content[i+1])
+		}
+	case yaml.DocumentNode, yaml.SequenceNode:
+		for _, child := range node.Content {
+			redactNode(child)
+		}
+	}
+}
+
+func redactedValue(value string) string {
+	sum := sha256.Sum256([]byte(value))
+	return "<redacted:" + hex.EncodeToString(sum[:4]) + ">"
+}
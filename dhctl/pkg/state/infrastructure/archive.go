@@ -0,0 +1,267 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/deckhouse/deckhouse/dhctl/pkg/state"
+)
+
+const (
+	stateArchiveVersion       = 1
+	stateArchiveManifestPath  = "manifest.json"
+	stateArchiveSignaturePath = "manifest.json.sig"
+	// stateArchiveMaxFileSize limits the size of every file read from the archive
+	stateArchiveMaxFileSize = 64 << 20
+
+	StateArchiveFileBaseInfrastructure = "base-infrastructure"
+	StateArchiveFileNode               = "node"
+	StateArchiveFileNodeGroupSettings  = "node-group-settings"
+)
+
+var ErrStateArchiveSignature = errors.New("infrastructure state archive signature is invalid")
+
+// StateArchiveManifest describes files of the infrastructure state archive.
+// Manifest is signed with HMAC-SHA256, every file is verified with its SHA256 digest from the manifest.
+type StateArchiveManifest struct {
+	Version     int                `json:"version"`
+	ClusterUUID string             `json:"cluster_uuid"`
+	CreatedAt   time.Time          `json:"created_at"`
+	Files       []StateArchiveFile `json:"files"`
+}
+
+type StateArchiveFile struct {
+	Path      string `json:"path"`
+	Kind      string `json:"kind"`
+	NodeGroup string `json:"node_group,omitempty"`
+	Node      string `json:"node,omitempty"`
+	SHA256    string `json:"sha256"`
+}
+
+func signStateArchiveManifest(key, manifest []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(manifest)
+	return mac.Sum(nil)
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// WriteStateArchive writes snapshot as gzipped tar archive signed with key.
+func WriteStateArchive(w io.Writer, key []byte, clusterUUID string, snapshot *StatesSnapshot) error {
+	if len(key) == 0 {
+		return fmt.Errorf("signing key for infrastructure state archive is empty")
+	}
+
+	manifest := StateArchiveManifest{
+		Version:     stateArchiveVersion,
+		ClusterUUID: clusterUUID,
+		CreatedAt:   time.Now().UTC(),
+	}
+	files := make(map[string][]byte)
+
+	addFile := func(file StateArchiveFile, content []byte) {
+		file.SHA256 = sha256Hex(content)
+		manifest.Files = append(manifest.Files, file)
+		files[file.Path] = content
+	}
+
+	if len(snapshot.BaseInfrastructure) > 0 {
+		addFile(StateArchiveFile{
+			Path: "base-infrastructure.tfstate",
+			Kind: StateArchiveFileBaseInfrastructure,
+		}, snapshot.BaseInfrastructure)
+	}
+
+	for _, info := range snapshot.States() {
+		if info.Node == "" {
+			continue
+		}
+
+		nodeGroup := snapshot.NodeGroups[info.NodeGroup]
+		if _, ok := files[nodeGroupSettingsPath(info.NodeGroup)]; !ok && len(nodeGroup.Settings) > 0 {
+			addFile(StateArchiveFile{
+				Path:      nodeGroupSettingsPath(info.NodeGroup),
+				Kind:      StateArchiveFileNodeGroupSettings,
+				NodeGroup: info.NodeGroup,
+			}, nodeGroup.Settings)
+		}
+
+		addFile(StateArchiveFile{
+			Path:      fmt.Sprintf("node-groups/%s/%s.tfstate", info.NodeGroup, info.Node),
+			Kind:      StateArchiveFileNode,
+			NodeGroup: info.NodeGroup,
+			Node:      info.Node,
+		}, nodeGroup.State[info.Node])
+	}
+
+	manifestData, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	gzw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gzw)
+
+	writeFile := func(path string, content []byte) error {
+		err := tw.WriteHeader(&tar.Header{
+			Name:    path,
+			Mode:    0o600,
+			Size:    int64(len(content)),
+			ModTime: manifest.CreatedAt,
+		})
+		if err != nil {
+			return err
+		}
+
+		_, err = tw.Write(content)
+		return err
+	}
+
+	if err := writeFile(stateArchiveManifestPath, manifestData); err != nil {
+		return err
+	}
+
+	signature := hex.EncodeToString(signStateArchiveManifest(key, manifestData))
+	if err := writeFile(stateArchiveSignaturePath, []byte(signature)); err != nil {
+		return err
+	}
+
+	for _, file := range manifest.Files {
+		if err := writeFile(file.Path, files[file.Path]); err != nil {
+			return err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+
+	return gzw.Close()
+}
+
+func nodeGroupSettingsPath(nodeGroup string) string {
+	return fmt.Sprintf("node-groups/%s/settings.json", nodeGroup)
+}
+
+// ReadStateArchive reads archive written by WriteStateArchive and verifies its signature and content.
+func ReadStateArchive(r io.Reader, key []byte) (*StateArchiveManifest, *StatesSnapshot, error) {
+	gzr, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot read infrastructure state archive: %w", err)
+	}
+	defer gzr.Close()
+
+	files := make(map[string][]byte)
+	tr := tar.NewReader(gzr)
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("cannot read infrastructure state archive: %w", err)
+		}
+
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		if header.Size > stateArchiveMaxFileSize {
+			return nil, nil, fmt.Errorf("file %s in infrastructure state archive is too large", header.Name)
+		}
+
+		content, err := io.ReadAll(io.LimitReader(tr, stateArchiveMaxFileSize))
+		if err != nil {
+			return nil, nil, fmt.Errorf("cannot read %s from infrastructure state archive: %w", header.Name, err)
+		}
+
+		files[header.Name] = content
+	}
+
+	manifestData, ok := files[stateArchiveManifestPath]
+	if !ok {
+		return nil, nil, fmt.Errorf("infrastructure state archive has no %s", stateArchiveManifestPath)
+	}
+
+	signature, err := hex.DecodeString(string(files[stateArchiveSignaturePath]))
+	if err != nil || !hmac.Equal(signature, signStateArchiveManifest(key, manifestData)) {
+		return nil, nil, ErrStateArchiveSignature
+	}
+
+	var manifest StateArchiveManifest
+	if err := json.Unmarshal(manifestData, &manifest); err != nil {
+		return nil, nil, fmt.Errorf("cannot parse infrastructure state archive manifest: %w", err)
+	}
+
+	if manifest.Version != stateArchiveVersion {
+		return nil, nil, fmt.Errorf("unsupported infrastructure state archive version %d", manifest.Version)
+	}
+
+	snapshot := &StatesSnapshot{
+		NodeGroups: make(map[string]state.NodeGroupInfrastructureState),
+	}
+
+	getNodeGroup := func(name string) state.NodeGroupInfrastructureState {
+		nodeGroup, ok := snapshot.NodeGroups[name]
+		if !ok {
+			nodeGroup = state.NodeGroupInfrastructureState{State: make(map[string][]byte)}
+		}
+		return nodeGroup
+	}
+
+	for _, file := range manifest.Files {
+		content, ok := files[file.Path]
+		if !ok {
+			return nil, nil, fmt.Errorf("infrastructure state archive has no %s", file.Path)
+		}
+
+		if sha256Hex(content) != file.SHA256 {
+			return nil, nil, fmt.Errorf("checksum mismatch for %s in infrastructure state archive", file.Path)
+		}
+
+		switch file.Kind {
+		case StateArchiveFileBaseInfrastructure:
+			snapshot.BaseInfrastructure = content
+		case StateArchiveFileNodeGroupSettings:
+			nodeGroup := getNodeGroup(file.NodeGroup)
+			nodeGroup.Settings = content
+			snapshot.NodeGroups[file.NodeGroup] = nodeGroup
+		case StateArchiveFileNode:
+			if file.NodeGroup == "" || file.Node == "" {
+				return nil, nil, fmt.Errorf("node group or node name is empty for %s in infrastructure state archive", file.Path)
+			}
+			nodeGroup := getNodeGroup(file.NodeGroup)
+			nodeGroup.State[file.Node] = content
+			snapshot.NodeGroups[file.NodeGroup] = nodeGroup
+		default:
+			return nil, nil, fmt.Errorf("unknown kind %q of %s in infrastructure state archive", file.Kind, file.Path)
+		}
+	}
+
+	return &manifest, snapshot, nil
+}
@@ -0,0 +1,74 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/deckhouse/deckhouse/dhctl/pkg/state"
+)
+
+func testStatesSnapshot() *StatesSnapshot {
+	return &StatesSnapshot{
+		BaseInfrastructure: []byte(`{"version":4}`),
+		NodeGroups: map[string]state.NodeGroupInfrastructureState{
+			"master": {
+				State: map[string][]byte{
+					"test-master-0": []byte(`{"version":4,"serial":1}`),
+				},
+			},
+			"khm": {
+				State: map[string][]byte{
+					"test-khm-1": []byte(`{"version":4,"serial":3}`),
+					"test-khm-0": []byte(`{"version":4,"serial":2}`),
+				},
+				Settings: []byte(`{"replicas":2}`),
+			},
+		},
+	}
+}
+
+func TestStateArchive(t *testing.T) {
+	key := []byte("secret")
+	snapshot := testStatesSnapshot()
+
+	var archive bytes.Buffer
+	require.NoError(t, WriteStateArchive(&archive, key, "cluster-uuid", snapshot))
+
+	manifest, restored, err := ReadStateArchive(bytes.NewReader(archive.Bytes()), key)
+	require.NoError(t, err)
+	require.Equal(t, "cluster-uuid", manifest.ClusterUUID)
+	require.Len(t, manifest.Files, 5)
+	require.Equal(t, snapshot, restored)
+
+	require.Equal(t, []SnapshotStateInfo{
+		{Size: 13},
+		{NodeGroup: "khm", Node: "test-khm-0", Size: 24},
+		{NodeGroup: "khm", Node: "test-khm-1", Size: 24},
+		{NodeGroup: "master", Node: "test-master-0", Size: 24},
+	}, restored.States())
+
+	st, ok := restored.State("test-khm-1")
+	require.True(t, ok)
+	require.Equal(t, `{"version":4,"serial":3}`, string(st))
+
+	_, _, err = ReadStateArchive(bytes.NewReader(archive.Bytes()), []byte("another"))
+	require.ErrorIs(t, err, ErrStateArchiveSignature)
+
+	require.Error(t, WriteStateArchive(&archive, nil, "cluster-uuid", snapshot))
+}
@@ -0,0 +1,389 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	apiv1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilrand "k8s.io/apimachinery/pkg/util/rand"
+
+	dhlog "github.com/deckhouse/lib-dhctl/pkg/logger"
+	"github.com/deckhouse/lib-dhctl/pkg/retry"
+
+	"github.com/deckhouse/deckhouse/dhctl/pkg/global"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/kubernetes/actions/manifests"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/kubernetes/client"
+)
+
+const (
+	stateHistoryPrefix                = "tf-hist"
+	stateHistoryRevisionLabelKey      = "dhctl.deckhouse.io/state-history-revision"
+	stateHistoryWriteLabelKey         = "dhctl.deckhouse.io/state-history-write"
+	stateHistoryReasonAnnotationKey   = "dhctl.deckhouse.io/state-history-reason"
+	stateHistoryTimeAnnotationKey     = "dhctl.deckhouse.io/state-history-time"
+	stateHistorySourceAnnotationKey   = "dhctl.deckhouse.io/state-history-source"
+	stateHistoryRevisionIDTimeFormat  = "20060102-150405"
+	stateHistoryRevisionIDSuffixLen   = 5
+	clusterStateSecretDataKey         = "cluster-tf-state.json"
+	nodeStateSecretNamePrefix         = "d8-node-terraform-state-"
+	nodeStateSecretDataKey            = "node-tf-state.json"
+	stateWriteHistoryReason           = "write"
+	DefaultStateHistoryRevisionsLimit = 10
+)
+
+var ErrStateHistoryRevisionNotFound = errors.New("infrastructure state history revision not found")
+
+// StateHistoryRevision is the copy of infrastructure states saved in the cluster before
+// export or import of the states (all states) or before a state is rewritten (the rewritten state only).
+// Revisions are stored as secrets labeled as state backup, so they are ignored by converge.
+type StateHistoryRevision struct {
+	ID        string    `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	Reason    string    `json:"reason"`
+	States    int       `json:"states"`
+}
+
+// SaveStateHistoryRevision copies current infrastructure states of the cluster to the new revision
+// and removes the oldest revisions to keep no more than limit revisions.
+func SaveStateHistoryRevision(ctx context.Context, kubeCl *client.KubernetesClient, reason string, limit int) (*StateHistoryRevision, error) {
+	now := time.Now().UTC()
+	revision := &StateHistoryRevision{
+		ID:        newStateHistoryRevisionID(now),
+		CreatedAt: now,
+		Reason:    reason,
+	}
+
+	secrets, err := GetNodesStateSecretsFromCluster(ctx, kubeCl, "state history")
+	if err != nil {
+		return nil, err
+	}
+
+	var clusterSecret *apiv1.Secret
+	err = retry.NewLoop("Get Cluster infrastructure state secret", 45, 1*time.Second).
+		BreakIf(k8serrors.IsNotFound).
+		RunContext(ctx, func() error {
+			clusterSecret, err = kubeCl.CoreV1().Secrets(global.D8SystemNamespace).Get(ctx, manifests.InfrastructureClusterStateName, metav1.GetOptions{})
+			return err
+		})
+	switch {
+	case err == nil:
+		secrets = append([]*apiv1.Secret{clusterSecret}, secrets...)
+	case !k8serrors.IsNotFound(err):
+		return nil, err
+	}
+
+	for _, secret := range secrets {
+		if err := createStateHistorySecret(ctx, kubeCl, prepareStateHistorySecret(secret, revision)); err != nil {
+			return nil, err
+		}
+
+		revision.States++
+	}
+
+	if err := pruneStateHistory(ctx, kubeCl, limit); err != nil {
+		return nil, err
+	}
+
+	return revision, nil
+}
+
+// SaveStateWriteHistoryRevision copies the state secret to the new revision before the secret is rewritten
+// with newState and removes the oldest revisions of the secret to keep no more than limit of them.
+// Nothing is saved if the secret does not exist yet or already contains newState.
+func SaveStateWriteHistoryRevision(ctx context.Context, kubeCl *client.KubernetesClient, secretName string, newState []byte, limit int) (*StateHistoryRevision, error) {
+	var secret *apiv1.Secret
+	err := retry.NewLoop(fmt.Sprintf("Get infrastructure state %s for history", secretName), 3, 1*time.Second).
+		BreakIf(k8serrors.IsNotFound).
+		RunContext(ctx, func() error {
+			var err error
+			secret, err = kubeCl.CoreV1().Secrets(global.D8SystemNamespace).Get(ctx, secretName, metav1.GetOptions{})
+			return err
+		})
+	switch {
+	case k8serrors.IsNotFound(err):
+		return nil, nil
+	case err != nil:
+		return nil, err
+	}
+
+	if bytes.Equal(secretState(secret), newState) {
+		return nil, nil
+	}
+
+	now := time.Now().UTC()
+	revision := &StateHistoryRevision{
+		ID:        newStateHistoryRevisionID(now),
+		CreatedAt: now,
+		Reason:    stateWriteHistoryReason,
+		States:    1,
+	}
+
+	historySecret := prepareStateHistorySecret(secret, revision)
+	historySecret.Labels[stateHistoryWriteLabelKey] = "true"
+
+	if err := createStateHistorySecret(ctx, kubeCl, historySecret); err != nil {
+		return nil, err
+	}
+
+	if err := pruneStateWriteHistory(ctx, kubeCl, secretName, limit); err != nil {
+		return nil, err
+	}
+
+	return revision, nil
+}
+
+// saveStateWriteHistory is SaveStateWriteHistoryRevision for the state save path,
+// the state is saved even if the history cannot be saved, so the failure is only logged.
+func saveStateWriteHistory(ctx context.Context, kubeCl *client.KubernetesClient, secretName string, newState []byte) {
+	logger := dhlog.FromContext(ctx)
+
+	revision, err := SaveStateWriteHistoryRevision(ctx, kubeCl, secretName, newState, DefaultStateHistoryRevisionsLimit)
+	if err != nil {
+		logger.WarnContext(ctx, fmt.Sprintf("Cannot save infrastructure state %s to history: %v", secretName, err))
+		return
+	}
+
+	if revision != nil {
+		logger.DebugContext(ctx, fmt.Sprintf("Infrastructure state %s saved to history revision %s", secretName, revision.ID))
+	}
+}
+
+func secretState(secret *apiv1.Secret) []byte {
+	if st, ok := secret.Data[clusterStateSecretDataKey]; ok {
+		return st
+	}
+
+	return secret.Data[nodeStateSecretDataKey]
+}
+
+func createStateHistorySecret(ctx context.Context, kubeCl *client.KubernetesClient, historySecret *apiv1.Secret) error {
+	return retry.NewLoop(fmt.Sprintf("Save infrastructure state history %s", historySecret.Name), 45, 1*time.Second).
+		RunContext(ctx, func() error {
+			_, err := kubeCl.CoreV1().Secrets(global.D8SystemNamespace).Create(ctx, historySecret, metav1.CreateOptions{})
+			return err
+		})
+}
+
+// newStateHistoryRevisionID returns the revision id with the random suffix,
+// so revisions saved within the same second do not collide.
+func newStateHistoryRevisionID(createdAt time.Time) string {
+	return fmt.Sprintf("%s-%s", createdAt.Format(stateHistoryRevisionIDTimeFormat), utilrand.String(stateHistoryRevisionIDSuffixLen))
+}
+
+func prepareStateHistorySecret(secret *apiv1.Secret, revision *StateHistoryRevision) *apiv1.Secret {
+	historySecret := &apiv1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      stateHistorySecretName(secret.Name, revision.ID),
+			Namespace: global.D8SystemNamespace,
+			Labels:    make(map[string]string, len(secret.Labels)+2),
+			Annotations: map[string]string{
+				stateHistoryReasonAnnotationKey: revision.Reason,
+				stateHistoryTimeAnnotationKey:   revision.CreatedAt.Format(time.RFC3339Nano),
+				stateHistorySourceAnnotationKey: secret.Name,
+			},
+		},
+		Data: secret.Data,
+		Type: secret.Type,
+	}
+
+	for k, v := range secret.Labels {
+		historySecret.Labels[k] = v
+	}
+	historySecret.Labels[stateHistoryRevisionLabelKey] = revision.ID
+	historySecret.Labels[global.InfrastructureStateBackupLabelKey] = "true"
+
+	return historySecret
+}
+
+func stateHistorySecretName(sourceName, revisionID string) string {
+	if sourceName == manifests.InfrastructureClusterStateName {
+		return fmt.Sprintf("%s-%s-cluster-state", stateHistoryPrefix, revisionID)
+	}
+
+	return fmt.Sprintf("%s-%s-node-%s", stateHistoryPrefix, revisionID, strings.TrimPrefix(sourceName, nodeStateSecretNamePrefix))
+}
+
+func listStateHistorySecrets(ctx context.Context, kubeCl *client.KubernetesClient, selector string) ([]apiv1.Secret, error) {
+	var secrets []apiv1.Secret
+	err := retry.NewLoop("List infrastructure state history", 45, 1*time.Second).
+		RunContext(ctx, func() error {
+			list, err := kubeCl.CoreV1().Secrets(global.D8SystemNamespace).List(ctx, metav1.ListOptions{LabelSelector: selector})
+			if err != nil {
+				return err
+			}
+
+			secrets = list.Items
+			return nil
+		})
+
+	return secrets, err
+}
+
+// ListStateHistoryRevisions returns revisions of the infrastructure states, the newest goes first.
+func ListStateHistoryRevisions(ctx context.Context, kubeCl *client.KubernetesClient) ([]StateHistoryRevision, error) {
+	return listStateHistoryRevisions(ctx, kubeCl, stateHistoryRevisionLabelKey)
+}
+
+func listStateHistoryRevisions(ctx context.Context, kubeCl *client.KubernetesClient, selector string) ([]StateHistoryRevision, error) {
+	secrets, err := listStateHistorySecrets(ctx, kubeCl, selector)
+	if err != nil {
+		return nil, err
+	}
+
+	return groupStateHistoryRevisions(secrets), nil
+}
+
+// groupStateHistoryRevisions groups history secrets by revisions, the newest revision goes first.
+func groupStateHistoryRevisions(secrets []apiv1.Secret) []StateHistoryRevision {
+	revisions := make(map[string]*StateHistoryRevision)
+	for _, secret := range secrets {
+		id := secret.Labels[stateHistoryRevisionLabelKey]
+
+		revision, ok := revisions[id]
+		if !ok {
+			createdAt, _ := time.Parse(time.RFC3339, secret.Annotations[stateHistoryTimeAnnotationKey])
+			revision = &StateHistoryRevision{
+				ID:        id,
+				CreatedAt: createdAt,
+				Reason:    secret.Annotations[stateHistoryReasonAnnotationKey],
+			}
+			revisions[id] = revision
+		}
+
+		revision.States++
+	}
+
+	result := make([]StateHistoryRevision, 0, len(revisions))
+	for _, revision := range revisions {
+		result = append(result, *revision)
+	}
+
+	// ids have seconds precision and random suffix, so the creation time decides first
+	sort.Slice(result, func(i, j int) bool {
+		if !result[i].CreatedAt.Equal(result[j].CreatedAt) {
+			return result[i].CreatedAt.After(result[j].CreatedAt)
+		}
+		return result[i].ID > result[j].ID
+	})
+
+	return result
+}
+
+// GetStateHistoryRevision returns states saved in the revision.
+func GetStateHistoryRevision(ctx context.Context, kubeCl *client.KubernetesClient, id string) (*StatesSnapshot, error) {
+	secrets, err := listStateHistorySecrets(ctx, kubeCl, fmt.Sprintf("%s=%s", stateHistoryRevisionLabelKey, id))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(secrets) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrStateHistoryRevisionNotFound, id)
+	}
+
+	snapshot := &StatesSnapshot{}
+	nodeSecrets := make([]*apiv1.Secret, 0, len(secrets))
+	for i := range secrets {
+		if st, ok := secrets[i].Data[clusterStateSecretDataKey]; ok {
+			snapshot.BaseInfrastructure = st
+			continue
+		}
+
+		nodeSecrets = append(nodeSecrets, &secrets[i])
+	}
+
+	snapshot.NodeGroups, err = extractNodesStatesFromSecrets(nodeSecrets)
+	if err != nil {
+		return nil, err
+	}
+
+	return snapshot, nil
+}
+
+// pruneStateHistory keeps no more than limit revisions of all states,
+// revisions saved on state writes are pruned separately, see pruneStateWriteHistory.
+func pruneStateHistory(ctx context.Context, kubeCl *client.KubernetesClient, limit int) error {
+	if limit <= 0 {
+		return nil
+	}
+
+	revisions, err := listStateHistoryRevisions(ctx, kubeCl, fmt.Sprintf("%s,!%s", stateHistoryRevisionLabelKey, stateHistoryWriteLabelKey))
+	if err != nil {
+		return err
+	}
+
+	return deleteStateHistoryRevisions(ctx, kubeCl, revisions, limit)
+}
+
+// pruneStateWriteHistory keeps no more than limit revisions saved on writes of the state secret.
+func pruneStateWriteHistory(ctx context.Context, kubeCl *client.KubernetesClient, secretName string, limit int) error {
+	if limit <= 0 {
+		return nil
+	}
+
+	secrets, err := listStateHistorySecrets(ctx, kubeCl, stateHistoryWriteLabelKey)
+	if err != nil {
+		return err
+	}
+
+	sourceSecrets := make([]apiv1.Secret, 0, len(secrets))
+	for _, secret := range secrets {
+		if secret.Annotations[stateHistorySourceAnnotationKey] == secretName {
+			sourceSecrets = append(sourceSecrets, secret)
+		}
+	}
+
+	return deleteStateHistoryRevisions(ctx, kubeCl, groupStateHistoryRevisions(sourceSecrets), limit)
+}
+
+// deleteStateHistoryRevisions deletes revisions after the first limit ones, revisions are sorted from the newest.
+func deleteStateHistoryRevisions(ctx context.Context, kubeCl *client.KubernetesClient, revisions []StateHistoryRevision, limit int) error {
+	if len(revisions) <= limit {
+		return nil
+	}
+
+	for _, revision := range revisions[limit:] {
+		secrets, err := listStateHistorySecrets(ctx, kubeCl, fmt.Sprintf("%s=%s", stateHistoryRevisionLabelKey, revision.ID))
+		if err != nil {
+			return err
+		}
+
+		for _, secret := range secrets {
+			err := retry.NewLoop(fmt.Sprintf("Delete infrastructure state history %s", secret.Name), 45, 1*time.Second).
+				RunContext(ctx, func() error {
+					err := kubeCl.CoreV1().Secrets(global.D8SystemNamespace).Delete(ctx, secret.Name, metav1.DeleteOptions{})
+					if k8serrors.IsNotFound(err) {
+						return nil
+					}
+					return err
+				})
+			if err != nil {
+				return err
+			}
+		}
+
+		dhlog.FromContext(ctx).DebugContext(ctx, fmt.Sprintf("Infrastructure state history revision %s was deleted", revision.ID))
+	}
+
+	return nil
+}
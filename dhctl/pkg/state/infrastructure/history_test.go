@@ -0,0 +1,117 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/deckhouse/deckhouse/dhctl/pkg/kubernetes/client"
+)
+
+func TestStateHistory(t *testing.T) {
+	fakeClient := client.NewFakeKubernetesClient()
+
+	createSecret(t, fakeClient, cluster)
+	createSecret(t, fakeClient, master)
+	createSecret(t, fakeClient, node)
+
+	revision, err := SaveStateHistoryRevision(t.Context(), fakeClient, "export", 1)
+	require.NoError(t, err)
+	require.Equal(t, 3, revision.States)
+
+	// history secrets are state backups and must be ignored by converge
+	nodesState, err := GetNodesStateFromCluster(t.Context(), fakeClient)
+	require.NoError(t, err)
+	require.Len(t, nodesState, 2)
+	require.Len(t, nodesState["khm"].State, 1)
+
+	snapshot, err := GetStateHistoryRevision(t.Context(), fakeClient, revision.ID)
+	require.NoError(t, err)
+	require.Equal(t, []byte("secret"), snapshot.BaseInfrastructure)
+	require.Equal(t, []byte("secret"), snapshot.NodeGroups["khm"].Settings)
+	require.Equal(t, []byte("secret"), snapshot.NodeGroups["master"].State["nmit-delete-12-03-master-0"])
+
+	// revisions saved within the same second must not collide
+	newRevision, err := SaveStateHistoryRevision(t.Context(), fakeClient, "import", 1)
+	require.NoError(t, err)
+	require.NotEqual(t, revision.ID, newRevision.ID)
+
+	revisions, err := ListStateHistoryRevisions(t.Context(), fakeClient)
+	require.NoError(t, err)
+	require.Len(t, revisions, 1)
+	require.Equal(t, newRevision.ID, revisions[0].ID)
+	require.Equal(t, "import", revisions[0].Reason)
+	require.Equal(t, 3, revisions[0].States)
+
+	_, err = GetStateHistoryRevision(t.Context(), fakeClient, revision.ID)
+	require.ErrorIs(t, err, ErrStateHistoryRevisionNotFound)
+
+	_, err = fakeClient.CoreV1().Secrets("d8-system").Get(t.Context(), "tf-hist-"+newRevision.ID+"-node-nmit-delete-12-03-khm-0", metav1.GetOptions{})
+	require.NoError(t, err)
+}
+
+func TestStateWriteHistory(t *testing.T) {
+	fakeClient := client.NewFakeKubernetesClient()
+
+	createSecret(t, fakeClient, cluster)
+	createSecret(t, fakeClient, node)
+
+	full, err := SaveStateHistoryRevision(t.Context(), fakeClient, "export", 1)
+	require.NoError(t, err)
+
+	// a new state is not saved to the history
+	require.NoError(t, SaveNodeInfrastructureState(t.Context(), fakeClient, "new-node-0", "khm", []byte("new"), nil))
+
+	for _, st := range []string{"first", "second", "third"} {
+		require.NoError(t, SaveNodeInfrastructureState(t.Context(), fakeClient, "nmit-delete-12-03-khm-0", "khm", []byte(st), []byte("secret")))
+	}
+
+	// the same state is not saved twice
+	revision, err := SaveStateWriteHistoryRevision(t.Context(), fakeClient, "d8-node-terraform-state-nmit-delete-12-03-khm-0", []byte("third"), 2)
+	require.NoError(t, err)
+	require.Nil(t, revision)
+
+	revisions, err := ListStateHistoryRevisions(t.Context(), fakeClient)
+	require.NoError(t, err)
+	require.Len(t, revisions, 4)
+
+	// revisions of state writes do not evict revisions of all states
+	_, err = SaveStateHistoryRevision(t.Context(), fakeClient, "import", 2)
+	require.NoError(t, err)
+	_, err = GetStateHistoryRevision(t.Context(), fakeClient, full.ID)
+	require.NoError(t, err)
+
+	require.NoError(t, pruneStateWriteHistory(t.Context(), fakeClient, "d8-node-terraform-state-nmit-delete-12-03-khm-0", 1))
+
+	revisions, err = ListStateHistoryRevisions(t.Context(), fakeClient)
+	require.NoError(t, err)
+	require.Len(t, revisions, 3)
+
+	var writeRevision *StateHistoryRevision
+	for i := range revisions {
+		if revisions[i].Reason == stateWriteHistoryReason {
+			writeRevision = &revisions[i]
+		}
+	}
+	require.NotNil(t, writeRevision)
+	require.Equal(t, 1, writeRevision.States)
+
+	snapshot, err := GetStateHistoryRevision(t.Context(), fakeClient, writeRevision.ID)
+	require.NoError(t, err)
+	require.Equal(t, []byte("second"), snapshot.NodeGroups["khm"].State["nmit-delete-12-03-khm-0"])
+}
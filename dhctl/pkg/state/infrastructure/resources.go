@@ -0,0 +1,78 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// StateResource is an instance of the managed resource stored in the infrastructure state.
+type StateResource struct {
+	Address  string `json:"address"`
+	Type     string `json:"type"`
+	Name     string `json:"name"`
+	Provider string `json:"provider"`
+	ID       string `json:"id,omitempty"`
+}
+
+// ListStateResources returns every instance of managed resources of the state
+// in the order of the state. Data sources are skipped because they are not destroyed.
+func ListStateResources(st []byte) ([]StateResource, error) {
+	if len(st) == 0 {
+		return nil, nil
+	}
+
+	var parsed stateWithInstances
+	if err := json.Unmarshal(st, &parsed); err != nil {
+		return nil, fmt.Errorf("cannot parse state: %w", err)
+	}
+
+	resources := make([]StateResource, 0)
+	for _, resource := range parsed.Resources {
+		if resource.Mode == "data" {
+			continue
+		}
+
+		address := resource.Type + "." + resource.Name
+		if resource.Module != "" {
+			address = resource.Module + "." + address
+		}
+
+		for _, instance := range resource.Instances {
+			r := StateResource{
+				Address:  address,
+				Type:     resource.Type,
+				Name:     resource.Name,
+				Provider: stateProviderAddress(resource.Provider),
+			}
+
+			// index_key is a number for count and a quoted string for for_each,
+			// both are rendered as is in the address: res[0] or res["key"]
+			if len(instance.IndexKey) > 0 && string(instance.IndexKey) != "null" {
+				r.Address = fmt.Sprintf("%s[%s]", address, instance.IndexKey)
+			}
+
+			var id string
+			if err := json.Unmarshal(instance.Attributes["id"], &id); err == nil {
+				r.ID = id
+			}
+
+			resources = append(resources, r)
+		}
+	}
+
+	return resources, nil
+}
@@ -0,0 +1,100 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestListStateResources(t *testing.T) {
+	st := []byte(`{
+  "version": 4,
+  "resources": [
+    {
+      "mode": "data",
+      "type": "yandex_compute_image",
+      "name": "image",
+      "provider": "provider[\"registry.opentofu.org/yandex-cloud/yandex\"]",
+      "instances": [{"attributes": {"id": "fd8image"}}]
+    },
+    {
+      "module": "module.vpc_components",
+      "mode": "managed",
+      "type": "yandex_vpc_network",
+      "name": "kube",
+      "provider": "provider[\"registry.opentofu.org/yandex-cloud/yandex\"]",
+      "instances": [{"attributes": {"id": "enp-network"}}]
+    },
+    {
+      "mode": "managed",
+      "type": "yandex_vpc_subnet",
+      "name": "kube",
+      "provider": "provider[\"registry.opentofu.org/yandex-cloud/yandex\"]",
+      "instances": [
+        {"index_key": 0, "attributes": {"id": "e9b-a"}},
+        {"index_key": 1, "attributes": {"id": "e2l-b"}}
+      ]
+    },
+    {
+      "mode": "managed",
+      "type": "yandex_vpc_route_table",
+      "name": "nat",
+      "provider": "provider[\"registry.opentofu.org/yandex-cloud/yandex\"]",
+      "instances": [{"index_key": "ru-central1-a", "attributes": {"name": "nat"}}]
+    }
+  ]
+}`)
+
+	resources, err := ListStateResources(st)
+	require.NoError(t, err)
+	require.Equal(t, []StateResource{
+		{
+			Address:  "module.vpc_components.yandex_vpc_network.kube",
+			Type:     "yandex_vpc_network",
+			Name:     "kube",
+			Provider: "registry.opentofu.org/yandex-cloud/yandex",
+			ID:       "enp-network",
+		},
+		{
+			Address:  "yandex_vpc_subnet.kube[0]",
+			Type:     "yandex_vpc_subnet",
+			Name:     "kube",
+			Provider: "registry.opentofu.org/yandex-cloud/yandex",
+			ID:       "e9b-a",
+		},
+		{
+			Address:  "yandex_vpc_subnet.kube[1]",
+			Type:     "yandex_vpc_subnet",
+			Name:     "kube",
+			Provider: "registry.opentofu.org/yandex-cloud/yandex",
+			ID:       "e2l-b",
+		},
+		{
+			Address:  `yandex_vpc_route_table.nat["ru-central1-a"]`,
+			Type:     "yandex_vpc_route_table",
+			Name:     "nat",
+			Provider: "registry.opentofu.org/yandex-cloud/yandex",
+		},
+	}, resources)
+
+	resources, err = ListStateResources(nil)
+	require.NoError(t, err)
+	require.Empty(t, resources)
+
+	_, err = ListStateResources([]byte("{"))
+	require.Error(t, err)
+}
@@ -0,0 +1,137 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const supportedStateFormatVersion = 4
+
+// ProvidersSchema is the output of "providers schema -json" command of the infrastructure utility.
+type ProvidersSchema struct {
+	ProviderSchemas map[string]ProviderSchema `json:"provider_schemas"`
+}
+
+type ProviderSchema struct {
+	ResourceSchemas   map[string]ResourceSchema `json:"resource_schemas"`
+	DataSourceSchemas map[string]ResourceSchema `json:"data_source_schemas"`
+}
+
+type ResourceSchema struct {
+	Block SchemaBlock `json:"block"`
+}
+
+type SchemaBlock struct {
+	Attributes map[string]json.RawMessage `json:"attributes"`
+	BlockTypes map[string]json.RawMessage `json:"block_types"`
+}
+
+type stateWithInstances struct {
+	Version   int                     `json:"version"`
+	Resources []resourceWithInstances `json:"resources"`
+}
+
+type resourceWithInstances struct {
+	Module    string `json:"module"`
+	Mode      string `json:"mode"`
+	Type      string `json:"type"`
+	Name      string `json:"name"`
+	Provider  string `json:"provider"`
+	Instances []struct {
+		IndexKey   json.RawMessage            `json:"index_key"`
+		Attributes map[string]json.RawMessage `json:"attributes"`
+	} `json:"instances"`
+}
+
+func ParseProvidersSchema(content []byte) (*ProvidersSchema, error) {
+	var schema ProvidersSchema
+	if err := json.Unmarshal(content, &schema); err != nil {
+		return nil, fmt.Errorf("cannot parse providers schema: %w", err)
+	}
+
+	return &schema, nil
+}
+
+// ValidateState checks that the state has supported format and every resource of the state
+// with its attributes is described by the providers schema.
+func (s *ProvidersSchema) ValidateState(st []byte) error {
+	if len(st) == 0 {
+		return fmt.Errorf("state is empty")
+	}
+
+	var parsed stateWithInstances
+	if err := json.Unmarshal(st, &parsed); err != nil {
+		return fmt.Errorf("cannot parse state: %w", err)
+	}
+
+	if parsed.Version != supportedStateFormatVersion {
+		return fmt.Errorf("unsupported state format version %d", parsed.Version)
+	}
+
+	var errs []error
+	for _, resource := range parsed.Resources {
+		if err := s.validateResource(resource); err != nil {
+			errs = append(errs, fmt.Errorf("%s.%s: %w", resource.Type, resource.Name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (s *ProvidersSchema) validateResource(resource resourceWithInstances) error {
+	providerAddress := stateProviderAddress(resource.Provider)
+
+	provider, ok := s.ProviderSchemas[providerAddress]
+	if !ok {
+		return fmt.Errorf("unknown provider %q", providerAddress)
+	}
+
+	schemas := provider.ResourceSchemas
+	if resource.Mode == "data" {
+		schemas = provider.DataSourceSchemas
+	}
+
+	schema, ok := schemas[resource.Type]
+	if !ok {
+		return fmt.Errorf("resource type is not supported by provider %q", providerAddress)
+	}
+
+	for _, instance := range resource.Instances {
+		for attribute := range instance.Attributes {
+			_, isAttribute := schema.Block.Attributes[attribute]
+			_, isBlock := schema.Block.BlockTypes[attribute]
+			if !isAttribute && !isBlock {
+				return fmt.Errorf("unknown attribute %q", attribute)
+			}
+		}
+	}
+
+	return nil
+}
+
+// stateProviderAddress converts provider["registry.opentofu.org/yandex-cloud/yandex"].alias
+// to registry.opentofu.org/yandex-cloud/yandex.
+func stateProviderAddress(provider string) string {
+	address := strings.TrimPrefix(provider, `provider["`)
+	if i := strings.Index(address, `"]`); i >= 0 {
+		address = address[:i]
+	}
+
+	return address
+}
@@ -0,0 +1,89 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testProvidersSchema = `{
+  "format_version": "1.0",
+  "provider_schemas": {
+    "registry.opentofu.org/yandex-cloud/yandex": {
+      "resource_schemas": {
+        "yandex_compute_instance": {
+          "block": {
+            "attributes": {"id": {"type": "string"}, "name": {"type": "string"}},
+            "block_types": {"boot_disk": {"nesting_mode": "list"}}
+          }
+        }
+      },
+      "data_source_schemas": {
+        "yandex_compute_image": {
+          "block": {"attributes": {"id": {"type": "string"}}}
+        }
+      }
+    }
+  }
+}`
+
+func TestProvidersSchemaValidateState(t *testing.T) {
+	schema, err := ParseProvidersSchema([]byte(testProvidersSchema))
+	require.NoError(t, err)
+
+	require.NoError(t, schema.ValidateState([]byte(`{
+  "version": 4,
+  "resources": [
+    {
+      "mode": "managed", "type": "yandex_compute_instance", "name": "master",
+      "provider": "provider[\"registry.opentofu.org/yandex-cloud/yandex\"]",
+      "instances": [{"attributes": {"id": "1", "name": "master", "boot_disk": []}}]
+    },
+    {
+      "mode": "data", "type": "yandex_compute_image", "name": "image",
+      "provider": "provider[\"registry.opentofu.org/yandex-cloud/yandex\"].alias",
+      "instances": [{"attributes": {"id": "2"}}]
+    }
+  ]
+}`)))
+
+	err = schema.ValidateState([]byte(`{
+  "version": 4,
+  "resources": [
+    {
+      "mode": "managed", "type": "yandex_compute_instance", "name": "master",
+      "provider": "provider[\"registry.opentofu.org/yandex-cloud/yandex\"]",
+      "instances": [{"attributes": {"id": "1", "cores": 4}}]
+    },
+    {
+      "mode": "managed", "type": "yandex_compute_disk", "name": "disk",
+      "provider": "provider[\"registry.opentofu.org/yandex-cloud/yandex\"]"
+    },
+    {
+      "mode": "managed", "type": "aws_instance", "name": "node",
+      "provider": "provider[\"registry.opentofu.org/hashicorp/aws\"]"
+    }
+  ]
+}`))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), `yandex_compute_instance.master: unknown attribute "cores"`)
+	require.Contains(t, err.Error(), `yandex_compute_disk.disk: resource type is not supported`)
+	require.Contains(t, err.Error(), `aws_instance.node: unknown provider "registry.opentofu.org/hashicorp/aws"`)
+
+	require.ErrorContains(t, schema.ValidateState([]byte(`{"version": 3}`)), "unsupported state format version 3")
+	require.ErrorContains(t, schema.ValidateState(nil), "state is empty")
+}
@@ -0,0 +1,173 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/deckhouse/lib-dhctl/pkg/retry"
+
+	"github.com/deckhouse/deckhouse/dhctl/pkg/kubernetes/actions"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/kubernetes/actions/manifests"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/kubernetes/client"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/state"
+)
+
+// StatesSnapshot is the copy of all infrastructure states of the cluster.
+type StatesSnapshot struct {
+	BaseInfrastructure []byte
+	NodeGroups         map[string]state.NodeGroupInfrastructureState
+}
+
+type SnapshotStateInfo struct {
+	NodeGroup string `json:"node_group,omitempty"`
+	Node      string `json:"node,omitempty"`
+	Size      int    `json:"size"`
+}
+
+// Name returns name of the state used in the commands: base-infrastructure or node name.
+func (i SnapshotStateInfo) Name() string {
+	if i.Node == "" {
+		return baseInfrastructureStateName
+	}
+
+	return i.Node
+}
+
+const baseInfrastructureStateName = "base-infrastructure"
+
+// States returns information about every state in the snapshot, base infrastructure state goes first.
+func (s *StatesSnapshot) States() []SnapshotStateInfo {
+	infos := make([]SnapshotStateInfo, 0)
+	if len(s.BaseInfrastructure) > 0 {
+		infos = append(infos, SnapshotStateInfo{Size: len(s.BaseInfrastructure)})
+	}
+
+	nodeGroups := make([]string, 0, len(s.NodeGroups))
+	for nodeGroup := range s.NodeGroups {
+		nodeGroups = append(nodeGroups, nodeGroup)
+	}
+	sort.Strings(nodeGroups)
+
+	for _, nodeGroup := range nodeGroups {
+		nodes := make([]string, 0, len(s.NodeGroups[nodeGroup].State))
+		for node := range s.NodeGroups[nodeGroup].State {
+			nodes = append(nodes, node)
+		}
+		sort.Strings(nodes)
+
+		for _, node := range nodes {
+			infos = append(infos, SnapshotStateInfo{
+				NodeGroup: nodeGroup,
+				Node:      node,
+				Size:      len(s.NodeGroups[nodeGroup].State[node]),
+			})
+		}
+	}
+
+	return infos
+}
+
+// State returns state by name, see SnapshotStateInfo.Name.
+func (s *StatesSnapshot) State(name string) ([]byte, bool) {
+	if name == baseInfrastructureStateName {
+		return s.BaseInfrastructure, len(s.BaseInfrastructure) > 0
+	}
+
+	for _, nodeGroup := range s.NodeGroups {
+		if st, ok := nodeGroup.State[name]; ok {
+			return st, true
+		}
+	}
+
+	return nil, false
+}
+
+func GetStatesSnapshotFromCluster(ctx context.Context, kubeCl *client.KubernetesClient) (*StatesSnapshot, error) {
+	baseState, err := GetClusterStateFromCluster(ctx, kubeCl)
+	if err != nil {
+		return nil, err
+	}
+
+	nodesState, err := GetNodesStateFromCluster(ctx, kubeCl)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StatesSnapshot{
+		BaseInfrastructure: baseState,
+		NodeGroups:         nodesState,
+	}, nil
+}
+
+// SaveStatesSnapshotToCluster rewrites states of the cluster with states from the snapshot.
+// States of the cluster which are absent in the snapshot are not deleted.
+func SaveStatesSnapshotToCluster(ctx context.Context, kubeCl *client.KubernetesClient, snapshot *StatesSnapshot) error {
+	if len(snapshot.BaseInfrastructure) > 0 {
+		if err := saveBaseInfrastructureState(ctx, kubeCl, snapshot.BaseInfrastructure); err != nil {
+			return err
+		}
+	}
+
+	for _, info := range snapshot.States() {
+		if info.Node == "" {
+			continue
+		}
+
+		nodeGroup := snapshot.NodeGroups[info.NodeGroup]
+		err := SaveNodeInfrastructureState(ctx, kubeCl, info.Node, info.NodeGroup, nodeGroup.State[info.Node], nodeGroup.Settings)
+		if err != nil {
+			return fmt.Errorf("cannot save infrastructure state for node %s: %w", info.Node, err)
+		}
+	}
+
+	return nil
+}
+
+func saveBaseInfrastructureState(ctx context.Context, kubeCl *client.KubernetesClient, st []byte) error {
+	task := actions.ManifestTask{
+		Name:     `Secret "d8-cluster-terraform-state"`,
+		Manifest: func() any { return manifests.SecretWithInfrastructureState(st) },
+		CreateFunc: func(ctx context.Context, manifest any) error {
+			_, err := kubeCl.
+				CoreV1().Secrets("d8-system").
+				Create(ctx, manifest.(*v1.Secret), metav1.CreateOptions{})
+
+			return err
+		},
+		UpdateFunc: func(ctx context.Context, manifest any) error {
+			_, err := kubeCl.
+				CoreV1().Secrets("d8-system").
+				Update(ctx, manifest.(*v1.Secret), metav1.UpdateOptions{})
+
+			return err
+		},
+	}
+
+	loopParams := retry.NewEmptyParams(
+		retry.WithName("Save Cluster infrastructure state"),
+		retry.WithAttempts(45),
+		retry.WithWait(1*time.Second),
+		retry.WithWhitelist(actions.ErrManifestTaskTransient),
+	)
+
+	return retry.NewLoopWithParams(loopParams).RunContext(ctx, func() error { return task.CreateOrUpdate(ctx) })
+}
@@ -298,6 +298,8 @@ func SaveNodeInfrastructureState(
 		return ErrNoInfrastructureState
 	}
 
+	saveStateWriteHistory(ctx, kubeCl, manifests.SecretNameForNodeInfrastructureState(nodeName), tfState)
+
 	task := actions.ManifestTask{
 		Name: fmt.Sprintf(`Secret "d8-node-terraform-state-%s"`, nodeName),
 		Manifest: func() any {
@@ -333,6 +335,8 @@ func SaveMasterNodeInfrastructureState(ctx context.Context, kubeCl *client.Kuber
 		return ErrNoInfrastructureState
 	}
 
+	saveStateWriteHistory(ctx, kubeCl, manifests.SecretNameForNodeInfrastructureState(nodeName), tfState)
+
 	getInfrastructureStateManifest := func() any {
 		return manifests.SecretWithNodeInfrastructureState(nodeName, global.MasterNodeGroupName, tfState, nil)
 	}
@@ -419,6 +423,8 @@ func SaveClusterInfrastructureState(ctx context.Context, kubeCl *client.Kubernet
 		return ErrNoInfrastructureState
 	}
 
+	saveStateWriteHistory(ctx, kubeCl, manifests.InfrastructureClusterStateName, outputs.InfrastructureState)
+
 	task := actions.ManifestTask{
 		Name:     `Secret "d8-cluster-terraform-state"`,
 		Manifest: func() any { return manifests.SecretWithInfrastructureState(outputs.InfrastructureState) },
@@ -568,7 +574,12 @@ func DeleteInfrastructureState(ctx context.Context, kubeProvider kubernetes.Kube
 				return err
 			}
 
-			return kubeCl.CoreV1().Secrets("d8-system").Delete(ctx, secretName, metav1.DeleteOptions{})
+			err = kubeCl.CoreV1().Secrets("d8-system").Delete(ctx, secretName, metav1.DeleteOptions{})
+			if k8errors.IsNotFound(err) {
+				// Secret has already been deleted
+				return nil
+			}
+			return err
 		})
 }
 
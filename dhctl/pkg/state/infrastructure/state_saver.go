@@ -17,6 +17,7 @@ package infrastructure
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	apiv1 "k8s.io/api/core/v1"
@@ -55,6 +56,8 @@ var (
 
 type ClusterStateSaver struct {
 	getter kubernetes.KubeClientProviderWithCtx
+	// history is saved once, before the first intermediate state overwrites the state of the previous run
+	historyOnce sync.Once
 }
 
 func NewClusterStateSaver(getter kubernetes.KubeClientProviderWithCtx) *ClusterStateSaver {
@@ -68,6 +71,15 @@ func (s *ClusterStateSaver) SaveState(ctx context.Context, outputs *infrastructu
 		return nil
 	}
 
+	s.historyOnce.Do(func() {
+		kubeClient, err := s.getter.KubeClientCtx(ctx)
+		if err != nil {
+			dhlog.FromContext(ctx).WarnContext(ctx, fmt.Sprintf("Cannot save infrastructure state to history: could not get kube client: %v", err))
+			return
+		}
+		saveStateWriteHistory(ctx, kubeClient, manifests.InfrastructureClusterStateName, outputs.InfrastructureState)
+	})
+
 	task := actions.ManifestTask{
 		Name: `Secret "d8-cluster-terraform-state"`,
 		PatchData: func() any {
@@ -120,6 +132,8 @@ type NodeStateSaver struct {
 	nodeName          string
 	nodeGroup         string
 	nodeGroupSettings []byte
+	// history is saved once, before the first intermediate state overwrites the state of the previous run
+	historyOnce sync.Once
 }
 
 func NewNodeStateSaver(getter kubernetes.KubeClientProviderWithCtx, nodeName, nodeGroup string, nodeGroupSettings []byte) *NodeStateSaver {
@@ -148,6 +162,10 @@ func (s *NodeStateSaver) SaveState(ctx context.Context, outputs *infrastructure.
 		return fmt.Errorf("Could not get kube client: %w", err)
 	}
 
+	s.historyOnce.Do(func() {
+		saveStateWriteHistory(ctx, kubeClient, manifests.SecretNameForNodeInfrastructureState(s.nodeName), outputs.InfrastructureState)
+	})
+
 	task := actions.ManifestTask{
 		Name: fmt.Sprintf(`Secret "d8-node-terraform-state-%s"`, s.nodeName),
 		Manifest: func() any {
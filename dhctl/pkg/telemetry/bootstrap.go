@@ -37,8 +37,7 @@ import (
 type ShutdownFunc func(ctx context.Context) error
 
 func Bootstrap(ctx context.Context) error {
-	traceValue, ok := os.LookupEnv("DHCTL_TRACE")
-	if !ok || traceValue == "" || traceValue == "0" || traceValue == "no" {
+	if !IsEnabled() {
 		return nil
 	}
 
@@ -49,7 +48,9 @@ func Bootstrap(ctx context.Context) error {
 		err             error
 	)
 
-	if _, ok := os.LookupEnv("OTEL_EXPORTER_OTLP_ENDPOINT"); ok {
+	if path := traceFile(); path != "" {
+		tracesExporter, logsExporter, err = configureFileExporter(path)
+	} else if _, ok := os.LookupEnv("OTEL_EXPORTER_OTLP_ENDPOINT"); ok {
 		tracesExporter, metricsExporter, logsExporter, err = configureRemoteExporter(ctx)
 	} else {
 		tracesExporter, metricsExporter, logsExporter, err = configureLocalExporter(ctx)
@@ -87,9 +88,12 @@ func Bootstrap(ctx context.Context) error {
 
 	tracesShutdown := initTraces(tracesExporter, otelResource)
 
-	metricsShutdown, err := initMetrics(metricsExporter, otelResource)
-	if err != nil {
-		return fmt.Errorf("failed to init metrics: %w", err)
+	var metricsShutdown ShutdownFunc = func(context.Context) error { return nil }
+	if metricsExporter != nil {
+		metricsShutdown, err = initMetrics(metricsExporter, otelResource)
+		if err != nil {
+			return fmt.Errorf("failed to init metrics: %w", err)
+		}
 	}
 
 	logsShutdown := initLogs(logsExporter, otelResource)
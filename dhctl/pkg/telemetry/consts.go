@@ -16,4 +16,9 @@ package telemetry
 
 const (
 	traceApplicationName = "dhctl"
+
+	// BashibleTracerName is the instrumentation scope of the spans relayed from bashible on nodes.
+	BashibleTracerName = "bashible"
+	// PhaseAttribute marks spans that cover the whole operation phase.
+	PhaseAttribute = "dhctl.phase"
 )
@@ -16,8 +16,20 @@ package telemetry
 
 import "os"
 
-// IsEnabled returns true if telemetry is enabled via the DHCTL_TRACE environment variable.
+// traceFileEnv sets the path of the OTLP JSON file to write spans and log records to.
+const traceFileEnv = "DHCTL_TRACE_FILE"
+
+// IsEnabled returns true if telemetry is enabled via the DHCTL_TRACE environment variable
+// or the trace file is set via the DHCTL_TRACE_FILE environment variable.
 func IsEnabled() bool {
+	if traceFile() != "" {
+		return true
+	}
+
 	traceValue, ok := os.LookupEnv("DHCTL_TRACE")
 	return ok && traceValue != "" && traceValue != "0" && traceValue != "no"
 }
+
+func traceFile() string {
+	return os.Getenv(traceFileEnv)
+}
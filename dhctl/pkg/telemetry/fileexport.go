@@ -0,0 +1,141 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package telemetry
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+
+	"github.com/deckhouse/deckhouse/dhctl/pkg/telemetry/otlpjson"
+)
+
+var errFileExporterClosed = errors.New("OTLP JSON file exporter is closed")
+
+// otlpFile appends OTLP JSON export requests to the file, one request per line.
+// It is shared by the span and the log exporter and is closed after both are shut down.
+type otlpFile struct {
+	mu   sync.Mutex
+	file *os.File
+	refs int
+}
+
+func newOTLPFile(path string) (*otlpFile, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create directory for %q: %w", path, err)
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %q: %w", path, err)
+	}
+
+	return &otlpFile{file: file, refs: 2}, nil
+}
+
+func (f *otlpFile) write(request any) error {
+	content, err := json.Marshal(request)
+	if err != nil {
+		return fmt.Errorf("failed to encode OTLP JSON: %w", err)
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.file == nil {
+		return errFileExporterClosed
+	}
+
+	_, err = f.file.Write(append(content, '\n'))
+	return err
+}
+
+func (f *otlpFile) sync() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.file == nil {
+		return nil
+	}
+
+	return f.file.Sync()
+}
+
+func (f *otlpFile) release() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.refs--
+	if f.refs > 0 || f.file == nil {
+		return nil
+	}
+
+	err := f.file.Close()
+	f.file = nil
+	return err
+}
+
+type fileSpanExporter struct {
+	file *otlpFile
+}
+
+func (e *fileSpanExporter) ExportSpans(_ context.Context, spans []sdktrace.ReadOnlySpan) error {
+	if len(spans) == 0 {
+		return nil
+	}
+	return e.file.write(otlpjson.FromSpans(spans))
+}
+
+func (e *fileSpanExporter) Shutdown(_ context.Context) error {
+	return e.file.release()
+}
+
+type fileLogExporter struct {
+	file *otlpFile
+}
+
+func (e *fileLogExporter) Export(_ context.Context, records []sdklog.Record) error {
+	if len(records) == 0 {
+		return nil
+	}
+	return e.file.write(otlpjson.FromRecords(records))
+}
+
+func (e *fileLogExporter) ForceFlush(_ context.Context) error {
+	return e.file.sync()
+}
+
+func (e *fileLogExporter) Shutdown(_ context.Context) error {
+	return e.file.release()
+}
+
+// configureFileExporter writes spans and log records to the local file in the OTLP JSON format,
+// so traces can be analyzed without a collector, e.g. with "dhctl telemetry summarize".
+// Metrics are not written to the file.
+func configureFileExporter(path string) (sdktrace.SpanExporter, sdklog.Exporter, error) {
+	file, err := newOTLPFile(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to initialize OTLP JSON file exporter: %w", err)
+	}
+
+	return &fileSpanExporter{file: file}, &fileLogExporter{file: file}, nil
+}
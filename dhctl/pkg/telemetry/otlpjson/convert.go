@@ -0,0 +1,275 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlpjson
+
+import (
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// FromSpans converts finished spans to OTLP JSON traces grouped by resource and instrumentation scope.
+func FromSpans(spans []sdktrace.ReadOnlySpan) TracesData {
+	res := TracesData{}
+	resourceIndex := make(map[attribute.Distinct]int)
+	scopeIndex := make(map[attribute.Distinct]map[instrumentation.Scope]int)
+
+	for _, s := range spans {
+		key := resourceKey(s.Resource())
+		ri, ok := resourceIndex[key]
+		if !ok {
+			ri = len(res.ResourceSpans)
+			resourceIndex[key] = ri
+			scopeIndex[key] = make(map[instrumentation.Scope]int)
+			res.ResourceSpans = append(res.ResourceSpans, ResourceSpans{
+				Resource:  fromResource(s.Resource()),
+				SchemaURL: resourceSchemaURL(s.Resource()),
+			})
+		}
+
+		scope := scopeKey(s.InstrumentationScope())
+		si, ok := scopeIndex[key][scope]
+		if !ok {
+			si = len(res.ResourceSpans[ri].ScopeSpans)
+			scopeIndex[key][scope] = si
+			res.ResourceSpans[ri].ScopeSpans = append(res.ResourceSpans[ri].ScopeSpans, ScopeSpans{
+				Scope: Scope{Name: scope.Name, Version: scope.Version},
+			})
+		}
+
+		scopeSpans := &res.ResourceSpans[ri].ScopeSpans[si]
+		scopeSpans.Spans = append(scopeSpans.Spans, fromSpan(s))
+	}
+
+	return res
+}
+
+func fromSpan(s sdktrace.ReadOnlySpan) Span {
+	span := Span{
+		TraceID:           s.SpanContext().TraceID().String(),
+		SpanID:            s.SpanContext().SpanID().String(),
+		Name:              s.Name(),
+		Kind:              int(s.SpanKind()),
+		StartTimeUnixNano: UnixNano(s.StartTime()),
+		EndTimeUnixNano:   UnixNano(s.EndTime()),
+		Attributes:        FromAttributes(s.Attributes()),
+		Status:            Status{Message: s.Status().Description},
+	}
+
+	if s.Parent().SpanID().IsValid() {
+		span.ParentSpanID = s.Parent().SpanID().String()
+	}
+
+	// codes.Code values differ from the OTLP ones
+	switch s.Status().Code {
+	case codes.Ok:
+		span.Status.Code = StatusCodeOk
+	case codes.Error:
+		span.Status.Code = StatusCodeError
+	}
+
+	for _, e := range s.Events() {
+		span.Events = append(span.Events, SpanEvent{
+			TimeUnixNano: UnixNano(e.Time),
+			Name:         e.Name,
+			Attributes:   FromAttributes(e.Attributes),
+		})
+	}
+
+	return span
+}
+
+// FromRecords converts log records to OTLP JSON logs grouped by resource and instrumentation scope.
+func FromRecords(records []sdklog.Record) LogsData {
+	res := LogsData{}
+	resourceIndex := make(map[attribute.Distinct]int)
+	scopeIndex := make(map[attribute.Distinct]map[instrumentation.Scope]int)
+
+	for i := range records {
+		r := &records[i]
+
+		key := resourceKey(r.Resource())
+		ri, ok := resourceIndex[key]
+		if !ok {
+			ri = len(res.ResourceLogs)
+			resourceIndex[key] = ri
+			scopeIndex[key] = make(map[instrumentation.Scope]int)
+			res.ResourceLogs = append(res.ResourceLogs, ResourceLogs{
+				Resource:  fromResource(r.Resource()),
+				SchemaURL: resourceSchemaURL(r.Resource()),
+			})
+		}
+
+		scope := scopeKey(r.InstrumentationScope())
+		si, ok := scopeIndex[key][scope]
+		if !ok {
+			si = len(res.ResourceLogs[ri].ScopeLogs)
+			scopeIndex[key][scope] = si
+			res.ResourceLogs[ri].ScopeLogs = append(res.ResourceLogs[ri].ScopeLogs, ScopeLogs{
+				Scope: Scope{Name: scope.Name, Version: scope.Version},
+			})
+		}
+
+		scopeLogs := &res.ResourceLogs[ri].ScopeLogs[si]
+		scopeLogs.LogRecords = append(scopeLogs.LogRecords, fromRecord(r))
+	}
+
+	return res
+}
+
+func fromRecord(r *sdklog.Record) LogRecord {
+	record := LogRecord{
+		TimeUnixNano:         UnixNano(r.Timestamp()),
+		ObservedTimeUnixNano: UnixNano(r.ObservedTimestamp()),
+		SeverityNumber:       int(r.Severity()),
+		SeverityText:         r.SeverityText(),
+		EventName:            r.EventName(),
+	}
+
+	if !r.Body().Empty() {
+		body := fromLogValue(r.Body())
+		record.Body = &body
+	}
+
+	r.WalkAttributes(func(kv log.KeyValue) bool {
+		record.Attributes = append(record.Attributes, KeyValue{Key: kv.Key, Value: fromLogValue(kv.Value)})
+		return true
+	})
+
+	if r.TraceID().IsValid() {
+		record.TraceID = r.TraceID().String()
+	}
+	if r.SpanID().IsValid() {
+		record.SpanID = r.SpanID().String()
+	}
+
+	return record
+}
+
+func FromAttributes(attrs []attribute.KeyValue) Attributes {
+	if len(attrs) == 0 {
+		return nil
+	}
+
+	res := make(Attributes, 0, len(attrs))
+	for _, kv := range attrs {
+		res = append(res, KeyValue{Key: string(kv.Key), Value: fromAttributeValue(kv.Value)})
+	}
+	return res
+}
+
+func fromAttributeValue(v attribute.Value) AnyValue {
+	switch v.Type() {
+	case attribute.BOOL:
+		b := v.AsBool()
+		return AnyValue{BoolValue: &b}
+	case attribute.INT64:
+		i := Int64(v.AsInt64())
+		return AnyValue{IntValue: &i}
+	case attribute.FLOAT64:
+		f := v.AsFloat64()
+		return AnyValue{DoubleValue: &f}
+	case attribute.STRING:
+		return StringValue(v.AsString())
+	case attribute.BOOLSLICE:
+		values := make([]AnyValue, 0)
+		for _, b := range v.AsBoolSlice() {
+			values = append(values, fromAttributeValue(attribute.BoolValue(b)))
+		}
+		return AnyValue{ArrayValue: &ArrayValue{Values: values}}
+	case attribute.INT64SLICE:
+		values := make([]AnyValue, 0)
+		for _, i := range v.AsInt64Slice() {
+			values = append(values, fromAttributeValue(attribute.Int64Value(i)))
+		}
+		return AnyValue{ArrayValue: &ArrayValue{Values: values}}
+	case attribute.FLOAT64SLICE:
+		values := make([]AnyValue, 0)
+		for _, f := range v.AsFloat64Slice() {
+			values = append(values, fromAttributeValue(attribute.Float64Value(f)))
+		}
+		return AnyValue{ArrayValue: &ArrayValue{Values: values}}
+	case attribute.STRINGSLICE:
+		values := make([]AnyValue, 0)
+		for _, s := range v.AsStringSlice() {
+			values = append(values, StringValue(s))
+		}
+		return AnyValue{ArrayValue: &ArrayValue{Values: values}}
+	}
+
+	return StringValue(v.Emit())
+}
+
+func fromLogValue(v log.Value) AnyValue {
+	switch v.Kind() {
+	case log.KindBool:
+		b := v.AsBool()
+		return AnyValue{BoolValue: &b}
+	case log.KindInt64:
+		i := Int64(v.AsInt64())
+		return AnyValue{IntValue: &i}
+	case log.KindFloat64:
+		f := v.AsFloat64()
+		return AnyValue{DoubleValue: &f}
+	case log.KindString:
+		return StringValue(v.AsString())
+	case log.KindBytes:
+		return AnyValue{BytesValue: v.AsBytes()}
+	case log.KindSlice:
+		values := make([]AnyValue, 0, len(v.AsSlice()))
+		for _, item := range v.AsSlice() {
+			values = append(values, fromLogValue(item))
+		}
+		return AnyValue{ArrayValue: &ArrayValue{Values: values}}
+	case log.KindMap:
+		values := make([]KeyValue, 0, len(v.AsMap()))
+		for _, kv := range v.AsMap() {
+			values = append(values, KeyValue{Key: kv.Key, Value: fromLogValue(kv.Value)})
+		}
+		return AnyValue{KvlistValue: &KeyValueList{Values: values}}
+	}
+
+	return AnyValue{}
+}
+
+func fromResource(r *resource.Resource) Resource {
+	if r == nil {
+		return Resource{}
+	}
+	return Resource{Attributes: FromAttributes(r.Attributes())}
+}
+
+func resourceKey(r *resource.Resource) attribute.Distinct {
+	if r == nil {
+		return attribute.EmptySet().Equivalent()
+	}
+	return r.Equivalent()
+}
+
+func resourceSchemaURL(r *resource.Resource) string {
+	if r == nil {
+		return ""
+	}
+	return r.SchemaURL()
+}
+
+// scopeKey drops scope attributes, which are not comparable and are not written anyway.
+func scopeKey(s instrumentation.Scope) instrumentation.Scope {
+	return instrumentation.Scope{Name: s.Name, Version: s.Version, SchemaURL: s.SchemaURL}
+}
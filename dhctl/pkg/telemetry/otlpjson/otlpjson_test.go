@@ -0,0 +1,121 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlpjson
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/log"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type recordsExporter struct {
+	records []sdklog.Record
+}
+
+func (e *recordsExporter) Export(_ context.Context, records []sdklog.Record) error {
+	for _, r := range records {
+		e.records = append(e.records, r.Clone())
+	}
+	return nil
+}
+
+func (e *recordsExporter) Shutdown(context.Context) error   { return nil }
+func (e *recordsExporter) ForceFlush(context.Context) error { return nil }
+
+func TestRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	start := time.Unix(1700000000, 0)
+
+	spanRecorder := tracetest.NewSpanRecorder()
+	tracerProvider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spanRecorder))
+
+	logsExporter := &recordsExporter{}
+	loggerProvider := sdklog.NewLoggerProvider(sdklog.WithProcessor(sdklog.NewSimpleProcessor(logsExporter)))
+
+	ctx, parent := tracerProvider.Tracer("dhctl").Start(ctx, "bootstrap", trace.WithTimestamp(start))
+	_, child := tracerProvider.Tracer("bashible").Start(ctx, "001_install_packages.sh",
+		trace.WithTimestamp(start.Add(time.Second)),
+		trace.WithAttributes(attribute.String("node", "master-0"), attribute.Int("attempt", 2)),
+	)
+	child.SetStatus(codes.Error, "step failed")
+	child.End(trace.WithTimestamp(start.Add(3 * time.Second)))
+
+	record := log.Record{}
+	record.SetTimestamp(start.Add(2 * time.Second))
+	record.SetSeverity(log.SeverityError)
+	record.SetBody(log.StringValue("Failed to execute step"))
+	loggerProvider.Logger("bashible").Emit(ctx, record)
+
+	parent.End(trace.WithTimestamp(start.Add(5 * time.Second)))
+
+	buf := bytes.Buffer{}
+	encoder := json.NewEncoder(&buf)
+	require.NoError(t, encoder.Encode(FromSpans(spanRecorder.Ended())))
+	require.NoError(t, encoder.Encode(FromRecords(logsExporter.records)))
+
+	data, err := Read(&buf)
+	require.NoError(t, err)
+	require.Len(t, data.Traces, 1)
+	require.Len(t, data.Logs, 1)
+
+	resourceSpans := data.Traces[0].ResourceSpans
+	require.Len(t, resourceSpans, 1)
+	require.Len(t, resourceSpans[0].ScopeSpans, 2)
+
+	bashibleSpans := resourceSpans[0].ScopeSpans[0]
+	require.Equal(t, "bashible", bashibleSpans.Scope.Name)
+	require.Len(t, bashibleSpans.Spans, 1)
+
+	span := bashibleSpans.Spans[0]
+	require.Equal(t, "001_install_packages.sh", span.Name)
+	require.Equal(t, parent.SpanContext().SpanID().String(), span.ParentSpanID)
+	require.Equal(t, parent.SpanContext().TraceID().String(), span.TraceID)
+	require.Equal(t, start.Add(time.Second), span.StartTimeUnixNano.Time())
+	require.Equal(t, 2*time.Second, span.EndTimeUnixNano.Time().Sub(span.StartTimeUnixNano.Time()))
+	require.Equal(t, Status{Code: StatusCodeError, Message: "step failed"}, span.Status)
+	require.Equal(t, "master-0", span.Attributes.String("node"))
+	require.Equal(t, "2", span.Attributes.String("attempt"))
+
+	logRecord := data.Logs[0].ResourceLogs[0].ScopeLogs[0].LogRecords[0]
+	require.Equal(t, int(log.SeverityError), logRecord.SeverityNumber)
+	require.Equal(t, "Failed to execute step", logRecord.Body.String())
+	require.Equal(t, parent.SpanContext().SpanID().String(), logRecord.SpanID)
+}
+
+func TestInt64(t *testing.T) {
+	var s struct {
+		A Int64 `json:"a"`
+		B Int64 `json:"b"`
+	}
+
+	require.NoError(t, json.Unmarshal([]byte(`{"a":"1700000000000000000","b":42}`), &s))
+	require.Equal(t, Int64(1700000000000000000), s.A)
+	require.Equal(t, Int64(42), s.B)
+
+	content, err := json.Marshal(s)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"a":"1700000000000000000","b":"42"}`, string(content))
+}
@@ -0,0 +1,58 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlpjson
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Data is the content of OTLP JSON file.
+type Data struct {
+	Traces []TracesData
+	Logs   []LogsData
+}
+
+type exportRequest struct {
+	ResourceSpans []ResourceSpans `json:"resourceSpans"`
+	ResourceLogs  []ResourceLogs  `json:"resourceLogs"`
+}
+
+// Read decodes all export requests from r. Requests are usually separated with
+// new lines, but any sequence of JSON objects is accepted.
+func Read(r io.Reader) (*Data, error) {
+	data := &Data{}
+	decoder := json.NewDecoder(r)
+
+	for i := 1; ; i++ {
+		var req exportRequest
+		err := decoder.Decode(&req)
+		if errors.Is(err, io.EOF) {
+			return data, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode export request %d: %w", i, err)
+		}
+
+		if len(req.ResourceSpans) > 0 {
+			data.Traces = append(data.Traces, TracesData{ResourceSpans: req.ResourceSpans})
+		}
+		if len(req.ResourceLogs) > 0 {
+			data.Logs = append(data.Logs, LogsData{ResourceLogs: req.ResourceLogs})
+		}
+	}
+}
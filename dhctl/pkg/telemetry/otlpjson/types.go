@@ -0,0 +1,234 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package otlpjson implements the JSON encoding of OTLP traces and logs
+// (https://opentelemetry.io/docs/specs/otlp/#json-protobuf-encoding) as it is written by
+// the OpenTelemetry Collector file exporter: every line is one export request.
+package otlpjson
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+type TracesData struct {
+	ResourceSpans []ResourceSpans `json:"resourceSpans"`
+}
+
+type ResourceSpans struct {
+	Resource   Resource     `json:"resource"`
+	ScopeSpans []ScopeSpans `json:"scopeSpans"`
+	SchemaURL  string       `json:"schemaUrl,omitempty"`
+}
+
+type ScopeSpans struct {
+	Scope Scope  `json:"scope"`
+	Spans []Span `json:"spans"`
+}
+
+type Span struct {
+	TraceID           string      `json:"traceId"`
+	SpanID            string      `json:"spanId"`
+	ParentSpanID      string      `json:"parentSpanId,omitempty"`
+	Name              string      `json:"name"`
+	Kind              int         `json:"kind,omitempty"`
+	StartTimeUnixNano Int64       `json:"startTimeUnixNano"`
+	EndTimeUnixNano   Int64       `json:"endTimeUnixNano"`
+	Attributes        Attributes  `json:"attributes,omitempty"`
+	Events            []SpanEvent `json:"events,omitempty"`
+	Status            Status      `json:"status"`
+}
+
+type SpanEvent struct {
+	TimeUnixNano Int64      `json:"timeUnixNano"`
+	Name         string     `json:"name"`
+	Attributes   Attributes `json:"attributes,omitempty"`
+}
+
+const (
+	StatusCodeUnset = 0
+	StatusCodeOk    = 1
+	StatusCodeError = 2
+)
+
+type Status struct {
+	Code    int    `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+type LogsData struct {
+	ResourceLogs []ResourceLogs `json:"resourceLogs"`
+}
+
+type ResourceLogs struct {
+	Resource  Resource    `json:"resource"`
+	ScopeLogs []ScopeLogs `json:"scopeLogs"`
+	SchemaURL string      `json:"schemaUrl,omitempty"`
+}
+
+type ScopeLogs struct {
+	Scope      Scope       `json:"scope"`
+	LogRecords []LogRecord `json:"logRecords"`
+}
+
+type LogRecord struct {
+	TimeUnixNano         Int64      `json:"timeUnixNano,omitempty"`
+	ObservedTimeUnixNano Int64      `json:"observedTimeUnixNano,omitempty"`
+	SeverityNumber       int        `json:"severityNumber,omitempty"`
+	SeverityText         string     `json:"severityText,omitempty"`
+	EventName            string     `json:"eventName,omitempty"`
+	Body                 *AnyValue  `json:"body,omitempty"`
+	Attributes           Attributes `json:"attributes,omitempty"`
+	TraceID              string     `json:"traceId,omitempty"`
+	SpanID               string     `json:"spanId,omitempty"`
+}
+
+type Resource struct {
+	Attributes Attributes `json:"attributes,omitempty"`
+}
+
+type Scope struct {
+	Name    string `json:"name,omitempty"`
+	Version string `json:"version,omitempty"`
+}
+
+type KeyValue struct {
+	Key   string   `json:"key"`
+	Value AnyValue `json:"value"`
+}
+
+type Attributes []KeyValue
+
+// Get returns the value of the first attribute with the key.
+func (a Attributes) Get(key string) (AnyValue, bool) {
+	for _, kv := range a {
+		if kv.Key == key {
+			return kv.Value, true
+		}
+	}
+	return AnyValue{}, false
+}
+
+// String returns the value of the attribute with the key formatted as a string
+// or an empty string if there is no such attribute.
+func (a Attributes) String(key string) string {
+	v, ok := a.Get(key)
+	if !ok {
+		return ""
+	}
+	return v.String()
+}
+
+type AnyValue struct {
+	StringValue *string       `json:"stringValue,omitempty"`
+	BoolValue   *bool         `json:"boolValue,omitempty"`
+	IntValue    *Int64        `json:"intValue,omitempty"`
+	DoubleValue *float64      `json:"doubleValue,omitempty"`
+	ArrayValue  *ArrayValue   `json:"arrayValue,omitempty"`
+	KvlistValue *KeyValueList `json:"kvlistValue,omitempty"`
+	BytesValue  []byte        `json:"bytesValue,omitempty"`
+}
+
+type ArrayValue struct {
+	Values []AnyValue `json:"values"`
+}
+
+type KeyValueList struct {
+	Values []KeyValue `json:"values"`
+}
+
+func (v AnyValue) String() string {
+	switch {
+	case v.StringValue != nil:
+		return *v.StringValue
+	case v.BoolValue != nil:
+		return strconv.FormatBool(*v.BoolValue)
+	case v.IntValue != nil:
+		return strconv.FormatInt(int64(*v.IntValue), 10)
+	case v.DoubleValue != nil:
+		return strconv.FormatFloat(*v.DoubleValue, 'g', -1, 64)
+	case v.ArrayValue != nil:
+		b := bytes.Buffer{}
+		b.WriteString("[")
+		for i, item := range v.ArrayValue.Values {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			b.WriteString(item.String())
+		}
+		b.WriteString("]")
+		return b.String()
+	case v.KvlistValue != nil:
+		b := bytes.Buffer{}
+		b.WriteString("{")
+		for i, kv := range v.KvlistValue.Values {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			b.WriteString(kv.Key)
+			b.WriteString(": ")
+			b.WriteString(kv.Value.String())
+		}
+		b.WriteString("}")
+		return b.String()
+	case v.BytesValue != nil:
+		return fmt.Sprintf("%x", v.BytesValue)
+	}
+	return ""
+}
+
+func StringValue(s string) AnyValue {
+	return AnyValue{StringValue: &s}
+}
+
+// Int64 is a 64-bit integer encoded as a decimal string as required by OTLP JSON.
+// A plain JSON number is accepted on decoding too, since some senders produce it.
+type Int64 int64
+
+func (i Int64) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(strconv.FormatInt(int64(i), 10))), nil
+}
+
+func (i *Int64) UnmarshalJSON(data []byte) error {
+	s := string(bytes.Trim(data, `"`))
+	if s == "" || s == "null" {
+		*i = 0
+		return nil
+	}
+
+	v, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid int64 value %s: %w", data, err)
+	}
+
+	*i = Int64(v)
+	return nil
+}
+
+// Time converts a unix nano timestamp to time.Time. Zero is converted to zero time.
+func (i Int64) Time() time.Time {
+	if i == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, int64(i))
+}
+
+func UnixNano(t time.Time) Int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return Int64(t.UnixNano())
+}
@@ -20,15 +20,18 @@ import (
 	"fmt"
 	"log/slog"
 	"net/http"
-	"strconv"
 	"sync"
 	"time"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
+	otellog "go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/log/global"
 	semconv "go.opentelemetry.io/otel/semconv/v1.40.0"
 	"go.opentelemetry.io/otel/trace"
+
+	"github.com/deckhouse/deckhouse/dhctl/pkg/telemetry/otlpjson"
 )
 
 type Server struct {
@@ -74,6 +77,7 @@ func (s *Server) Start(_ context.Context, bindAddr string) error {
 
 	mux := http.NewServeMux()
 	mux.HandleFunc("/v1/traces", s.handleTraces)
+	mux.HandleFunc("/v1/logs", s.handleLogs)
 
 	s.server = &http.Server{
 		Addr:    bindAddr,
@@ -110,44 +114,46 @@ func (s *Server) Stop(ctx context.Context) error {
 	return s.server.Shutdown(ctx)
 }
 
-// OTLP JSON structs (partial, just enough for what we need)
-type exportTraceServiceRequest struct {
-	ResourceSpans []resourceSpans `json:"resourceSpans"`
-}
+func (s *Server) currentSpan() trace.Span {
+	s.spanMu.Lock()
+	defer s.spanMu.Unlock()
 
-type resourceSpans struct {
-	ScopeSpans []scopeSpans `json:"scopeSpans"`
+	return s.span
 }
 
-type scopeSpans struct {
-	Spans []span `json:"spans"`
-}
+// parentContext returns the context to start relayed spans and emit relayed log records in.
+// The sender's trace is used if it is valid, otherwise records are attached to the current relay span.
+func (s *Server) parentContext(traceIDHex, parentSpanIDHex string) context.Context {
+	ctx := context.Background()
 
-type span struct {
-	TraceID           string          `json:"traceId"`
-	SpanID            string          `json:"spanId"`
-	ParentSpanID      string          `json:"parentSpanId"`
-	Name              string          `json:"name"`
-	Kind              int             `json:"kind"`
-	StartTimeUnixNano string          `json:"startTimeUnixNano"`
-	EndTimeUnixNano   string          `json:"endTimeUnixNano"`
-	Attributes        []spanAttribute `json:"attributes"`
-	Status            status          `json:"status"`
-}
+	traceID, err := trace.TraceIDFromHex(traceIDHex)
+	if err != nil && traceIDHex != "" {
+		s.logger.ErrorContext(ctx, fmt.Sprintf("Failed to parse TraceID '%s': %v", traceIDHex, err))
+	}
 
-type spanAttribute struct {
-	Key   string    `json:"key"`
-	Value attrValue `json:"value"`
-}
+	parentSpanID := trace.SpanID{}
+	if parentSpanIDHex != "" {
+		parentSpanID, err = trace.SpanIDFromHex(parentSpanIDHex)
+		if err != nil {
+			s.logger.ErrorContext(ctx, fmt.Sprintf("Failed to parse ParentSpanID '%s': %v", parentSpanIDHex, err))
+		}
+	}
 
-type attrValue struct {
-	StringValue string `json:"stringValue"`
-	IntValue    string `json:"intValue"`
-	BoolValue   bool   `json:"boolValue"`
-}
+	if traceID.IsValid() && parentSpanID.IsValid() {
+		sc := trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID:    traceID,
+			SpanID:     parentSpanID,
+			TraceFlags: trace.FlagsSampled,
+			Remote:     true,
+		})
+		return trace.ContextWithRemoteSpanContext(ctx, sc)
+	}
 
-type status struct {
-	Code int `json:"code"` // 0=UNSET, 1=OK, 2=ERROR
+	if span := s.currentSpan(); span != nil {
+		return trace.ContextWithSpan(ctx, span)
+	}
+
+	return ctx
 }
 
 func (s *Server) handleTraces(w http.ResponseWriter, r *http.Request) {
@@ -156,7 +162,7 @@ func (s *Server) handleTraces(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	var req exportTraceServiceRequest
+	var req otlpjson.TracesData
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		s.logger.ErrorContext(context.Background(), fmt.Sprintf("Failed to decode OTLP JSON: %v", err))
 		http.Error(w, "Bad request", http.StatusBadRequest)
@@ -166,48 +172,10 @@ func (s *Server) handleTraces(w http.ResponseWriter, r *http.Request) {
 	for _, rs := range req.ResourceSpans {
 		for _, ss := range rs.ScopeSpans {
 			for _, sp := range ss.Spans {
-				ctx := context.Background()
-
-				traceID, err := trace.TraceIDFromHex(sp.TraceID)
-				if err != nil {
-					s.logger.ErrorContext(context.Background(), fmt.Sprintf("Failed to parse TraceID '%s': %v", sp.TraceID, err))
-				}
-
-				parentSpanIDToUse := trace.SpanID{}
-				if sp.ParentSpanID != "" {
-					parentSpanIDToUse, err = trace.SpanIDFromHex(sp.ParentSpanID)
-					if err != nil {
-						s.logger.ErrorContext(context.Background(), fmt.Sprintf("Failed to parse ParentSpanID '%s': %v", sp.ParentSpanID, err))
-					}
-				}
+				ctx := s.parentContext(sp.TraceID, sp.ParentSpanID)
 
-				if traceID.IsValid() && parentSpanIDToUse.IsValid() {
-					sc := trace.NewSpanContext(trace.SpanContextConfig{
-						TraceID:    traceID,
-						SpanID:     parentSpanIDToUse,
-						TraceFlags: trace.FlagsSampled,
-						Remote:     true,
-					})
-					ctx = trace.ContextWithRemoteSpanContext(ctx, sc)
-				} else if s.span != nil {
-					ctx = trace.ContextWithSpan(ctx, s.span)
-				}
-
-				var startTime, endTime time.Time
-				// OTLP start time is string of int64 unix nano
-				if sp.StartTimeUnixNano != "" {
-					if nsec, err := strconv.ParseInt(sp.StartTimeUnixNano, 10, 64); err == nil {
-						// Unix takes seconds and nanoseconds
-						startTime = time.Unix(0, nsec)
-					} else {
-						s.logger.ErrorContext(context.Background(), fmt.Sprintf("Failed to parse StartTimeUnixNano '%s': %v", sp.StartTimeUnixNano, err))
-					}
-				}
-				if sp.EndTimeUnixNano != "" {
-					if nsec, err := strconv.ParseInt(sp.EndTimeUnixNano, 10, 64); err == nil {
-						endTime = time.Unix(0, nsec)
-					}
-				}
+				startTime := sp.StartTimeUnixNano.Time()
+				endTime := sp.EndTimeUnixNano.Time()
 
 				opts := []trace.SpanStartOption{
 					trace.WithSpanKind(trace.SpanKind(sp.Kind)),
@@ -225,20 +193,18 @@ func (s *Server) handleTraces(w http.ResponseWriter, r *http.Request) {
 				}
 				for _, attr := range sp.Attributes {
 					switch {
-					case attr.Value.StringValue != "":
-						otelAttrs = append(otelAttrs, attribute.String(attr.Key, attr.Value.StringValue))
-					case attr.Value.IntValue != "":
-						otelAttrs = append(otelAttrs, attribute.String(attr.Key, attr.Value.IntValue))
+					case attr.Value.BoolValue != nil:
+						otelAttrs = append(otelAttrs, attribute.Bool(attr.Key, *attr.Value.BoolValue))
 					default:
-						otelAttrs = append(otelAttrs, attribute.Bool(attr.Key, attr.Value.BoolValue))
+						otelAttrs = append(otelAttrs, attribute.String(attr.Key, attr.Value.String()))
 					}
 				}
 				newSpan.SetAttributes(otelAttrs...)
 
 				switch sp.Status.Code {
-				case 2:
-					newSpan.SetStatus(codes.Error, "")
-				case 1:
+				case otlpjson.StatusCodeError:
+					newSpan.SetStatus(codes.Error, sp.Status.Message)
+				case otlpjson.StatusCodeOk:
 					newSpan.SetStatus(codes.Ok, "")
 				}
 
@@ -253,3 +219,48 @@ func (s *Server) handleTraces(w http.ResponseWriter, r *http.Request) {
 
 	w.WriteHeader(http.StatusOK)
 }
+
+// handleLogs re-emits log records received from the node (e.g. from bashible) to the dhctl
+// LoggerProvider, so they are exported together with dhctl's own records.
+func (s *Server) handleLogs(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req otlpjson.LogsData
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		s.logger.ErrorContext(context.Background(), fmt.Sprintf("Failed to decode OTLP JSON: %v", err))
+		http.Error(w, "Bad request", http.StatusBadRequest)
+		return
+	}
+
+	logger := global.GetLoggerProvider().Logger(s.tracerName)
+
+	for _, rl := range req.ResourceLogs {
+		for _, sl := range rl.ScopeLogs {
+			for _, lr := range sl.LogRecords {
+				ctx := s.parentContext(lr.TraceID, lr.SpanID)
+
+				record := otellog.Record{}
+				record.SetTimestamp(lr.TimeUnixNano.Time())
+				record.SetObservedTimestamp(lr.ObservedTimeUnixNano.Time())
+				record.SetSeverity(otellog.Severity(lr.SeverityNumber))
+				record.SetSeverityText(lr.SeverityText)
+				if lr.Body != nil {
+					record.SetBody(otellog.StringValue(lr.Body.String()))
+				}
+
+				attrs := []otellog.KeyValue{otellog.String(string(semconv.ServiceNameKey), s.tracerName)}
+				for _, attr := range lr.Attributes {
+					attrs = append(attrs, otellog.String(attr.Key, attr.Value.String()))
+				}
+				record.AddAttributes(attrs...)
+
+				logger.Emit(ctx, record)
+			}
+		}
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
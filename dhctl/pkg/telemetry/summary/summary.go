@@ -0,0 +1,349 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package summary
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/deckhouse/deckhouse/dhctl/pkg/telemetry"
+	"github.com/deckhouse/deckhouse/dhctl/pkg/telemetry/otlpjson"
+)
+
+const (
+	severityWarn  = 13
+	severityError = 17
+)
+
+// Duration is time.Duration rendered as a string in JSON.
+type Duration time.Duration
+
+func (d Duration) String() string {
+	return time.Duration(d).Round(time.Millisecond).String()
+}
+
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+type Summary struct {
+	Traces []Trace `json:"traces"`
+}
+
+// Trace is the timing breakdown of the single dhctl operation.
+type Trace struct {
+	TraceID  string     `json:"trace_id"`
+	Name     string     `json:"name"`
+	Start    time.Time  `json:"start"`
+	Duration Duration   `json:"duration"`
+	Failed   bool       `json:"failed"`
+	Phases   []Phase    `json:"phases,omitempty"`
+	Steps    []Step     `json:"bashible_steps,omitempty"`
+	Warnings int        `json:"warnings"`
+	Errors   []LogEntry `json:"errors,omitempty"`
+}
+
+type Phase struct {
+	Name     string    `json:"name"`
+	Start    time.Time `json:"start"`
+	Duration Duration  `json:"duration"`
+	// Bashible is the total duration of bashible steps started during the phase.
+	Bashible Duration `json:"bashible"`
+	Warnings int      `json:"warnings"`
+	Errors   int      `json:"errors"`
+
+	start, end time.Time
+}
+
+// Step aggregates all runs of the bashible step, e.g. on different nodes.
+type Step struct {
+	Name   string   `json:"name"`
+	Phase  string   `json:"phase,omitempty"`
+	Runs   int      `json:"runs"`
+	Failed int      `json:"failed"`
+	Total  Duration `json:"total"`
+	Max    Duration `json:"max"`
+
+	start time.Time
+}
+
+type LogEntry struct {
+	Time    time.Time `json:"time"`
+	Phase   string    `json:"phase,omitempty"`
+	Message string    `json:"message"`
+}
+
+type span struct {
+	otlpjson.Span
+	scope string
+}
+
+type logRecord struct {
+	otlpjson.LogRecord
+	scope string
+}
+
+// Summarize builds the per-phase and per-bashible-step timing breakdown of every trace in data.
+// Phases are the spans marked with telemetry.PhaseAttribute, bashible steps are the spans
+// relayed from nodes. Steps and log records are attributed to the phase they started in.
+func Summarize(data *otlpjson.Data) *Summary {
+	spansByTrace := make(map[string][]span)
+	for _, traces := range data.Traces {
+		for _, rs := range traces.ResourceSpans {
+			for _, ss := range rs.ScopeSpans {
+				for _, s := range ss.Spans {
+					spansByTrace[s.TraceID] = append(spansByTrace[s.TraceID], span{Span: s, scope: ss.Scope.Name})
+				}
+			}
+		}
+	}
+
+	logsByTrace := make(map[string][]logRecord)
+	for _, logs := range data.Logs {
+		for _, rl := range logs.ResourceLogs {
+			for _, sl := range rl.ScopeLogs {
+				for _, r := range sl.LogRecords {
+					if r.TraceID == "" {
+						continue
+					}
+					logsByTrace[r.TraceID] = append(logsByTrace[r.TraceID], logRecord{LogRecord: r, scope: sl.Scope.Name})
+				}
+			}
+		}
+	}
+
+	res := &Summary{Traces: make([]Trace, 0, len(spansByTrace))}
+	for traceID, spans := range spansByTrace {
+		res.Traces = append(res.Traces, summarizeTrace(traceID, spans, logsByTrace[traceID]))
+	}
+
+	sort.Slice(res.Traces, func(i, j int) bool {
+		return res.Traces[i].Start.Before(res.Traces[j].Start)
+	})
+
+	return res
+}
+
+func summarizeTrace(traceID string, spans []span, logs []logRecord) Trace {
+	sort.SliceStable(spans, func(i, j int) bool {
+		return spans[i].StartTimeUnixNano < spans[j].StartTimeUnixNano
+	})
+
+	ids := make(map[string]struct{}, len(spans))
+	for _, s := range spans {
+		ids[s.SpanID] = struct{}{}
+	}
+
+	res := Trace{TraceID: traceID}
+
+	var end time.Time
+	for _, s := range spans {
+		start, spanEnd := s.StartTimeUnixNano.Time(), s.EndTimeUnixNano.Time()
+
+		// the earliest span without the parent in the trace is the dhctl command span
+		if _, hasParent := ids[s.ParentSpanID]; !hasParent && res.Name == "" {
+			res.Name = s.Name
+			res.Failed = s.Status.Code == otlpjson.StatusCodeError
+		}
+		if res.Start.IsZero() || start.Before(res.Start) {
+			res.Start = start
+		}
+		if spanEnd.After(end) {
+			end = spanEnd
+		}
+
+		if phase := s.Attributes.String(telemetry.PhaseAttribute); phase != "" {
+			res.Phases = append(res.Phases, Phase{
+				Name:     phase,
+				Start:    start,
+				Duration: Duration(spanEnd.Sub(start)),
+				start:    start,
+				end:      spanEnd,
+			})
+		}
+	}
+	res.Duration = Duration(end.Sub(res.Start))
+
+	steps := make(map[string]*Step)
+	for _, s := range spans {
+		if s.scope != telemetry.BashibleTracerName {
+			continue
+		}
+
+		start := s.StartTimeUnixNano.Time()
+		duration := s.EndTimeUnixNano.Time().Sub(start)
+
+		step, ok := steps[s.Name]
+		if !ok {
+			step = &Step{Name: s.Name, start: start}
+			if phase := phaseAt(res.Phases, start); phase != nil {
+				step.Phase = phase.Name
+			}
+			steps[s.Name] = step
+		}
+
+		step.Runs++
+		step.Total += Duration(duration)
+		if Duration(duration) > step.Max {
+			step.Max = Duration(duration)
+		}
+		if s.Status.Code == otlpjson.StatusCodeError {
+			step.Failed++
+		}
+
+		if phase := phaseAt(res.Phases, start); phase != nil {
+			phase.Bashible += Duration(duration)
+		}
+	}
+
+	for _, step := range steps {
+		res.Steps = append(res.Steps, *step)
+	}
+	sort.Slice(res.Steps, func(i, j int) bool {
+		if res.Steps[i].start.Equal(res.Steps[j].start) {
+			return res.Steps[i].Name < res.Steps[j].Name
+		}
+		return res.Steps[i].start.Before(res.Steps[j].start)
+	})
+
+	sort.SliceStable(logs, func(i, j int) bool {
+		return recordTime(logs[i]).Before(recordTime(logs[j]))
+	})
+	for _, r := range logs {
+		if r.SeverityNumber < severityWarn {
+			continue
+		}
+
+		t := recordTime(r)
+		phase := phaseAt(res.Phases, t)
+
+		if r.SeverityNumber < severityError {
+			res.Warnings++
+			if phase != nil {
+				phase.Warnings++
+			}
+			continue
+		}
+
+		entry := LogEntry{Time: t, Message: logMessage(r)}
+		if phase != nil {
+			phase.Errors++
+			entry.Phase = phase.Name
+		}
+		res.Errors = append(res.Errors, entry)
+	}
+
+	return res
+}
+
+// phaseAt returns the phase running at the moment t. Phases do not overlap,
+// but the latest started one wins if they do.
+func phaseAt(phases []Phase, t time.Time) *Phase {
+	for i := len(phases) - 1; i >= 0; i-- {
+		if !t.Before(phases[i].start) && !t.After(phases[i].end) {
+			return &phases[i]
+		}
+	}
+	return nil
+}
+
+// recordTime returns the time of the event or the observed time if the former is not set.
+func recordTime(r logRecord) time.Time {
+	if r.TimeUnixNano != 0 {
+		return r.TimeUnixNano.Time()
+	}
+	return r.ObservedTimeUnixNano.Time()
+}
+
+func logMessage(r logRecord) string {
+	msg := ""
+	if r.Body != nil {
+		msg = r.Body.String()
+	}
+	if r.scope == telemetry.BashibleTracerName {
+		if step := r.Attributes.String("step"); step != "" {
+			msg = fmt.Sprintf("%s: %s", step, msg)
+		}
+	}
+	return strings.TrimSpace(msg)
+}
+
+func (s *Summary) String() string {
+	if len(s.Traces) == 0 {
+		return "No traces found\n"
+	}
+
+	b := &strings.Builder{}
+	for i, t := range s.Traces {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		t.write(b)
+	}
+	return b.String()
+}
+
+func (t *Trace) write(b *strings.Builder) {
+	status := "succeeded"
+	if t.Failed {
+		status = "failed"
+	}
+
+	fmt.Fprintf(b, "Trace %s: %s\n", t.TraceID, t.Name)
+	fmt.Fprintf(b, "  Started:  %s\n", t.Start.UTC().Format(time.RFC3339))
+	fmt.Fprintf(b, "  Duration: %s (%s)\n", t.Duration, status)
+
+	if len(t.Phases) > 0 {
+		b.WriteString("\n")
+		w := tabwriter.NewWriter(b, 0, 0, 3, ' ', 0)
+		fmt.Fprintln(w, "  PHASE\tDURATION\tSHARE\tBASHIBLE\tWARNINGS\tERRORS")
+		for _, p := range t.Phases {
+			fmt.Fprintf(w, "  %s\t%s\t%s\t%s\t%d\t%d\n", p.Name, p.Duration, share(p.Duration, t.Duration), p.Bashible, p.Warnings, p.Errors)
+		}
+		_ = w.Flush()
+	}
+
+	if len(t.Steps) > 0 {
+		b.WriteString("\n")
+		w := tabwriter.NewWriter(b, 0, 0, 3, ' ', 0)
+		fmt.Fprintln(w, "  BASHIBLE STEP\tPHASE\tRUNS\tFAILED\tTOTAL\tMAX\tSHARE")
+		for _, s := range t.Steps {
+			fmt.Fprintf(w, "  %s\t%s\t%d\t%d\t%s\t%s\t%s\n", s.Name, s.Phase, s.Runs, s.Failed, s.Total, s.Max, share(s.Total, t.Duration))
+		}
+		_ = w.Flush()
+	}
+
+	if len(t.Errors) > 0 {
+		b.WriteString("\n  Errors:\n")
+		for _, e := range t.Errors {
+			phase := ""
+			if e.Phase != "" {
+				phase = fmt.Sprintf(" [%s]", e.Phase)
+			}
+			fmt.Fprintf(b, "    %s%s %s\n", e.Time.UTC().Format(time.TimeOnly), phase, e.Message)
+		}
+	}
+}
+
+func share(part, total Duration) string {
+	if total <= 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%.1f%%", float64(part)*100/float64(total))
+}
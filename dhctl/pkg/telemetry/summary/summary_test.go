@@ -0,0 +1,88 @@
+// Copyright 2026 Flant JSC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package summary
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/deckhouse/deckhouse/dhctl/pkg/telemetry/otlpjson"
+)
+
+const testTraceFile = `{"resourceSpans":[{"resource":{},"scopeSpans":[{"scope":{"name":"dhctl"},"spans":[
+{"traceId":"t1","spanId":"root","name":"bootstrap","startTimeUnixNano":"1000000000000","endTimeUnixNano":"1100000000000","status":{"code":2,"message":"exit code 1"}},
+{"traceId":"t1","spanId":"p1","parentSpanId":"root","name":"phase BaseInfra","startTimeUnixNano":"1000000000000","endTimeUnixNano":"1020000000000","attributes":[{"key":"dhctl.phase","value":{"stringValue":"BaseInfra"}}],"status":{}},
+{"traceId":"t1","spanId":"p2","parentSpanId":"root","name":"phase ExecuteBashibleBundle","startTimeUnixNano":"1020000000000","endTimeUnixNano":"1100000000000","attributes":[{"key":"dhctl.phase","value":{"stringValue":"ExecuteBashibleBundle"}}],"status":{}}
+]},{"scope":{"name":"bashible"},"spans":[
+{"traceId":"t1","spanId":"s1","parentSpanId":"root","name":"001_install_packages.sh","startTimeUnixNano":"1030000000000","endTimeUnixNano":"1040000000000","status":{}},
+{"traceId":"t1","spanId":"s2","parentSpanId":"root","name":"002_configure_kubelet.sh","startTimeUnixNano":"1040000000000","endTimeUnixNano":"1045000000000","status":{"code":2}},
+{"traceId":"t1","spanId":"s3","parentSpanId":"root","name":"001_install_packages.sh","startTimeUnixNano":"1050000000000","endTimeUnixNano":"1070000000000","status":{}}
+]}]}]}
+{"resourceLogs":[{"resource":{},"scopeLogs":[{"scope":{"name":"bashible"},"logRecords":[
+{"timeUnixNano":"1042000000000","severityNumber":13,"body":{"stringValue":"Failed to execute step 002_configure_kubelet.sh, attempt 1"},"traceId":"t1","spanId":"root"},
+{"timeUnixNano":"1044000000000","severityNumber":17,"body":{"stringValue":"Failed to execute step 002_configure_kubelet.sh, retry limit reached"},"attributes":[{"key":"step","value":{"stringValue":"002_configure_kubelet.sh"}}],"traceId":"t1","spanId":"root"},
+{"timeUnixNano":"1044000000000","severityNumber":17,"body":{"stringValue":"not in trace"}}
+]}]}]}
+`
+
+func TestSummarize(t *testing.T) {
+	data, err := otlpjson.Read(strings.NewReader(testTraceFile))
+	require.NoError(t, err)
+
+	summary := Summarize(data)
+	require.Len(t, summary.Traces, 1)
+
+	trace := summary.Traces[0]
+	require.Equal(t, "bootstrap", trace.Name)
+	require.True(t, trace.Failed)
+	require.Equal(t, Duration(100*time.Second), trace.Duration)
+	require.Equal(t, 1, trace.Warnings)
+
+	require.Len(t, trace.Phases, 2)
+	require.Equal(t, "BaseInfra", trace.Phases[0].Name)
+	require.Equal(t, Duration(20*time.Second), trace.Phases[0].Duration)
+	require.Equal(t, Duration(0), trace.Phases[0].Bashible)
+	require.Equal(t, "ExecuteBashibleBundle", trace.Phases[1].Name)
+	require.Equal(t, Duration(35*time.Second), trace.Phases[1].Bashible)
+	require.Equal(t, 1, trace.Phases[1].Warnings)
+	require.Equal(t, 1, trace.Phases[1].Errors)
+
+	require.Len(t, trace.Steps, 2)
+	require.Equal(t, "001_install_packages.sh", trace.Steps[0].Name)
+	require.Equal(t, "ExecuteBashibleBundle", trace.Steps[0].Phase)
+	require.Equal(t, 2, trace.Steps[0].Runs)
+	require.Equal(t, Duration(30*time.Second), trace.Steps[0].Total)
+	require.Equal(t, Duration(20*time.Second), trace.Steps[0].Max)
+	require.Equal(t, "002_configure_kubelet.sh", trace.Steps[1].Name)
+	require.Equal(t, 1, trace.Steps[1].Failed)
+
+	require.Len(t, trace.Errors, 1)
+	require.Equal(t, "002_configure_kubelet.sh: Failed to execute step 002_configure_kubelet.sh, retry limit reached", trace.Errors[0].Message)
+
+	out := summary.String()
+	require.Contains(t, out, "Trace t1: bootstrap")
+	require.Contains(t, out, "Duration: 1m40s (failed)")
+	require.Contains(t, out, "001_install_packages.sh")
+	require.Contains(t, out, "30.0%")
+}
+
+func TestSummarizeEmpty(t *testing.T) {
+	summary := Summarize(&otlpjson.Data{})
+	require.Empty(t, summary.Traces)
+	require.Equal(t, "No traces found\n", summary.String())
+}
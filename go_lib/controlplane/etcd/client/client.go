@@ -21,6 +21,7 @@ import (
 	"crypto/tls"
 	"crypto/x509"
 	"fmt"
+	"io"
 	"log/slog"
 	"net/url"
 	"os"
@@ -58,6 +59,7 @@ type Interface interface {
 	GetMemberID(ctx context.Context, peerURL string) (uint64, error)
 	CheckClusterHealthy(ctx context.Context, timeout time.Duration) error
 	Defragment(ctx context.Context, endpoint string) error
+	Snapshot(ctx context.Context, endpoint string) (*Snapshot, error)
 	Raw() *clientv3.Client
 	Close() error
 }
@@ -480,6 +482,39 @@ func (c *Client) Defragment(ctx context.Context, endpoint string) error {
 	return err
 }
 
+// Snapshot is a point-in-time snapshot stream of a single etcd member.
+// Closing it also closes the dedicated connection to the member.
+type Snapshot struct {
+	io.ReadCloser
+	// Version is the etcd server version that created the snapshot.
+	Version string
+
+	conn Interface
+}
+
+func (s *Snapshot) Close() error {
+	err := s.ReadCloser.Close()
+	if connErr := s.conn.Close(); err == nil {
+		err = connErr
+	}
+	return err
+}
+
+// Snapshot streams a snapshot of the member at endpoint. The client balancer would pick any member,
+// so a dedicated connection to endpoint is opened for the duration of the stream.
+func (c *Client) Snapshot(ctx context.Context, endpoint string) (*Snapshot, error) {
+	cli, err := c.newEtcdClient([]string{endpoint})
+	if err != nil {
+		return nil, fmt.Errorf("connect to %s: %w", endpoint, err)
+	}
+	resp, err := cli.Raw().SnapshotWithVersion(ctx)
+	if err != nil {
+		_ = cli.Close()
+		return nil, fmt.Errorf("request snapshot from %s: %w", endpoint, err)
+	}
+	return &Snapshot{ReadCloser: resp.Snapshot, Version: resp.Version, conn: cli}, nil
+}
+
 func (c *Client) WaitForClusterAvailable(retries int, retryInterval time.Duration) (bool, error) {
 	for i := 0; i < retries; i++ {
 		if i > 0 {
@@ -88,6 +88,10 @@ func (f *fakeClient) Defragment(_ context.Context, _ string) error {
 	return nil
 }
 
+func (f *fakeClient) Snapshot(_ context.Context, _ string) (*Snapshot, error) {
+	return nil, errors.New("not implemented")
+}
+
 func (f *fakeClient) Raw() *clientv3.Client {
 	return nil
 }
@@ -0,0 +1,107 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hooks
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/flant/addon-operator/pkg/module_manager/go_hook"
+	"github.com/flant/addon-operator/pkg/module_manager/go_hook/metrics"
+	"github.com/flant/addon-operator/sdk"
+	"gopkg.in/robfig/cron.v2"
+)
+
+const (
+	etcdSnapshotsEnabledConfigPath    = "controlPlaneManager.etcd.snapshots.enabled"
+	etcdSnapshotsScheduleConfigPath   = "controlPlaneManager.etcd.snapshots.cronSchedule"
+	etcdSnapshotsS3ConfigPath         = "controlPlaneManager.etcd.snapshots.s3"
+	etcdSnapshotsEnabledInternalPath  = "controlPlaneManager.internal.etcdSnapshots.enabled"
+	etcdSnapshotsScheduleInternalPath = "controlPlaneManager.internal.etcdSnapshots.cronSchedule"
+
+	etcdSnapshotsDefaultCronSchedule       = "0 */6 * * *"
+	etcdSnapshotsInvalidCronScheduleGroup  = "D8InvalidEtcdSnapshotsCronSchedule"
+	etcdSnapshotsInvalidCronScheduleMetric = "d8_invalid_etcd_snapshots_cron_schedule"
+	etcdSnapshotsScheduleGroup             = "D8EtcdSnapshotsSchedule"
+	etcdSnapshotsScheduleIntervalMetric    = "d8_etcd_snapshots_schedule_interval_seconds"
+
+	// etcdSnapshotsIntervalSamples is the number of upcoming slots inspected to find the longest gap of an irregular schedule.
+	etcdSnapshotsIntervalSamples = 16
+)
+
+var _ = sdk.RegisterFunc(&go_hook.HookConfig{
+	Queue:        moduleQueue,
+	OnBeforeHelm: &go_hook.OrderedConfig{Order: 20},
+}, handleComputeEtcdSnapshots)
+
+func handleComputeEtcdSnapshots(_ context.Context, input *go_hook.HookInput) error {
+	enabled := input.ConfigValues.Get(etcdSnapshotsEnabledConfigPath).Bool()
+
+	input.MetricsCollector.Expire(etcdSnapshotsInvalidCronScheduleGroup)
+	input.MetricsCollector.Expire(etcdSnapshotsScheduleGroup)
+
+	cronSchedule := input.Values.Get(etcdSnapshotsScheduleConfigPath).String()
+
+	if cronSchedule == "" {
+		cronSchedule = etcdSnapshotsDefaultCronSchedule
+	} else if _, err := cron.Parse("TZ=UTC " + cronSchedule); err != nil {
+		input.Logger.Warn("etcd snapshots cronSchedule is invalid, falling back to default", "cronSchedule", cronSchedule, "err", err)
+		input.MetricsCollector.Set(
+			etcdSnapshotsInvalidCronScheduleMetric, 1,
+			map[string]string{"cron_schedule": cronSchedule},
+			metrics.WithGroup(etcdSnapshotsInvalidCronScheduleGroup),
+		)
+		cronSchedule = etcdSnapshotsDefaultCronSchedule
+	}
+
+	input.Values.Set(etcdSnapshotsEnabledInternalPath, enabled)
+	input.Values.Set(etcdSnapshotsScheduleInternalPath, cronSchedule)
+	input.Logger.Info("etcd snapshots config computed", "enabled", enabled, "cronSchedule", cronSchedule)
+
+	if !enabled {
+		return nil
+	}
+
+	// The expected interval lets the D8EtcdSnapshotOutdated alert follow the configured schedule.
+	sched, err := cron.Parse("TZ=UTC " + cronSchedule)
+	if err != nil {
+		return nil
+	}
+	input.MetricsCollector.Set(
+		etcdSnapshotsScheduleIntervalMetric, cronMaxInterval(sched, time.Now()).Seconds(),
+		map[string]string{"s3_upload": strconv.FormatBool(input.ConfigValues.Exists(etcdSnapshotsS3ConfigPath))},
+		metrics.WithGroup(etcdSnapshotsScheduleGroup),
+	)
+
+	return nil
+}
+
+// cronMaxInterval returns the longest gap between the upcoming runs of sched.
+func cronMaxInterval(sched cron.Schedule, from time.Time) time.Duration {
+	var longest time.Duration
+	prev := sched.Next(from)
+	for range etcdSnapshotsIntervalSamples {
+		next := sched.Next(prev)
+		if next.IsZero() {
+			break
+		}
+		longest = max(longest, next.Sub(prev))
+		prev = next
+	}
+	return longest
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hooks
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/deckhouse/deckhouse/testing/hooks"
+)
+
+const snapshotsValues = `{
+	"controlPlaneManager": {
+		"internal": {
+			"etcdSnapshots": {}
+		},
+		"etcd": {
+			"snapshots": {
+				"cronSchedule": ""
+			}
+		},
+		"apiserver": {"authn": {}, "authz": {}}
+	}
+}`
+
+const snapshotsInvalidCronValues = `{
+	"controlPlaneManager": {
+		"internal": {
+			"etcdSnapshots": {}
+		},
+		"etcd": {
+			"snapshots": {
+				"cronSchedule": "99 25 * * *"
+			}
+		},
+		"apiserver": {"authn": {}, "authz": {}}
+	}
+}`
+
+var _ = Describe("Modules :: control-plane-manager :: hooks :: compute_etcd_snapshots ::", func() {
+	findMetric := func(f *HookExecutionConfig, name string) (float64, map[string]string, bool) {
+		for _, m := range f.MetricsCollector.CollectedMetrics() {
+			if m.Name == name && m.Value != nil {
+				return *m.Value, m.Labels, true
+			}
+		}
+		return 0, nil, false
+	}
+
+	Context("no explicit config", func() {
+		f := HookExecutionConfigInit(snapshotsValues, ``)
+		BeforeEach(func() {
+			f.BindingContexts.Set(f.GenerateBeforeHelmContext())
+			f.RunHook()
+		})
+		It("sets enabled=false, default cronSchedule and no interval metric", func() {
+			Expect(f).To(ExecuteSuccessfully())
+			Expect(f.ValuesGet(etcdSnapshotsEnabledInternalPath).Bool()).To(BeFalse())
+			Expect(f.ValuesGet(etcdSnapshotsScheduleInternalPath).String()).To(Equal(etcdSnapshotsDefaultCronSchedule))
+			_, _, found := findMetric(f, etcdSnapshotsScheduleIntervalMetric)
+			Expect(found).To(BeFalse())
+		})
+	})
+
+	Context("enabled with S3 upload", func() {
+		f := HookExecutionConfigInit(snapshotsValues,
+			`{"controlPlaneManager":{"etcd":{"snapshots":{"enabled":true,"s3":{"endpoint":"https://s3.example.com","bucket":"b","credentialsSecretName":"s"}}}}}`)
+		BeforeEach(func() {
+			f.BindingContexts.Set(f.GenerateBeforeHelmContext())
+			f.RunHook()
+		})
+		It("sets enabled=true and exports the schedule interval", func() {
+			Expect(f).To(ExecuteSuccessfully())
+			Expect(f.ValuesGet(etcdSnapshotsEnabledInternalPath).Bool()).To(BeTrue())
+			value, labels, found := findMetric(f, etcdSnapshotsScheduleIntervalMetric)
+			Expect(found).To(BeTrue())
+			Expect(value).To(Equal(6 * 3600.0))
+			Expect(labels["s3_upload"]).To(Equal("true"))
+		})
+	})
+
+	Context("semantically invalid cronSchedule in values", func() {
+		f := HookExecutionConfigInit(snapshotsInvalidCronValues, `{"controlPlaneManager":{"etcd":{"snapshots":{"enabled":true}}}}`)
+		BeforeEach(func() {
+			f.BindingContexts.Set(f.GenerateBeforeHelmContext())
+			f.RunHook()
+		})
+		It("falls back to default cronSchedule and emits invalid cron metric", func() {
+			Expect(f).To(ExecuteSuccessfully())
+			Expect(f.ValuesGet(etcdSnapshotsScheduleInternalPath).String()).To(Equal(etcdSnapshotsDefaultCronSchedule))
+			value, labels, found := findMetric(f, etcdSnapshotsInvalidCronScheduleMetric)
+			Expect(found).To(BeTrue())
+			Expect(value).To(Equal(1.0))
+			Expect(labels["cron_schedule"]).To(Equal("99 25 * * *"))
+		})
+	})
+})
@@ -34,11 +34,43 @@ import (
 
 type SecretEncryptionKey []byte
 
+// secretEncryptionKeyState is the content of d8-secret-encryption-key. While control-plane-manager rotates the key,
+// the secret also holds the new key and the rotation stage, which sets the order of the keys in the encryption configuration.
+// Either key may be the KMS provider instead of a static key: the secret then keeps the endpoint of its plugin.
+type secretEncryptionKeyState struct {
+	Provider    string `json:"provider,omitempty"`
+	Key         []byte `json:"key"`
+	KeyName     string `json:"keyName"`
+	NewProvider string `json:"newProvider,omitempty"`
+	NewKey      []byte `json:"newKey,omitempty"`
+	NewKeyName  string `json:"newKeyName,omitempty"`
+	Stage       string `json:"stage,omitempty"`
+	KMSEndpoint string `json:"kmsEndpoint,omitempty"`
+}
+
+// secretEncryptionKeyValue is a static key (secret) or the KMS provider (endpoint).
+type secretEncryptionKeyValue struct {
+	Name     string `json:"name"`
+	Secret   string `json:"secret,omitempty"`
+	Endpoint string `json:"endpoint,omitempty"`
+}
+
 const (
 	secretEncryptionKeySecretName          = "d8-secret-encryption-key"
 	secretEncryptionKeySecretKey           = "secretEncryptionKey"
+	secretEncryptionKeyNameSecretKey       = "secretEncryptionKeyName"
+	newSecretEncryptionKeySecretKey        = "newSecretEncryptionKey"
+	newSecretEncryptionKeyNameSecretKey    = "newSecretEncryptionKeyName"
+	secretEncryptionKeyStageSecretKey      = "rotationStage"
+	secretEncryptionProviderSecretKey      = "secretEncryptionProvider"
+	newSecretEncryptionProviderSecretKey   = "newSecretEncryptionProvider"
+	secretEncryptionKMSEndpointSecretKey   = "kmsEndpoint"
+	secretEncryptionProviderKMS            = "KMS"
+	defaultSecretEncryptionKeyName         = "secretbox"
 	secretEncryptionKeyValuePath           = "controlPlaneManager.internal.secretEncryptionKey"
+	secretEncryptionKeysValuePath          = "controlPlaneManager.internal.secretEncryptionKeys"
 	secretEncryptionEnabledConfigValuePath = "controlPlaneManager.apiserver.encryptionEnabled"
+	kmsEndpointConfigValuePath             = "controlPlaneManager.apiserver.kms.endpoint"
 	kubeSystemNS                           = "kube-system"
 )
 
@@ -57,7 +89,21 @@ func extractEncryptionSecret(obj *unstructured.Unstructured) (go_hook.FilterResu
 		return nil, fmt.Errorf("cannot convert incoming object to Secret: %v", err)
 	}
 
-	return secret.Data[secretEncryptionKeySecretKey], nil
+	state := secretEncryptionKeyState{
+		Provider:    string(secret.Data[secretEncryptionProviderSecretKey]),
+		Key:         secret.Data[secretEncryptionKeySecretKey],
+		KeyName:     string(secret.Data[secretEncryptionKeyNameSecretKey]),
+		NewProvider: string(secret.Data[newSecretEncryptionProviderSecretKey]),
+		NewKey:      secret.Data[newSecretEncryptionKeySecretKey],
+		NewKeyName:  string(secret.Data[newSecretEncryptionKeyNameSecretKey]),
+		Stage:       string(secret.Data[secretEncryptionKeyStageSecretKey]),
+		KMSEndpoint: string(secret.Data[secretEncryptionKMSEndpointSecretKey]),
+	}
+	if state.KeyName == "" {
+		state.KeyName = defaultSecretEncryptionKeyName
+	}
+
+	return state, nil
 }
 
 var _ = sdk.RegisterFunc(&go_hook.HookConfig{
@@ -84,16 +130,17 @@ var _ = sdk.RegisterFunc(&go_hook.HookConfig{
 func ensureEncryptionSecretKey(_ context.Context, input *go_hook.HookInput) error {
 	keys := input.Snapshots.Get("secret_encryption_key")
 
-	secretKey := make([]byte, 0)
+	var state secretEncryptionKeyState
 	if len(keys) > 0 {
-		err := keys[0].UnmarshalTo(&secretKey)
+		err := keys[0].UnmarshalTo(&state)
 
 		if err != nil {
 			return fmt.Errorf("failed to unmarshal 'secret_encryption_key' snapshot: %w", err)
 		}
 	}
 
-	if len(secretKey) == 0 {
+	// After a migration to KMS the secret keeps no static key, only the KMS provider.
+	if len(state.Key) == 0 && state.Provider != secretEncryptionProviderKMS {
 		if !input.Values.Get(secretEncryptionEnabledConfigValuePath).Bool() {
 			return nil
 		}
@@ -102,7 +149,7 @@ func ensureEncryptionSecretKey(_ context.Context, input *go_hook.HookInput) erro
 		if err != nil {
 			return err
 		}
-		secretKey = key
+		state = secretEncryptionKeyState{Key: key, KeyName: defaultSecretEncryptionKeyName}
 
 		newCM := &v1.Secret{
 			ObjectMeta: metav1.ObjectMeta{
@@ -132,11 +179,60 @@ func ensureEncryptionSecretKey(_ context.Context, input *go_hook.HookInput) erro
 		input.PatchCollector.CreateOrUpdate(newCM)
 	}
 
-	input.Values.Set(secretEncryptionKeyValuePath, base64.StdEncoding.EncodeToString(secretKey))
+	if len(state.Key) > 0 {
+		input.Values.Set(secretEncryptionKeyValuePath, base64.StdEncoding.EncodeToString(state.Key))
+	} else {
+		input.Values.Remove(secretEncryptionKeyValuePath)
+	}
+	// The configured endpoint wins, so that moving the plugin to another socket needs no migration.
+	kmsEndpoint := input.Values.Get(kmsEndpointConfigValuePath).String()
+	if kmsEndpoint == "" {
+		kmsEndpoint = state.KMSEndpoint
+	} else if state.usesKMS() && state.KMSEndpoint != kmsEndpoint {
+		// Record the new endpoint, so that a migration away from KMS does not fall back to the old one.
+		patch := map[string]interface{}{
+			"data": map[string]interface{}{
+				secretEncryptionKMSEndpointSecretKey: base64.StdEncoding.EncodeToString([]byte(kmsEndpoint)),
+			},
+		}
+		input.PatchCollector.PatchWithMerge(patch, "v1", "Secret", kubeSystemNS, secretEncryptionKeySecretName)
+	}
+	input.Values.Set(secretEncryptionKeysValuePath, secretEncryptionKeyValues(state, kmsEndpoint))
 
 	return nil
 }
 
+// usesKMS reports whether the encryption configuration lists the KMS provider at the current stage.
+func (s secretEncryptionKeyState) usesKMS() bool {
+	return s.Provider == secretEncryptionProviderKMS || (s.Stage != "" && s.NewProvider == secretEncryptionProviderKMS)
+}
+
+// secretEncryptionKeyValues returns the keys in the order kube-apiserver must try them: the first one encrypts.
+// The new key is only added for decryption first, so that no kube-apiserver gets an object it cannot read.
+func secretEncryptionKeyValues(state secretEncryptionKeyState, kmsEndpoint string) []secretEncryptionKeyValue {
+	keyValue := func(provider, name string, key []byte) secretEncryptionKeyValue {
+		if provider == secretEncryptionProviderKMS {
+			return secretEncryptionKeyValue{Name: name, Endpoint: kmsEndpoint}
+		}
+		return secretEncryptionKeyValue{Name: name, Secret: base64.StdEncoding.EncodeToString(key)}
+	}
+
+	current := keyValue(state.Provider, state.KeyName, state.Key)
+	if state.NewKeyName == "" {
+		return []secretEncryptionKeyValue{current}
+	}
+
+	next := keyValue(state.NewProvider, state.NewKeyName, state.NewKey)
+	switch state.Stage {
+	case "KeyAdded":
+		return []secretEncryptionKeyValue{current, next}
+	case "KeyPromoted", "Reencrypted":
+		return []secretEncryptionKeyValue{next, current}
+	default:
+		return []secretEncryptionKeyValue{current}
+	}
+}
+
 func generateSecretEncryptionKey() ([]byte, error) {
 	secret := make([]byte, 32)
 	_, err := rand.Read(secret)
@@ -94,6 +94,144 @@ data:
 
 			Expect(f.ValuesGet(secretEncryptionKeyValuePath).Exists()).To(BeTrue())
 			Expect(f.ValuesGet(secretEncryptionKeyValuePath).String()).To(Equal(encodedKey))
+			Expect(f.ValuesGet(secretEncryptionKeysValuePath).String()).To(MatchJSON(fmt.Sprintf(`[{"name":"secretbox","secret":%q}]`, encodedKey)))
+		})
+	})
+
+	Context("Key rotation in progress", func() {
+		currentKey := base64.StdEncoding.EncodeToString([]byte("12345678901234567890123456789012"))
+		newKey := base64.StdEncoding.EncodeToString([]byte("abcdefghijklmnopqrstuvwxyz123456"))
+
+		rotationSecret := func(stage string) string {
+			return fmt.Sprintf(`
+apiVersion: v1
+kind: Secret
+metadata:
+  name: %s
+  namespace: %s
+data:
+  secretEncryptionKey: %s
+  newSecretEncryptionKey: %s
+  newSecretEncryptionKeyName: %s
+  rotationStage: %s
+`, secretEncryptionKeySecretName, kubeSystemNS, currentKey, newKey,
+				base64.StdEncoding.EncodeToString([]byte("key-20261018120000")),
+				base64.StdEncoding.EncodeToString([]byte(stage)))
+		}
+
+		Context("New key added", func() {
+			BeforeEach(func() {
+				f.BindingContexts.Set(f.KubeStateSet(rotationSecret("KeyAdded")))
+				f.RunHook()
+			})
+
+			It("Should keep encrypting with the current key", func() {
+				Expect(f).To(ExecuteSuccessfully())
+				Expect(f.ValuesGet(secretEncryptionKeyValuePath).String()).To(Equal(currentKey))
+				Expect(f.ValuesGet(secretEncryptionKeysValuePath).String()).To(MatchJSON(fmt.Sprintf(
+					`[{"name":"secretbox","secret":%q},{"name":"key-20261018120000","secret":%q}]`, currentKey, newKey)))
+			})
+		})
+
+		Context("New key promoted", func() {
+			BeforeEach(func() {
+				f.BindingContexts.Set(f.KubeStateSet(rotationSecret("KeyPromoted")))
+				f.RunHook()
+			})
+
+			It("Should encrypt with the new key", func() {
+				Expect(f).To(ExecuteSuccessfully())
+				Expect(f.ValuesGet(secretEncryptionKeysValuePath).String()).To(MatchJSON(fmt.Sprintf(
+					`[{"name":"key-20261018120000","secret":%q},{"name":"secretbox","secret":%q}]`, newKey, currentKey)))
+			})
+		})
+	})
+
+	Context("Migration to KMS", func() {
+		currentKey := base64.StdEncoding.EncodeToString([]byte("12345678901234567890123456789012"))
+		endpoint := "unix:///var/run/kmsplugin/kms.sock"
+
+		BeforeEach(func() {
+			f.ValuesSet(kmsEndpointConfigValuePath, endpoint)
+			f.BindingContexts.Set(f.KubeStateSet(fmt.Sprintf(`
+apiVersion: v1
+kind: Secret
+metadata:
+  name: %s
+  namespace: %s
+data:
+  secretEncryptionKey: %s
+  newSecretEncryptionProvider: %s
+  newSecretEncryptionKeyName: %s
+  kmsEndpoint: %s
+  rotationStage: %s
+`, secretEncryptionKeySecretName, kubeSystemNS, currentKey,
+				base64.StdEncoding.EncodeToString([]byte("KMS")),
+				base64.StdEncoding.EncodeToString([]byte("d8-kms")),
+				base64.StdEncoding.EncodeToString([]byte(endpoint)),
+				base64.StdEncoding.EncodeToString([]byte("KeyPromoted")))))
+			f.RunHook()
+		})
+
+		It("Should encrypt with the KMS provider and keep the static key for decryption", func() {
+			Expect(f).To(ExecuteSuccessfully())
+			Expect(f.ValuesGet(secretEncryptionKeyValuePath).String()).To(Equal(currentKey))
+			Expect(f.ValuesGet(secretEncryptionKeysValuePath).String()).To(MatchJSON(fmt.Sprintf(
+				`[{"name":"d8-kms","endpoint":%q},{"name":"secretbox","secret":%q}]`, endpoint, currentKey)))
+		})
+	})
+
+	Context("KMS provider in use", func() {
+		kmsSecret := func(endpoint string) string {
+			return fmt.Sprintf(`
+apiVersion: v1
+kind: Secret
+metadata:
+  name: %s
+  namespace: %s
+data:
+  secretEncryptionProvider: %s
+  secretEncryptionKeyName: %s
+  kmsEndpoint: %s
+`, secretEncryptionKeySecretName, kubeSystemNS,
+				base64.StdEncoding.EncodeToString([]byte("KMS")),
+				base64.StdEncoding.EncodeToString([]byte("d8-kms")),
+				base64.StdEncoding.EncodeToString([]byte(endpoint)))
+		}
+
+		Context("Endpoint configured", func() {
+			BeforeEach(func() {
+				f.ValuesSet(kmsEndpointConfigValuePath, "unix:///var/run/kmsplugin/new.sock")
+				f.BindingContexts.Set(f.KubeStateSet(kmsSecret("unix:///var/run/kmsplugin/kms.sock")))
+				f.RunHook()
+			})
+
+			It("Should use the configured endpoint and not generate a static key", func() {
+				Expect(f).To(ExecuteSuccessfully())
+				Expect(f.ValuesGet(secretEncryptionKeyValuePath).Exists()).To(BeFalse())
+				Expect(f.ValuesGet(secretEncryptionKeysValuePath).String()).To(MatchJSON(
+					`[{"name":"d8-kms","endpoint":"unix:///var/run/kmsplugin/new.sock"}]`))
+			})
+
+			It("Should record the configured endpoint in the secret", func() {
+				Expect(f).To(ExecuteSuccessfully())
+				secret := f.KubernetesResource("Secret", kubeSystemNS, secretEncryptionKeySecretName)
+				Expect(secret.Field("data.kmsEndpoint").String()).To(Equal(
+					base64.StdEncoding.EncodeToString([]byte("unix:///var/run/kmsplugin/new.sock"))))
+			})
+		})
+
+		Context("Endpoint removed from the configuration", func() {
+			BeforeEach(func() {
+				f.BindingContexts.Set(f.KubeStateSet(kmsSecret("unix:///var/run/kmsplugin/kms.sock")))
+				f.RunHook()
+			})
+
+			It("Should keep the stored endpoint until the migration back to a static key", func() {
+				Expect(f).To(ExecuteSuccessfully())
+				Expect(f.ValuesGet(secretEncryptionKeysValuePath).String()).To(MatchJSON(
+					`[{"name":"d8-kms","endpoint":"unix:///var/run/kmsplugin/kms.sock"}]`))
+			})
 		})
 	})
 
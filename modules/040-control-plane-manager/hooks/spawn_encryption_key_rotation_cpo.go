@@ -0,0 +1,220 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hooks
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/flant/addon-operator/pkg/module_manager/go_hook"
+	"github.com/flant/addon-operator/sdk"
+	"github.com/flant/shell-operator/pkg/kube_events_manager/types"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/ptr"
+
+	sdkobjectpatch "github.com/deckhouse/module-sdk/pkg/object-patch"
+)
+
+const (
+	encryptionKeyRotationPeriodDaysPath = "controlPlaneManager.apiserver.encryptionKeyRotationPeriodDays"
+	encryptionKeyRotatedAtAnnotation    = "control-plane.deckhouse.io/encryption-key-rotated-at"
+)
+
+// encryptionKeyRotationNow is overridden in tests to inject a fixed time.
+var encryptionKeyRotationNow = time.Now
+
+type encryptionKeyAge struct {
+	// RotatedAt is the time the current key was rotated in, or the time the secret was created for the first key.
+	RotatedAt  time.Time `json:"rotatedAt"`
+	InProgress bool      `json:"inProgress"`
+	// Provider is KMS once the secrets are encrypted by the KMS plugin instead of a static key.
+	Provider string `json:"provider,omitempty"`
+}
+
+var _ = sdk.RegisterFunc(&go_hook.HookConfig{
+	Queue: moduleQueue + "/encryption_key_rotation",
+	Schedule: []go_hook.ScheduleConfig{
+		{
+			Crontab: "0 * * * *",
+			Name:    "encryption-key-rotation-tick",
+		},
+	},
+	Kubernetes: []go_hook.KubernetesConfig{
+		{
+			Name:       "encryption_key_age",
+			ApiVersion: "v1",
+			Kind:       "Secret",
+			NamespaceSelector: &types.NamespaceSelector{
+				NameSelector: &types.NameSelector{
+					MatchNames: []string{kubeSystemNS},
+				},
+			},
+			NameSelector: &types.NameSelector{
+				MatchNames: []string{secretEncryptionKeySecretName},
+			},
+			ExecuteHookOnEvents: ptr.To(false),
+			FilterFunc:          filterEncryptionKeyAge,
+		},
+		{
+			Name:       "kube_apiserver_pods_rotation",
+			ApiVersion: "v1",
+			Kind:       "Pod",
+			NamespaceSelector: &types.NamespaceSelector{
+				NameSelector: &types.NameSelector{
+					MatchNames: []string{kubeSystemNS},
+				},
+			},
+			LabelSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					"component": "kube-apiserver",
+					"tier":      "control-plane",
+				},
+			},
+			ExecuteHookOnEvents: ptr.To(false),
+			FilterFunc:          filterDefragEtcdPod,
+		},
+	},
+}, handleSpawnEncryptionKeyRotationCPO)
+
+func filterEncryptionKeyAge(obj *unstructured.Unstructured) (go_hook.FilterResult, error) {
+	var secret corev1.Secret
+	if err := sdk.FromUnstructured(obj, &secret); err != nil {
+		return nil, fmt.Errorf("parse encryption key Secret: %w", err)
+	}
+
+	age := encryptionKeyAge{
+		RotatedAt:  secret.CreationTimestamp.UTC(),
+		InProgress: len(secret.Data[secretEncryptionKeyStageSecretKey]) > 0,
+		Provider:   string(secret.Data[secretEncryptionProviderSecretKey]),
+	}
+	if rotatedAt, ok := secret.Annotations[encryptionKeyRotatedAtAnnotation]; ok {
+		parsed, err := time.Parse(time.RFC3339, rotatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("parse %s annotation %q: %w", encryptionKeyRotatedAtAnnotation, rotatedAt, err)
+		}
+		age.RotatedAt = parsed.UTC()
+	}
+
+	return age, nil
+}
+
+func handleSpawnEncryptionKeyRotationCPO(_ context.Context, input *go_hook.HookInput) error {
+	if !input.Values.Get(secretEncryptionEnabledConfigValuePath).Bool() {
+		input.Logger.Debug("secret encryption disabled, skipping")
+		return nil
+	}
+	if !input.Values.Get(clusterIsBootstrappedPath).Bool() {
+		input.Logger.Debug("cluster not bootstrapped yet, skipping")
+		return nil
+	}
+
+	ages, err := sdkobjectpatch.UnmarshalToStruct[encryptionKeyAge](input.Snapshots, "encryption_key_age")
+	if err != nil {
+		return fmt.Errorf("unmarshal encryption_key_age snapshot: %w", err)
+	}
+	if len(ages) == 0 {
+		return nil
+	}
+	age := ages[0]
+
+	if !age.InProgress && !encryptionKeyRotationDue(input, age) {
+		return nil
+	}
+
+	var nodeNames []string
+	for nodeName, err := range sdkobjectpatch.SnapshotIter[string](input.Snapshots.Get("kube_apiserver_pods_rotation")) {
+		if err != nil {
+			return fmt.Errorf("iterate kube_apiserver_pods_rotation: %w", err)
+		}
+		if nodeName != "" {
+			nodeNames = append(nodeNames, nodeName)
+		}
+	}
+	if len(nodeNames) == 0 {
+		input.Logger.Warn("encryption key rotation: no node with a ready kube-apiserver pod, will retry")
+		return nil
+	}
+	sort.Strings(nodeNames)
+
+	// The name only depends on the key being rotated, so one operation is created per rotation.
+	name := encryptionKeyRotationCPOName(age.RotatedAt)
+	input.PatchCollector.CreateIfNotExists(buildEncryptionKeyRotationCPO(name, nodeNames[0]))
+	input.Logger.Info("encryption key rotation: CPO created", "name", name, "node", nodeNames[0])
+
+	return nil
+}
+
+// encryptionKeyRotationDue tells whether the key must be replaced. A migration to or from the KMS provider
+// is the same rotation with the KMS provider as one of the keys, so it is due as soon as it is configured.
+// The KMS plugin rotates its own keys: the periodic rotation only applies to a static key.
+func encryptionKeyRotationDue(input *go_hook.HookInput, age encryptionKeyAge) bool {
+	kmsConfigured := input.Values.Get(kmsEndpointConfigValuePath).String() != ""
+	usesKMS := age.Provider == secretEncryptionProviderKMS
+	if kmsConfigured != usesKMS {
+		return true
+	}
+	if usesKMS {
+		return false
+	}
+
+	periodDays, ok := input.Values.GetOk(encryptionKeyRotationPeriodDaysPath)
+	if !ok {
+		return false
+	}
+	due := age.RotatedAt.Add(time.Duration(periodDays.Int()) * 24 * time.Hour)
+	return !encryptionKeyRotationNow().Before(due)
+}
+
+func encryptionKeyRotationCPOName(rotatedAt time.Time) string {
+	sum := sha256.Sum256([]byte(rotatedAt.Format(time.RFC3339)))
+	return fmt.Sprintf("encryption-key-rotation-%x", sum[:4])
+}
+
+// buildEncryptionKeyRotationCPO builds the rotation operation. It has no ControlPlaneNode owner:
+// it is not an operation of the node, which only runs it, but of every kube-apiserver.
+func buildEncryptionKeyRotationCPO(cpoName, nodeName string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "control-plane.deckhouse.io/v1alpha1",
+		"kind":       "ControlPlaneOperation",
+		"metadata": map[string]interface{}{
+			"name":      cpoName,
+			"namespace": kubeSystemNS,
+			"labels": map[string]interface{}{
+				"control-plane.deckhouse.io/node":      nodeName,
+				"control-plane.deckhouse.io/component": "kube-apiserver",
+				"heritage":                             "deckhouse",
+				"module":                               "control-plane-manager",
+			},
+		},
+		"spec": map[string]interface{}{
+			"nodeName":  nodeName,
+			"component": "KubeAPIServer",
+			"steps": []interface{}{
+				"AddEncryptionKey",
+				"PromoteEncryptionKey",
+				"ReencryptResources",
+				"RemoveEncryptionKey",
+			},
+			"approved": false,
+		},
+	}}
+}
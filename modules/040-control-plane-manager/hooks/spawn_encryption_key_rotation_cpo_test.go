@@ -0,0 +1,223 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hooks
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var rotationTestNow = time.Date(2024, 6, 15, 6, 0, 0, 0, time.UTC)
+
+const (
+	valuesRotationEnabled = `{
+		"global": {"clusterIsBootstrapped": true},
+		"controlPlaneManager": {
+			"internal": {},
+			"apiserver": {"authn": {}, "authz": {}, "encryptionEnabled": true, "encryptionKeyRotationPeriodDays": 90}
+		}
+	}`
+
+	valuesRotationDisabled = `{
+		"global": {"clusterIsBootstrapped": true},
+		"controlPlaneManager": {
+			"internal": {},
+			"apiserver": {"authn": {}, "authz": {}, "encryptionEnabled": true}
+		}
+	}`
+
+	valuesKMSConfigured = `{
+		"global": {"clusterIsBootstrapped": true},
+		"controlPlaneManager": {
+			"internal": {},
+			"apiserver": {"authn": {}, "authz": {}, "encryptionEnabled": true, "encryptionKeyRotationPeriodDays": 90,
+				"kms": {"endpoint": "unix:///var/run/kmsplugin/kms.sock"}}
+		}
+	}`
+)
+
+// kmsEncryptionKeySecretYAML is the secret once the KMS provider replaced the static key.
+func kmsEncryptionKeySecretYAML(rotatedAt string) string {
+	// S01T is KMS, ZDgta21z is d8-kms
+	return fmt.Sprintf(`
+---
+apiVersion: v1
+kind: Secret
+metadata:
+  name: d8-secret-encryption-key
+  namespace: kube-system
+  annotations:
+    control-plane.deckhouse.io/encryption-key-rotated-at: "%s"
+data:
+  secretEncryptionProvider: S01T
+  secretEncryptionKeyName: ZDgta21z
+`, rotatedAt)
+}
+
+func encryptionKeySecretYAML(rotatedAt, stage string) string {
+	data := "  secretEncryptionKey: MTIzNDU2Nzg5MDEyMzQ1Njc4OTAxMjM0NTY3ODkwMTI=\n"
+	if stage != "" {
+		data += "  rotationStage: " + stage + "\n"
+	}
+	return fmt.Sprintf(`
+---
+apiVersion: v1
+kind: Secret
+metadata:
+  name: d8-secret-encryption-key
+  namespace: kube-system
+  annotations:
+    control-plane.deckhouse.io/encryption-key-rotated-at: "%s"
+data:
+%s`, rotatedAt, data)
+}
+
+func readyKubeAPIServerPods(nodes ...string) string {
+	out := ""
+	for _, n := range nodes {
+		out += strings.ReplaceAll(defragEtcdPodYAML(n, true), "etcd", "kube-apiserver")
+	}
+	return out
+}
+
+var _ = Describe("Modules :: control-plane-manager :: hooks :: spawn_encryption_key_rotation_cpo ::", func() {
+	BeforeEach(func() {
+		encryptionKeyRotationNow = func() time.Time { return rotationTestNow }
+	})
+	AfterEach(func() {
+		encryptionKeyRotationNow = time.Now
+	})
+
+	Context("rotation period not set", func() {
+		f := newDefragHook(valuesRotationDisabled)
+		BeforeEach(func() {
+			f.BindingContexts.Set(f.KubeStateSet(encryptionKeySecretYAML("2023-01-01T00:00:00Z", "") + readyKubeAPIServerPods("master-0")))
+			f.RunHook()
+		})
+		It("creates no CPOs", func() {
+			Expect(f).To(ExecuteSuccessfully())
+			count, _ := listCPOs(f)
+			Expect(count).To(Equal(0))
+		})
+	})
+
+	Context("key is younger than the rotation period", func() {
+		f := newDefragHook(valuesRotationEnabled)
+		BeforeEach(func() {
+			f.BindingContexts.Set(f.KubeStateSet(encryptionKeySecretYAML("2024-04-01T00:00:00Z", "") + readyKubeAPIServerPods("master-0")))
+			f.RunHook()
+		})
+		It("creates no CPOs", func() {
+			Expect(f).To(ExecuteSuccessfully())
+			count, _ := listCPOs(f)
+			Expect(count).To(Equal(0))
+		})
+	})
+
+	Context("key is older than the rotation period", func() {
+		f := newDefragHook(valuesRotationEnabled)
+		BeforeEach(func() {
+			f.BindingContexts.Set(f.KubeStateSet(encryptionKeySecretYAML("2024-03-01T00:00:00Z", "") + readyKubeAPIServerPods("master-1", "master-0")))
+			f.RunHook()
+		})
+		It("creates a rotation CPO on the first node with a ready kube-apiserver", func() {
+			Expect(f).To(ExecuteSuccessfully())
+			count, cpos := listCPOs(f)
+			Expect(count).To(Equal(1))
+
+			metadata, _ := cpos[0]["metadata"].(map[string]interface{})
+			Expect(metadata["name"]).To(Equal(encryptionKeyRotationCPOName(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC))))
+			Expect(metadata).ToNot(HaveKey("ownerReferences"))
+
+			spec, _ := cpos[0]["spec"].(map[string]interface{})
+			Expect(spec["nodeName"]).To(Equal("master-0"))
+			Expect(spec["component"]).To(Equal("KubeAPIServer"))
+			Expect(spec["steps"]).To(Equal([]interface{}{"AddEncryptionKey", "PromoteEncryptionKey", "ReencryptResources", "RemoveEncryptionKey"}))
+			Expect(spec["approved"]).To(BeFalse())
+		})
+	})
+
+	Context("rotation in progress", func() {
+		f := newDefragHook(valuesRotationEnabled)
+		BeforeEach(func() {
+			// S2V5UHJvbW90ZWQ= is KeyPromoted
+			f.BindingContexts.Set(f.KubeStateSet(encryptionKeySecretYAML("2024-06-01T00:00:00Z", "S2V5UHJvbW90ZWQ=") + readyKubeAPIServerPods("master-0")))
+			f.RunHook()
+		})
+		It("creates a CPO to continue it", func() {
+			Expect(f).To(ExecuteSuccessfully())
+			count, _ := listCPOs(f)
+			Expect(count).To(Equal(1))
+		})
+	})
+
+	Context("KMS configured while a static key is in use", func() {
+		f := newDefragHook(valuesKMSConfigured)
+		BeforeEach(func() {
+			f.BindingContexts.Set(f.KubeStateSet(encryptionKeySecretYAML("2024-06-01T00:00:00Z", "") + readyKubeAPIServerPods("master-0")))
+			f.RunHook()
+		})
+		It("creates a CPO to migrate to KMS before the key is due", func() {
+			Expect(f).To(ExecuteSuccessfully())
+			count, _ := listCPOs(f)
+			Expect(count).To(Equal(1))
+		})
+	})
+
+	Context("KMS in use and configured", func() {
+		f := newDefragHook(valuesKMSConfigured)
+		BeforeEach(func() {
+			f.BindingContexts.Set(f.KubeStateSet(kmsEncryptionKeySecretYAML("2023-01-01T00:00:00Z") + readyKubeAPIServerPods("master-0")))
+			f.RunHook()
+		})
+		It("creates no CPOs, the KMS plugin rotates its own keys", func() {
+			Expect(f).To(ExecuteSuccessfully())
+			count, _ := listCPOs(f)
+			Expect(count).To(Equal(0))
+		})
+	})
+
+	Context("KMS in use but no longer configured", func() {
+		f := newDefragHook(valuesRotationDisabled)
+		BeforeEach(func() {
+			f.BindingContexts.Set(f.KubeStateSet(kmsEncryptionKeySecretYAML("2024-06-01T00:00:00Z") + readyKubeAPIServerPods("master-0")))
+			f.RunHook()
+		})
+		It("creates a CPO to migrate back to a static key", func() {
+			Expect(f).To(ExecuteSuccessfully())
+			count, _ := listCPOs(f)
+			Expect(count).To(Equal(1))
+		})
+	})
+
+	Context("no ready kube-apiserver", func() {
+		f := newDefragHook(valuesRotationEnabled)
+		BeforeEach(func() {
+			f.BindingContexts.Set(f.KubeStateSet(encryptionKeySecretYAML("2024-03-01T00:00:00Z", "")))
+			f.RunHook()
+		})
+		It("creates no CPOs", func() {
+			Expect(f).To(ExecuteSuccessfully())
+			count, _ := listCPOs(f)
+			Expect(count).To(Equal(0))
+		})
+	})
+})
@@ -0,0 +1,270 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hooks
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/flant/addon-operator/pkg/module_manager/go_hook"
+	"github.com/flant/addon-operator/sdk"
+	"github.com/flant/shell-operator/pkg/kube_events_manager/types"
+	"gopkg.in/robfig/cron.v2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	sdkobjectpatch "github.com/deckhouse/module-sdk/pkg/object-patch"
+)
+
+const (
+	snapshotStateCMName = "d8-control-plane-manager-etcd-snapshot"
+	snapshotLastSlotKey = "lastHandledCronSlot"
+)
+
+// snapshotNow is overridden in tests to inject a fixed time.
+var snapshotNow = time.Now
+
+var _ = sdk.RegisterFunc(&go_hook.HookConfig{
+	Queue: moduleQueue + "/etcd_snapshot",
+	Schedule: []go_hook.ScheduleConfig{
+		{
+			Crontab: "* * * * *",
+			Name:    "etcd-snapshot-tick",
+		},
+	},
+	Kubernetes: []go_hook.KubernetesConfig{
+		{
+			Name:       "control_plane_nodes_snapshot",
+			ApiVersion: "control-plane.deckhouse.io/v1alpha1",
+			Kind:       "ControlPlaneNode",
+			NamespaceSelector: &types.NamespaceSelector{
+				NameSelector: &types.NameSelector{
+					MatchNames: []string{defragStateCMNamespace},
+				},
+			},
+			ExecuteHookOnEvents: ptr.To(false),
+			FilterFunc:          filterDefragCPN,
+		},
+		{
+			Name:       "snapshot_state_cm",
+			ApiVersion: "v1",
+			Kind:       "ConfigMap",
+			NamespaceSelector: &types.NamespaceSelector{
+				NameSelector: &types.NameSelector{
+					MatchNames: []string{defragStateCMNamespace},
+				},
+			},
+			NameSelector:        &types.NameSelector{MatchNames: []string{snapshotStateCMName}},
+			ExecuteHookOnEvents: ptr.To(false),
+			FilterFunc:          filterSnapshotStateCM,
+		},
+		{
+			Name:       "etcd_pods_snapshot",
+			ApiVersion: "v1",
+			Kind:       "Pod",
+			NamespaceSelector: &types.NamespaceSelector{
+				NameSelector: &types.NameSelector{
+					MatchNames: []string{defragStateCMNamespace},
+				},
+			},
+			LabelSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					"component": "etcd",
+					"tier":      "control-plane",
+				},
+			},
+			ExecuteHookOnEvents: ptr.To(false),
+			FilterFunc:          filterDefragEtcdPod,
+		},
+	},
+}, handleSpawnEtcdSnapshotCPO)
+
+func filterSnapshotStateCM(obj *unstructured.Unstructured) (go_hook.FilterResult, error) {
+	var cm corev1.ConfigMap
+	if err := sdk.FromUnstructured(obj, &cm); err != nil {
+		return nil, fmt.Errorf("parse snapshot state ConfigMap: %w", err)
+	}
+	return cm.Data[snapshotLastSlotKey], nil
+}
+
+func handleSpawnEtcdSnapshotCPO(_ context.Context, input *go_hook.HookInput) error {
+	if !input.Values.Get(etcdSnapshotsEnabledInternalPath).Bool() {
+		input.Logger.Debug("etcd snapshots disabled, skipping")
+		return nil
+	}
+	if !input.Values.Get(clusterIsBootstrappedPath).Bool() {
+		input.Logger.Debug("cluster not bootstrapped yet, skipping")
+		return nil
+	}
+
+	cronSpec := input.Values.Get(etcdSnapshotsScheduleInternalPath).String()
+	sched, err := cron.Parse("TZ=UTC " + cronSpec)
+	if err != nil {
+		// Normally this code can't be reached because the valid default cronSchedule is set in the compute_etcd_snapshots.go hook
+		input.Logger.Warn("etcd snapshots cronSchedule is invalid, skipping tick", "cronSchedule", cronSpec, "err", err)
+		return nil
+	}
+
+	currentSlot := snapshotNow().UTC().Truncate(time.Minute)
+
+	var lastHandled time.Time
+	for slot, err := range sdkobjectpatch.SnapshotIter[string](input.Snapshots.Get("snapshot_state_cm")) {
+		if err != nil {
+			return fmt.Errorf("unmarshal snapshot state ConfigMap: %w", err)
+		}
+		if slot != "" {
+			parsed, err := time.Parse(time.RFC3339, slot)
+			if err != nil {
+				return fmt.Errorf("parse lastHandledCronSlot %q: %w", slot, err)
+			}
+			lastHandled = parsed.UTC()
+		}
+	}
+
+	// First install: no state CM yet. The first snapshot is taken at the next scheduled slot.
+	if lastHandled.IsZero() {
+		input.Logger.Info("etcd snapshots: first run, initializing state; CPO will be created at the next scheduled slot")
+		input.PatchCollector.CreateOrUpdate(buildSnapshotStateCM(currentSlot))
+		return nil
+	}
+
+	nextSlot := sched.Next(lastHandled)
+	input.Logger.Debug("etcd snapshots cron check",
+		"currentSlot", currentSlot.Format(time.RFC3339),
+		"lastHandled", lastHandled.Format(time.RFC3339),
+		"nextSlot", nextSlot.Format(time.RFC3339),
+	)
+
+	if currentSlot.Before(nextSlot) {
+		return nil
+	}
+
+	// Unlike defrag, a missed slot is not skipped: a late snapshot is still better than none.
+	// All missed slots collapse into one snapshot.
+
+	var nodeNames []string
+	cpnUIDs := make(map[string]k8stypes.UID)
+	for cpn, err := range sdkobjectpatch.SnapshotIter[defragCPN](input.Snapshots.Get("control_plane_nodes_snapshot")) {
+		if err != nil {
+			return fmt.Errorf("iterate control_plane_nodes_snapshot: %w", err)
+		}
+		nodeNames = append(nodeNames, cpn.Name)
+		cpnUIDs[cpn.Name] = cpn.UID
+	}
+	sort.Strings(nodeNames)
+
+	etcdReadyNodes := make(map[string]struct{})
+	for nodeName, err := range sdkobjectpatch.SnapshotIter[string](input.Snapshots.Get("etcd_pods_snapshot")) {
+		if err != nil {
+			return fmt.Errorf("iterate etcd_pods_snapshot: %w", err)
+		}
+		if nodeName != "" {
+			etcdReadyNodes[nodeName] = struct{}{}
+		}
+	}
+
+	// A snapshot of any healthy member is a snapshot of the whole cluster, so one member is enough.
+	// The slot stays unhandled until such a member exists, so the snapshot is retried on the next tick.
+	var target string
+	for _, nodeName := range nodeNames {
+		if _, ok := etcdReadyNodes[nodeName]; ok {
+			target = nodeName
+			break
+		}
+	}
+	if target == "" {
+		input.Logger.Warn("etcd snapshots: no node with a ready etcd pod, will retry", "nodes", nodeNames)
+		return nil
+	}
+
+	name := etcdSnapshotCPOName(nextSlot, target)
+	input.PatchCollector.CreateIfNotExists(buildSnapshotCPO(name, target, nextSlot, cpnUIDs[target]))
+	input.Logger.Info("etcd snapshots: CPO created", "name", name, "node", target, "slot", nextSlot.Format(time.RFC3339))
+
+	input.PatchCollector.CreateOrUpdate(buildSnapshotStateCM(currentSlot))
+
+	return nil
+}
+
+func etcdSnapshotCPOName(slotTime time.Time, nodeName string) string {
+	slot := sha256.Sum256([]byte(slotTime.Format(time.RFC3339)))
+	name := sha256.Sum256([]byte(nodeName))
+	return fmt.Sprintf("etcd-snapshot-%x-%x", slot[:3], name[:3])
+}
+
+func buildSnapshotCPO(cpoName, nodeName string, slotTime time.Time, cpnUID k8stypes.UID) *unstructured.Unstructured {
+	obj := map[string]interface{}{
+		"apiVersion": "control-plane.deckhouse.io/v1alpha1",
+		"kind":       "ControlPlaneOperation",
+		"metadata": map[string]interface{}{
+			"name":      cpoName,
+			"namespace": defragStateCMNamespace,
+			"labels": map[string]interface{}{
+				"control-plane.deckhouse.io/node":      nodeName,
+				"control-plane.deckhouse.io/component": "etcd",
+				"control-plane.deckhouse.io/slot":      slotTime.Format("060102-1504"),
+				"heritage":                             "deckhouse",
+				"module":                               "control-plane-manager",
+			},
+		},
+		"spec": map[string]interface{}{
+			"nodeName":  nodeName,
+			"component": "Etcd",
+			"steps":     []interface{}{"SnapshotEtcd"},
+			"approved":  false,
+		},
+	}
+
+	if cpnUID != "" {
+		obj["metadata"].(map[string]interface{})["ownerReferences"] = []interface{}{
+			map[string]interface{}{
+				"apiVersion":         "control-plane.deckhouse.io/v1alpha1",
+				"kind":               "ControlPlaneNode",
+				"name":               nodeName,
+				"uid":                string(cpnUID),
+				"controller":         true,
+				"blockOwnerDeletion": false,
+			},
+		}
+	}
+
+	return &unstructured.Unstructured{Object: obj}
+}
+
+func buildSnapshotStateCM(lastHandled time.Time) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "ConfigMap",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      snapshotStateCMName,
+			Namespace: defragStateCMNamespace,
+			Labels: map[string]string{
+				"heritage": "deckhouse",
+				"module":   "control-plane-manager",
+			},
+		},
+		Data: map[string]string{snapshotLastSlotKey: lastHandled.Format(time.RFC3339)},
+	}
+}
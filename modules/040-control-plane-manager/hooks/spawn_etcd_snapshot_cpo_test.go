@@ -0,0 +1,188 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hooks
+
+import (
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// snapshotTestNow is 06:02 UTC — 2 minutes past the "0 */6 * * *" cron slot.
+var snapshotTestNow = time.Date(2024, 6, 15, 6, 2, 0, 0, time.UTC)
+
+const (
+	// snapshotPastSlot is before 06:00, so sched.Next(pastSlot) = 06:00 ≤ 06:02 → hook fires.
+	snapshotPastSlot = "2024-06-15T05:59:00Z"
+	// snapshotCurrentSlot matches snapshotTestNow; sched.Next = 12:00 → no fire.
+	snapshotCurrentSlot = "2024-06-15T06:02:00Z"
+
+	valuesSnapshotsEnabled = `{
+		"global": {"clusterIsBootstrapped": true},
+		"controlPlaneManager": {
+			"internal": {
+				"etcdSnapshots": {"enabled": true, "cronSchedule": "0 */6 * * *"}
+			},
+			"apiserver": {"authn": {}, "authz": {}}
+		}
+	}`
+
+	valuesSnapshotsDisabled = `{
+		"global": {"clusterIsBootstrapped": true},
+		"controlPlaneManager": {
+			"internal": {
+				"etcdSnapshots": {"enabled": false, "cronSchedule": "0 */6 * * *"}
+			},
+			"apiserver": {"authn": {}, "authz": {}}
+		}
+	}`
+)
+
+func snapshotStateCMYAML(slot string) string {
+	return fmt.Sprintf(`
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: d8-control-plane-manager-etcd-snapshot
+  namespace: kube-system
+data:
+  lastHandledCronSlot: "%s"
+`, slot)
+}
+
+var _ = Describe("Modules :: control-plane-manager :: hooks :: spawn_etcd_snapshot_cpo ::", func() {
+	BeforeEach(func() {
+		snapshotNow = func() time.Time { return snapshotTestNow }
+	})
+	AfterEach(func() {
+		snapshotNow = time.Now
+	})
+
+	Context("snapshots disabled in internal values", func() {
+		f := newDefragHook(valuesSnapshotsDisabled)
+		BeforeEach(func() {
+			state := defragCPN0 + defragReadyEtcdPods("master-0") + snapshotStateCMYAML(snapshotPastSlot)
+			f.BindingContexts.Set(f.KubeStateSet(state))
+			f.RunHook()
+		})
+		It("executes successfully and creates no CPOs", func() {
+			Expect(f).To(ExecuteSuccessfully())
+			count, _ := listCPOs(f)
+			Expect(count).To(Equal(0))
+		})
+	})
+
+	Context("first install: no ConfigMap", func() {
+		f := newDefragHook(valuesSnapshotsEnabled)
+		BeforeEach(func() {
+			f.BindingContexts.Set(f.KubeStateSet(defragCPN0 + defragReadyEtcdPods("master-0")))
+			f.RunHook()
+		})
+		It("initializes the ConfigMap without creating CPOs", func() {
+			Expect(f).To(ExecuteSuccessfully())
+			count, _ := listCPOs(f)
+			Expect(count).To(Equal(0))
+			cm := f.KubernetesResource("ConfigMap", "kube-system", snapshotStateCMName)
+			Expect(cm.Exists()).To(BeTrue())
+			Expect(cm.Field("data.lastHandledCronSlot").String()).To(Equal(snapshotCurrentSlot))
+		})
+	})
+
+	Context("cron slot already handled (idempotency)", func() {
+		f := newDefragHook(valuesSnapshotsEnabled)
+		BeforeEach(func() {
+			state := defragCPN0 + defragReadyEtcdPods("master-0") + snapshotStateCMYAML(snapshotCurrentSlot)
+			f.BindingContexts.Set(f.KubeStateSet(state))
+			f.RunHook()
+		})
+		It("executes successfully and creates no CPOs", func() {
+			Expect(f).To(ExecuteSuccessfully())
+			count, _ := listCPOs(f)
+			Expect(count).To(Equal(0))
+		})
+	})
+
+	Context("cron fired, 3 master nodes, first one not Ready", func() {
+		f := newDefragHook(valuesSnapshotsEnabled)
+		BeforeEach(func() {
+			state := defragCPN0 + defragCPN1 + defragCPN2 +
+				defragEtcdPodYAML("master-0", false) +
+				defragReadyEtcdPods("master-1", "master-2") +
+				snapshotStateCMYAML(snapshotPastSlot)
+			f.BindingContexts.Set(f.KubeStateSet(state))
+			f.RunHook()
+		})
+		It("creates a single SnapshotEtcd CPO on the first healthy member and updates the ConfigMap", func() {
+			Expect(f).To(ExecuteSuccessfully())
+
+			count, cpos := listCPOs(f)
+			Expect(count).To(Equal(1))
+
+			spec, _ := cpos[0]["spec"].(map[string]interface{})
+			Expect(spec["nodeName"]).To(Equal("master-1"))
+			Expect(spec["component"]).To(Equal("Etcd"))
+			Expect(spec["approved"]).To(Equal(false))
+			steps, _ := spec["steps"].([]interface{})
+			Expect(steps).To(ConsistOf("SnapshotEtcd"))
+
+			meta, _ := cpos[0]["metadata"].(map[string]interface{})
+			Expect(meta["name"]).To(HavePrefix("etcd-snapshot-"))
+			labels, _ := meta["labels"].(map[string]interface{})
+			Expect(labels["control-plane.deckhouse.io/node"]).To(Equal("master-1"))
+			ownerRefs, _ := meta["ownerReferences"].([]interface{})
+			Expect(ownerRefs).To(HaveLen(1))
+
+			cm := f.KubernetesResource("ConfigMap", "kube-system", snapshotStateCMName)
+			Expect(cm.Field("data.lastHandledCronSlot").String()).To(Equal(snapshotCurrentSlot))
+		})
+	})
+
+	Context("cron fired, no etcd member is Ready", func() {
+		f := newDefragHook(valuesSnapshotsEnabled)
+		BeforeEach(func() {
+			state := defragCPN0 + defragEtcdPodYAML("master-0", false) + snapshotStateCMYAML(snapshotPastSlot)
+			f.BindingContexts.Set(f.KubeStateSet(state))
+			f.RunHook()
+		})
+		It("creates no CPOs and keeps the slot unhandled to retry on the next tick", func() {
+			Expect(f).To(ExecuteSuccessfully())
+			count, _ := listCPOs(f)
+			Expect(count).To(Equal(0))
+			cm := f.KubernetesResource("ConfigMap", "kube-system", snapshotStateCMName)
+			Expect(cm.Field("data.lastHandledCronSlot").String()).To(Equal(snapshotPastSlot))
+		})
+	})
+
+	Context("slot missed while Deckhouse was down", func() {
+		f := newDefragHook(valuesSnapshotsEnabled)
+		BeforeEach(func() {
+			state := defragCPN0 + defragReadyEtcdPods("master-0") + snapshotStateCMYAML("2024-06-14T00:00:00Z")
+			f.BindingContexts.Set(f.KubeStateSet(state))
+			f.RunHook()
+		})
+		It("catches up with a single snapshot", func() {
+			Expect(f).To(ExecuteSuccessfully())
+			count, _ := listCPOs(f)
+			Expect(count).To(Equal(1))
+			cm := f.KubernetesResource("ConfigMap", "kube-system", snapshotStateCMName)
+			Expect(cm.Field("data.lastHandledCronSlot").String()).To(Equal(snapshotCurrentSlot))
+		})
+	})
+})
@@ -25,16 +25,28 @@ const (
 	CPOConditionApproved  = "Approved"
 	CPOConditionCompleted = "Completed"
 
-	CPOConditionBackupDone           = "BackupDone"
-	CPOConditionCASynced             = "CASynced"
-	CPOConditionPKICertsRenewed      = "PKICertsRenewed"
-	CPOConditionKubeconfigsRenewed   = "KubeconfigsRenewed"
-	CPOConditionManifestsSynced      = "ManifestsSynced"
-	CPOConditionEtcdClusterJoined    = "EtcdClusterJoined"
-	CPOConditionEtcdDefragmented     = "EtcdDefragmented"
-	CPOConditionPodReady             = "PodReady"
-	CPOConditionCertificatesObserved = "CertificatesObserved"
-	CPOConditionSignatureRenewed     = "SignatureRenewed"
+	CPOConditionBackupDone            = "BackupDone"
+	CPOConditionCASynced              = "CASynced"
+	CPOConditionPKICertsRenewed       = "PKICertsRenewed"
+	CPOConditionKubeconfigsRenewed    = "KubeconfigsRenewed"
+	CPOConditionManifestsSynced       = "ManifestsSynced"
+	CPOConditionEtcdClusterJoined     = "EtcdClusterJoined"
+	CPOConditionEtcdDefragmented      = "EtcdDefragmented"
+	CPOConditionPodReady              = "PodReady"
+	CPOConditionCertificatesObserved  = "CertificatesObserved"
+	CPOConditionSignatureRenewed      = "SignatureRenewed"
+	CPOConditionRestored              = "Restored"
+	CPOConditionEtcdSnapshotTaken     = "EtcdSnapshotTaken"
+	CPOConditionEtcdStopped           = "EtcdStopped"
+	CPOConditionEtcdSnapshotRestored  = "EtcdSnapshotRestored"
+	CPOConditionKubeAPIServerStarted  = "KubeAPIServerStarted"
+	CPOConditionEncryptionKeyAdded    = "EncryptionKeyAdded"
+	CPOConditionEncryptionKeyPromoted = "EncryptionKeyPromoted"
+	CPOConditionResourcesReencrypted  = "ResourcesReencrypted"
+	CPOConditionEncryptionKeyRemoved  = "EncryptionKeyRemoved"
+
+	// CPOConditionRolledBack tracks the automatic rollback of an operation whose pod did not become ready.
+	CPOConditionRolledBack = "RolledBack"
 )
 
 const (
@@ -49,6 +61,7 @@ const (
 	CPOReasonOperationCompleted  = "OperationCompleted"
 	CPOReasonOperationFailed     = "OperationFailed"
 	CPOReasonOperationAbandoned  = "OperationAbandoned"
+	CPOReasonOperationRolledBack = "OperationRolledBack"
 
 	// For RenewPKICerts and RenewKubeconfigs steps, used as Message for the step completed condition.
 	CPOStepResultRenewed    = "Renewed"
@@ -85,6 +98,24 @@ func StepConditionType(step StepName) string {
 		return CPOConditionCertificatesObserved
 	case StepRenewSignature:
 		return CPOConditionSignatureRenewed
+	case StepRestore:
+		return CPOConditionRestored
+	case StepSnapshotEtcd:
+		return CPOConditionEtcdSnapshotTaken
+	case StepStopEtcd:
+		return CPOConditionEtcdStopped
+	case StepRestoreEtcdSnapshot:
+		return CPOConditionEtcdSnapshotRestored
+	case StepStartKubeAPIServer:
+		return CPOConditionKubeAPIServerStarted
+	case StepAddEncryptionKey:
+		return CPOConditionEncryptionKeyAdded
+	case StepPromoteEncryptionKey:
+		return CPOConditionEncryptionKeyPromoted
+	case StepReencryptResources:
+		return CPOConditionResourcesReencrypted
+	case StepRemoveEncryptionKey:
+		return CPOConditionEncryptionKeyRemoved
 	default:
 		return string(step)
 	}
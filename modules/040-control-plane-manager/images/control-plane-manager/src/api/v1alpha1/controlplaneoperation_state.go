@@ -38,9 +38,21 @@ func (op *ControlPlaneOperation) IsAbandoned() bool {
 	return cond != nil && cond.Status == metav1.ConditionFalse && cond.Reason == CPOReasonOperationAbandoned
 }
 
+// IsRolledBack reports whether the operation was rolled back to its backup after the pod did not become ready.
+func (op *ControlPlaneOperation) IsRolledBack() bool {
+	cond := op.GetCondition(CPOConditionCompleted)
+	return cond != nil && cond.Status == metav1.ConditionFalse && cond.Reason == CPOReasonOperationRolledBack
+}
+
+// IsRollingBack reports whether the operation backup has been requested to be put back and the rollback is not finished yet.
+func (op *ControlPlaneOperation) IsRollingBack() bool {
+	cond := op.GetCondition(CPOConditionRolledBack)
+	return cond != nil && cond.Status == metav1.ConditionFalse && cond.Reason == CPOReasonStepInProgress
+}
+
 // IsTerminal reports whether the operation reached a final state and must not be retried.
 func (op *ControlPlaneOperation) IsTerminal() bool {
-	return op.IsCompleted() || op.IsAbandoned()
+	return op.IsCompleted() || op.IsAbandoned() || op.IsRolledBack()
 }
 
 func (op *ControlPlaneOperation) IsStepCompleted(name StepName) bool {
@@ -71,10 +83,12 @@ func NewOperationState(op *ControlPlaneOperation) *OperationState {
 	return &OperationState{op: op, original: op.DeepCopy()}
 }
 
-func (s *OperationState) IsCompleted() bool { return s.op.IsCompleted() }
-func (s *OperationState) IsFailed() bool    { return s.op.IsFailed() }
-func (s *OperationState) IsTerminal() bool  { return s.op.IsTerminal() }
-func (s *OperationState) IsAbandoned() bool { return s.op.IsAbandoned() }
+func (s *OperationState) IsCompleted() bool   { return s.op.IsCompleted() }
+func (s *OperationState) IsFailed() bool      { return s.op.IsFailed() }
+func (s *OperationState) IsTerminal() bool    { return s.op.IsTerminal() }
+func (s *OperationState) IsAbandoned() bool   { return s.op.IsAbandoned() }
+func (s *OperationState) IsRolledBack() bool  { return s.op.IsRolledBack() }
+func (s *OperationState) IsRollingBack() bool { return s.op.IsRollingBack() }
 func (s *OperationState) IsStepCompleted(name StepName) bool {
 	return s.op.IsStepCompleted(name)
 }
@@ -182,6 +196,27 @@ func (s *OperationState) MarkOperationFailed(message string) {
 	s.setOperationCondition(CPOReasonOperationFailed, message)
 }
 
+// MarkRollbackInProgress records that the operation backup is being put back.
+func (s *OperationState) MarkRollbackInProgress(message string) {
+	s.SetCondition(metav1.Condition{
+		Type:    CPOConditionRolledBack,
+		Status:  metav1.ConditionFalse,
+		Reason:  CPOReasonStepInProgress,
+		Message: message,
+	})
+}
+
+// MarkOperationRolledBack finishes the rollback and makes the operation terminal.
+func (s *OperationState) MarkOperationRolledBack(message string) {
+	s.SetCondition(metav1.Condition{
+		Type:    CPOConditionRolledBack,
+		Status:  metav1.ConditionTrue,
+		Reason:  CPOReasonStepCompleted,
+		Message: message,
+	})
+	s.setOperationCondition(CPOReasonOperationRolledBack, message)
+}
+
 func (s *OperationState) MarkOperationCompleted() {
 	s.SetCondition(metav1.Condition{
 		Type:    CPOConditionCompleted,
@@ -195,6 +230,10 @@ func (s *OperationState) SetObservedState(state *ObservedComponentState) {
 	s.op.Status.ObservedState = state
 }
 
+func (s *OperationState) SetRestore(restore *RestoreStatus) {
+	s.op.Status.Restore = restore
+}
+
 func (s *OperationState) markCurrentInProgressStep(reason, message string) {
 	for _, name := range s.op.Spec.Steps {
 		cond := s.op.GetCondition(StepConditionType(name))
@@ -17,6 +17,8 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"slices"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -32,8 +34,17 @@ import (
 //   - DefragEtcd       — defragments the etcd data store on the target node to reclaim disk space.
 //   - WaitPodReady     — waits for the component static pod to become Ready after restart.
 //   - CertObserve      — collects current certificate expiration dates for the component and publishes them to status.observedState.
+//   - Restore          — puts back the component files saved by the Backup step of the operation named in spec.restoreFrom.
+//   - SnapshotEtcd     — saves a verified etcd snapshot of the target member into /var/lib/etcd-snapshots and uploads it to S3 if configured.
+//   - StopEtcd         — stops etcd and kube-apiserver on the node for an etcd restore and moves the etcd data directory aside.
+//   - RestoreEtcdSnapshot — stops every etcd member, restores spec.etcdSnapshot on the node as a new single-member cluster and re-joins the other members.
+//   - StartKubeAPIServer  — starts kube-apiserver stopped by StopEtcd and waits for it to become Ready.
+//   - AddEncryptionKey     — generates a new secret encryption key and waits until every kube-apiserver can decrypt with it.
+//   - PromoteEncryptionKey — makes the new key the one used for encryption on every kube-apiserver.
+//   - ReencryptResources   — rewrites every object of the encrypted resources so that it is stored encrypted with the new key.
+//   - RemoveEncryptionKey  — removes the previous key from every kube-apiserver.
 //
-// +kubebuilder:validation:Enum=Backup;SyncCA;RenewPKICerts;RenewKubeconfigs;SyncManifests;JoinEtcdCluster;DefragEtcd;WaitPodReady;CertObserve;RenewSignature
+// +kubebuilder:validation:Enum=Backup;SyncCA;RenewPKICerts;RenewKubeconfigs;SyncManifests;JoinEtcdCluster;DefragEtcd;WaitPodReady;CertObserve;RenewSignature;Restore;SnapshotEtcd;StopEtcd;RestoreEtcdSnapshot;StartKubeAPIServer;AddEncryptionKey;PromoteEncryptionKey;ReencryptResources;RemoveEncryptionKey
 type StepName string
 
 const (
@@ -47,8 +58,27 @@ const (
 	StepWaitPodReady     StepName = "WaitPodReady"
 	StepCertObserve      StepName = "CertObserve"
 	StepRenewSignature   StepName = "RenewSignature"
+	StepRestore          StepName = "Restore"
+	StepSnapshotEtcd     StepName = "SnapshotEtcd"
+
+	StepStopEtcd            StepName = "StopEtcd"
+	StepRestoreEtcdSnapshot StepName = "RestoreEtcdSnapshot"
+	StepStartKubeAPIServer  StepName = "StartKubeAPIServer"
+
+	StepAddEncryptionKey     StepName = "AddEncryptionKey"
+	StepPromoteEncryptionKey StepName = "PromoteEncryptionKey"
+	StepReencryptResources   StepName = "ReencryptResources"
+	StepRemoveEncryptionKey  StepName = "RemoveEncryptionKey"
 )
 
+// encryptionKeyRotationSteps are the steps of a secret encryption key rotation, in their order.
+var encryptionKeyRotationSteps = []StepName{
+	StepAddEncryptionKey,
+	StepPromoteEncryptionKey,
+	StepReencryptResources,
+	StepRemoveEncryptionKey,
+}
+
 // OperationComponent identifies the control plane component the operation targets.
 //
 // Possible values:
@@ -123,6 +153,9 @@ func OperationComponentFromPodName(name string) (OperationComponent, bool) {
 }
 
 // ControlPlaneOperationSpec describes the desired state of an operation.
+// +kubebuilder:validation:XValidation:rule="!self.steps.exists(s, s == 'Restore') || (has(self.restoreFrom) && size(self.restoreFrom) > 0)",message="restoreFrom is required for the Restore step"
+// +kubebuilder:validation:XValidation:rule="!self.steps.exists(s, s == 'RestoreEtcdSnapshot') || (self.component == 'Etcd' && has(self.etcdSnapshot) && size(self.etcdSnapshot) > 0)",message="etcdSnapshot is required for the RestoreEtcdSnapshot step, which is only valid for the Etcd component"
+// +kubebuilder:validation:XValidation:rule="!self.steps.exists(s, s in ['AddEncryptionKey', 'PromoteEncryptionKey', 'ReencryptResources', 'RemoveEncryptionKey']) || (self.component == 'KubeAPIServer' && self.steps.all(s, s in ['AddEncryptionKey', 'PromoteEncryptionKey', 'ReencryptResources', 'RemoveEncryptionKey']))",message="encryption key rotation steps are only valid for the KubeAPIServer component and cannot be combined with other steps"
 type ControlPlaneOperationSpec struct {
 	// NodeName is the name of the control plane node on which the operation must be executed.
 	// +kubebuilder:validation:Required
@@ -158,6 +191,21 @@ type ControlPlaneOperationSpec struct {
 	// +optional
 	DesiredCAChecksum string `json:"desiredCaChecksum,omitempty"`
 
+	// RestoreFrom is the name of an earlier operation for the same node and component
+	// whose backup is put back by the Restore step.
+	//
+	// The backup must still be present in /etc/kubernetes/deckhouse/backup on the node;
+	// only the most recent backups of each component are kept.
+	// +optional
+	RestoreFrom string `json:"restoreFrom,omitempty"`
+
+	// EtcdSnapshot is the name of the snapshot file in /var/lib/etcd-snapshots on the node
+	// that the RestoreEtcdSnapshot step restores.
+	//
+	// The restore replaces the data of the whole cluster with the snapshot content.
+	// +optional
+	EtcdSnapshot string `json:"etcdSnapshot,omitempty"`
+
 	// Approved indicates whether the operation is allowed to run.
 	//
 	// Only one approved operation may run on a node at a time.
@@ -183,9 +231,12 @@ type ControlPlaneOperationStatus struct {
 	//   - OperationCompleted  — the operation finished successfully.
 	//   - OperationFailed     — the operation finished with an error; details are in "message".
 	//   - OperationAbandoned  — desired checksums became stale before the operation finished.
+	//   - OperationRolledBack — the component pod did not become ready after SyncManifests,
+	//                           so the files from the operation backup were put back.
 	//
 	// In addition to "Completed", a separate condition is created for each executed step,
 	// where "type" equals the step name (for example RenewPKICerts, SyncManifests).
+	// An automatic rollback is reflected in the "RolledBack" condition.
 	// +optional
 	// +listMapKey=type
 	// +listType=map
@@ -197,6 +248,22 @@ type ControlPlaneOperationStatus struct {
 	// Populated only for static pod components (etcd, kube-apiserver, kube-controller-manager, kube-scheduler).
 	// +optional
 	ObservedState *ObservedComponentState `json:"observedState,omitempty"`
+
+	// Restore records the component checksums replaced and put back by the Restore step.
+	// +optional
+	Restore *RestoreStatus `json:"restore,omitempty"`
+}
+
+// RestoreStatus describes the component checksums before and after the Restore step.
+// The checksums are read from the annotations of the component static pod manifest.
+type RestoreStatus struct {
+	// Replaced are the checksums of the manifest that was on the node before the backup was put back.
+	// +optional
+	Replaced Checksums `json:"replaced,omitempty"`
+
+	// Restored are the checksums of the manifest put back from the backup.
+	// +optional
+	Restored Checksums `json:"restored,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -230,6 +297,21 @@ func (op *ControlPlaneOperation) IsObserveOnlyOperation() bool {
 		op.Spec.Steps[0] == StepCertObserve
 }
 
+// IsEncryptionKeyRotation reports whether this operation rotates the secret encryption key.
+// Such an operation changes the configuration of every kube-apiserver through the regular operations
+// and waits for them, so it must not hold the kube-apiserver approval slot of its node.
+func (op *ControlPlaneOperation) IsEncryptionKeyRotation() bool {
+	if op.Spec.Component != OperationComponentKubeAPIServer || len(op.Spec.Steps) == 0 {
+		return false
+	}
+	for _, step := range op.Spec.Steps {
+		if !slices.Contains(encryptionKeyRotationSteps, step) {
+			return false
+		}
+	}
+	return true
+}
+
 // HasStep reports whether operation step pipeline includes step.
 func (op *ControlPlaneOperation) HasStep(step StepName) bool {
 	for i := range op.Spec.Steps {
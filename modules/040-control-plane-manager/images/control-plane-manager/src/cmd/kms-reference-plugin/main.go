@@ -0,0 +1,98 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// kms-reference-plugin is a KMS v2 plugin for kube-apiserver that keeps its keys in local files.
+// It is meant for tests: run it on every control plane node with the same key files.
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/deckhouse/deckhouse/pkg/log"
+
+	"control-plane-manager/internal/kmsplugin"
+)
+
+func main() {
+	endpoint := flag.String("endpoint", "unix:///var/run/kmsplugin/kms.sock", "unix socket to serve the KMS v2 API on")
+	keyFiles := flag.String("key-files", "", "comma-separated files with base64-encoded 32-byte keys; the first one encrypts, all of them decrypt")
+	generate := flag.Bool("generate-key", false, "create the first key file with a random key if it does not exist")
+	flag.Parse()
+
+	paths := strings.Split(*keyFiles, ",")
+	if *keyFiles == "" {
+		log.Fatal("--key-files is required")
+	}
+	if *generate {
+		if err := generateKeyFile(paths[0]); err != nil {
+			log.Fatal("failed to generate key", log.Err(err))
+		}
+	}
+
+	keys := make([][]byte, 0, len(paths))
+	for _, path := range paths {
+		key, err := readKeyFile(path)
+		if err != nil {
+			log.Fatal("failed to read key", log.Err(err))
+		}
+		keys = append(keys, key)
+	}
+	plugin, err := kmsplugin.NewReferencePlugin(keys...)
+	if err != nil {
+		log.Fatal("failed to create plugin", log.Err(err))
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	log.Info("serving KMS v2 API", slog.String("endpoint", *endpoint))
+	if err := kmsplugin.Serve(ctx, *endpoint, plugin); err != nil {
+		log.Fatal("failed to serve", log.Err(err))
+	}
+}
+
+func readKeyFile(path string) ([]byte, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(raw)))
+	if err != nil {
+		return nil, fmt.Errorf("decode %s: %w", path, err)
+	}
+	return key, nil
+}
+
+func generateKeyFile(path string) error {
+	if _, err := os.Stat(path); !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	key := make([]byte, kmsplugin.KeySize)
+	if _, err := rand.Read(key); err != nil {
+		return err
+	}
+	return os.WriteFile(path, []byte(base64.StdEncoding.EncodeToString(key)+"\n"), 0o600)
+}
@@ -76,6 +76,26 @@ const (
 	DiffBasePath         = DeckhousePath + "/diffs"
 	MaxDiffsPerComponent = 7
 
+	// Etcd snapshots config
+	EtcdSnapshotsPath             = "/var/lib/etcd-snapshots"
+	EtcdSnapshotsConfigSecretName = "d8-control-plane-manager-etcd-snapshots"
+	DefaultEtcdSnapshotsRetention = 7
+
+	// Etcd restore config
+	EtcdRestorePath     = DeckhousePath + "/etcd-restore"
+	EtcdRestoreLabelKey = "control-plane.deckhouse.io/etcd-restore"
+	EtcdutlPath         = "/usr/bin/etcdutl"
+
+	// Secret encryption key rotation
+	SecretEncryptionKeySecretName       = "d8-secret-encryption-key"
+	EncryptionKeyRotatedAtAnnotationKey = "control-plane.deckhouse.io/encryption-key-rotated-at"
+	ReencryptionProgressAnnotationKey   = "control-plane.deckhouse.io/reencryption-progress" // Set on the operation while ReencryptResources runs
+
+	// KMS encryption provider
+	SecretKeyKMSEndpoint = "kms-endpoint" // Key of d8-control-plane-manager-config, set while the KMS provider is configured
+	KMSProviderName      = "d8-kms"
+	KMSPluginSocketPath  = "/var/run/kmsplugin"
+
 	// CertObserveInterval is the minimum duration between periodic CertObserve steps for a component.
 	CertObserveInterval = 7 * 24 * time.Hour
 
@@ -322,6 +322,23 @@ func (r *Reconciler) ensureOperationsExist(
 			continue
 		}
 
+		// A rolled back operation already proved these checksums do not converge on this node:
+		// retrying would roll the same change out and back again. Wait for a new configuration instead.
+		if rolledBack := findRolledBackOperation(ops, state); rolledBack != nil {
+			logger.Debug("operation with same desired checksums was rolled back, waiting for configuration change",
+				slog.String("operation", rolledBack.Name),
+				slog.String("component", string(state.component)))
+			continue
+		}
+
+		// A manual restore put back older files on purpose: keep them until the desired configuration changes.
+		if restore := findHoldingRestoreOperation(ops, state); restore != nil {
+			logger.Debug("desired checksums were replaced by a restore, waiting for configuration change",
+				slog.String("operation", restore.Name),
+				slog.String("component", string(state.component)))
+			continue
+		}
+
 		steps := determineSteps(state, pkiChanged, caChanged)
 		op := operationBase(cpn, state.component, steps)
 		op.ObjectMeta.GenerateName = operationGenerateNamePrefix(state)
@@ -414,7 +431,7 @@ func findLatestAppliedOperationForComponent(ops []controlplanev1alpha1.ControlPl
 		if op.Spec.Component != component {
 			continue
 		}
-		if !op.IsTerminal() || (!op.IsCompleted() && !hasCommitPoint(op)) {
+		if !op.IsTerminal() || op.IsRolledBack() || (!op.IsCompleted() && !hasCommitPoint(op)) {
 			continue
 		}
 		if latest == nil || op.CreationTimestamp.After(latest.CreationTimestamp.Time) {
@@ -551,6 +568,9 @@ func (r *Reconciler) updateStatusFromOperations(ctx context.Context, cpn *contro
 	for _, state := range states {
 		op := findOperationForState(ops, state)
 		cond := r.conditionForState(state, op, cpn)
+		if restore := findHoldingRestoreOperation(ops, state); restore != nil {
+			cond = restoredCondition(state, restore, cpn)
+		}
 		meta.SetStatusCondition(&cpn.Status.Conditions, cond)
 
 		if latestOp := findLatestAppliedOperationForComponent(ops, state.component); latestOp != nil {
@@ -680,6 +700,51 @@ func findActiveOperation(ops []controlplanev1alpha1.ControlPlaneOperation, match
 	return latest
 }
 
+// findRolledBackOperation returns a rolled back operation targeting the same desired checksums as state, if any.
+func findRolledBackOperation(ops []controlplanev1alpha1.ControlPlaneOperation, state componentState) *controlplanev1alpha1.ControlPlaneOperation {
+	for i := range ops {
+		op := &ops[i]
+		if op.Spec.Component == state.component && op.IsRolledBack() && matchesDesiredChecksums(op, state) {
+			return op
+		}
+	}
+	return nil
+}
+
+// findHoldingRestoreOperation returns the latest operation that changed the component files if it is
+// a manual restore that replaced the files of the desired checksums in state.
+func findHoldingRestoreOperation(ops []controlplanev1alpha1.ControlPlaneOperation, state componentState) *controlplanev1alpha1.ControlPlaneOperation {
+	var latest *controlplanev1alpha1.ControlPlaneOperation
+	for i := range ops {
+		op := &ops[i]
+		if op.Spec.Component != state.component {
+			continue
+		}
+		if !op.IsTerminal() || op.IsRolledBack() || (!op.IsCompleted() && !hasCommitPoint(op)) {
+			continue
+		}
+		// Observe and renewal operations leave the configuration as it is.
+		if op.Status.Restore == nil && op.Spec.DesiredConfigChecksum == "" &&
+			op.Spec.DesiredPKIChecksum == "" && op.Spec.DesiredCAChecksum == "" {
+			continue
+		}
+		if latest == nil || op.CreationTimestamp.After(latest.CreationTimestamp.Time) {
+			latest = op
+		}
+	}
+	if latest == nil || latest.Status.Restore == nil {
+		return nil
+	}
+
+	replaced := latest.Status.Restore.Replaced
+	if replaced.Config != state.spec.Config ||
+		(state.hasPKI && replaced.PKI != state.spec.PKI) ||
+		replaced.CA != state.specCA {
+		return nil
+	}
+	return latest
+}
+
 // hasCommitPoint returns true if the operation has completed a step that writes to disk.
 func hasCommitPoint(op *controlplanev1alpha1.ControlPlaneOperation) bool {
 	return op.IsStepCompleted(controlplanev1alpha1.StepSyncManifests) ||
@@ -729,6 +794,16 @@ func (r *Reconciler) conditionForState(
 		}
 	}
 
+	if op.IsRolledBack() {
+		return metav1.Condition{
+			Type:               state.conditionType,
+			Status:             metav1.ConditionFalse,
+			Reason:             controlplanev1alpha1.CPNReasonNotReady,
+			Message:            fmt.Sprintf("operation %s was rolled back: %s", op.Name, op.GetCondition(controlplanev1alpha1.CPOConditionCompleted).Message),
+			ObservedGeneration: gen,
+		}
+	}
+
 	if op.IsFailed() {
 		msg := op.FailureMessage()
 		return metav1.Condition{
@@ -758,13 +833,34 @@ func (r *Reconciler) conditionForState(
 	}
 }
 
+func restoredCondition(
+	state componentState,
+	restore *controlplanev1alpha1.ControlPlaneOperation,
+	cpn *controlplanev1alpha1.ControlPlaneNode,
+) metav1.Condition {
+	return metav1.Condition{
+		Type:               state.conditionType,
+		Status:             metav1.ConditionFalse,
+		Reason:             controlplanev1alpha1.CPNReasonNotReady,
+		Message:            fmt.Sprintf("operation %s restored the backup of operation %s, waiting for configuration change", restore.Name, restore.Spec.RestoreFrom),
+		ObservedGeneration: cpn.Generation,
+	}
+}
+
 // applyOperationResult updates CPN status checksums based on a completed operation.
 // All non-empty desired checksums are applied - no need to switch on step type.
+// A restore operation reports the checksums of the files it put back instead.
 func applyOperationResult(cpn *controlplanev1alpha1.ControlPlaneNode, op *controlplanev1alpha1.ControlPlaneOperation) {
 	compStatus := cpn.Status.Components.Component(op.Spec.Component)
 	if compStatus == nil {
 		return
 	}
+	if op.Status.Restore != nil {
+		compStatus.Checksums.Config = op.Status.Restore.Restored.Config
+		compStatus.Checksums.PKI = op.Status.Restore.Restored.PKI
+		compStatus.Checksums.CA = op.Status.Restore.Restored.CA
+		return
+	}
 	if op.Spec.DesiredConfigChecksum != "" {
 		compStatus.Checksums.Config = op.Spec.DesiredConfigChecksum
 	}
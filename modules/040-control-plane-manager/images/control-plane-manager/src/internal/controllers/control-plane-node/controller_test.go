@@ -27,6 +27,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -189,6 +190,95 @@ func (suite *ControllerTestSuite) TestUpToDateControlPlaneNode() {
 	})
 }
 
+func (suite *ControllerTestSuite) TestRolledBackOperation() {
+	suite.Run("rolled back operation is neither applied nor recreated", func() {
+		state := componentState{
+			component: controlplanev1alpha1.OperationComponentKubeScheduler,
+			spec:      controlplanev1alpha1.Checksums{Config: "new-config"},
+		}
+		op := controlplanev1alpha1.ControlPlaneOperation{
+			ObjectMeta: metav1.ObjectMeta{Name: "kubescheduler-rolled-back"},
+			Spec: controlplanev1alpha1.ControlPlaneOperationSpec{
+				Component:             controlplanev1alpha1.OperationComponentKubeScheduler,
+				DesiredConfigChecksum: "new-config",
+			},
+			Status: controlplanev1alpha1.ControlPlaneOperationStatus{
+				Conditions: []metav1.Condition{
+					{Type: controlplanev1alpha1.CPOConditionManifestsSynced, Status: metav1.ConditionTrue, Reason: controlplanev1alpha1.CPOReasonStepCompleted},
+					{Type: controlplanev1alpha1.CPOConditionRolledBack, Status: metav1.ConditionTrue, Reason: controlplanev1alpha1.CPOReasonStepCompleted},
+					{Type: controlplanev1alpha1.CPOConditionCompleted, Status: metav1.ConditionFalse, Reason: controlplanev1alpha1.CPOReasonOperationRolledBack},
+				},
+			},
+		}
+		ops := []controlplanev1alpha1.ControlPlaneOperation{op}
+
+		require.True(suite.T(), op.IsTerminal())
+		require.Nil(suite.T(), findLatestAppliedOperationForComponent(ops, state.component),
+			"rolled back operation must not update applied checksums even though SyncManifests completed")
+		require.NotNil(suite.T(), findRolledBackOperation(ops, state))
+
+		state.spec.Config = "newer-config"
+		require.Nil(suite.T(), findRolledBackOperation(ops, state),
+			"a new configuration must be rolled out again")
+	})
+}
+
+func (suite *ControllerTestSuite) TestRestoreOperation() {
+	suite.Run("restore reports restored checksums and holds the replaced configuration", func() {
+		state := componentState{
+			component: controlplanev1alpha1.OperationComponentKubeScheduler,
+			spec:      controlplanev1alpha1.Checksums{Config: "new-config"},
+		}
+		completed := []metav1.Condition{
+			{Type: controlplanev1alpha1.CPOConditionCompleted, Status: metav1.ConditionTrue, Reason: controlplanev1alpha1.CPOReasonOperationCompleted},
+		}
+		applied := controlplanev1alpha1.ControlPlaneOperation{
+			ObjectMeta: metav1.ObjectMeta{Name: "kubescheduler-applied", CreationTimestamp: metav1.Unix(100, 0)},
+			Spec: controlplanev1alpha1.ControlPlaneOperationSpec{
+				Component:             controlplanev1alpha1.OperationComponentKubeScheduler,
+				DesiredConfigChecksum: "new-config",
+			},
+			Status: controlplanev1alpha1.ControlPlaneOperationStatus{Conditions: completed},
+		}
+		restore := controlplanev1alpha1.ControlPlaneOperation{
+			ObjectMeta: metav1.ObjectMeta{Name: "kubescheduler-restore", CreationTimestamp: metav1.Unix(200, 0)},
+			Spec: controlplanev1alpha1.ControlPlaneOperationSpec{
+				Component:   controlplanev1alpha1.OperationComponentKubeScheduler,
+				RestoreFrom: "kubescheduler-old",
+			},
+			Status: controlplanev1alpha1.ControlPlaneOperationStatus{
+				Conditions: completed,
+				Restore: &controlplanev1alpha1.RestoreStatus{
+					Replaced: controlplanev1alpha1.Checksums{Config: "new-config"},
+					Restored: controlplanev1alpha1.Checksums{Config: "old-config"},
+				},
+			},
+		}
+		observe := controlplanev1alpha1.ControlPlaneOperation{
+			ObjectMeta: metav1.ObjectMeta{Name: "kubescheduler-observe", CreationTimestamp: metav1.Unix(300, 0)},
+			Spec: controlplanev1alpha1.ControlPlaneOperationSpec{
+				Component: controlplanev1alpha1.OperationComponentKubeScheduler,
+			},
+			Status: controlplanev1alpha1.ControlPlaneOperationStatus{Conditions: completed},
+		}
+		ops := []controlplanev1alpha1.ControlPlaneOperation{applied, restore, observe}
+
+		cpn := &controlplanev1alpha1.ControlPlaneNode{}
+		cpn.Status.Components.KubeScheduler.Checksums.Config = "new-config"
+		applyOperationResult(cpn, &ops[1])
+		require.Equal(suite.T(), "old-config", cpn.Status.Components.KubeScheduler.Checksums.Config,
+			"status must report the checksums of the restored files")
+
+		held := findHoldingRestoreOperation(ops, state)
+		require.NotNil(suite.T(), held, "the replaced configuration must not be rolled out again")
+		require.Equal(suite.T(), "kubescheduler-restore", held.Name)
+
+		state.spec.Config = "newer-config"
+		require.Nil(suite.T(), findHoldingRestoreOperation(ops, state),
+			"a new configuration must be rolled out over the restored files")
+	})
+}
+
 func (suite *ControllerTestSuite) TearDownSubTest() {
 	if !suite.T().Failed() {
 		return
@@ -20,6 +20,8 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"path/filepath"
+	"sync"
 	"time"
 
 	"golang.org/x/time/rate"
@@ -51,6 +53,10 @@ const (
 	cacheSyncTimeout        = 3 * time.Minute
 	requeueWaitPod          = 5 * time.Second
 	requeueInterval         = 5 * time.Minute
+
+	// waitPodReadyRollbackTimeout is how long WaitPodReady waits for the pod after SyncManifests
+	// before the operation backup is put back.
+	waitPodReadyRollbackTimeout = 10 * time.Minute
 )
 
 type Reconciler struct {
@@ -59,6 +65,12 @@ type Reconciler struct {
 	node    NodeIdentity
 	steps   map[controlplanev1alpha1.StepName]Step
 	metrics *metrics
+
+	// etcdRestoreMu serializes the RestoreEtcdSnapshot step with the resumer of interrupted restores.
+	etcdRestoreMu sync.Mutex
+
+	newObjectRewriter func(kubeconfigDir string) (objectRewriter, error)
+	checkKMSPlugin    func(ctx context.Context, endpoint string) error
 }
 
 func Register(mgr manager.Manager, metricsStorage metricsstorage.Storage) error {
@@ -78,10 +90,28 @@ func Register(mgr manager.Manager, metricsStorage metricsstorage.Storage) error
 		node:    node,
 		steps:   defaultSteps(),
 		metrics: metricHandlers,
+
+		newObjectRewriter: newAdminObjectRewriter,
+		checkKMSPlugin:    checkKMSPluginHealth,
 	}
 	// Inject Reconciler-level deps into steps that need them.
 	r.steps[controlplanev1alpha1.StepWaitPodReady].(*waitPodReadyStep).waitForPod = r.waitForPod
 	r.steps[controlplanev1alpha1.StepDefragEtcd].(*defragEtcdStep).defragEtcd = r.defragEtcd
+	r.steps[controlplanev1alpha1.StepSnapshotEtcd].(*snapshotEtcdStep).snapshotEtcd = r.snapshotEtcd
+	r.steps[controlplanev1alpha1.StepRestoreEtcdSnapshot].(*restoreEtcdSnapshotStep).restoreEtcdSnapshot = r.restoreEtcdSnapshot
+	r.steps[controlplanev1alpha1.StepStartKubeAPIServer].(*startKubeAPIServerStep).startKubeAPIServer = r.startKubeAPIServer
+	r.steps[controlplanev1alpha1.StepAddEncryptionKey].(*encryptionKeyRotationStep).rotate = r.addEncryptionKey
+	r.steps[controlplanev1alpha1.StepPromoteEncryptionKey].(*encryptionKeyRotationStep).rotate = r.promoteEncryptionKey
+	r.steps[controlplanev1alpha1.StepReencryptResources].(*encryptionKeyRotationStep).rotate = r.reencryptResources
+	r.steps[controlplanev1alpha1.StepRemoveEncryptionKey].(*encryptionKeyRotationStep).rotate = r.removeEncryptionKey
+
+	// Snapshot metrics survive controller restarts: the newest snapshot on disk is the last success.
+	if latest, ok := latestEtcdSnapshot(constants.EtcdSnapshotsPath); ok {
+		r.metrics.setEtcdSnapshotSaved(node.Name, latest)
+		if uploadedAt, ok := etcdSnapshotUploadedAt(filepath.Join(constants.EtcdSnapshotsPath, latest.Name())); ok {
+			r.metrics.setEtcdSnapshotUploaded(node.Name, uploadedAt)
+		}
+	}
 
 	if constants.SignatureEnabled() {
 		kubeClient, err := kubernetes.NewForConfig(mgr.GetConfig())
@@ -91,6 +121,15 @@ func Register(mgr manager.Manager, metricsStorage metricsstorage.Storage) error
 		r.steps[controlplanev1alpha1.StepRenewSignature].(*renewSignatureStep).kubeClient = kubeClient
 	}
 
+	// An etcd restore interrupted by a restart cannot wait for the operation to be reconciled: etcd is down until it finishes.
+	if err := mgr.Add(&etcdRestoreResumer{r: r}); err != nil {
+		return fmt.Errorf("add etcd restore resumer: %w", err)
+	}
+
+	if err := mgr.Add(&kmsPluginMonitor{r: r}); err != nil {
+		return fmt.Errorf("add KMS plugin monitor: %w", err)
+	}
+
 	// harden admin kubeconfig perms and align root kubeconfig symlink during controller startup.
 	r.enforceNodePolicy(r.log)
 
@@ -203,7 +242,8 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 	secrets := ClusterSecrets{CPMData: cpmSecret.Data, PKIData: pkiSecret.Data}
 
 	// Verify that the secret content matches what this operation was created for.
-	if stale, reason := isDesiredStale(op, secrets); stale {
+	// A started rollback is finished regardless: the restored pod must be ready before the slot is released.
+	if stale, reason := isDesiredStale(op, secrets); stale && !state.IsRollingBack() {
 		completion, completionErr := r.markInProgressCommitPointCompletedIfApplied(ctx, state)
 		if completionErr != nil {
 			return reconcile.Result{}, completionErr
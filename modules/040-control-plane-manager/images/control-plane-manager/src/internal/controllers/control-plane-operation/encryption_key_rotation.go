@@ -0,0 +1,748 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controlplaneoperation
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/restmapper"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"github.com/deckhouse/deckhouse/pkg/log"
+
+	controlplanev1alpha1 "control-plane-manager/api/v1alpha1"
+	"control-plane-manager/internal/checksum"
+	"control-plane-manager/internal/constants"
+)
+
+// Keys of the d8-secret-encryption-key secret. The hook ensure_secret_encryption_key renders the encryption
+// configuration of kube-apiserver from them, so both sides must agree on the meaning of every stage.
+const (
+	encryptionKeyDataKey        = "secretEncryptionKey"
+	encryptionKeyNameDataKey    = "secretEncryptionKeyName"
+	newEncryptionKeyDataKey     = "newSecretEncryptionKey"
+	newEncryptionKeyNameDataKey = "newSecretEncryptionKeyName"
+	encryptionKeyStageDataKey   = "rotationStage"
+	providerDataKey             = "secretEncryptionProvider"
+	newProviderDataKey          = "newSecretEncryptionProvider"
+	kmsEndpointDataKey          = "kmsEndpoint"
+
+	// defaultEncryptionKeyName is the name of the key created before rotation was supported.
+	defaultEncryptionKeyName = "secretbox"
+
+	encryptionConfigSecretKey = "extra-file-secret-encryption-config.yaml"
+	encryptionKeySize         = 32
+
+	encryptionKeyRotationRequeue = 15 * time.Second
+	reencryptionRequeue          = time.Second
+	reencryptionPageSize         = 500
+)
+
+// encryptionKeyStage is the stage of a key rotation. Every stage has its own order of keys in the encryption configuration.
+type encryptionKeyStage string
+
+const (
+	// encryptionKeyStageNone: [current]. No rotation in progress.
+	encryptionKeyStageNone encryptionKeyStage = ""
+	// encryptionKeyStageAdded: [current, new]. Every kube-apiserver learns to decrypt with the new key before anything is encrypted with it.
+	encryptionKeyStageAdded encryptionKeyStage = "KeyAdded"
+	// encryptionKeyStagePromoted: [new, current]. New writes use the new key; existing objects are still readable.
+	encryptionKeyStagePromoted encryptionKeyStage = "KeyPromoted"
+	// encryptionKeyStageReencrypted: [new, current]. Every object has been rewritten, so the current key can go.
+	encryptionKeyStageReencrypted encryptionKeyStage = "Reencrypted"
+)
+
+// encryptionProvider is the provider a key of the rotation belongs to. A migration to or from
+// a KMS plugin is a rotation whose new "key" is the KMS provider, or whose current one is.
+type encryptionProvider string
+
+const (
+	// encryptionProviderAESCBC is a static key kept in the secret; it is also every key written before KMS was supported.
+	encryptionProviderAESCBC encryptionProvider = ""
+	// encryptionProviderKMS is the KMS v2 plugin on the endpoint of the secret; the secret keeps no key for it.
+	encryptionProviderKMS encryptionProvider = "KMS"
+)
+
+// encryptionKeyState is the content of the d8-secret-encryption-key secret.
+type encryptionKeyState struct {
+	Provider    encryptionProvider
+	Key         []byte
+	KeyName     string
+	NewProvider encryptionProvider
+	NewKey      []byte
+	NewKeyName  string
+	Stage       encryptionKeyStage
+	// KMSEndpoint is the endpoint of the KMS plugin while the current or the new key is the KMS provider.
+	// It keeps the plugin usable until the data is re-encrypted even if KMS is no longer configured.
+	KMSEndpoint string
+}
+
+func encryptionKeyStateFromData(data map[string][]byte) encryptionKeyState {
+	state := encryptionKeyState{
+		Provider:    encryptionProvider(data[providerDataKey]),
+		Key:         data[encryptionKeyDataKey],
+		KeyName:     string(data[encryptionKeyNameDataKey]),
+		NewProvider: encryptionProvider(data[newProviderDataKey]),
+		NewKey:      data[newEncryptionKeyDataKey],
+		NewKeyName:  string(data[newEncryptionKeyNameDataKey]),
+		Stage:       encryptionKeyStage(data[encryptionKeyStageDataKey]),
+		KMSEndpoint: string(data[kmsEndpointDataKey]),
+	}
+	if state.KeyName == "" {
+		state.KeyName = defaultEncryptionKeyName
+	}
+	return state
+}
+
+func (s encryptionKeyState) data() map[string][]byte {
+	data := map[string][]byte{
+		encryptionKeyNameDataKey: []byte(s.KeyName),
+	}
+	if s.Provider == encryptionProviderKMS {
+		data[providerDataKey] = []byte(s.Provider)
+	} else {
+		data[encryptionKeyDataKey] = s.Key
+	}
+	if s.Stage != encryptionKeyStageNone {
+		if s.NewProvider == encryptionProviderKMS {
+			data[newProviderDataKey] = []byte(s.NewProvider)
+		} else {
+			data[newEncryptionKeyDataKey] = s.NewKey
+		}
+		data[newEncryptionKeyNameDataKey] = []byte(s.NewKeyName)
+		data[encryptionKeyStageDataKey] = []byte(s.Stage)
+	}
+	if s.usesKMS() {
+		data[kmsEndpointDataKey] = []byte(s.KMSEndpoint)
+	}
+	return data
+}
+
+// usesKMS reports whether the encryption configuration lists the KMS provider at the current stage.
+func (s encryptionKeyState) usesKMS() bool {
+	return s.Provider == encryptionProviderKMS || (s.Stage != encryptionKeyStageNone && s.NewProvider == encryptionProviderKMS)
+}
+
+// newKeyDescription names the new key in messages.
+func (s encryptionKeyState) newKeyDescription() string {
+	return describeKey(s.NewProvider, s.NewKeyName)
+}
+
+func describeKey(provider encryptionProvider, name string) string {
+	if provider == encryptionProviderKMS {
+		return "KMS provider " + name
+	}
+	return "key " + name
+}
+
+// keyNames returns the key names in the order the encryption configuration must list them at the current stage.
+func (s encryptionKeyState) keyNames() []string {
+	switch s.Stage {
+	case encryptionKeyStageAdded:
+		return []string{s.KeyName, s.NewKeyName}
+	case encryptionKeyStagePromoted, encryptionKeyStageReencrypted:
+		return []string{s.NewKeyName, s.KeyName}
+	default:
+		return []string{s.KeyName}
+	}
+}
+
+// newEncryptionKeyName returns a key name that differs from the current one: kube-apiserver picks
+// the key to decrypt an object with by the name stored in the object.
+func newEncryptionKeyName(current string, now time.Time) string {
+	name := "key-" + now.UTC().Format("20060102150405")
+	if name == current {
+		name += "-1"
+	}
+	return name
+}
+
+// addEncryptionKey is the Reconciler-level implementation of the AddEncryptionKey step.
+func (r *Reconciler) addEncryptionKey(ctx context.Context, env *StepEnv, logger *log.Logger) (StepResult, error) {
+	secret, state, err := r.getEncryptionKeyState(ctx)
+	if err != nil {
+		return StepResult{}, err
+	}
+
+	switch state.Stage {
+	case encryptionKeyStageNone:
+		// The new key is the KMS provider while KMS is configured, so the same steps migrate to KMS and back.
+		kmsEndpoint := string(env.Secrets.CPMData[constants.SecretKeyKMSEndpoint])
+		switch {
+		case kmsEndpoint != "" && state.Provider == encryptionProviderKMS:
+			return StepResult{}, errors.New("secrets are already encrypted with the KMS provider: the KMS plugin rotates its own keys")
+		case kmsEndpoint != "":
+			// Only the plugin of this node can be checked; kube-apiserver is not ready on a node whose plugin is unhealthy.
+			if err := r.checkKMSPlugin(ctx, kmsEndpoint); err != nil {
+				return pendingEncryptionKeyRotation(fmt.Sprintf("waiting for the KMS plugin to become healthy: %v", err)), nil
+			}
+			state.NewProvider = encryptionProviderKMS
+			state.NewKey = nil
+			state.NewKeyName = constants.KMSProviderName
+			state.KMSEndpoint = kmsEndpoint
+		default:
+			key := make([]byte, encryptionKeySize)
+			if _, err := rand.Read(key); err != nil {
+				return StepResult{}, fmt.Errorf("generate encryption key: %w", err)
+			}
+			state.NewProvider = encryptionProviderAESCBC
+			state.NewKey = key
+			state.NewKeyName = newEncryptionKeyName(state.KeyName, time.Now())
+		}
+		state.Stage = encryptionKeyStageAdded
+		if updated, err := r.updateEncryptionKeyState(ctx, secret, state); err != nil || !updated {
+			return pendingEncryptionKeyRotation("the d8-secret-encryption-key secret changed concurrently, retrying"), err
+		}
+		logger.Info("new encryption key added", slog.String("key", state.NewKeyName), slog.String("provider", string(state.NewProvider)))
+		return pendingEncryptionKeyRotation(fmt.Sprintf("%s added, waiting for kube-apiserver to apply it", state.newKeyDescription())), nil
+
+	case encryptionKeyStageAdded:
+		return r.completeWhenEncryptionConfigApplied(ctx, env, state, fmt.Sprintf("%s can be used for decryption by every kube-apiserver", state.newKeyDescription()))
+
+	default:
+		return StepResult{Outcome: OutcomeCompleted, Message: fmt.Sprintf("%s already added", state.newKeyDescription())}, nil
+	}
+}
+
+// promoteEncryptionKey is the Reconciler-level implementation of the PromoteEncryptionKey step.
+func (r *Reconciler) promoteEncryptionKey(ctx context.Context, env *StepEnv, logger *log.Logger) (StepResult, error) {
+	secret, state, err := r.getEncryptionKeyState(ctx)
+	if err != nil {
+		return StepResult{}, err
+	}
+
+	switch state.Stage {
+	case encryptionKeyStageNone:
+		return StepResult{}, errors.New("no new encryption key to promote: the AddEncryptionKey step must run first")
+
+	case encryptionKeyStageAdded:
+		// A kube-apiserver that cannot decrypt with the new key yet would fail to read every object written with it.
+		applied, message, err := r.encryptionConfigApplied(ctx, env, state.keyNames())
+		if err != nil {
+			return StepResult{}, err
+		}
+		if !applied {
+			return pendingEncryptionKeyRotation(message), nil
+		}
+		state.Stage = encryptionKeyStagePromoted
+		if updated, err := r.updateEncryptionKeyState(ctx, secret, state); err != nil || !updated {
+			return pendingEncryptionKeyRotation("the d8-secret-encryption-key secret changed concurrently, retrying"), err
+		}
+		logger.Info("new encryption key promoted", slog.String("key", state.NewKeyName))
+		return pendingEncryptionKeyRotation(fmt.Sprintf("%s promoted, waiting for kube-apiserver to apply it", state.newKeyDescription())), nil
+
+	default:
+		return r.completeWhenEncryptionConfigApplied(ctx, env, state, fmt.Sprintf("%s is used for encryption by every kube-apiserver", state.newKeyDescription()))
+	}
+}
+
+// reencryptResources is the Reconciler-level implementation of the ReencryptResources step.
+// It rewrites one page of objects per call, so the step condition shows the progress, and keeps the position
+// in the operation annotation, so the step resumes from it after a controller restart.
+func (r *Reconciler) reencryptResources(ctx context.Context, env *StepEnv, logger *log.Logger) (StepResult, error) {
+	secret, state, err := r.getEncryptionKeyState(ctx)
+	if err != nil {
+		return StepResult{}, err
+	}
+	op := env.State.Raw()
+
+	switch state.Stage {
+	case encryptionKeyStageNone, encryptionKeyStageAdded:
+		return StepResult{}, errors.New("the new encryption key is not used for encryption yet: the PromoteEncryptionKey step must run first")
+	case encryptionKeyStageReencrypted:
+		if err := r.saveReencryptionProgress(ctx, op, nil); err != nil {
+			return StepResult{}, fmt.Errorf("remove re-encryption progress: %w", err)
+		}
+		return StepResult{Outcome: OutcomeCompleted, Message: "resources already re-encrypted"}, nil
+	}
+
+	// An object rewritten through a kube-apiserver still encrypting with the previous key would stay on it.
+	applied, message, err := r.encryptionConfigApplied(ctx, env, state.keyNames())
+	if err != nil {
+		return StepResult{}, err
+	}
+	if !applied {
+		return pendingEncryptionKeyRotation(message), nil
+	}
+
+	rewriter, err := r.newObjectRewriter(r.node.KubeconfigDir)
+	if err != nil {
+		return StepResult{}, fmt.Errorf("create client for re-encryption: %w", err)
+	}
+	progress, err := reencryptionProgressFrom(op)
+	if err != nil {
+		return StepResult{}, err
+	}
+	if progress == nil {
+		resources, err := encryptedResources(env.Secrets.CPMData)
+		if err != nil {
+			return StepResult{}, err
+		}
+		sensitive, err := rewriter.SensitiveDataResources(ctx)
+		if err != nil {
+			return StepResult{}, fmt.Errorf("find custom resources with sensitive data: %w", err)
+		}
+		for _, resource := range sensitive {
+			if !slices.Contains(resources, resource) {
+				resources = append(resources, resource)
+			}
+		}
+		progress = &reencryptionProgress{Resources: resources}
+		logger.Info("re-encrypting resources", slog.Any("resources", resources))
+	}
+	progress.rewriter = rewriter
+
+	done, err := progress.next(ctx)
+	if err != nil {
+		return StepResult{}, err
+	}
+	// A progress that failed to persist only makes the next call rewrite the same page again.
+	if err := r.saveReencryptionProgress(ctx, op, progress); err != nil {
+		return StepResult{}, fmt.Errorf("save re-encryption progress: %w", err)
+	}
+	if !done {
+		return StepResult{Outcome: OutcomePending, Message: progress.message(), RequeueAfter: reencryptionRequeue}, nil
+	}
+
+	state.Stage = encryptionKeyStageReencrypted
+	if updated, err := r.updateEncryptionKeyState(ctx, secret, state); err != nil || !updated {
+		return pendingEncryptionKeyRotation("the d8-secret-encryption-key secret changed concurrently, retrying"), err
+	}
+	if err := r.saveReencryptionProgress(ctx, op, nil); err != nil {
+		return StepResult{}, fmt.Errorf("remove re-encryption progress: %w", err)
+	}
+	logger.Info("resources re-encrypted", slog.Int("objects", progress.Total))
+	return StepResult{Outcome: OutcomeCompleted, Message: progress.message()}, nil
+}
+
+// reencryptionProgressFrom returns the re-encryption progress kept in the operation annotation, or nil if
+// the step has not started yet.
+func reencryptionProgressFrom(op *controlplanev1alpha1.ControlPlaneOperation) (*reencryptionProgress, error) {
+	data := op.Annotations[constants.ReencryptionProgressAnnotationKey]
+	if data == "" {
+		return nil, nil
+	}
+	progress := &reencryptionProgress{}
+	if err := json.Unmarshal([]byte(data), progress); err != nil {
+		return nil, fmt.Errorf("parse %s annotation: %w", constants.ReencryptionProgressAnnotationKey, err)
+	}
+	return progress, nil
+}
+
+// saveReencryptionProgress stores progress in the operation annotation; a nil progress removes the annotation.
+func (r *Reconciler) saveReencryptionProgress(ctx context.Context, op *controlplanev1alpha1.ControlPlaneOperation, progress *reencryptionProgress) error {
+	original := op.DeepCopy()
+	if progress == nil {
+		if _, ok := op.Annotations[constants.ReencryptionProgressAnnotationKey]; !ok {
+			return nil
+		}
+		delete(op.Annotations, constants.ReencryptionProgressAnnotationKey)
+	} else {
+		data, err := json.Marshal(progress)
+		if err != nil {
+			return err
+		}
+		if op.Annotations == nil {
+			op.Annotations = make(map[string]string, 1)
+		}
+		op.Annotations[constants.ReencryptionProgressAnnotationKey] = string(data)
+	}
+
+	return r.client.Patch(ctx, op, client.MergeFrom(original))
+}
+
+// removeEncryptionKey is the Reconciler-level implementation of the RemoveEncryptionKey step.
+func (r *Reconciler) removeEncryptionKey(ctx context.Context, env *StepEnv, logger *log.Logger) (StepResult, error) {
+	secret, state, err := r.getEncryptionKeyState(ctx)
+	if err != nil {
+		return StepResult{}, err
+	}
+
+	switch state.Stage {
+	case encryptionKeyStageAdded, encryptionKeyStagePromoted:
+		return StepResult{}, errors.New("resources are not re-encrypted yet: the ReencryptResources step must run first")
+
+	case encryptionKeyStageReencrypted:
+		previous := describeKey(state.Provider, state.KeyName)
+		next := encryptionKeyState{Provider: state.NewProvider, Key: state.NewKey, KeyName: state.NewKeyName}
+		if next.Provider == encryptionProviderKMS {
+			next.KMSEndpoint = state.KMSEndpoint
+		}
+		state = next
+		if secret.Annotations == nil {
+			secret.Annotations = map[string]string{}
+		}
+		secret.Annotations[constants.EncryptionKeyRotatedAtAnnotationKey] = time.Now().UTC().Format(time.RFC3339)
+		if updated, err := r.updateEncryptionKeyState(ctx, secret, state); err != nil || !updated {
+			return pendingEncryptionKeyRotation("the d8-secret-encryption-key secret changed concurrently, retrying"), err
+		}
+		logger.Info("previous encryption key removed", slog.String("key", previous))
+		return pendingEncryptionKeyRotation(fmt.Sprintf("%s removed, waiting for kube-apiserver to apply it", previous)), nil
+
+	default:
+		return r.completeWhenEncryptionConfigApplied(ctx, env, state, fmt.Sprintf("only %s is configured on every kube-apiserver", describeKey(state.Provider, state.KeyName)))
+	}
+}
+
+func pendingEncryptionKeyRotation(message string) StepResult {
+	return StepResult{Outcome: OutcomePending, Message: message, RequeueAfter: encryptionKeyRotationRequeue}
+}
+
+func (r *Reconciler) completeWhenEncryptionConfigApplied(ctx context.Context, env *StepEnv, state encryptionKeyState, message string) (StepResult, error) {
+	applied, pending, err := r.encryptionConfigApplied(ctx, env, state.keyNames())
+	if err != nil {
+		return StepResult{}, err
+	}
+	if !applied {
+		return pendingEncryptionKeyRotation(pending), nil
+	}
+	return StepResult{Outcome: OutcomeCompleted, Message: message}, nil
+}
+
+func (r *Reconciler) getEncryptionKeyState(ctx context.Context) (*corev1.Secret, encryptionKeyState, error) {
+	secret := &corev1.Secret{}
+	if err := r.client.Get(ctx, client.ObjectKey{Name: constants.SecretEncryptionKeySecretName, Namespace: constants.KubeSystemNamespace}, secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, encryptionKeyState{}, errors.New("secret encryption is not enabled: no d8-secret-encryption-key secret")
+		}
+		return nil, encryptionKeyState{}, fmt.Errorf("get encryption key secret: %w", err)
+	}
+	state := encryptionKeyStateFromData(secret.Data)
+	if state.Provider != encryptionProviderKMS && len(state.Key) == 0 {
+		return nil, encryptionKeyState{}, errors.New("the d8-secret-encryption-key secret has no key")
+	}
+	return secret, state, nil
+}
+
+// updateEncryptionKeyState writes state into secret. It reports false on a concurrent change: the step
+// re-reads the secret on requeue, so a stage is never skipped or applied twice.
+func (r *Reconciler) updateEncryptionKeyState(ctx context.Context, secret *corev1.Secret, state encryptionKeyState) (bool, error) {
+	secret.Data = state.data()
+	if err := r.client.Update(ctx, secret); err != nil {
+		if apierrors.IsConflict(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("update encryption key secret: %w", err)
+	}
+	return true, nil
+}
+
+// encryptionConfigApplied reports whether every kube-apiserver runs with the encryption configuration listing keyNames.
+// The rendered configuration is checked first: until it lists the keys, the checksums of the nodes refer to an older one.
+func (r *Reconciler) encryptionConfigApplied(ctx context.Context, env *StepEnv, keyNames []string) (bool, string, error) {
+	cfg, err := parseEncryptionConfig(env.Secrets.CPMData)
+	if err != nil {
+		return false, "", err
+	}
+	if !slices.Equal(cfg.keyNames(), keyNames) {
+		return false, fmt.Sprintf("waiting for the encryption configuration with keys %s to be rendered", strings.Join(keyNames, ", ")), nil
+	}
+
+	desired, err := checksum.ComponentChecksum(env.Secrets.CPMData, controlplanev1alpha1.OperationComponentKubeAPIServer.PodComponentName())
+	if err != nil {
+		return false, "", fmt.Errorf("calculate kube-apiserver config checksum: %w", err)
+	}
+
+	cpns := &controlplanev1alpha1.ControlPlaneNodeList{}
+	if err := r.client.List(ctx, cpns, client.InNamespace(constants.KubeSystemNamespace)); err != nil {
+		return false, "", fmt.Errorf("list control plane nodes: %w", err)
+	}
+	var nodes, pending []string
+	for i := range cpns.Items {
+		cpn := &cpns.Items[i]
+		if cpn.Spec.Components.KubeAPIServer.Checksums.Config == "" {
+			continue // etcd-arbiter
+		}
+		nodes = append(nodes, cpn.Name)
+		if cpn.Status.Components.KubeAPIServer.Checksums.Config != desired {
+			pending = append(pending, cpn.Name)
+		}
+	}
+	if len(nodes) == 0 {
+		return false, "waiting for control plane nodes with kube-apiserver", nil
+	}
+	if len(pending) > 0 {
+		slices.Sort(pending)
+		return false, fmt.Sprintf("waiting for kube-apiserver to apply the encryption configuration on %s", strings.Join(pending, ", ")), nil
+	}
+	return true, "", nil
+}
+
+// encryptionConfig is the part of the kube-apiserver EncryptionConfiguration the rotation relies on.
+type encryptionConfig struct {
+	Resources []struct {
+		Resources []string `json:"resources"`
+		Providers []struct {
+			AESCBC *struct {
+				Keys []struct {
+					Name string `json:"name"`
+				} `json:"keys"`
+			} `json:"aescbc,omitempty"`
+			KMS *struct {
+				Name string `json:"name"`
+			} `json:"kms,omitempty"`
+		} `json:"providers"`
+	} `json:"resources"`
+}
+
+func parseEncryptionConfig(cpmData map[string][]byte) (*encryptionConfig, error) {
+	raw, ok := cpmData[encryptionConfigSecretKey]
+	if !ok {
+		return nil, errors.New("secret encryption is not configured for kube-apiserver")
+	}
+	cfg := &encryptionConfig{}
+	if err := yaml.Unmarshal(raw, cfg); err != nil {
+		return nil, fmt.Errorf("parse encryption configuration: %w", err)
+	}
+	return cfg, nil
+}
+
+// keyNames returns the names of the aescbc keys and KMS providers in the order kube-apiserver tries them.
+func (c *encryptionConfig) keyNames() []string {
+	for _, res := range c.Resources {
+		var names []string
+		for _, provider := range res.Providers {
+			switch {
+			case provider.KMS != nil:
+				names = append(names, provider.KMS.Name)
+			case provider.AESCBC != nil:
+				for _, key := range provider.AESCBC.Keys {
+					names = append(names, key.Name)
+				}
+			}
+		}
+		if len(names) > 0 {
+			return names
+		}
+	}
+	return nil
+}
+
+// encryptedResources returns the resources the rendered encryption configuration encrypts, e.g. secrets or deployments.apps.
+func encryptedResources(cpmData map[string][]byte) ([]string, error) {
+	cfg, err := parseEncryptionConfig(cpmData)
+	if err != nil {
+		return nil, err
+	}
+	var resources []string
+	for _, res := range cfg.Resources {
+		for _, resource := range res.Resources {
+			if strings.Contains(resource, "*") {
+				return nil, fmt.Errorf("re-encryption of wildcard resource %q is not supported", resource)
+			}
+			if !slices.Contains(resources, resource) {
+				resources = append(resources, resource)
+			}
+		}
+	}
+	if len(resources) == 0 {
+		return nil, errors.New("the encryption configuration lists no resources")
+	}
+	return resources, nil
+}
+
+// objectRewriter rewrites stored objects unchanged. kube-apiserver stores an object read with a key other than
+// the first one again even if nothing changed, so a rewrite re-encrypts it with the current key.
+type objectRewriter interface {
+	// RewritePage rewrites one page of objects of resource and returns the continue token of the next page ("" after the last one).
+	RewritePage(ctx context.Context, resource, continueToken string) (reencryptedPage, error)
+	// SensitiveDataResources returns the custom resources with fields marked x-kubernetes-sensitive-data:
+	// kube-apiserver encrypts them with the keys configured for secrets.
+	SensitiveDataResources(ctx context.Context) ([]string, error)
+}
+
+type reencryptedPage struct {
+	Rewritten int
+	Continue  string
+	// Remaining is the server estimate of objects left after this page, if known.
+	Remaining *int64
+}
+
+// reencryptionProgress tracks the re-encryption of one operation. It is kept in the operation annotation
+// after every page: after a controller restart the step rewrites at most one page twice.
+type reencryptionProgress struct {
+	rewriter objectRewriter
+
+	Resources []string `json:"resources"`
+	// Current is the index of the resource being rewritten.
+	Current  int    `json:"current"`
+	Continue string `json:"continue,omitempty"`
+	// Rewritten is the number of objects of the current resource rewritten so far.
+	Rewritten int    `json:"rewritten,omitempty"`
+	Remaining *int64 `json:"remaining,omitempty"`
+	Total     int    `json:"total"`
+}
+
+// next rewrites the next page and reports whether every resource is done.
+func (p *reencryptionProgress) next(ctx context.Context) (bool, error) {
+	if p.Current >= len(p.Resources) {
+		return true, nil
+	}
+	resource := p.Resources[p.Current]
+
+	page, err := p.rewriter.RewritePage(ctx, resource, p.Continue)
+	if apierrors.IsResourceExpired(err) {
+		// The list snapshot was compacted away: objects rewritten so far are done, but the list restarts.
+		p.Continue, p.Remaining = "", nil
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("re-encrypt %s: %w", resource, err)
+	}
+
+	p.Rewritten += page.Rewritten
+	p.Total += page.Rewritten
+	p.Continue, p.Remaining = page.Continue, page.Remaining
+	if page.Continue == "" {
+		p.Current++
+		p.Rewritten, p.Remaining = 0, nil
+	}
+	return p.Current >= len(p.Resources), nil
+}
+
+func (p *reencryptionProgress) message() string {
+	if p.Current >= len(p.Resources) {
+		return fmt.Sprintf("%d objects of %s re-encrypted", p.Total, strings.Join(p.Resources, ", "))
+	}
+	message := fmt.Sprintf("%s: %d objects re-encrypted", p.Resources[p.Current], p.Rewritten)
+	if p.Remaining != nil {
+		message += fmt.Sprintf(", about %d left", *p.Remaining)
+	}
+	return fmt.Sprintf("%s (resource %d of %d)", message, p.Current+1, len(p.Resources))
+}
+
+// adminObjectRewriter rewrites objects with the node admin kubeconfig: the controller service account
+// may not update arbitrary resources across the cluster.
+type adminObjectRewriter struct {
+	client dynamic.Interface
+	mapper meta.RESTMapper
+}
+
+func newAdminObjectRewriter(kubeconfigDir string) (objectRewriter, error) {
+	cfg, err := clientcmd.BuildConfigFromFlags("", filepath.Join(kubeconfigDir, "admin.conf"))
+	if err != nil {
+		return nil, err
+	}
+	dyn, err := dynamic.NewForConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	disc, err := discovery.NewDiscoveryClientForConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &adminObjectRewriter{
+		client: dyn,
+		mapper: restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(disc)),
+	}, nil
+}
+
+func (w *adminObjectRewriter) RewritePage(ctx context.Context, resource, continueToken string) (reencryptedPage, error) {
+	gvr, err := w.mapper.ResourceFor(schema.ParseGroupResource(resource).WithVersion(""))
+	if err != nil {
+		return reencryptedPage{}, fmt.Errorf("resolve resource: %w", err)
+	}
+
+	list, err := w.client.Resource(gvr).List(ctx, metav1.ListOptions{Limit: reencryptionPageSize, Continue: continueToken})
+	if err != nil {
+		return reencryptedPage{}, err
+	}
+
+	rewritten := 0
+	for i := range list.Items {
+		obj := &list.Items[i]
+		_, err := w.client.Resource(gvr).Namespace(obj.GetNamespace()).Update(ctx, obj, metav1.UpdateOptions{})
+		switch {
+		case err == nil:
+			rewritten++
+		case apierrors.IsConflict(err), apierrors.IsNotFound(err):
+			// Changed or deleted since the list: it has been stored with the current key anyway.
+		default:
+			return reencryptedPage{}, fmt.Errorf("rewrite %s/%s: %w", obj.GetNamespace(), obj.GetName(), err)
+		}
+	}
+	return reencryptedPage{Rewritten: rewritten, Continue: list.GetContinue(), Remaining: list.GetRemainingItemCount()}, nil
+}
+
+var crdResource = schema.GroupVersionResource{Group: "apiextensions.k8s.io", Version: "v1", Resource: "customresourcedefinitions"}
+
+func (w *adminObjectRewriter) SensitiveDataResources(ctx context.Context) ([]string, error) {
+	crds, err := w.client.Resource(crdResource).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []string
+	for i := range crds.Items {
+		crd := crds.Items[i].Object
+		versions, _, _ := unstructured.NestedSlice(crd, "spec", "versions")
+		if !slices.ContainsFunc(versions, func(v any) bool {
+			version, _ := v.(map[string]any)
+			openAPISchema, _, _ := unstructured.NestedMap(version, "schema", "openAPIV3Schema")
+			return hasSensitiveData(openAPISchema)
+		}) {
+			continue
+		}
+		plural, _, _ := unstructured.NestedString(crd, "spec", "names", "plural")
+		group, _, _ := unstructured.NestedString(crd, "spec", "group")
+		resources = append(resources, plural+"."+group)
+	}
+	slices.Sort(resources)
+	return resources, nil
+}
+
+// hasSensitiveData reports whether a schema marks any field with x-kubernetes-sensitive-data.
+func hasSensitiveData(node any) bool {
+	switch v := node.(type) {
+	case map[string]any:
+		if sensitive, ok := v["x-kubernetes-sensitive-data"].(bool); ok && sensitive {
+			return true
+		}
+		for _, child := range v {
+			if hasSensitiveData(child) {
+				return true
+			}
+		}
+	case []any:
+		for _, child := range v {
+			if hasSensitiveData(child) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,445 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controlplaneoperation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/deckhouse/deckhouse/pkg/log"
+
+	controlplanev1alpha1 "control-plane-manager/api/v1alpha1"
+	"control-plane-manager/internal/checksum"
+	"control-plane-manager/internal/constants"
+)
+
+// fakeObjectRewriter returns the pages of every resource in turn.
+type fakeObjectRewriter struct {
+	pages     map[string][]reencryptedPage
+	errs      []error
+	calls     []string
+	sensitive []string
+}
+
+func (f *fakeObjectRewriter) SensitiveDataResources(context.Context) ([]string, error) {
+	return f.sensitive, nil
+}
+
+func (f *fakeObjectRewriter) RewritePage(_ context.Context, resource, continueToken string) (reencryptedPage, error) {
+	f.calls = append(f.calls, resource+"@"+continueToken)
+	if len(f.errs) > 0 {
+		err := f.errs[0]
+		f.errs = f.errs[1:]
+		return reencryptedPage{}, err
+	}
+	page := f.pages[resource][0]
+	f.pages[resource] = f.pages[resource][1:]
+	return page, nil
+}
+
+type rotationTest struct {
+	t   *testing.T
+	r   *Reconciler
+	env *StepEnv
+	// kmsEndpoint imitates the KMS provider being configured in the module settings.
+	kmsEndpoint string
+}
+
+func newRotationTest(t *testing.T, rewriter objectRewriter) *rotationTest {
+	t.Helper()
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: constants.SecretEncryptionKeySecretName, Namespace: constants.KubeSystemNamespace},
+		Data:       map[string][]byte{encryptionKeyDataKey: []byte("12345678901234567890123456789012")},
+	}
+	cpn := &controlplanev1alpha1.ControlPlaneNode{
+		ObjectMeta: metav1.ObjectMeta{Name: testNodeName, Namespace: constants.KubeSystemNamespace},
+	}
+	cpn.Spec.Components.KubeAPIServer.Checksums.Config = "desired"
+
+	op := &controlplanev1alpha1.ControlPlaneOperation{ObjectMeta: metav1.ObjectMeta{Name: "rotate"}}
+	r := newTestReconciler(nil, secret, cpn, op)
+	r.newObjectRewriter = func(string) (objectRewriter, error) { return rewriter, nil }
+	rt := &rotationTest{
+		t:   t,
+		r:   r,
+		env: &StepEnv{State: controlplanev1alpha1.NewOperationState(op), Node: r.node},
+	}
+	rt.render(defaultEncryptionKeyName)
+	rt.rollOut()
+	return rt
+}
+
+// render imitates the module hook rendering the encryption configuration with keyNames; the KMS provider
+// is rendered for constants.KMSProviderName.
+func (rt *rotationTest) render(keyNames ...string) {
+	var b strings.Builder
+	b.WriteString("apiVersion: apiserver.config.k8s.io/v1\nkind: EncryptionConfiguration\nresources:\n- resources:\n  - secrets\n  providers:\n")
+	inAESCBC := false
+	for _, name := range keyNames {
+		if name == constants.KMSProviderName {
+			fmt.Fprintf(&b, "  - kms:\n      apiVersion: v2\n      name: %s\n      endpoint: unix:///var/run/kmsplugin/kms.sock\n", name)
+			inAESCBC = false
+			continue
+		}
+		if !inAESCBC {
+			b.WriteString("  - aescbc:\n      keys:\n")
+			inAESCBC = true
+		}
+		fmt.Fprintf(&b, "      - name: %s\n        secret: c2VjcmV0\n", name)
+	}
+	b.WriteString("  - identity: {}\n")
+	rt.env.Secrets.CPMData = map[string][]byte{encryptionConfigSecretKey: []byte(b.String())}
+	if rt.kmsEndpoint != "" {
+		rt.env.Secrets.CPMData[constants.SecretKeyKMSEndpoint] = []byte(rt.kmsEndpoint)
+	}
+}
+
+// rollOut imitates kube-apiserver restarting with the rendered configuration.
+func (rt *rotationTest) rollOut() {
+	rt.t.Helper()
+	sum, err := checksum.ComponentChecksum(rt.env.Secrets.CPMData, "kube-apiserver")
+	require.NoError(rt.t, err)
+	cpn := &controlplanev1alpha1.ControlPlaneNode{}
+	require.NoError(rt.t, rt.r.client.Get(context.Background(), client.ObjectKey{Name: testNodeName, Namespace: constants.KubeSystemNamespace}, cpn))
+	cpn.Status.Components.KubeAPIServer.Checksums.Config = sum
+	require.NoError(rt.t, rt.r.client.Update(context.Background(), cpn))
+}
+
+func (rt *rotationTest) run(step func(context.Context, *StepEnv, *log.Logger) (StepResult, error)) StepResult {
+	rt.t.Helper()
+	res, err := step(context.Background(), rt.env, log.NewNop())
+	require.NoError(rt.t, err)
+	return res
+}
+
+func (rt *rotationTest) persistedOp() *controlplanev1alpha1.ControlPlaneOperation {
+	rt.t.Helper()
+	op := &controlplanev1alpha1.ControlPlaneOperation{}
+	require.NoError(rt.t, rt.r.client.Get(context.Background(), client.ObjectKeyFromObject(rt.env.State.Raw()), op))
+	return op
+}
+
+func (rt *rotationTest) state() (*corev1.Secret, encryptionKeyState) {
+	rt.t.Helper()
+	secret, state, err := rt.r.getEncryptionKeyState(context.Background())
+	require.NoError(rt.t, err)
+	return secret, state
+}
+
+func TestEncryptionKeyRotation(t *testing.T) {
+	t.Parallel()
+	rewriter := &fakeObjectRewriter{
+		pages: map[string][]reencryptedPage{
+			"secrets":             {{Rewritten: 500, Continue: "next", Remaining: ptr.To(int64(20))}, {Rewritten: 20}},
+			"widgets.example.com": {{Rewritten: 3}},
+		},
+		sensitive: []string{"widgets.example.com"},
+	}
+	rt := newRotationTest(t, rewriter)
+	r := rt.r
+
+	// AddEncryptionKey
+	res := rt.run(r.addEncryptionKey)
+	require.Equal(t, OutcomePending, res.Outcome)
+	_, state := rt.state()
+	require.Equal(t, encryptionKeyStageAdded, state.Stage)
+	require.Len(t, state.NewKey, encryptionKeySize)
+	require.True(t, strings.HasPrefix(state.NewKeyName, "key-"))
+	newKey, newName := state.NewKey, state.NewKeyName
+
+	res = rt.run(r.addEncryptionKey)
+	require.Equal(t, OutcomePending, res.Outcome)
+	require.Contains(t, res.Message, "to be rendered")
+
+	rt.render(defaultEncryptionKeyName, newName)
+	res = rt.run(r.addEncryptionKey)
+	require.Equal(t, OutcomePending, res.Outcome)
+	require.Equal(t, "waiting for kube-apiserver to apply the encryption configuration on "+testNodeName, res.Message)
+
+	rt.rollOut()
+	require.Equal(t, OutcomeCompleted, rt.run(r.addEncryptionKey).Outcome)
+
+	// PromoteEncryptionKey
+	require.Equal(t, OutcomePending, rt.run(r.promoteEncryptionKey).Outcome)
+	_, state = rt.state()
+	require.Equal(t, encryptionKeyStagePromoted, state.Stage)
+	require.Equal(t, OutcomePending, rt.run(r.promoteEncryptionKey).Outcome)
+	rt.render(newName, defaultEncryptionKeyName)
+	rt.rollOut()
+	require.Equal(t, OutcomeCompleted, rt.run(r.promoteEncryptionKey).Outcome)
+
+	// ReencryptResources
+	res = rt.run(r.reencryptResources)
+	require.Equal(t, OutcomePending, res.Outcome)
+	require.Equal(t, "secrets: 500 objects re-encrypted, about 20 left (resource 1 of 2)", res.Message)
+	require.JSONEq(t, `{"resources":["secrets","widgets.example.com"],"current":0,"continue":"next","rewritten":500,"remaining":20,"total":500}`,
+		rt.persistedOp().Annotations[constants.ReencryptionProgressAnnotationKey])
+	res = rt.run(r.reencryptResources)
+	require.Equal(t, OutcomePending, res.Outcome)
+	require.Equal(t, "widgets.example.com: 0 objects re-encrypted (resource 2 of 2)", res.Message)
+	res = rt.run(r.reencryptResources)
+	require.Equal(t, OutcomeCompleted, res.Outcome)
+	require.Equal(t, "523 objects of secrets, widgets.example.com re-encrypted", res.Message)
+	require.Equal(t, []string{"secrets@", "secrets@next", "widgets.example.com@"}, rewriter.calls)
+	require.NotContains(t, rt.persistedOp().Annotations, constants.ReencryptionProgressAnnotationKey)
+	_, state = rt.state()
+	require.Equal(t, encryptionKeyStageReencrypted, state.Stage)
+
+	// RemoveEncryptionKey
+	require.Equal(t, OutcomePending, rt.run(r.removeEncryptionKey).Outcome)
+	secret, state := rt.state()
+	require.Equal(t, encryptionKeyState{Key: newKey, KeyName: newName}, state)
+	require.NotContains(t, secret.Data, newEncryptionKeyDataKey)
+	require.NotContains(t, secret.Data, encryptionKeyStageDataKey)
+	require.NotEmpty(t, secret.Annotations[constants.EncryptionKeyRotatedAtAnnotationKey])
+
+	rt.render(newName)
+	rt.rollOut()
+	require.Equal(t, OutcomeCompleted, rt.run(r.removeEncryptionKey).Outcome)
+}
+
+func TestEncryptionKeyMigrationToKMSAndBack(t *testing.T) {
+	t.Parallel()
+	rewriter := &fakeObjectRewriter{pages: map[string][]reencryptedPage{
+		"secrets": {{Rewritten: 5}, {Rewritten: 5}},
+	}}
+	rt := newRotationTest(t, rewriter)
+	r := rt.r
+	ctx := context.Background()
+	const endpoint = "unix:///var/run/kmsplugin/kms.sock"
+	pluginErr := errors.New("connection refused")
+	r.checkKMSPlugin = func(context.Context, string) error { return pluginErr }
+	require.NoError(t, r.client.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+		Name: constants.ControlPlaneManagerConfigSecretName, Namespace: constants.KubeSystemNamespace,
+	}}))
+
+	inUse, err := r.kmsEndpointInUse(ctx)
+	require.NoError(t, err)
+	require.Empty(t, inUse)
+
+	// Migration to KMS: the KMS provider is the new key.
+	rt.kmsEndpoint = endpoint
+	rt.render(defaultEncryptionKeyName)
+	res := rt.run(r.addEncryptionKey)
+	require.Equal(t, OutcomePending, res.Outcome)
+	require.Equal(t, "waiting for the KMS plugin to become healthy: connection refused", res.Message)
+	_, state := rt.state()
+	require.Equal(t, encryptionKeyStageNone, state.Stage)
+
+	pluginErr = nil
+	rt.run(r.addEncryptionKey)
+	secret, state := rt.state()
+	require.Equal(t, encryptionProviderKMS, state.NewProvider)
+	require.Equal(t, constants.KMSProviderName, state.NewKeyName)
+	require.Equal(t, endpoint, state.KMSEndpoint)
+	require.NotContains(t, secret.Data, newEncryptionKeyDataKey)
+
+	rt.render(defaultEncryptionKeyName, constants.KMSProviderName)
+	rt.rollOut()
+	require.Equal(t, "KMS provider d8-kms can be used for decryption by every kube-apiserver", rt.run(r.addEncryptionKey).Message)
+	rt.run(r.promoteEncryptionKey)
+	rt.render(constants.KMSProviderName, defaultEncryptionKeyName)
+	rt.rollOut()
+	require.Equal(t, OutcomeCompleted, rt.run(r.promoteEncryptionKey).Outcome)
+	require.Equal(t, OutcomeCompleted, rt.run(r.reencryptResources).Outcome)
+	require.Equal(t, "key secretbox removed, waiting for kube-apiserver to apply it", rt.run(r.removeEncryptionKey).Message)
+
+	secret, state = rt.state()
+	require.Equal(t, encryptionKeyState{Provider: encryptionProviderKMS, KeyName: constants.KMSProviderName, KMSEndpoint: endpoint}, state)
+	require.NotContains(t, secret.Data, encryptionKeyDataKey)
+	rt.render(constants.KMSProviderName)
+	rt.rollOut()
+	require.Equal(t, "only KMS provider d8-kms is configured on every kube-apiserver", rt.run(r.removeEncryptionKey).Message)
+
+	_, err = r.addEncryptionKey(ctx, rt.env, log.NewNop())
+	require.ErrorContains(t, err, "the KMS plugin rotates its own keys")
+
+	// The plugin moved to another socket: the configured endpoint is in use at once, and the secret is left
+	// to the ensure_secret_encryption_key hook, which records it so that a migration back uses the new one.
+	const movedEndpoint = "unix:///var/run/kmsplugin/moved.sock"
+	cpmSecret := &corev1.Secret{}
+	require.NoError(t, r.client.Get(ctx, client.ObjectKey{Name: constants.ControlPlaneManagerConfigSecretName, Namespace: constants.KubeSystemNamespace}, cpmSecret))
+	cpmSecret.Data = map[string][]byte{constants.SecretKeyKMSEndpoint: []byte(movedEndpoint)}
+	require.NoError(t, r.client.Update(ctx, cpmSecret))
+	inUse, err = r.kmsEndpointInUse(ctx)
+	require.NoError(t, err)
+	require.Equal(t, movedEndpoint, inUse)
+	keySecret, state := rt.state()
+	require.NotEqual(t, movedEndpoint, state.KMSEndpoint, "the monitor must not write the secret")
+	keySecret.Data[kmsEndpointDataKey] = []byte(movedEndpoint)
+	require.NoError(t, r.client.Update(ctx, keySecret))
+	cpmSecret.Data = nil
+	require.NoError(t, r.client.Update(ctx, cpmSecret))
+
+	// Migration back once KMS is no longer configured: the plugin stays in use until the data is re-encrypted.
+	rt.kmsEndpoint = ""
+	rt.render(constants.KMSProviderName)
+	rt.run(r.addEncryptionKey)
+	_, state = rt.state()
+	require.Equal(t, encryptionProviderAESCBC, state.NewProvider)
+	require.Len(t, state.NewKey, encryptionKeySize)
+	newName := state.NewKeyName
+
+	inUse, err = r.kmsEndpointInUse(ctx)
+	require.NoError(t, err)
+	require.Equal(t, movedEndpoint, inUse)
+
+	rt.render(constants.KMSProviderName, newName)
+	rt.rollOut()
+	rt.run(r.promoteEncryptionKey)
+	rt.render(newName, constants.KMSProviderName)
+	rt.rollOut()
+	require.Equal(t, OutcomeCompleted, rt.run(r.promoteEncryptionKey).Outcome)
+	require.Equal(t, OutcomeCompleted, rt.run(r.reencryptResources).Outcome)
+	require.Equal(t, "KMS provider d8-kms removed, waiting for kube-apiserver to apply it", rt.run(r.removeEncryptionKey).Message)
+
+	secret, state = rt.state()
+	require.Equal(t, encryptionProviderAESCBC, state.Provider)
+	require.Equal(t, newName, state.KeyName)
+	require.NotContains(t, secret.Data, kmsEndpointDataKey)
+	require.NotContains(t, secret.Data, providerDataKey)
+
+	inUse, err = r.kmsEndpointInUse(ctx)
+	require.NoError(t, err)
+	require.Empty(t, inUse)
+}
+
+func TestEncryptionKeyRotationStepsOutOfOrder(t *testing.T) {
+	t.Parallel()
+	rt := newRotationTest(t, &fakeObjectRewriter{})
+	ctx := context.Background()
+
+	_, err := rt.r.promoteEncryptionKey(ctx, rt.env, log.NewNop())
+	require.ErrorContains(t, err, "AddEncryptionKey step must run first")
+	_, err = rt.r.reencryptResources(ctx, rt.env, log.NewNop())
+	require.ErrorContains(t, err, "PromoteEncryptionKey step must run first")
+
+	rt.run(rt.r.addEncryptionKey)
+	_, err = rt.r.removeEncryptionKey(ctx, rt.env, log.NewNop())
+	require.ErrorContains(t, err, "ReencryptResources step must run first")
+}
+
+func TestReencryptResourcesResumesPersistedProgress(t *testing.T) {
+	t.Parallel()
+	rewriter := &fakeObjectRewriter{pages: map[string][]reencryptedPage{"configmaps": {{Rewritten: 7}}}}
+	rt := newRotationTest(t, rewriter)
+	rt.run(rt.r.addEncryptionKey)
+	_, state := rt.state()
+	rt.render(defaultEncryptionKeyName, state.NewKeyName)
+	rt.rollOut()
+	rt.run(rt.r.addEncryptionKey)
+	rt.run(rt.r.promoteEncryptionKey)
+	rt.render(state.NewKeyName, defaultEncryptionKeyName)
+	rt.rollOut()
+	rt.run(rt.r.promoteEncryptionKey)
+
+	// The operation as a restarted controller reads it: secrets are done, configmaps are half way.
+	op := rt.persistedOp()
+	op.Annotations = map[string]string{constants.ReencryptionProgressAnnotationKey: `{"resources":["secrets","configmaps"],"current":1,"continue":"page-2","rewritten":500,"total":1500}`}
+	require.NoError(t, rt.r.client.Update(context.Background(), op))
+	rt.env.State = controlplanev1alpha1.NewOperationState(op)
+
+	res := rt.run(rt.r.reencryptResources)
+	require.Equal(t, OutcomeCompleted, res.Outcome)
+	require.Equal(t, "1507 objects of secrets, configmaps re-encrypted", res.Message)
+	require.Equal(t, []string{"configmaps@page-2"}, rewriter.calls)
+	require.NotContains(t, rt.persistedOp().Annotations, constants.ReencryptionProgressAnnotationKey)
+}
+
+func TestReencryptionProgressRestartsExpiredList(t *testing.T) {
+	t.Parallel()
+	rewriter := &fakeObjectRewriter{pages: map[string][]reencryptedPage{
+		"secrets":          {{Rewritten: 1, Continue: "a"}, {Rewritten: 2}},
+		"deployments.apps": {{Rewritten: 3}},
+	}}
+	p := &reencryptionProgress{rewriter: rewriter, Resources: []string{"secrets", "deployments.apps"}}
+	ctx := context.Background()
+
+	done, err := p.next(ctx)
+	require.NoError(t, err)
+	require.False(t, done)
+
+	rewriter.errs = []error{apierrors.NewResourceExpired("continue token expired")}
+	done, err = p.next(ctx)
+	require.NoError(t, err)
+	require.False(t, done)
+	require.Empty(t, p.Continue)
+
+	done, err = p.next(ctx)
+	require.NoError(t, err)
+	require.False(t, done)
+	require.Equal(t, "deployments.apps: 0 objects re-encrypted (resource 2 of 2)", p.message())
+
+	done, err = p.next(ctx)
+	require.NoError(t, err)
+	require.True(t, done)
+	require.Equal(t, 6, p.Total)
+	require.Equal(t, []string{"secrets@", "secrets@a", "secrets@", "deployments.apps@"}, rewriter.calls)
+}
+
+func TestEncryptedResources(t *testing.T) {
+	t.Parallel()
+	cfg := func(resources string) map[string][]byte {
+		return map[string][]byte{encryptionConfigSecretKey: []byte(
+			"resources:\n- resources: [" + resources + "]\n  providers:\n  - identity: {}\n")}
+	}
+
+	resources, err := encryptedResources(cfg("secrets, configmaps, secrets"))
+	require.NoError(t, err)
+	require.Equal(t, []string{"secrets", "configmaps"}, resources)
+
+	_, err = encryptedResources(cfg("'*.apps'"))
+	require.ErrorContains(t, err, "wildcard")
+
+	_, err = encryptedResources(map[string][]byte{})
+	require.ErrorContains(t, err, "not configured")
+}
+
+func TestHasSensitiveData(t *testing.T) {
+	t.Parallel()
+	marked := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"spec": map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"password": map[string]any{"type": "string", "x-kubernetes-sensitive-data": true},
+				},
+			},
+		},
+	}
+	require.True(t, hasSensitiveData(marked))
+
+	unmarked := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"spec": map[string]any{"type": "object", "x-kubernetes-sensitive-data": false},
+		},
+	}
+	require.False(t, hasSensitiveData(unmarked))
+	require.False(t, hasSensitiveData(nil))
+}
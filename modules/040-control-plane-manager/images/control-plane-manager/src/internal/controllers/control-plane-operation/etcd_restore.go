@@ -0,0 +1,773 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controlplaneoperation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"net"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/deckhouse/deckhouse/go_lib/controlplane/etcd"
+	"github.com/deckhouse/deckhouse/pkg/log"
+
+	controlplanev1alpha1 "control-plane-manager/api/v1alpha1"
+	"control-plane-manager/internal/constants"
+)
+
+const (
+	etcdRestorePlanFile = "plan.json"
+	// etcdRestoreRequestFile is written by the operator into the restore directory to restore a snapshot
+	// on the node without the Kubernetes API.
+	etcdRestoreRequestFile = "request.json"
+	// etcdRestorePeerStoppedMarkPrefix followed by a member name is written by the operator to acknowledge
+	// that etcd is stopped on a member that cannot be reached.
+	etcdRestorePeerStoppedMarkPrefix = "stopped-"
+
+	// Marks in the restore directory. Each one is written once its phase is done, so every phase runs at most once
+	// even though the operation status cannot be saved while etcd is down.
+	etcdRestoreStoppingMark = "stopping"
+	etcdRestoreStoppedMark  = "stopped"
+	etcdRestoreRestoredMark = "restored"
+	etcdRestoreDoneMark     = "done"
+
+	etcdRestoreRequeue        = 5 * time.Second
+	etcdRestoreResumeInterval = 10 * time.Second
+	etcdRestoreProbeTimeout   = 2 * time.Second
+	etcdutlOutputLimit        = 1024
+
+	// etcdRestoreRevisionBump moves the restored revision far above any revision served before the restore,
+	// so that watchers see the restored data as newer instead of silently missing it.
+	etcdRestoreRevisionBump = 1_000_000_000
+)
+
+// etcdRestoreParkedComponents are stopped by moving their manifests into the restore directory.
+// kube-apiserver is stopped as well: its watch cache refers to revisions of the data being replaced.
+var etcdRestoreParkedComponents = []controlplanev1alpha1.OperationComponent{
+	controlplanev1alpha1.OperationComponentEtcd,
+	controlplanev1alpha1.OperationComponentKubeAPIServer,
+}
+
+// etcdRestorePlan is what the node restoring a snapshot keeps on disk. From the moment the members are stopped
+// until the snapshot is restored there is no API, so the restore resumes from this file, and the operations
+// lost together with the old etcd data are re-created from it.
+type etcdRestorePlan struct {
+	// ID identifies the restore: the name of the operation with the RestoreEtcdSnapshot step.
+	ID       string `json:"id"`
+	Snapshot string `json:"snapshot"`
+	// Operation is the restore operation itself without its status.
+	Operation *controlplanev1alpha1.ControlPlaneOperation `json:"operation"`
+	// Peers are the other members of the cluster, taken from the member list before anything is stopped.
+	Peers []etcdMemberInfo `json:"peers"`
+}
+
+// etcdRestoreRequest is the content of the request file.
+type etcdRestoreRequest struct {
+	// Snapshot is the name of the snapshot file in /var/lib/etcd-snapshots.
+	Snapshot string `json:"snapshot"`
+	// Peers are the other members of the cluster. Required if the member list cannot be read from etcd.
+	Peers []etcdMemberInfo `json:"peers,omitempty"`
+}
+
+// restoreEtcdSnapshot is the Reconciler-level implementation of the RestoreEtcdSnapshot step.
+//
+// The restore runs in phases: StopEtcd operations are created for the other members, the local member is stopped,
+// the step waits until no member answers on its peer URL, restores the snapshot as a new single-member cluster,
+// starts etcd and kube-apiserver again and finally re-creates the operations missing from the restored data:
+// this one and a re-join operation for every other member. Every phase leaves a mark in the restore directory,
+// so a requeue, a lost status update or a controller restart continues where the restore stopped.
+func (r *Reconciler) restoreEtcdSnapshot(ctx context.Context, state *controlplanev1alpha1.OperationState, logger *log.Logger) (StepResult, error) {
+	op := state.Raw()
+	if op.Spec.Component != controlplanev1alpha1.OperationComponentEtcd {
+		return StepResult{}, errors.New("the RestoreEtcdSnapshot step is only valid for the Etcd component")
+	}
+	if errs := validation.IsValidLabelValue(op.Name); len(errs) > 0 {
+		return StepResult{}, fmt.Errorf("operation name %q cannot identify the restore: %s", op.Name, strings.Join(errs, "; "))
+	}
+
+	r.etcdRestoreMu.Lock()
+	defer r.etcdRestoreMu.Unlock()
+
+	dir := etcdRestoreDir(op.Name)
+	plan, err := loadEtcdRestorePlan(dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		plan, err = r.planEtcdRestore(op, dir, nil, logger)
+	}
+	if err != nil {
+		return StepResult{}, err
+	}
+
+	done, message, err := r.advanceEtcdRestore(ctx, plan, logger)
+	if err != nil {
+		return StepResult{}, err
+	}
+	if !done {
+		return StepResult{Outcome: OutcomePending, Message: message, RequeueAfter: etcdRestoreRequeue}, nil
+	}
+	return StepResult{Outcome: OutcomeCompleted, Message: message}, nil
+}
+
+// planEtcdRestore checks the snapshot and saves the restore plan. Nothing is stopped yet, so any error here
+// fails the operation and leaves the cluster untouched.
+// The members are read from etcd unless peers are given.
+func (r *Reconciler) planEtcdRestore(op *controlplanev1alpha1.ControlPlaneOperation, dir string, peers []etcdMemberInfo, logger *log.Logger) (*etcdRestorePlan, error) {
+	snapshot := op.Spec.EtcdSnapshot
+	if snapshot == "" || filepath.Base(snapshot) != snapshot {
+		return nil, fmt.Errorf("spec.etcdSnapshot must be the name of a file in %s, got %q", constants.EtcdSnapshotsPath, snapshot)
+	}
+	if err := verifyEtcdSnapshot(filepath.Join(constants.EtcdSnapshotsPath, snapshot)); err != nil {
+		return nil, fmt.Errorf("snapshot %s: %w", snapshot, err)
+	}
+
+	members := peers
+	if len(members) == 0 {
+		var err error
+		members, err = snapshotEtcdMembers(constants.KubernetesPkiPath, r.node.KubeconfigDir)
+		if err != nil {
+			return nil, fmt.Errorf("list etcd members: %w", err)
+		}
+	}
+
+	plan := &etcdRestorePlan{
+		ID:       op.Name,
+		Snapshot: snapshot,
+		Operation: &controlplanev1alpha1.ControlPlaneOperation{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        op.Name,
+				Namespace:   op.Namespace,
+				Labels:      op.Labels,
+				Annotations: op.Annotations,
+			},
+			Spec: op.Spec,
+		},
+	}
+	peerURL := etcd.GetPeerURL(r.node.AdvertiseIP)
+	for _, m := range members {
+		if memberHasPeerURL(&m, peerURL) || m.Name == r.node.Name {
+			continue
+		}
+		plan.Peers = append(plan.Peers, m)
+	}
+
+	if err := saveEtcdRestorePlan(dir, plan); err != nil {
+		return nil, fmt.Errorf("save etcd restore plan: %w", err)
+	}
+	logger.Info("etcd restore planned", slog.String("snapshot", snapshot), slog.Int("peers", len(plan.Peers)))
+	return plan, nil
+}
+
+// advanceEtcdRestore runs the next phases of the restore. It reports done once the snapshot is restored
+// and every operation of the restore exists again.
+func (r *Reconciler) advanceEtcdRestore(ctx context.Context, plan *etcdRestorePlan, logger *log.Logger) (bool, string, error) {
+	dir := etcdRestoreDir(plan.ID)
+	if hasEtcdRestoreMark(dir, etcdRestoreDoneMark) {
+		return true, etcdRestoreDoneMessage(plan), nil
+	}
+
+	// The other members are told to stop while the API still works: once quorum is lost, nothing can be created.
+	if !hasEtcdRestoreMark(dir, etcdRestoreStoppingMark) {
+		for _, peer := range plan.Peers {
+			if peer.Name == "" {
+				continue // added but never started: there is no etcd to stop
+			}
+			if err := r.createEtcdRestoreOperation(ctx, etcdRestoreOperation(plan.ID, "stop", peer.Name, controlplanev1alpha1.StepStopEtcd)); err != nil {
+				return false, fmt.Sprintf("failed to create StopEtcd operation for %s, will retry: %v", peer.Name, err), nil
+			}
+		}
+		if err := setEtcdRestoreMark(dir, etcdRestoreStoppingMark); err != nil {
+			return false, "", err
+		}
+		logger.Info("etcd restore: StopEtcd operations created for the other members")
+	}
+
+	peerURL := etcd.GetPeerURL(r.node.AdvertiseIP)
+	stopped, message, err := stopLocalEtcd(dir, constants.ManifestsPath, filepath.Dir(etcdDataDir), peerURL, plan.ID, etcdPeerListening)
+	if err != nil {
+		return false, "", fmt.Errorf("stop local etcd: %w", err)
+	}
+	if !stopped {
+		return false, message, nil
+	}
+
+	if !hasEtcdRestoreMark(dir, etcdRestoreRestoredMark) {
+		running, unreachable := unstoppedEtcdPeers(plan.Peers, probeEtcdPeer, func(name string) bool {
+			return hasEtcdRestoreMark(dir, etcdRestorePeerStoppedMarkPrefix+name)
+		})
+		if len(running) > 0 {
+			return false, fmt.Sprintf("waiting for etcd to stop on %s", strings.Join(running, ", ")), nil
+		}
+		if len(unreachable) > 0 {
+			message := fmt.Sprintf("cannot tell whether etcd is stopped on unreachable members %s: make sure it is, then acknowledge each member with `touch %s<member>`",
+				strings.Join(unreachable, ", "), filepath.Join(dir, etcdRestorePeerStoppedMarkPrefix))
+			logger.Warn("etcd restore: " + message)
+			return false, message, nil
+		}
+
+		logger.Info("etcd restore: restoring snapshot", slog.String("snapshot", plan.Snapshot))
+		snapshotPath := filepath.Join(constants.EtcdSnapshotsPath, plan.Snapshot)
+		if err := restoreEtcdData(ctx, snapshotPath, filepath.Dir(etcdDataDir), plan.ID, r.node.Name, peerURL, runEtcdutl); err != nil {
+			return false, "", err
+		}
+		if err := setEtcdRestoreMark(dir, etcdRestoreRestoredMark); err != nil {
+			return false, "", err
+		}
+	}
+
+	for _, component := range etcdRestoreParkedComponents {
+		if _, err := unparkManifest(constants.ManifestsPath, dir, component.PodComponentName()); err != nil {
+			return false, "", fmt.Errorf("start %s: %w", component.PodComponentName(), err)
+		}
+	}
+
+	// The restored data predates this restore, so its operations are gone: the operation restoring the snapshot
+	// is put back to finish its remaining steps, and every other member gets a clean re-join.
+	ops := []*controlplanev1alpha1.ControlPlaneOperation{plan.Operation.DeepCopy()}
+	for _, peer := range plan.Peers {
+		if peer.Name == "" {
+			continue
+		}
+		ops = append(ops, etcdRestoreOperation(plan.ID, "join", peer.Name,
+			controlplanev1alpha1.StepStopEtcd,
+			controlplanev1alpha1.StepJoinEtcdCluster,
+			controlplanev1alpha1.StepWaitPodReady,
+			controlplanev1alpha1.StepStartKubeAPIServer,
+		))
+	}
+	for _, op := range ops {
+		if err := r.createEtcdRestoreOperation(ctx, op); err != nil {
+			return false, fmt.Sprintf("snapshot restored, waiting for kube-apiserver to re-create operation %s: %v", op.Name, err), nil
+		}
+	}
+
+	if err := setEtcdRestoreMark(dir, etcdRestoreDoneMark); err != nil {
+		return false, "", err
+	}
+	logger.Info("etcd restore: snapshot restored, members re-join", slog.String("snapshot", plan.Snapshot))
+	return true, etcdRestoreDoneMessage(plan), nil
+}
+
+func etcdRestoreDoneMessage(plan *etcdRestorePlan) string {
+	return fmt.Sprintf("snapshot %s restored as a new cluster; %d other members re-join", plan.Snapshot, len(plan.Peers))
+}
+
+// createEtcdRestoreOperation creates op unless it already exists.
+func (r *Reconciler) createEtcdRestoreOperation(ctx context.Context, op *controlplanev1alpha1.ControlPlaneOperation) error {
+	if err := r.client.Create(ctx, op); err != nil && !apierrors.IsAlreadyExists(err) {
+		return err
+	}
+	return nil
+}
+
+// etcdRestoreSnapshotOperation builds the operation restoring snapshot on nodeName for a restore requested
+// on the node. It is created once the snapshot is restored, like the operation of a restore started through the API.
+func etcdRestoreSnapshotOperation(id, nodeName, snapshot string) *controlplanev1alpha1.ControlPlaneOperation {
+	return &controlplanev1alpha1.ControlPlaneOperation{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      id,
+			Namespace: constants.KubeSystemNamespace,
+			Labels: map[string]string{
+				constants.ControlPlaneNodeNameLabelKey:  nodeName,
+				constants.ControlPlaneComponentLabelKey: controlplanev1alpha1.OperationComponentEtcd.LabelValue(),
+			},
+		},
+		Spec: controlplanev1alpha1.ControlPlaneOperationSpec{
+			NodeName:     nodeName,
+			Component:    controlplanev1alpha1.OperationComponentEtcd,
+			EtcdSnapshot: snapshot,
+			Steps: []controlplanev1alpha1.StepName{
+				controlplanev1alpha1.StepRestoreEtcdSnapshot,
+				controlplanev1alpha1.StepWaitPodReady,
+				controlplanev1alpha1.StepStartKubeAPIServer,
+			},
+			Approved: true,
+		},
+	}
+}
+
+// etcdRestoreOperation builds an approved operation of the restore id for another member.
+// Such operations bypass the approver: they are part of the restore approved as a whole.
+func etcdRestoreOperation(id, phase, nodeName string, steps ...controlplanev1alpha1.StepName) *controlplanev1alpha1.ControlPlaneOperation {
+	return &controlplanev1alpha1.ControlPlaneOperation{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-%s-%s", id, phase, nodeName),
+			Namespace: constants.KubeSystemNamespace,
+			Labels: map[string]string{
+				constants.ControlPlaneNodeNameLabelKey:  nodeName,
+				constants.ControlPlaneComponentLabelKey: controlplanev1alpha1.OperationComponentEtcd.LabelValue(),
+				constants.HeritageLabelKey:              constants.HeritageLabelValue,
+				constants.EtcdRestoreLabelKey:           id,
+			},
+		},
+		Spec: controlplanev1alpha1.ControlPlaneOperationSpec{
+			NodeName:  nodeName,
+			Component: controlplanev1alpha1.OperationComponentEtcd,
+			Steps:     steps,
+			Approved:  true,
+		},
+	}
+}
+
+// stopEtcdStep stops etcd on a member of a cluster being restored from a snapshot.
+// Its operations are created by the RestoreEtcdSnapshot step for the other members of the cluster.
+type stopEtcdStep struct{}
+
+func (c *stopEtcdStep) Execute(_ context.Context, env *StepEnv, logger *log.Logger) (StepResult, error) {
+	op := env.State.Raw()
+	id := op.Labels[constants.EtcdRestoreLabelKey]
+	if op.Spec.Component != controlplanev1alpha1.OperationComponentEtcd || id == "" {
+		return StepResult{}, fmt.Errorf("the StopEtcd step requires an Etcd operation with the %s label", constants.EtcdRestoreLabelKey)
+	}
+
+	stopped, message, err := stopLocalEtcd(etcdRestoreDir(id), constants.ManifestsPath, filepath.Dir(etcdDataDir), etcd.GetPeerURL(env.Node.AdvertiseIP), id, etcdPeerListening)
+	if err != nil {
+		logger.Error("failed to stop etcd", log.Err(err))
+		return StepResult{}, fmt.Errorf("stop etcd: %w", err)
+	}
+	if !stopped {
+		return StepResult{Outcome: OutcomePending, Message: message, RequeueAfter: etcdRestoreRequeue}, nil
+	}
+	logger.Info("etcd stopped for restore", slog.String("restore", id))
+	return StepResult{Outcome: OutcomeCompleted, Message: message}, nil
+}
+
+// startKubeAPIServer is the Reconciler-level implementation of the StartKubeAPIServer step:
+// it puts back the kube-apiserver manifest parked by StopEtcd and waits for the pod.
+func (r *Reconciler) startKubeAPIServer(ctx context.Context, state *controlplanev1alpha1.OperationState, logger *log.Logger) (StepResult, error) {
+	op := state.Raw()
+	id := etcdRestoreID(op)
+	if id == "" {
+		return StepResult{}, errors.New("the StartKubeAPIServer step is only valid for the operations of an etcd restore")
+	}
+
+	component := controlplanev1alpha1.OperationComponentKubeAPIServer
+	present, err := unparkManifest(constants.ManifestsPath, etcdRestoreDir(id), component.PodComponentName())
+	if err != nil {
+		return StepResult{}, fmt.Errorf("start kube-apiserver: %w", err)
+	}
+	if !present {
+		return StepResult{Outcome: OutcomeCompleted, Message: "skipped: no kube-apiserver on this node"}, nil
+	}
+
+	expected, err := manifestChecksumAnnotations(component)
+	if err != nil {
+		return StepResult{}, fmt.Errorf("read kube-apiserver manifest: %w", err)
+	}
+	ready, message := r.checkPodReady(ctx, component, expected, "waiting for kube-apiserver pod", logger)
+	if !ready {
+		return StepResult{Outcome: OutcomePending, Message: message, RequeueAfter: requeueWaitPod}, nil
+	}
+	return StepResult{Outcome: OutcomeCompleted}, nil
+}
+
+// etcdRestoreID returns the restore op belongs to, or "" if it is not part of one.
+// The operation restoring the snapshot identifies the restore by its name; the operations it creates carry the name in a label.
+func etcdRestoreID(op *controlplanev1alpha1.ControlPlaneOperation) string {
+	if op.HasStep(controlplanev1alpha1.StepRestoreEtcdSnapshot) {
+		return op.Name
+	}
+	return op.Labels[constants.EtcdRestoreLabelKey]
+}
+
+// restoredEtcdAnnotations returns the checksum annotations for the manifest of a member re-joining after a restore.
+// Such operations carry no desired checksums, so the member keeps those of the manifest it ran before StopEtcd.
+func restoredEtcdAnnotations(op *controlplanev1alpha1.ControlPlaneOperation, annotations checksumAnnotations) checksumAnnotations {
+	id := op.Labels[constants.EtcdRestoreLabelKey]
+	if id == "" || annotations.ConfigChecksum != "" {
+		return annotations
+	}
+	parked, ok, err := readManifestFileAnnotations(filepath.Join(etcdRestoreDir(id), controlplanev1alpha1.OperationComponentEtcd.PodComponentName()+".yaml"))
+	if err != nil || !ok {
+		return annotations
+	}
+	annotations.ConfigChecksum = parked[constants.ConfigChecksumAnnotationKey]
+	annotations.PKIChecksum = parked[constants.PKIChecksumAnnotationKey]
+	annotations.CAChecksum = parked[constants.CAChecksumAnnotationKey]
+	return annotations
+}
+
+// stopLocalEtcd stops etcd and kube-apiserver by moving their manifests into dir, waits until etcd no longer
+// answers on peerURL and moves the etcd data directory aside. The stopped mark makes it a no-op afterwards,
+// so a member that has already re-joined the restored cluster is never stopped again.
+func stopLocalEtcd(dir, manifestsDir, dataRoot, peerURL, id string, listening func(string) bool) (bool, string, error) {
+	if hasEtcdRestoreMark(dir, etcdRestoreStoppedMark) {
+		return true, "etcd already stopped", nil
+	}
+
+	for _, component := range etcdRestoreParkedComponents {
+		if err := parkManifest(manifestsDir, dir, component.PodComponentName()); err != nil {
+			return false, "", fmt.Errorf("stop %s: %w", component.PodComponentName(), err)
+		}
+	}
+	if listening(peerURL) {
+		return false, "waiting for the local etcd to stop", nil
+	}
+
+	aside, err := moveEtcdDataAside(dataRoot, id)
+	if err != nil {
+		return false, "", err
+	}
+	if err := setEtcdRestoreMark(dir, etcdRestoreStoppedMark); err != nil {
+		return false, "", err
+	}
+	if aside == "" {
+		return true, "etcd stopped", nil
+	}
+	return true, fmt.Sprintf("etcd stopped, previous data moved to %s", aside), nil
+}
+
+// parkManifest moves the static pod manifest of component from manifestsDir into dir, which stops the pod.
+// A manifest that is already parked or does not exist on this node is left as is.
+func parkManifest(manifestsDir, dir, component string) error {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return err
+	}
+	err := os.Rename(filepath.Join(manifestsDir, component+".yaml"), filepath.Join(dir, component+".yaml"))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+// unparkManifest copies the manifest parked by parkManifest back into manifestsDir unless a manifest is already there.
+// The parked copy is kept. It reports whether the component has a manifest afterwards.
+func unparkManifest(manifestsDir, dir, component string) (bool, error) {
+	dst := filepath.Join(manifestsDir, component+".yaml")
+	if _, err := os.Stat(dst); err == nil {
+		return true, nil
+	}
+	data, err := os.ReadFile(filepath.Join(dir, component+".yaml"))
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, writeFileAtomically(dst, data, 0o600)
+}
+
+// moveEtcdDataAside renames the member directory under dataRoot, so the data before the restore is kept
+// for investigation and the member re-joins with an empty data directory. It returns the new path, or ""
+// if there was no data.
+func moveEtcdDataAside(dataRoot, id string) (string, error) {
+	member := filepath.Join(dataRoot, "member")
+	if _, err := os.Stat(member); errors.Is(err, fs.ErrNotExist) {
+		return "", nil
+	} else if err != nil {
+		return "", err
+	}
+
+	aside := filepath.Join(dataRoot, "member-before-restore-"+id)
+	if _, err := os.Stat(aside); err == nil {
+		return "", fmt.Errorf("cannot move etcd data aside: %s already exists", aside)
+	}
+	if err := os.Rename(member, aside); err != nil {
+		return "", fmt.Errorf("move etcd data aside: %w", err)
+	}
+	return aside, nil
+}
+
+// restoreEtcdData restores the snapshot into the member directory under dataRoot as a new single-member cluster.
+// etcdutl writes into a temporary directory first, so an interrupted restore never leaves a partial member directory.
+func restoreEtcdData(ctx context.Context, snapshotPath, dataRoot, id, name, peerURL string, run func(context.Context, []string) error) error {
+	member := filepath.Join(dataRoot, "member")
+	if _, err := os.Stat(member); err == nil {
+		// The member directory was moved aside when etcd stopped, so it can only come from an interrupted restore.
+		return nil
+	}
+
+	tmp := filepath.Join(dataRoot, "restore-"+id)
+	if err := os.RemoveAll(tmp); err != nil {
+		return err
+	}
+	defer func() { _ = os.RemoveAll(tmp) }()
+
+	if err := run(ctx, etcdutlRestoreArgs(snapshotPath, tmp, name, peerURL, "etcd-restore-"+id)); err != nil {
+		return fmt.Errorf("etcdutl snapshot restore: %w", err)
+	}
+	if err := os.Rename(filepath.Join(tmp, "member"), member); err != nil {
+		return fmt.Errorf("move restored data into place: %w", err)
+	}
+	return nil
+}
+
+// etcdutlRestoreArgs returns the etcdutl arguments restoring snapshotPath into dataDir.
+// A new cluster token gives the restored cluster a new ID, so a member of the old cluster can never join it by mistake.
+func etcdutlRestoreArgs(snapshotPath, dataDir, name, peerURL, token string) []string {
+	return []string{
+		"snapshot", "restore", snapshotPath,
+		"--data-dir", dataDir,
+		"--name", name,
+		"--initial-cluster", name + "=" + peerURL,
+		"--initial-advertise-peer-urls", peerURL,
+		"--initial-cluster-token", token,
+		"--bump-revision", strconv.Itoa(etcdRestoreRevisionBump),
+		"--mark-compacted",
+	}
+}
+
+func runEtcdutl(ctx context.Context, args []string) error {
+	out, err := exec.CommandContext(ctx, constants.EtcdutlPath, args...).CombinedOutput()
+	if err != nil {
+		out = []byte(strings.TrimSpace(string(out)))
+		if len(out) > etcdutlOutputLimit {
+			out = out[len(out)-etcdutlOutputLimit:]
+		}
+		return fmt.Errorf("%w: %s", err, out)
+	}
+	return nil
+}
+
+type etcdPeerState int
+
+const (
+	etcdPeerUnreachable etcdPeerState = iota
+	etcdPeerRunning
+	etcdPeerStopped
+)
+
+// probeEtcdPeer dials the etcd peer URL. A refused connection means that the host is up and nothing listens
+// on the peer port; any other failure leaves the state of the member unknown.
+func probeEtcdPeer(peerURL string) etcdPeerState {
+	u, err := url.Parse(peerURL)
+	if err != nil || u.Host == "" {
+		return etcdPeerUnreachable
+	}
+	conn, err := net.DialTimeout("tcp", u.Host, etcdRestoreProbeTimeout)
+	if err != nil {
+		if errors.Is(err, syscall.ECONNREFUSED) {
+			return etcdPeerStopped
+		}
+		return etcdPeerUnreachable
+	}
+	_ = conn.Close()
+	return etcdPeerRunning
+}
+
+// etcdPeerListening reports whether something accepts connections on the etcd peer URL.
+func etcdPeerListening(peerURL string) bool {
+	return probeEtcdPeer(peerURL) == etcdPeerRunning
+}
+
+// unstoppedEtcdPeers returns the peers still answering on one of their peer URLs and the peers that cannot be
+// reached on some of them. An unreachable peer counts as stopped only once acknowledged reports it so.
+func unstoppedEtcdPeers(peers []etcdMemberInfo, probe func(string) etcdPeerState, acknowledged func(string) bool) (running, unreachable []string) {
+	for _, peer := range peers {
+		states := make([]etcdPeerState, 0, len(peer.PeerURLs))
+		for _, peerURL := range peer.PeerURLs {
+			states = append(states, probe(peerURL))
+		}
+		name := etcdPeerName(peer)
+		switch {
+		case slices.Contains(states, etcdPeerRunning):
+			running = append(running, name)
+		case slices.Contains(states, etcdPeerUnreachable) && !acknowledged(name):
+			unreachable = append(unreachable, name)
+		}
+	}
+	return running, unreachable
+}
+
+// etcdPeerName returns the member name, or the host of its first peer URL for a member that has never started.
+func etcdPeerName(peer etcdMemberInfo) string {
+	if peer.Name != "" {
+		return peer.Name
+	}
+	for _, peerURL := range peer.PeerURLs {
+		if u, err := url.Parse(peerURL); err == nil && u.Host != "" {
+			return u.Host
+		}
+	}
+	return strings.Join(peer.PeerURLs, ",")
+}
+
+func etcdRestoreDir(id string) string {
+	return filepath.Join(constants.EtcdRestorePath, id)
+}
+
+func loadEtcdRestorePlan(dir string) (*etcdRestorePlan, error) {
+	data, err := os.ReadFile(filepath.Join(dir, etcdRestorePlanFile))
+	if err != nil {
+		return nil, err
+	}
+	plan := &etcdRestorePlan{}
+	if err := json.Unmarshal(data, plan); err != nil {
+		return nil, fmt.Errorf("parse etcd restore plan %s: %w", dir, err)
+	}
+	if plan.ID == "" || plan.Operation == nil {
+		return nil, fmt.Errorf("etcd restore plan %s is incomplete", dir)
+	}
+	return plan, nil
+}
+
+func loadEtcdRestoreRequest(dir string) (*etcdRestoreRequest, error) {
+	data, err := os.ReadFile(filepath.Join(dir, etcdRestoreRequestFile))
+	if err != nil {
+		return nil, err
+	}
+	req := &etcdRestoreRequest{}
+	if err := json.Unmarshal(data, req); err != nil {
+		return nil, fmt.Errorf("parse etcd restore request %s: %w", dir, err)
+	}
+	return req, nil
+}
+
+func saveEtcdRestorePlan(dir string, plan *etcdRestorePlan) error {
+	data, err := json.MarshalIndent(plan, "", "  ")
+	if err != nil {
+		return err
+	}
+	return writeFileAtomically(filepath.Join(dir, etcdRestorePlanFile), data, 0o600)
+}
+
+func hasEtcdRestoreMark(dir, mark string) bool {
+	_, err := os.Stat(filepath.Join(dir, mark))
+	return err == nil
+}
+
+func setEtcdRestoreMark(dir, mark string) error {
+	return writeFileAtomically(filepath.Join(dir, mark), []byte(time.Now().UTC().Format(time.RFC3339)+"\n"), 0o600)
+}
+
+// pendingEtcdRestorePlans returns the restores under root that have started stopping members but are not done.
+// A plan that has not stopped anything yet is left to its operation, which may as well have been deleted.
+func pendingEtcdRestorePlans(root string) []*etcdRestorePlan {
+	entries, err := os.ReadDir(root)
+	if err != nil {
+		return nil
+	}
+	var plans []*etcdRestorePlan
+	for _, entry := range entries {
+		dir := filepath.Join(root, entry.Name())
+		if !entry.IsDir() || !hasEtcdRestoreMark(dir, etcdRestoreStoppingMark) || hasEtcdRestoreMark(dir, etcdRestoreDoneMark) {
+			continue
+		}
+		if plan, err := loadEtcdRestorePlan(dir); err == nil {
+			plans = append(plans, plan)
+		}
+	}
+	return plans
+}
+
+// requestedEtcdRestores returns the IDs of the restores requested under root that have not started yet.
+func requestedEtcdRestores(root string) []string {
+	entries, err := os.ReadDir(root)
+	if err != nil {
+		return nil
+	}
+	var ids []string
+	for _, entry := range entries {
+		dir := filepath.Join(root, entry.Name())
+		if !entry.IsDir() || hasEtcdRestoreMark(dir, etcdRestoreStoppingMark) {
+			continue
+		}
+		if _, err := os.Stat(filepath.Join(dir, etcdRestoreRequestFile)); err == nil {
+			ids = append(ids, entry.Name())
+		}
+	}
+	return ids
+}
+
+// acceptEtcdRestoreRequest turns the request of restore id into a plan. The API may be down, so no StopEtcd
+// operations are created: the operator stops etcd on the other members, and the stopping mark hands the plan
+// over to the resumer.
+func (r *Reconciler) acceptEtcdRestoreRequest(id string, logger *log.Logger) error {
+	if errs := validation.IsValidLabelValue(id); len(errs) > 0 {
+		return fmt.Errorf("directory name %q cannot identify the restore: %s", id, strings.Join(errs, "; "))
+	}
+
+	r.etcdRestoreMu.Lock()
+	defer r.etcdRestoreMu.Unlock()
+
+	dir := etcdRestoreDir(id)
+	req, err := loadEtcdRestoreRequest(dir)
+	if err != nil {
+		return err
+	}
+	if _, err := loadEtcdRestorePlan(dir); errors.Is(err, fs.ErrNotExist) {
+		op := etcdRestoreSnapshotOperation(id, r.node.Name, req.Snapshot)
+		if _, err := r.planEtcdRestore(op, dir, req.Peers, logger); err != nil {
+			return err
+		}
+	} else if err != nil {
+		return err
+	}
+	return setEtcdRestoreMark(dir, etcdRestoreStoppingMark)
+}
+
+// etcdRestoreResumer drives the restores that cannot rely on their operation: those interrupted by a controller
+// restart and those requested on the node. It does not wait for the cache: while a restore is in progress
+// etcd is down, so neither the cache nor the operation status is available until the restore brings them back.
+type etcdRestoreResumer struct {
+	r *Reconciler
+}
+
+func (s *etcdRestoreResumer) NeedLeaderElection() bool {
+	return false
+}
+
+func (s *etcdRestoreResumer) Start(ctx context.Context) error {
+	for {
+		for _, id := range requestedEtcdRestores(constants.EtcdRestorePath) {
+			logger := s.r.log.With(slog.String("operation", id))
+			if err := s.r.acceptEtcdRestoreRequest(id, logger); err != nil {
+				logger.Error("failed to accept etcd restore request, will retry", log.Err(err))
+				continue
+			}
+			logger.Info("etcd restore requested on the node")
+		}
+		for _, plan := range pendingEtcdRestorePlans(constants.EtcdRestorePath) {
+			s.resume(ctx, plan)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(etcdRestoreResumeInterval):
+		}
+	}
+}
+
+func (s *etcdRestoreResumer) resume(ctx context.Context, plan *etcdRestorePlan) {
+	logger := s.r.log.With(slog.String("operation", plan.ID))
+
+	s.r.etcdRestoreMu.Lock()
+	defer s.r.etcdRestoreMu.Unlock()
+
+	done, message, err := s.r.advanceEtcdRestore(ctx, plan, logger)
+	switch {
+	case err != nil:
+		logger.Error("failed to resume etcd restore, will retry", log.Err(err))
+	case !done:
+		logger.Info("resuming etcd restore", slog.String("status", message))
+	}
+}
@@ -0,0 +1,295 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controlplaneoperation
+
+import (
+	"context"
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	controlplanev1alpha1 "control-plane-manager/api/v1alpha1"
+	"control-plane-manager/internal/constants"
+)
+
+const testPeerURL = "https://192.168.0.10:2380"
+
+func TestStopLocalEtcd(t *testing.T) {
+	t.Parallel()
+	root := t.TempDir()
+	dir := filepath.Join(root, "restore", "r1")
+	manifests := filepath.Join(root, "manifests")
+	dataRoot := filepath.Join(root, "etcd")
+	require.NoError(t, os.MkdirAll(manifests, 0o700))
+	require.NoError(t, os.MkdirAll(filepath.Join(dataRoot, "member", "snap"), 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(manifests, "etcd.yaml"), []byte("etcd"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(manifests, "kube-apiserver.yaml"), []byte("apiserver"), 0o600))
+
+	listening := true
+	probe := func(url string) bool {
+		require.Equal(t, testPeerURL, url)
+		return listening
+	}
+
+	stopped, message, err := stopLocalEtcd(dir, manifests, dataRoot, testPeerURL, "r1", probe)
+	require.NoError(t, err)
+	require.False(t, stopped)
+	require.Equal(t, "waiting for the local etcd to stop", message)
+	require.NoFileExists(t, filepath.Join(manifests, "etcd.yaml"))
+	require.FileExists(t, filepath.Join(dir, "etcd.yaml"))
+	require.FileExists(t, filepath.Join(dir, "kube-apiserver.yaml"))
+	require.DirExists(t, filepath.Join(dataRoot, "member"), "data must stay in place while etcd runs")
+
+	listening = false
+	stopped, message, err = stopLocalEtcd(dir, manifests, dataRoot, testPeerURL, "r1", probe)
+	require.NoError(t, err)
+	require.True(t, stopped)
+	require.Contains(t, message, "member-before-restore-r1")
+	require.NoDirExists(t, filepath.Join(dataRoot, "member"))
+	require.DirExists(t, filepath.Join(dataRoot, "member-before-restore-r1", "snap"))
+
+	// Once stopped, a member that has re-joined the restored cluster is left alone.
+	require.NoError(t, os.MkdirAll(filepath.Join(dataRoot, "member"), 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(manifests, "etcd.yaml"), []byte("joined"), 0o600))
+	listening = true
+	stopped, _, err = stopLocalEtcd(dir, manifests, dataRoot, testPeerURL, "r1", probe)
+	require.NoError(t, err)
+	require.True(t, stopped)
+	require.FileExists(t, filepath.Join(manifests, "etcd.yaml"))
+	require.DirExists(t, filepath.Join(dataRoot, "member"))
+}
+
+func TestMoveEtcdDataAsideRefusesToOverwrite(t *testing.T) {
+	t.Parallel()
+	dataRoot := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dataRoot, "member"), 0o700))
+	require.NoError(t, os.MkdirAll(filepath.Join(dataRoot, "member-before-restore-r1"), 0o700))
+
+	_, err := moveEtcdDataAside(dataRoot, "r1")
+	require.ErrorContains(t, err, "already exists")
+	require.DirExists(t, filepath.Join(dataRoot, "member"))
+}
+
+func TestUnparkManifest(t *testing.T) {
+	t.Parallel()
+	root := t.TempDir()
+	dir := filepath.Join(root, "restore")
+	manifests := filepath.Join(root, "manifests")
+	require.NoError(t, os.MkdirAll(dir, 0o700))
+	require.NoError(t, os.MkdirAll(manifests, 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "kube-apiserver.yaml"), []byte("parked"), 0o600))
+
+	present, err := unparkManifest(manifests, dir, "kube-apiserver")
+	require.NoError(t, err)
+	require.True(t, present)
+	data, err := os.ReadFile(filepath.Join(manifests, "kube-apiserver.yaml"))
+	require.NoError(t, err)
+	require.Equal(t, "parked", string(data))
+
+	// A manifest written since then, e.g. by a re-join, is never replaced by the parked one.
+	require.NoError(t, os.WriteFile(filepath.Join(manifests, "kube-apiserver.yaml"), []byte("new"), 0o600))
+	present, err = unparkManifest(manifests, dir, "kube-apiserver")
+	require.NoError(t, err)
+	require.True(t, present)
+	data, err = os.ReadFile(filepath.Join(manifests, "kube-apiserver.yaml"))
+	require.NoError(t, err)
+	require.Equal(t, "new", string(data))
+
+	present, err = unparkManifest(manifests, dir, "etcd")
+	require.NoError(t, err)
+	require.False(t, present, "nothing was parked for a component absent on the node")
+}
+
+func TestRestoreEtcdData(t *testing.T) {
+	t.Parallel()
+
+	t.Run("restores into the member directory", func(t *testing.T) {
+		t.Parallel()
+		dataRoot := t.TempDir()
+		var got []string
+		run := func(_ context.Context, args []string) error {
+			got = args
+			dataDir := args[slices.Index(args, "--data-dir")+1]
+			return os.MkdirAll(filepath.Join(dataDir, "member", "wal"), 0o700)
+		}
+
+		require.NoError(t, restoreEtcdData(context.Background(), "/snapshots/s.db", dataRoot, "r1", "master-0", testPeerURL, run))
+		require.DirExists(t, filepath.Join(dataRoot, "member", "wal"))
+		require.NoDirExists(t, filepath.Join(dataRoot, "restore-r1"))
+		require.Equal(t, etcdutlRestoreArgs("/snapshots/s.db", filepath.Join(dataRoot, "restore-r1"), "master-0", testPeerURL, "etcd-restore-r1"), got)
+	})
+
+	t.Run("keeps data restored before an interruption", func(t *testing.T) {
+		t.Parallel()
+		dataRoot := t.TempDir()
+		require.NoError(t, os.MkdirAll(filepath.Join(dataRoot, "member"), 0o700))
+		run := func(context.Context, []string) error {
+			t.Fatal("etcdutl must not run again")
+			return nil
+		}
+
+		require.NoError(t, restoreEtcdData(context.Background(), "/snapshots/s.db", dataRoot, "r1", "master-0", testPeerURL, run))
+	})
+
+	t.Run("leaves no partial data on failure", func(t *testing.T) {
+		t.Parallel()
+		dataRoot := t.TempDir()
+		run := func(_ context.Context, args []string) error {
+			dataDir := args[slices.Index(args, "--data-dir")+1]
+			require.NoError(t, os.MkdirAll(filepath.Join(dataDir, "member"), 0o700))
+			return errors.New("snapshot corrupted")
+		}
+
+		require.ErrorContains(t, restoreEtcdData(context.Background(), "/snapshots/s.db", dataRoot, "r1", "master-0", testPeerURL, run), "snapshot corrupted")
+		entries, err := os.ReadDir(dataRoot)
+		require.NoError(t, err)
+		require.Empty(t, entries)
+	})
+}
+
+func TestEtcdutlRestoreArgs(t *testing.T) {
+	t.Parallel()
+	require.Equal(t, []string{
+		"snapshot", "restore", "/var/lib/etcd-snapshots/s.db",
+		"--data-dir", "/var/lib/etcd/restore-r1",
+		"--name", "master-0",
+		"--initial-cluster", "master-0=" + testPeerURL,
+		"--initial-advertise-peer-urls", testPeerURL,
+		"--initial-cluster-token", "etcd-restore-r1",
+		"--bump-revision", "1000000000",
+		"--mark-compacted",
+	}, etcdutlRestoreArgs("/var/lib/etcd-snapshots/s.db", "/var/lib/etcd/restore-r1", "master-0", testPeerURL, "etcd-restore-r1"))
+}
+
+func TestEtcdRestorePlanRoundTrip(t *testing.T) {
+	t.Parallel()
+	root := t.TempDir()
+	dir := filepath.Join(root, "r1")
+
+	plan := &etcdRestorePlan{
+		ID:       "r1",
+		Snapshot: "s.db",
+		Operation: &controlplanev1alpha1.ControlPlaneOperation{
+			ObjectMeta: metav1.ObjectMeta{Name: "r1", Namespace: constants.KubeSystemNamespace},
+			Spec: controlplanev1alpha1.ControlPlaneOperationSpec{
+				NodeName:     "master-0",
+				Component:    controlplanev1alpha1.OperationComponentEtcd,
+				Steps:        []controlplanev1alpha1.StepName{controlplanev1alpha1.StepRestoreEtcdSnapshot},
+				EtcdSnapshot: "s.db",
+			},
+		},
+		Peers: []etcdMemberInfo{{Name: "master-1", PeerURLs: []string{"https://192.168.0.11:2380"}}},
+	}
+	require.NoError(t, saveEtcdRestorePlan(dir, plan))
+
+	loaded, err := loadEtcdRestorePlan(dir)
+	require.NoError(t, err)
+	require.Equal(t, plan, loaded)
+
+	require.Empty(t, pendingEtcdRestorePlans(root), "a restore that has not stopped anything is left to its operation")
+	require.NoError(t, setEtcdRestoreMark(dir, etcdRestoreStoppingMark))
+	require.Len(t, pendingEtcdRestorePlans(root), 1)
+	require.NoError(t, setEtcdRestoreMark(dir, etcdRestoreDoneMark))
+	require.Empty(t, pendingEtcdRestorePlans(root))
+}
+
+func TestEtcdRestoreOperation(t *testing.T) {
+	t.Parallel()
+	op := etcdRestoreOperation("r1", "join", "master-1",
+		controlplanev1alpha1.StepStopEtcd, controlplanev1alpha1.StepJoinEtcdCluster)
+
+	require.Equal(t, "r1-join-master-1", op.Name)
+	require.Equal(t, constants.KubeSystemNamespace, op.Namespace)
+	require.Equal(t, "master-1", op.Labels[constants.ControlPlaneNodeNameLabelKey])
+	require.Equal(t, "r1", op.Labels[constants.EtcdRestoreLabelKey])
+	require.True(t, op.Spec.Approved)
+	require.Equal(t, controlplanev1alpha1.OperationComponentEtcd, op.Spec.Component)
+	require.Equal(t, []controlplanev1alpha1.StepName{controlplanev1alpha1.StepStopEtcd, controlplanev1alpha1.StepJoinEtcdCluster}, op.Spec.Steps)
+	require.Equal(t, "r1", etcdRestoreID(op))
+}
+
+func TestUnstoppedEtcdPeers(t *testing.T) {
+	t.Parallel()
+	peers := []etcdMemberInfo{
+		{Name: "master-1", PeerURLs: []string{"https://192.168.0.11:2380"}},
+		{Name: "master-2", PeerURLs: []string{"https://192.168.0.12:2380"}},
+		{Name: "master-3", PeerURLs: []string{"https://192.168.0.13:2380"}},
+		{Name: "master-4", PeerURLs: []string{"https://192.168.0.14:2380"}},
+		{PeerURLs: []string{"https://192.168.0.15:2380"}},
+	}
+	states := map[string]etcdPeerState{
+		"https://192.168.0.11:2380": etcdPeerStopped,
+		"https://192.168.0.12:2380": etcdPeerRunning,
+		"https://192.168.0.13:2380": etcdPeerUnreachable,
+		"https://192.168.0.14:2380": etcdPeerUnreachable,
+		"https://192.168.0.15:2380": etcdPeerUnreachable,
+	}
+	acknowledged := map[string]bool{"master-4": true}
+
+	running, unreachable := unstoppedEtcdPeers(peers,
+		func(url string) etcdPeerState { return states[url] },
+		func(name string) bool { return acknowledged[name] })
+	require.Equal(t, []string{"master-2"}, running)
+	require.Equal(t, []string{"master-3", "192.168.0.15:2380"}, unreachable,
+		"an unreachable member counts as stopped only once acknowledged")
+}
+
+func TestProbeEtcdPeer(t *testing.T) {
+	t.Parallel()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	address := listener.Addr().String()
+	require.Equal(t, etcdPeerRunning, probeEtcdPeer("https://"+address))
+
+	require.NoError(t, listener.Close())
+	require.Equal(t, etcdPeerStopped, probeEtcdPeer("https://"+address), "a refused connection means etcd is stopped")
+	require.Equal(t, etcdPeerUnreachable, probeEtcdPeer("not a url"))
+}
+
+func TestAcceptEtcdRestoreRequest(t *testing.T) {
+	t.Parallel()
+	root := t.TempDir()
+	require.Empty(t, requestedEtcdRestores(root))
+
+	dir := filepath.Join(root, "r1")
+	require.NoError(t, os.MkdirAll(dir, 0o700))
+	require.Empty(t, requestedEtcdRestores(root), "a directory without a request is not a restore request")
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, etcdRestoreRequestFile),
+		[]byte(`{"snapshot":"s.db","peers":[{"name":"master-1","peerURLs":["https://192.168.0.11:2380"]}]}`), 0o600))
+	require.Equal(t, []string{"r1"}, requestedEtcdRestores(root))
+
+	req, err := loadEtcdRestoreRequest(dir)
+	require.NoError(t, err)
+	require.Equal(t, "s.db", req.Snapshot)
+	require.Equal(t, []etcdMemberInfo{{Name: "master-1", PeerURLs: []string{"https://192.168.0.11:2380"}}}, req.Peers)
+
+	require.NoError(t, setEtcdRestoreMark(dir, etcdRestoreStoppingMark))
+	require.Empty(t, requestedEtcdRestores(root), "an accepted request is driven by its plan")
+
+	op := etcdRestoreSnapshotOperation("r1", "master-0", "s.db")
+	require.Equal(t, "r1", etcdRestoreID(op))
+	require.Equal(t, "master-0", op.Labels[constants.ControlPlaneNodeNameLabelKey])
+	require.Equal(t, "s.db", op.Spec.EtcdSnapshot)
+	require.True(t, op.Spec.Approved)
+}
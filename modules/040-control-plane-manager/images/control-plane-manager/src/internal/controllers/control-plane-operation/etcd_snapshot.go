@@ -0,0 +1,413 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controlplaneoperation
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/deckhouse/deckhouse/go_lib/controlplane/etcd"
+	etcdclient "github.com/deckhouse/deckhouse/go_lib/controlplane/etcd/client"
+	"github.com/deckhouse/deckhouse/pkg/log"
+
+	controlplanev1alpha1 "control-plane-manager/api/v1alpha1"
+	"control-plane-manager/internal/constants"
+)
+
+const (
+	etcdSnapshotFilePrefix = "etcd-snapshot-"
+	etcdSnapshotFileSuffix = ".db"
+	// etcdSnapshotUploadedSuffix marks a snapshot uploaded to S3, so the upload metric survives controller restarts.
+	etcdSnapshotUploadedSuffix = ".uploaded"
+
+	etcdSnapshotTimeout       = 10 * time.Minute
+	etcdSnapshotUploadTimeout = 30 * time.Minute
+	etcdSnapshotRetryInterval = 30 * time.Second
+
+	// etcdSnapshotDeadline bounds how long SnapshotEtcd retries before abandoning, instead of
+	// holding the global etcd slot while the member cannot be snapshotted.
+	etcdSnapshotDeadline = 10 * time.Minute
+
+	// bboltMagic is the magic number in the meta page of a bbolt database, which an etcd snapshot is.
+	bboltMagic = 0xED0CDAED
+	// bboltMagicOffset is the offset of the magic number: the page header is 16 bytes long.
+	bboltMagicOffset = 16
+
+	// Keys of the d8-control-plane-manager-etcd-snapshots secret.
+	etcdSnapshotsRetentionKey            = "retention"
+	etcdSnapshotsS3EndpointKey           = "s3-endpoint"
+	etcdSnapshotsS3BucketKey             = "s3-bucket"
+	etcdSnapshotsS3RegionKey             = "s3-region"
+	etcdSnapshotsS3PrefixKey             = "s3-prefix"
+	etcdSnapshotsS3CredentialsSecretKey  = "s3-credentials-secret-name"
+	etcdSnapshotsS3InsecureSkipVerifyKey = "s3-insecure-skip-verify"
+	etcdSnapshotsS3CAKey                 = "s3-ca.crt"
+	etcdSnapshotsS3AccessKeyIDKey        = "accessKeyID"
+	etcdSnapshotsS3SecretAccessKeyKey    = "secretAccessKey"
+	etcdSnapshotsS3DefaultRegion         = "us-east-1"
+)
+
+// etcdSnapshotConfig holds the snapshot settings rendered from ModuleConfig into the
+// d8-control-plane-manager-etcd-snapshots secret.
+type etcdSnapshotConfig struct {
+	Retention int
+	S3        *s3Config
+	// S3CredentialsSecretName is the kube-system secret with the S3 access keys.
+	S3CredentialsSecretName string
+}
+
+// snapshotEtcd is the Reconciler-level implementation of the SnapshotEtcd step.
+// It saves a verified snapshot of the local member, applies local retention and uploads the snapshot to S3 if configured.
+// A failed upload does not fail the step: the snapshot is kept locally and the failure is reported in the step message
+// and by the missing upload metric, so an unreachable bucket never holds the global etcd slot.
+func (r *Reconciler) snapshotEtcd(ctx context.Context, state *controlplanev1alpha1.OperationState, logger *log.Logger) (StepResult, error) {
+	op := state.Raw()
+	if op.Spec.Component != controlplanev1alpha1.OperationComponentEtcd {
+		return StepResult{Outcome: OutcomeCompleted}, nil
+	}
+
+	cfg, err := r.loadEtcdSnapshotConfig(ctx)
+	if err != nil {
+		logger.Warn("failed to load etcd snapshot config, will retry", log.Err(err))
+		return etcdSnapshotRetryResult(op, err), nil
+	}
+
+	// The file name is derived from the operation, so a retried operation reuses the snapshot it already saved.
+	path := filepath.Join(constants.EtcdSnapshotsPath, etcdSnapshotFileName(r.node.Name, op.CreationTimestamp.Time))
+	info, err := os.Stat(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		logger.Info("taking etcd snapshot", slog.String("path", path))
+		if err := takeEtcdSnapshot(ctx, path, r.node.KubeconfigDir, logger); err != nil {
+			logger.Warn("failed to take etcd snapshot, will retry", log.Err(err))
+			return etcdSnapshotRetryResult(op, err), nil
+		}
+		info, err = os.Stat(path)
+	}
+	if err != nil {
+		return StepResult{}, fmt.Errorf("stat etcd snapshot: %w", err)
+	}
+	r.metrics.setEtcdSnapshotSaved(r.node.Name, info)
+
+	if err := rotateEtcdSnapshots(constants.EtcdSnapshotsPath, cfg.Retention); err != nil {
+		logger.Warn("failed to rotate etcd snapshots", log.Err(err))
+	}
+
+	message := fmt.Sprintf("saved %s (%d bytes)", info.Name(), info.Size())
+	if cfg.S3 == nil {
+		return StepResult{Outcome: OutcomeCompleted, Message: message}, nil
+	}
+
+	object, err := r.uploadEtcdSnapshot(ctx, cfg, path)
+	if err != nil {
+		logger.Warn("failed to upload etcd snapshot", log.Err(err))
+		return StepResult{Outcome: OutcomeCompleted, Message: fmt.Sprintf("%s; upload failed: %v", message, err)}, nil
+	}
+
+	logger.Info("etcd snapshot uploaded", slog.String("object", object))
+	if err := os.WriteFile(path+etcdSnapshotUploadedSuffix, []byte(object+"\n"), 0o600); err != nil {
+		logger.Warn("failed to mark etcd snapshot as uploaded", log.Err(err))
+	}
+	r.metrics.setEtcdSnapshotUploaded(r.node.Name, time.Now())
+	return StepResult{Outcome: OutcomeCompleted, Message: fmt.Sprintf("%s; uploaded to %s", message, object)}, nil
+}
+
+// uploadEtcdSnapshot uploads the snapshot at path to the configured bucket and returns the object URL.
+func (r *Reconciler) uploadEtcdSnapshot(ctx context.Context, cfg etcdSnapshotConfig, path string) (string, error) {
+	credentials := &corev1.Secret{}
+	if err := r.client.Get(ctx, client.ObjectKey{Name: cfg.S3CredentialsSecretName, Namespace: constants.KubeSystemNamespace}, credentials); err != nil {
+		return "", fmt.Errorf("get S3 credentials secret %s: %w", cfg.S3CredentialsSecretName, err)
+	}
+
+	s3 := *cfg.S3
+	s3.AccessKeyID = string(credentials.Data[etcdSnapshotsS3AccessKeyIDKey])
+	s3.SecretAccessKey = string(credentials.Data[etcdSnapshotsS3SecretAccessKeyKey])
+	if s3.AccessKeyID == "" || s3.SecretAccessKey == "" {
+		return "", fmt.Errorf("S3 credentials secret %s must contain %q and %q",
+			cfg.S3CredentialsSecretName, etcdSnapshotsS3AccessKeyIDKey, etcdSnapshotsS3SecretAccessKeyKey)
+	}
+
+	uploader, err := newS3Uploader(s3)
+	if err != nil {
+		return "", err
+	}
+
+	uploadCtx, cancel := context.WithTimeout(ctx, etcdSnapshotUploadTimeout)
+	defer cancel()
+	if err := uploader.Upload(uploadCtx, path); err != nil {
+		return "", err
+	}
+	return uploader.ObjectURL(filepath.Base(path)), nil
+}
+
+// etcdSnapshotRetryResult retries a failed snapshot until etcdSnapshotDeadline and abandons the operation after that.
+// Unlike a failed step, a pending one is not backed off for up to 30 minutes while holding the etcd slot.
+func etcdSnapshotRetryResult(op *controlplanev1alpha1.ControlPlaneOperation, err error) StepResult {
+	if operationElapsed(op, time.Now()) > etcdSnapshotDeadline {
+		return StepResult{
+			Outcome: OutcomeAbandoned,
+			Message: fmt.Sprintf("etcd snapshot failed for %s: %v; abandoning snapshot operation", etcdSnapshotDeadline, err),
+		}
+	}
+	return StepResult{
+		Outcome:      OutcomePending,
+		Message:      fmt.Sprintf("etcd snapshot failed, will retry: %v", err),
+		RequeueAfter: etcdSnapshotRetryInterval,
+	}
+}
+
+// loadEtcdSnapshotConfig reads the snapshot settings. Without the settings secret (snapshots disabled in ModuleConfig)
+// the defaults are used, so a manually created SnapshotEtcd operation still saves a local snapshot.
+func (r *Reconciler) loadEtcdSnapshotConfig(ctx context.Context) (etcdSnapshotConfig, error) {
+	secret := &corev1.Secret{}
+	err := r.client.Get(ctx, client.ObjectKey{Name: constants.EtcdSnapshotsConfigSecretName, Namespace: constants.KubeSystemNamespace}, secret)
+	if apierrors.IsNotFound(err) {
+		return etcdSnapshotConfig{Retention: constants.DefaultEtcdSnapshotsRetention}, nil
+	}
+	if err != nil {
+		return etcdSnapshotConfig{}, fmt.Errorf("get secret %s: %w", constants.EtcdSnapshotsConfigSecretName, err)
+	}
+
+	return parseEtcdSnapshotConfig(secret.Data)
+}
+
+// parseEtcdSnapshotConfig parses the settings secret data. S3 is configured only when an endpoint is set.
+func parseEtcdSnapshotConfig(data map[string][]byte) (etcdSnapshotConfig, error) {
+	cfg := etcdSnapshotConfig{Retention: constants.DefaultEtcdSnapshotsRetention}
+	if raw := strings.TrimSpace(string(data[etcdSnapshotsRetentionKey])); raw != "" {
+		retention, err := strconv.Atoi(raw)
+		if err != nil || retention < 1 {
+			return etcdSnapshotConfig{}, fmt.Errorf("invalid %s %q: must be a positive integer", etcdSnapshotsRetentionKey, raw)
+		}
+		cfg.Retention = retention
+	}
+
+	endpoint := strings.TrimSpace(string(data[etcdSnapshotsS3EndpointKey]))
+	if endpoint == "" {
+		return cfg, nil
+	}
+
+	credentialsSecretName := strings.TrimSpace(string(data[etcdSnapshotsS3CredentialsSecretKey]))
+	s3 := &s3Config{
+		Endpoint:           endpoint,
+		Bucket:             strings.TrimSpace(string(data[etcdSnapshotsS3BucketKey])),
+		Region:             strings.TrimSpace(string(data[etcdSnapshotsS3RegionKey])),
+		Prefix:             strings.Trim(strings.TrimSpace(string(data[etcdSnapshotsS3PrefixKey])), "/"),
+		InsecureSkipVerify: strings.TrimSpace(string(data[etcdSnapshotsS3InsecureSkipVerifyKey])) == "true",
+		CA:                 data[etcdSnapshotsS3CAKey],
+	}
+	if s3.Bucket == "" {
+		return etcdSnapshotConfig{}, fmt.Errorf("%s must be set together with %s", etcdSnapshotsS3BucketKey, etcdSnapshotsS3EndpointKey)
+	}
+	if credentialsSecretName == "" {
+		return etcdSnapshotConfig{}, fmt.Errorf("%s must be set together with %s", etcdSnapshotsS3CredentialsSecretKey, etcdSnapshotsS3EndpointKey)
+	}
+	if s3.Region == "" {
+		s3.Region = etcdSnapshotsS3DefaultRegion
+	}
+	cfg.S3 = s3
+	cfg.S3CredentialsSecretName = credentialsSecretName
+	return cfg, nil
+}
+
+// etcdSnapshotFileName returns the snapshot file name for a node and an operation creation time.
+func etcdSnapshotFileName(nodeName string, createdAt time.Time) string {
+	return etcdSnapshotFilePrefix + nodeName + "-" + createdAt.UTC().Format("20060102T150405Z") + etcdSnapshotFileSuffix
+}
+
+func isEtcdSnapshotFile(name string) bool {
+	return strings.HasPrefix(name, etcdSnapshotFilePrefix) && strings.HasSuffix(name, etcdSnapshotFileSuffix)
+}
+
+// takeEtcdSnapshot streams a snapshot of the local member into dst.
+func takeEtcdSnapshot(ctx context.Context, dst, kubeconfigDir string, logger *log.Logger) error {
+	kubeClient, err := etcdclient.ClientSetFromFile(filepath.Join(kubeconfigDir, "admin.conf"))
+	if err != nil {
+		return fmt.Errorf("create k8s client from admin.conf: %w", err)
+	}
+
+	etcdCli, err := etcdclient.New(kubeClient, constants.KubernetesPkiPath)
+	if err != nil {
+		return fmt.Errorf("create etcd client: %w", err)
+	}
+	defer etcdCli.Close()
+
+	snapshotCtx, cancel := context.WithTimeout(ctx, etcdSnapshotTimeout)
+	defer cancel()
+
+	// Always snapshot the local member directly — the controller runs on the same node.
+	snapshot, err := etcdCli.Snapshot(snapshotCtx, etcd.GetClientURL("127.0.0.1"))
+	if err != nil {
+		return err
+	}
+	defer snapshot.Close()
+
+	logger.Info("etcd snapshot stream opened", slog.String("etcd_version", snapshot.Version))
+	return saveEtcdSnapshot(snapshot, dst)
+}
+
+// saveEtcdSnapshot writes the snapshot stream to a temp file next to dst, verifies it and renames it to dst,
+// so dst only ever holds a complete and verified snapshot.
+func saveEtcdSnapshot(r io.Reader, dst string) error {
+	dir := filepath.Dir(dst)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return fmt.Errorf("create dir %s: %w", dir, err)
+	}
+
+	tmp, err := os.CreateTemp(dir, "."+filepath.Base(dst)+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("create temp file: %w", err)
+	}
+	tmpPath := tmp.Name()
+	defer func() { _ = os.Remove(tmpPath) }()
+
+	if _, err := io.Copy(tmp, r); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("receive snapshot: %w", err)
+	}
+	if err := tmp.Sync(); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("sync temp file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("close temp file: %w", err)
+	}
+
+	if err := verifyEtcdSnapshot(tmpPath); err != nil {
+		return fmt.Errorf("verify snapshot: %w", err)
+	}
+
+	if err := os.Chmod(tmpPath, 0o600); err != nil {
+		return fmt.Errorf("chmod temp file: %w", err)
+	}
+	return os.Rename(tmpPath, dst)
+}
+
+// verifyEtcdSnapshot checks that the file is a complete etcd snapshot: etcd appends the sha256 of the
+// database to the snapshot stream, and the database itself must start with a bbolt meta page.
+func verifyEtcdSnapshot(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	dbSize := info.Size() - sha256.Size
+	if dbSize < bboltMagicOffset+4 {
+		return fmt.Errorf("snapshot is too small (%d bytes)", info.Size())
+	}
+
+	header := make([]byte, bboltMagicOffset+4)
+	if _, err := io.ReadFull(f, header); err != nil {
+		return fmt.Errorf("read header: %w", err)
+	}
+	if binary.LittleEndian.Uint32(header[bboltMagicOffset:]) != bboltMagic {
+		return errors.New("snapshot is not a bbolt database")
+	}
+
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	hash := sha256.New()
+	if _, err := io.CopyN(hash, f, dbSize); err != nil {
+		return fmt.Errorf("hash snapshot: %w", err)
+	}
+	expected := make([]byte, sha256.Size)
+	if _, err := io.ReadFull(f, expected); err != nil {
+		return fmt.Errorf("read snapshot hash: %w", err)
+	}
+	if !bytes.Equal(hash.Sum(nil), expected) {
+		return errors.New("snapshot sha256 does not match the hash appended by etcd")
+	}
+	return nil
+}
+
+// rotateEtcdSnapshots keeps the keep most recent snapshots in dir and removes upload marks of the deleted ones.
+func rotateEtcdSnapshots(dir string, keep int) error {
+	if err := rotateFiles(dir, isEtcdSnapshotFile, keep); err != nil {
+		return err
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return fmt.Errorf("read dir: %w", err)
+	}
+	for _, e := range entries {
+		snapshot, ok := strings.CutSuffix(e.Name(), etcdSnapshotUploadedSuffix)
+		if !ok || !isEtcdSnapshotFile(snapshot) {
+			continue
+		}
+		if _, err := os.Stat(filepath.Join(dir, snapshot)); !errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err := os.Remove(filepath.Join(dir, e.Name())); err != nil {
+			return fmt.Errorf("remove upload mark %s: %w", e.Name(), err)
+		}
+	}
+	return nil
+}
+
+// etcdSnapshotUploadedAt returns when the snapshot at path was uploaded to S3, if it was.
+func etcdSnapshotUploadedAt(path string) (time.Time, bool) {
+	info, err := os.Stat(path + etcdSnapshotUploadedSuffix)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return info.ModTime(), true
+}
+
+// latestEtcdSnapshot returns the most recent snapshot in dir, if any.
+func latestEtcdSnapshot(dir string) (fs.FileInfo, bool) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, false
+	}
+
+	var latest fs.FileInfo
+	for _, e := range entries {
+		if e.IsDir() || !isEtcdSnapshotFile(e.Name()) {
+			continue
+		}
+		info, err := e.Info()
+		if err != nil {
+			continue
+		}
+		if latest == nil || info.ModTime().After(latest.ModTime()) {
+			latest = info
+		}
+	}
+	return latest, latest != nil
+}
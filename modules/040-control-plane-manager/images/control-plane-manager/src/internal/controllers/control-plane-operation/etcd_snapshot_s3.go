@@ -0,0 +1,232 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controlplaneoperation
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/md5" //nolint:gosec // Content-MD5 is the integrity check S3 verifies on upload.
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// s3MaxPutObjectSize is the largest object a single PutObject request may upload.
+	s3MaxPutObjectSize = 5 << 30
+
+	s3UnsignedPayload = "UNSIGNED-PAYLOAD"
+	s3SigningAlgo     = "AWS4-HMAC-SHA256"
+	s3AmzDateFormat   = "20060102T150405Z"
+	s3ErrorBodyLimit  = 1024
+)
+
+// s3Config describes an S3-compatible bucket the etcd snapshots are uploaded to.
+type s3Config struct {
+	Endpoint           string
+	Bucket             string
+	Region             string
+	Prefix             string
+	AccessKeyID        string
+	SecretAccessKey    string
+	InsecureSkipVerify bool
+	CA                 []byte
+}
+
+// s3Uploader uploads files with single PutObject requests signed with AWS Signature Version 4.
+// Path-style addressing is used, which every S3-compatible storage supports.
+type s3Uploader struct {
+	cfg      s3Config
+	endpoint *url.URL
+	client   *http.Client
+	now      func() time.Time
+}
+
+func newS3Uploader(cfg s3Config) (*s3Uploader, error) {
+	endpoint, err := url.Parse(cfg.Endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("parse S3 endpoint: %w", err)
+	}
+	if endpoint.Scheme != "http" && endpoint.Scheme != "https" || endpoint.Host == "" {
+		return nil, fmt.Errorf("S3 endpoint %q must be an http(s) URL", cfg.Endpoint)
+	}
+
+	tlsConfig := &tls.Config{InsecureSkipVerify: cfg.InsecureSkipVerify} //nolint:gosec // explicitly requested in ModuleConfig
+	if len(cfg.CA) > 0 {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(cfg.CA) {
+			return nil, errors.New("S3 CA does not contain a PEM certificate")
+		}
+		tlsConfig.RootCAs = pool
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+
+	return &s3Uploader{
+		cfg:      cfg,
+		endpoint: endpoint,
+		client:   &http.Client{Transport: transport},
+		now:      time.Now,
+	}, nil
+}
+
+// objectKey returns the key of the object for fileName under the configured prefix.
+func (u *s3Uploader) objectKey(fileName string) string {
+	if u.cfg.Prefix == "" {
+		return fileName
+	}
+	return u.cfg.Prefix + "/" + fileName
+}
+
+// ObjectURL returns the s3:// URL of the object for fileName.
+func (u *s3Uploader) ObjectURL(fileName string) string {
+	return "s3://" + u.cfg.Bucket + "/" + u.objectKey(fileName)
+}
+
+// Upload puts the file at path into the bucket. Content-MD5 is sent, so the storage rejects
+// an object that was corrupted on the way.
+func (u *s3Uploader) Upload(ctx context.Context, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	if info.Size() > s3MaxPutObjectSize {
+		return fmt.Errorf("%s is %d bytes, larger than the single upload limit of %d bytes", info.Name(), info.Size(), int64(s3MaxPutObjectSize))
+	}
+
+	hash := md5.New() //nolint:gosec
+	if _, err := io.Copy(hash, f); err != nil {
+		return fmt.Errorf("hash %s: %w", info.Name(), err)
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	objectPath := "/" + u.cfg.Bucket + "/" + u.objectKey(info.Name())
+	target := *u.endpoint
+	target.Path = strings.TrimSuffix(target.Path, "/") + objectPath
+	target.RawPath = s3EscapePath(target.Path)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, target.String(), f)
+	if err != nil {
+		return fmt.Errorf("build request: %w", err)
+	}
+	req.ContentLength = info.Size()
+	req.Header.Set("Content-Type", "application/octet-stream")
+	req.Header.Set("Content-MD5", base64.StdEncoding.EncodeToString(hash.Sum(nil)))
+	req.Header.Set("X-Amz-Content-Sha256", s3UnsignedPayload)
+	signS3Request(req, u.cfg.AccessKeyID, u.cfg.SecretAccessKey, u.cfg.Region, u.now())
+
+	resp, err := u.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("upload %s: %w", u.ObjectURL(info.Name()), err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, s3ErrorBodyLimit))
+		return fmt.Errorf("upload %s: %s: %s", u.ObjectURL(info.Name()), resp.Status, strings.TrimSpace(string(body)))
+	}
+	return nil
+}
+
+// signS3Request adds the AWS Signature Version 4 Authorization header to req.
+// The host and every header already set on req are signed; X-Amz-Content-Sha256 must be set by the caller.
+func signS3Request(req *http.Request, accessKeyID, secretAccessKey, region string, now time.Time) {
+	now = now.UTC()
+	amzDate := now.Format(s3AmzDateFormat)
+	date := now.Format("20060102")
+	req.Header.Set("X-Amz-Date", amzDate)
+
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		headers[strings.ToLower(name)] = strings.TrimSpace(strings.Join(values, ","))
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		canonicalHeaders.String(),
+		signedHeaders,
+		req.Header.Get("X-Amz-Content-Sha256"),
+	}, "\n")
+
+	scope := date + "/" + region + "/s3/aws4_request"
+	canonicalHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{s3SigningAlgo, amzDate, scope, hex.EncodeToString(canonicalHash[:])}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+secretAccessKey), date)
+	key = hmacSHA256(key, region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s3SigningAlgo, accessKeyID, scope, signedHeaders, signature))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+// s3EscapePath percent-encodes every byte of p except the unreserved characters and '/', as SigV4 requires.
+func s3EscapePath(p string) string {
+	var b strings.Builder
+	for i := 0; i < len(p); i++ {
+		c := p[i]
+		if 'A' <= c && c <= 'Z' || 'a' <= c && c <= 'z' || '0' <= c && c <= '9' || strings.IndexByte("-_.~/", c) >= 0 {
+			b.WriteByte(c)
+			continue
+		}
+		fmt.Fprintf(&b, "%%%02X", c)
+	}
+	return b.String()
+}
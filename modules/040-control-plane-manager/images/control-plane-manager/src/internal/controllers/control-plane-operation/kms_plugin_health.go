@@ -0,0 +1,116 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controlplaneoperation
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/deckhouse/deckhouse/pkg/log"
+
+	"control-plane-manager/internal/constants"
+	"control-plane-manager/internal/kmsplugin"
+)
+
+const (
+	kmsPluginHealthInterval = time.Minute
+	kmsPluginHealthTimeout  = 10 * time.Second
+)
+
+// checkKMSPluginHealth calls the Status method of the plugin of this node, as kube-apiserver does for its health checks.
+func checkKMSPluginHealth(ctx context.Context, endpoint string) error {
+	ctx, cancel := context.WithTimeout(ctx, kmsPluginHealthTimeout)
+	defer cancel()
+	_, err := kmsplugin.CheckHealth(ctx, endpoint)
+	return err
+}
+
+// kmsPluginMonitor exports the health of the KMS plugin of this node while kube-apiserver uses it or is about to.
+// kube-apiserver only reports it through its readiness, which is not enough to tell a broken plugin from other failures.
+type kmsPluginMonitor struct {
+	r *Reconciler
+}
+
+func (m *kmsPluginMonitor) NeedLeaderElection() bool {
+	return false
+}
+
+func (m *kmsPluginMonitor) Start(ctx context.Context) error {
+	ticker := time.NewTicker(kmsPluginHealthInterval)
+	defer ticker.Stop()
+	for {
+		m.check(ctx)
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (m *kmsPluginMonitor) check(ctx context.Context) {
+	logger := m.r.log.With(slog.String("component", "kms-plugin-monitor"))
+
+	endpoint, err := m.r.kmsEndpointInUse(ctx)
+	if err != nil {
+		logger.Warn("failed to find the KMS plugin endpoint", log.Err(err))
+		return
+	}
+	if endpoint == "" {
+		m.r.metrics.deleteKMSPluginHealth(m.r.node.Name)
+		return
+	}
+
+	err = m.r.checkKMSPlugin(ctx, endpoint)
+	if err != nil {
+		logger.Warn("KMS plugin is unhealthy", slog.String("endpoint", endpoint), log.Err(err))
+	}
+	m.r.metrics.setKMSPluginHealth(m.r.node.Name, err == nil)
+}
+
+// kmsEndpointInUse returns the endpoint the encryption configuration of kube-apiserver lists, or is about to:
+// the configured one, or the one a migration away from KMS still needs. It only reads the secrets: the
+// ensure_secret_encryption_key hook records a changed endpoint in d8-secret-encryption-key.
+func (r *Reconciler) kmsEndpointInUse(ctx context.Context) (string, error) {
+	cpmSecret := &corev1.Secret{}
+	if err := r.client.Get(ctx, client.ObjectKey{Name: constants.ControlPlaneManagerConfigSecretName, Namespace: constants.KubeSystemNamespace}, cpmSecret); err != nil {
+		return "", fmt.Errorf("get cpm secret: %w", err)
+	}
+	configured := string(cpmSecret.Data[constants.SecretKeyKMSEndpoint])
+
+	keySecret := &corev1.Secret{}
+	if err := r.client.Get(ctx, client.ObjectKey{Name: constants.SecretEncryptionKeySecretName, Namespace: constants.KubeSystemNamespace}, keySecret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return configured, nil
+		}
+		return "", fmt.Errorf("get encryption key secret: %w", err)
+	}
+	state := encryptionKeyStateFromData(keySecret.Data)
+	if !state.usesKMS() {
+		return configured, nil
+	}
+	if configured == "" {
+		return state.KMSEndpoint, nil
+	}
+	return configured, nil
+}
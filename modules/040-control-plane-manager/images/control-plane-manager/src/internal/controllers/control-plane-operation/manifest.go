@@ -224,30 +224,64 @@ func readFileIfExists(path string) ([]byte, bool, error) {
 	return nil, false, fmt.Errorf("read %s: %w", path, err)
 }
 
-func manifestMatchesDesired(op *controlplanev1alpha1.ControlPlaneOperation) (bool, error) {
-	podComponent := op.Spec.Component.PodComponentName()
+// readManifestAnnotations returns the annotations of the component static pod manifest on disk.
+// ok is false when the component has no manifest or the manifest does not exist yet.
+func readManifestAnnotations(component controlplanev1alpha1.OperationComponent) (map[string]string, bool, error) {
+	podComponent := component.PodComponentName()
 	if podComponent == "" {
-		return false, nil
+		return nil, false, nil
 	}
+	return readManifestFileAnnotations(filepath.Join(constants.ManifestsPath, podComponent+".yaml"))
+}
 
-	path := filepath.Join(constants.ManifestsPath, podComponent+".yaml")
+// readManifestFileAnnotations reads the annotations of the static pod manifest at path.
+func readManifestFileAnnotations(path string) (map[string]string, bool, error) {
 	content, err := os.ReadFile(path)
 	if err != nil {
 		if os.IsNotExist(err) {
-			return false, nil
+			return nil, false, nil
 		}
-		return false, fmt.Errorf("read manifest %s: %w", path, err)
+		return nil, false, fmt.Errorf("read manifest %s: %w", path, err)
 	}
 
 	pod := &corev1.Pod{}
 	if err := yaml.Unmarshal(content, pod); err != nil {
-		return false, fmt.Errorf("unmarshal manifest %s: %w", path, err)
+		return nil, false, fmt.Errorf("unmarshal manifest %s: %w", path, err)
 	}
 
 	annotations := pod.Annotations
 	if annotations == nil {
 		annotations = map[string]string{}
 	}
+	return annotations, true, nil
+}
+
+// manifestChecksumAnnotations returns the checksum annotations of the component static pod manifest on disk.
+// Used when the expected pod state comes from restored files instead of the operation spec.
+func manifestChecksumAnnotations(component controlplanev1alpha1.OperationComponent) (checksumAnnotations, error) {
+	annotations, ok, err := readManifestAnnotations(component)
+	if err != nil {
+		return checksumAnnotations{}, err
+	}
+	if !ok {
+		return checksumAnnotations{}, fmt.Errorf("manifest for %s not found", component)
+	}
+
+	return checksumAnnotations{
+		ConfigChecksum:      annotations[constants.ConfigChecksumAnnotationKey],
+		PKIChecksum:         annotations[constants.PKIChecksumAnnotationKey],
+		CAChecksum:          annotations[constants.CAChecksumAnnotationKey],
+		CertRenewalID:       annotations[constants.CertRenewalIDAnnotationKey],
+		KubeconfigRenewalID: annotations[constants.KubeconfigRenewalIDAnnotationKey],
+		SignatureRenewalID:  annotations[constants.SignatureRenewalIDAnnotationKey],
+	}, nil
+}
+
+func manifestMatchesDesired(op *controlplanev1alpha1.ControlPlaneOperation) (bool, error) {
+	annotations, ok, err := readManifestAnnotations(op.Spec.Component)
+	if err != nil || !ok {
+		return false, err
+	}
 
 	if op.Spec.DesiredConfigChecksum != "" && annotations[constants.ConfigChecksumAnnotationKey] != op.Spec.DesiredConfigChecksum {
 		return false, nil
@@ -19,6 +19,7 @@ package controlplaneoperation
 import (
 	"errors"
 	"fmt"
+	"io/fs"
 	"time"
 
 	metricsstorage "github.com/deckhouse/deckhouse/pkg/metrics-storage"
@@ -33,10 +34,33 @@ const (
 	operationInProgressTooLongThreshold = 10 * time.Minute
 	operationInProgressMetricName       = "d8_control_plane_manager_operation_too_long"
 	operationInProgressMetricHelp       = "Indicates that a control-plane operation has been running for more than 10 minutes."
+
+	operationRolledBackMetricName = "d8_control_plane_manager_operation_rolled_back"
+	operationRolledBackMetricHelp = "Indicates that a control-plane operation put a component back to the files of an earlier backup."
+
+	rollbackTriggerAutomatic = "Automatic"
+	rollbackTriggerManual    = "Manual"
+
+	etcdSnapshotLastSuccessMetricName       = "d8_control_plane_manager_etcd_snapshot_last_success_timestamp_seconds"
+	etcdSnapshotLastSuccessMetricHelp       = "Time of the most recent verified etcd snapshot saved on the node."
+	etcdSnapshotSizeMetricName              = "d8_control_plane_manager_etcd_snapshot_size_bytes"
+	etcdSnapshotSizeMetricHelp              = "Size of the most recent etcd snapshot saved on the node."
+	etcdSnapshotUploadLastSuccessMetricName = "d8_control_plane_manager_etcd_snapshot_upload_last_success_timestamp_seconds"
+	etcdSnapshotUploadLastSuccessMetricHelp = "Time of the most recent etcd snapshot uploaded to S3 from the node."
+
+	kmsPluginHealthyMetricName = "d8_control_plane_manager_kms_plugin_healthy"
+	kmsPluginHealthyMetricHelp = "Health of the KMS plugin of the node, while kube-apiserver encrypts with it or is about to."
 )
 
 type metrics struct {
 	operationInProgress *collectors.ConstGaugeCollector
+	operationRolledBack *collectors.ConstGaugeCollector
+
+	etcdSnapshotLastSuccess       *collectors.ConstGaugeCollector
+	etcdSnapshotSize              *collectors.ConstGaugeCollector
+	etcdSnapshotUploadLastSuccess *collectors.ConstGaugeCollector
+
+	kmsPluginHealthy *collectors.ConstGaugeCollector
 }
 
 func newMetrics(storage metricsstorage.Storage) (*metrics, error) {
@@ -53,8 +77,58 @@ func newMetrics(storage metricsstorage.Storage) (*metrics, error) {
 		return nil, fmt.Errorf("register operation in progress metric: %w", err)
 	}
 
+	operationRolledBack, err := storage.RegisterGauge(
+		operationRolledBackMetricName,
+		[]string{"node", "component", "operation", "trigger"},
+		options.WithHelp(operationRolledBackMetricHelp),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("register operation rolled back metric: %w", err)
+	}
+
+	etcdSnapshotLastSuccess, err := storage.RegisterGauge(
+		etcdSnapshotLastSuccessMetricName,
+		[]string{"node"},
+		options.WithHelp(etcdSnapshotLastSuccessMetricHelp),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("register etcd snapshot last success metric: %w", err)
+	}
+
+	etcdSnapshotSize, err := storage.RegisterGauge(
+		etcdSnapshotSizeMetricName,
+		[]string{"node"},
+		options.WithHelp(etcdSnapshotSizeMetricHelp),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("register etcd snapshot size metric: %w", err)
+	}
+
+	etcdSnapshotUploadLastSuccess, err := storage.RegisterGauge(
+		etcdSnapshotUploadLastSuccessMetricName,
+		[]string{"node"},
+		options.WithHelp(etcdSnapshotUploadLastSuccessMetricHelp),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("register etcd snapshot upload last success metric: %w", err)
+	}
+
+	kmsPluginHealthy, err := storage.RegisterGauge(
+		kmsPluginHealthyMetricName,
+		[]string{"node"},
+		options.WithHelp(kmsPluginHealthyMetricHelp),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("register KMS plugin health metric: %w", err)
+	}
+
 	return &metrics{
-		operationInProgress: operationInProgress,
+		operationInProgress:           operationInProgress,
+		operationRolledBack:           operationRolledBack,
+		etcdSnapshotLastSuccess:       etcdSnapshotLastSuccess,
+		etcdSnapshotSize:              etcdSnapshotSize,
+		etcdSnapshotUploadLastSuccess: etcdSnapshotUploadLastSuccess,
+		kmsPluginHealthy:              kmsPluginHealthy,
 	}, nil
 }
 
@@ -71,6 +145,8 @@ func (m *metrics) syncOperationExecutionMetrics(op *controlplanev1alpha1.Control
 	componentLabel := string(op.Spec.Component)
 	operationLabel := op.Name
 
+	m.syncOperationRollbackMetrics(op)
+
 	if isOperationInProgressTooLong(op, time.Now()) {
 		m.operationInProgress.Set(
 			1,
@@ -87,8 +163,41 @@ func (m *metrics) syncOperationExecutionMetrics(op *controlplanev1alpha1.Control
 	m.operationInProgress.ExpireGroupMetrics(operationExecutionGroup(operationLabel))
 }
 
+// syncOperationRollbackMetrics exports the rolled back metric for automatic rollbacks
+// and for completed manual Restore operations.
+func (m *metrics) syncOperationRollbackMetrics(op *controlplanev1alpha1.ControlPlaneOperation) {
+	trigger, ok := operationRollbackTrigger(op)
+	if !ok {
+		m.operationRolledBack.ExpireGroupMetrics(operationExecutionGroup(op.Name))
+		return
+	}
+
+	m.operationRolledBack.Set(
+		1,
+		map[string]string{
+			"node":      op.Labels[constants.ControlPlaneNodeNameLabelKey],
+			"component": string(op.Spec.Component),
+			"operation": op.Name,
+			"trigger":   trigger,
+		},
+		collectors.WithGroup(operationExecutionGroup(op.Name)),
+	)
+}
+
+func operationRollbackTrigger(op *controlplanev1alpha1.ControlPlaneOperation) (string, bool) {
+	switch {
+	case op.IsRolledBack():
+		return rollbackTriggerAutomatic, true
+	case op.HasStep(controlplanev1alpha1.StepRestore) && op.IsStepCompleted(controlplanev1alpha1.StepRestore):
+		return rollbackTriggerManual, true
+	default:
+		return "", false
+	}
+}
+
+// isOperationInProgressTooLong does not apply to encryption key rotations: re-encrypting every object of a large cluster takes hours.
 func isOperationInProgressTooLong(op *controlplanev1alpha1.ControlPlaneOperation, now time.Time) bool {
-	if op == nil || !op.Spec.Approved || op.IsTerminal() {
+	if op == nil || !op.Spec.Approved || op.IsTerminal() || op.IsEncryptionKeyRotation() {
 		return false
 	}
 
@@ -128,4 +237,48 @@ func (m *metrics) deleteOperationExecutionMetrics(operation string) {
 	}
 
 	m.operationInProgress.ExpireGroupMetrics(operationExecutionGroup(operation))
+	m.operationRolledBack.ExpireGroupMetrics(operationExecutionGroup(operation))
+}
+
+// setEtcdSnapshotSaved exports the time and size of the snapshot saved on node.
+func (m *metrics) setEtcdSnapshotSaved(node string, snapshot fs.FileInfo) {
+	if m == nil || snapshot == nil {
+		return
+	}
+
+	labels := map[string]string{"node": node}
+	m.etcdSnapshotLastSuccess.Set(float64(snapshot.ModTime().Unix()), labels)
+	m.etcdSnapshotSize.Set(float64(snapshot.Size()), labels)
+}
+
+func (m *metrics) setEtcdSnapshotUploaded(node string, uploadedAt time.Time) {
+	if m == nil {
+		return
+	}
+
+	m.etcdSnapshotUploadLastSuccess.Set(float64(uploadedAt.Unix()), map[string]string{"node": node})
+}
+
+func (m *metrics) setKMSPluginHealth(node string, healthy bool) {
+	if m == nil {
+		return
+	}
+
+	value := 0.0
+	if healthy {
+		value = 1.0
+	}
+	m.kmsPluginHealthy.Set(value, map[string]string{"node": node}, collectors.WithGroup(kmsPluginGroup(node)))
+}
+
+func (m *metrics) deleteKMSPluginHealth(node string) {
+	if m == nil {
+		return
+	}
+
+	m.kmsPluginHealthy.ExpireGroupMetrics(kmsPluginGroup(node))
+}
+
+func kmsPluginGroup(node string) string {
+	return "kms/" + node
 }
@@ -57,6 +57,11 @@ func (r *Reconciler) reconcilePipeline(ctx context.Context, state *controlplanev
 		Node:    r.node,
 	}
 
+	// A rollback started by a previous reconcile takes over the pipeline until it finishes.
+	if state.IsRollingBack() {
+		return r.reconcileRollback(ctx, state, logger)
+	}
+
 	for _, name := range stepNames {
 		if state.IsStepCompleted(name) {
 			logger.With(slog.String("step", string(name))).Info("step already completed, skipping")
@@ -75,6 +80,10 @@ func (r *Reconciler) reconcilePipeline(ctx context.Context, state *controlplanev
 			return result, err
 		}
 
+		if state.IsRollingBack() {
+			return r.reconcileRollback(ctx, state, logger)
+		}
+
 		if state.IsAbandoned() || result.RequeueAfter > 0 {
 			return result, nil
 		}
@@ -116,6 +125,13 @@ func (r *Reconciler) executeStep(ctx context.Context, state *controlplanev1alpha
 				// operation non-terminal, holding its slot forever. Propagate it instead.
 				err = fmt.Errorf("persist operation abandon for %s: %w", name, patchErr)
 			}
+		case res.Outcome == OutcomeRollback:
+			// The step itself failed; the rollback is tracked separately so that it survives requeues.
+			state.MarkStepFailed(name, res.Message)
+			state.MarkRollbackInProgress(res.Message)
+			if patchErr := r.patchStatus(ctx, state); patchErr != nil {
+				err = fmt.Errorf("persist rollback start for %s: %w", name, patchErr)
+			}
 		default:
 			state.MarkStepCompletedWithMessage(name, res.Message)
 			if patchErr := r.patchStatus(ctx, state); patchErr != nil {
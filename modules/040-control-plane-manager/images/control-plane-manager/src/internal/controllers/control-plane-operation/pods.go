@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -35,41 +36,69 @@ import (
 // waitForPod checks if the static pod is ready with the expected checksums annotations.
 // Waits indefinitely for every component: giving up would free this node's approval slot for
 // another node while this pod is still unhealthy — for etcd, whose slot is global, that risks
-// losing quorum.
+// losing quorum. The only way out is a rollback: when the pod does not become ready within
+// waitPodReadyRollbackTimeout after SyncManifests, the operation backup is put back and the
+// slot is held until the restored pod is ready (see reconcileRollback).
 func (r *Reconciler) waitForPod(ctx context.Context, state *controlplanev1alpha1.OperationState, logger *log.Logger) (StepResult, error) {
 	op := state.Raw()
-	podName := fmt.Sprintf("%s-%s", op.Spec.Component.PodComponentName(), r.node.Name)
+	expected, err := expectedPodChecksums(op)
+	if err != nil {
+		return StepResult{}, fmt.Errorf("read expected pod checksums: %w", err)
+	}
+
+	ready, message := r.checkPodReady(ctx, op.Spec.Component, expected, waitPodInitialMessage(op), logger)
+	if ready {
+		return StepResult{Outcome: OutcomeCompleted}, nil
+	}
+
+	if rollbackDue(op, time.Now()) && backupExists(op.Spec.Component, op.Name) {
+		logger.Warn("pod did not become ready after manifest sync, rolling back",
+			slog.Duration("timeout", waitPodReadyRollbackTimeout))
+		return StepResult{
+			Outcome: OutcomeRollback,
+			Message: fmt.Sprintf("pod did not become ready within %s after SyncManifests: %s", waitPodReadyRollbackTimeout, message),
+		}, nil
+	}
+
+	return StepResult{
+		Outcome:      OutcomePending,
+		Message:      message,
+		RequeueAfter: requeueWaitPod,
+	}, nil
+}
+
+// expectedPodChecksums returns the checksum annotations the component pod must carry for op.
+// Restore operations and the operations of an etcd restore carry no desired checksums,
+// so the manifest on disk is the reference.
+func expectedPodChecksums(op *controlplanev1alpha1.ControlPlaneOperation) (checksumAnnotations, error) {
+	if op.HasStep(controlplanev1alpha1.StepRestore) || etcdRestoreID(op) != "" {
+		return manifestChecksumAnnotations(op.Spec.Component)
+	}
+	return checksumAnnotationsFromSpec(op.Spec), nil
+}
+
+// checkPodReady reports whether the component static pod on this node is ready with the expected
+// checksum annotations. If not, the returned message describes what is being waited for.
+func (r *Reconciler) checkPodReady(ctx context.Context, component controlplanev1alpha1.OperationComponent, expected checksumAnnotations, notFoundMessage string, logger *log.Logger) (bool, string) {
+	podName := fmt.Sprintf("%s-%s", component.PodComponentName(), r.node.Name)
 	pod := &corev1.Pod{}
 	if err := r.client.Get(ctx, client.ObjectKey{Name: podName, Namespace: constants.KubeSystemNamespace}, pod); err != nil {
 		logger.Info("pod not found yet, requeue", slog.String("pod", podName))
-		return StepResult{
-			Outcome:      OutcomePending,
-			Message:      waitPodInitialMessage(op),
-			RequeueAfter: requeueWaitPod,
-		}, nil
+		return false, notFoundMessage
 	}
 
 	if isPodCrashLooping(pod) {
 		logger.Warn("pod is crash looping, will retry", slog.String("pod", podName))
-		return StepResult{
-			Outcome:      OutcomePending,
-			Message:      fmt.Sprintf("pod %s is in CrashLoopBackOff, will retry", podName),
-			RequeueAfter: requeueWaitPod,
-		}, nil
+		return false, fmt.Sprintf("pod %s is in CrashLoopBackOff, will retry", podName)
 	}
 
-	expected := checksumAnnotationsFromSpec(op.Spec)
 	if !isPodReadyWithChecksums(pod, expected) {
 		logger.Info("pod not ready with expected checksums, requeue", slog.String("pod", podName))
-		return StepResult{
-			Outcome:      OutcomePending,
-			Message:      fmt.Sprintf("pod %s is not ready with expected checksums, will retry", podName),
-			RequeueAfter: requeueWaitPod,
-		}, nil
+		return false, fmt.Sprintf("pod %s is not ready with expected checksums, will retry", podName)
 	}
 
 	logger.Info("pod ready with matching checksums", slog.String("pod", podName))
-	return StepResult{Outcome: OutcomeCompleted}, nil
+	return true, ""
 }
 
 // mapPodToOperations finds in-progress CPOs for the component matching this pod.
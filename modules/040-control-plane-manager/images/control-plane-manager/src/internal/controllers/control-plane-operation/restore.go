@@ -0,0 +1,169 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controlplaneoperation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/deckhouse/deckhouse/pkg/log"
+
+	controlplanev1alpha1 "control-plane-manager/api/v1alpha1"
+	"control-plane-manager/internal/constants"
+)
+
+// restoreStep puts back the component files saved by the Backup step of the operation named in spec.restoreFrom.
+// Intended for manually created operations; automatic rollbacks go through reconcileRollback.
+type restoreStep struct{}
+
+func (c *restoreStep) Execute(_ context.Context, env *StepEnv, logger *log.Logger) (StepResult, error) {
+	op := env.State.Raw()
+	source := op.Spec.RestoreFrom
+	if source == "" {
+		return StepResult{}, errors.New("spec.restoreFrom must be set for the Restore step")
+	}
+	if source == op.Name {
+		return StepResult{}, errors.New("spec.restoreFrom must name an earlier operation, not the restore operation itself")
+	}
+
+	replaced, err := manifestChecksums(op.Spec.Component)
+	if err != nil {
+		return StepResult{}, fmt.Errorf("read manifest checksums before restore: %w", err)
+	}
+
+	logger.Info("restoring component files from backup", slog.String("source", source))
+	results, err := restoreBackup(operationBackupDir(op.Spec.Component, source), constants.KubernetesConfigPath)
+	if err != nil {
+		logger.Error("failed to restore backup", log.Err(err))
+		return StepResult{}, fmt.Errorf("restore backup of %s: %w", source, err)
+	}
+
+	saveDiffResults(op.Spec.Component, op.Name, results, logger)
+
+	// The control-plane-node controller reports these checksums as applied to the node, so the desired
+	// configuration is not rolled out over the restored files until it changes.
+	restored, err := manifestChecksums(op.Spec.Component)
+	if err != nil {
+		return StepResult{}, fmt.Errorf("read manifest checksums after restore: %w", err)
+	}
+	env.State.SetRestore(&controlplanev1alpha1.RestoreStatus{Replaced: replaced, Restored: restored})
+
+	return StepResult{Outcome: OutcomeCompleted, Message: restoreMessage(source, results)}, nil
+}
+
+// operationBackupDir returns the directory the Backup step of operationName writes into.
+func operationBackupDir(component controlplanev1alpha1.OperationComponent, operationName string) string {
+	return filepath.Join(constants.BackupBasePath, string(component), operationName)
+}
+
+// backupExists reports whether the Backup step of operationName saved at least the backup directory.
+func backupExists(component controlplanev1alpha1.OperationComponent, operationName string) bool {
+	info, err := os.Stat(operationBackupDir(component, operationName))
+	return err == nil && info.IsDir()
+}
+
+// restoreBackup copies every file from backupDir to the same relative path under targetRoot.
+// Static pod manifests are written last, so the kubelet restarts the pod only after the certificates,
+// kubeconfigs and extra files it refers to are back in place.
+// Files created after the backup was taken are left untouched.
+func restoreBackup(backupDir, targetRoot string) ([]fileWriteResult, error) {
+	var files []string
+	err := filepath.WalkDir(backupDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(backupDir, path)
+		if err != nil {
+			return err
+		}
+		files = append(files, rel)
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, fmt.Errorf("backup %s not found", backupDir)
+		}
+		return nil, fmt.Errorf("read backup %s: %w", backupDir, err)
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("backup %s is empty", backupDir)
+	}
+
+	sort.SliceStable(files, func(i, j int) bool {
+		return !isManifestBackupPath(files[i]) && isManifestBackupPath(files[j])
+	})
+
+	results := make([]fileWriteResult, 0, len(files))
+	for _, rel := range files {
+		src := filepath.Join(backupDir, rel)
+		info, err := os.Stat(src)
+		if err != nil {
+			return results, fmt.Errorf("stat %s: %w", src, err)
+		}
+		data, err := os.ReadFile(src)
+		if err != nil {
+			return results, fmt.Errorf("read %s: %w", src, err)
+		}
+		result, err := writeFileIfChanged(filepath.Join(targetRoot, rel), data, info.Mode().Perm())
+		if err != nil {
+			return results, fmt.Errorf("restore %s: %w", rel, err)
+		}
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// manifestChecksums returns the checksum annotations of the component static pod manifest on disk.
+// A missing manifest yields empty checksums.
+func manifestChecksums(component controlplanev1alpha1.OperationComponent) (controlplanev1alpha1.Checksums, error) {
+	annotations, _, err := readManifestAnnotations(component)
+	if err != nil {
+		return controlplanev1alpha1.Checksums{}, err
+	}
+	return controlplanev1alpha1.Checksums{
+		Config: annotations[constants.ConfigChecksumAnnotationKey],
+		PKI:    annotations[constants.PKIChecksumAnnotationKey],
+		CA:     annotations[constants.CAChecksumAnnotationKey],
+	}, nil
+}
+
+// isManifestBackupPath reports whether rel (relative to the backup root) is a static pod manifest.
+func isManifestBackupPath(rel string) bool {
+	manifestsDir := strings.TrimPrefix(constants.ManifestsPath, constants.KubernetesConfigPath+"/")
+	return strings.HasPrefix(rel, manifestsDir+string(filepath.Separator))
+}
+
+func restoreMessage(source string, results []fileWriteResult) string {
+	changed := 0
+	for i := range results {
+		if results[i].Changed {
+			changed++
+		}
+	}
+	return fmt.Sprintf("restored %d of %d files from backup of operation %s", changed, len(results), source)
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controlplaneoperation
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	controlplanev1alpha1 "control-plane-manager/api/v1alpha1"
+)
+
+func TestRestoreBackup(t *testing.T) {
+	t.Parallel()
+
+	writeFile := func(t *testing.T, path, content string, perm os.FileMode) {
+		t.Helper()
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o700))
+		require.NoError(t, os.WriteFile(path, []byte(content), perm))
+	}
+
+	t.Run("restores files and writes manifests last", func(t *testing.T) {
+		t.Parallel()
+		backupDir := t.TempDir()
+		targetRoot := t.TempDir()
+
+		writeFile(t, filepath.Join(backupDir, "manifests", "kube-scheduler.yaml"), "old manifest", 0o600)
+		writeFile(t, filepath.Join(backupDir, "scheduler.conf"), "old kubeconfig", 0o600)
+		writeFile(t, filepath.Join(backupDir, "deckhouse", "extra-files", "scheduler-config.yaml"), "old extra", 0o644)
+
+		writeFile(t, filepath.Join(targetRoot, "manifests", "kube-scheduler.yaml"), "new manifest", 0o600)
+		writeFile(t, filepath.Join(targetRoot, "scheduler.conf"), "old kubeconfig", 0o600)
+
+		results, err := restoreBackup(backupDir, targetRoot)
+		require.NoError(t, err)
+		require.Len(t, results, 3)
+		require.Equal(t, filepath.Join(targetRoot, "manifests", "kube-scheduler.yaml"), results[len(results)-1].Path,
+			"the manifest must be restored after the files it refers to")
+
+		changed := map[string]bool{}
+		for _, r := range results {
+			changed[r.Path] = r.Changed
+		}
+		require.True(t, changed[filepath.Join(targetRoot, "manifests", "kube-scheduler.yaml")])
+		require.False(t, changed[filepath.Join(targetRoot, "scheduler.conf")], "unchanged file must not be rewritten")
+		require.True(t, changed[filepath.Join(targetRoot, "deckhouse", "extra-files", "scheduler-config.yaml")])
+
+		data, err := os.ReadFile(filepath.Join(targetRoot, "manifests", "kube-scheduler.yaml"))
+		require.NoError(t, err)
+		require.Equal(t, "old manifest", string(data))
+
+		info, err := os.Stat(filepath.Join(targetRoot, "deckhouse", "extra-files", "scheduler-config.yaml"))
+		require.NoError(t, err)
+		require.Equal(t, os.FileMode(0o644), info.Mode().Perm())
+		require.Equal(t, "restored 2 of 3 files from backup of operation op", restoreMessage("op", results))
+	})
+
+	t.Run("missing backup is an error", func(t *testing.T) {
+		t.Parallel()
+		_, err := restoreBackup(filepath.Join(t.TempDir(), "absent"), t.TempDir())
+		require.ErrorContains(t, err, "not found")
+	})
+
+	t.Run("empty backup is an error", func(t *testing.T) {
+		t.Parallel()
+		_, err := restoreBackup(t.TempDir(), t.TempDir())
+		require.ErrorContains(t, err, "is empty")
+	})
+}
+
+func TestRollbackDue(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	newOp := func(syncedAgo time.Duration, backupDone bool) *controlplanev1alpha1.ControlPlaneOperation {
+		backupStatus := metav1.ConditionFalse
+		backupReason := controlplanev1alpha1.CPOReasonStepUnknown
+		if backupDone {
+			backupStatus = metav1.ConditionTrue
+			backupReason = controlplanev1alpha1.CPOReasonStepCompleted
+		}
+		return &controlplanev1alpha1.ControlPlaneOperation{
+			Spec: controlplanev1alpha1.ControlPlaneOperationSpec{
+				Component: controlplanev1alpha1.OperationComponentKubeScheduler,
+				Steps: []controlplanev1alpha1.StepName{
+					controlplanev1alpha1.StepBackup,
+					controlplanev1alpha1.StepSyncManifests,
+					controlplanev1alpha1.StepWaitPodReady,
+				},
+			},
+			Status: controlplanev1alpha1.ControlPlaneOperationStatus{
+				Conditions: []metav1.Condition{
+					{Type: controlplanev1alpha1.CPOConditionBackupDone, Status: backupStatus, Reason: backupReason},
+					{
+						Type:               controlplanev1alpha1.CPOConditionManifestsSynced,
+						Status:             metav1.ConditionTrue,
+						Reason:             controlplanev1alpha1.CPOReasonStepCompleted,
+						LastTransitionTime: metav1.NewTime(now.Add(-syncedAgo)),
+					},
+				},
+			},
+		}
+	}
+
+	require.False(t, rollbackDue(newOp(time.Minute, true), now), "within timeout")
+	require.True(t, rollbackDue(newOp(waitPodReadyRollbackTimeout+time.Minute, true), now), "past timeout")
+	require.False(t, rollbackDue(newOp(waitPodReadyRollbackTimeout+time.Minute, false), now), "no backup to roll back to")
+
+	noSync := newOp(waitPodReadyRollbackTimeout+time.Minute, true)
+	noSync.Status.Conditions = noSync.Status.Conditions[:1]
+	require.False(t, rollbackDue(noSync, now), "nothing was applied")
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controlplaneoperation
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/deckhouse/deckhouse/pkg/log"
+
+	controlplanev1alpha1 "control-plane-manager/api/v1alpha1"
+	"control-plane-manager/internal/constants"
+)
+
+// rollbackDue reports whether op wrote a new manifest that has not converged within waitPodReadyRollbackTimeout.
+// The timeout is counted from the ManifestsSynced transition, so retries of WaitPodReady do not reset it.
+func rollbackDue(op *controlplanev1alpha1.ControlPlaneOperation, now time.Time) bool {
+	if !op.IsStepCompleted(controlplanev1alpha1.StepBackup) || !op.IsStepCompleted(controlplanev1alpha1.StepSyncManifests) {
+		return false
+	}
+	synced := op.GetCondition(controlplanev1alpha1.CPOConditionManifestsSynced)
+	return now.Sub(synced.LastTransitionTime.Time) > waitPodReadyRollbackTimeout
+}
+
+// reconcileRollback puts back the files saved by the operation's own Backup step and waits for
+// the restored pod to become ready. The approval slot is held until then, so a failed rollout
+// never frees another node (or another etcd member) to start the same change.
+// Restoring is idempotent: unchanged files are not rewritten, so it is safe to repeat on every requeue.
+func (r *Reconciler) reconcileRollback(ctx context.Context, state *controlplanev1alpha1.OperationState, logger *log.Logger) (reconcile.Result, error) {
+	op := state.Raw()
+	component := op.Spec.Component
+	rollbackLogger := logger.With(slog.String("rollback", op.Name))
+
+	results, err := restoreBackup(operationBackupDir(component, op.Name), constants.KubernetesConfigPath)
+	if err != nil {
+		rollbackLogger.Error("failed to restore backup", log.Err(err))
+		state.MarkRollbackInProgress(fmt.Sprintf("restore backup: %v", err))
+		if patchErr := r.patchStatus(ctx, state); patchErr != nil {
+			rollbackLogger.Warn("failed to flush rollback status", log.Err(patchErr))
+		}
+		return reconcile.Result{}, fmt.Errorf("roll back %s: %w", op.Name, err)
+	}
+	if hasChangedFiles(results) {
+		rollbackLogger.Info("component files restored from backup")
+		saveDiffResults(component, op.Name, results, rollbackLogger)
+	}
+
+	expected, err := manifestChecksumAnnotations(component)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("read restored manifest checksums: %w", err)
+	}
+
+	ready, message := r.checkPodReady(ctx, component, expected,
+		fmt.Sprintf("waiting for restored %s pod", component.PodComponentName()), rollbackLogger)
+	if !ready {
+		state.MarkRollbackInProgress(message)
+		if patchErr := r.patchStatus(ctx, state); patchErr != nil {
+			rollbackLogger.Warn("failed to flush rollback status on requeue", log.Err(patchErr))
+		}
+		return reconcile.Result{RequeueAfter: requeueWaitPod}, nil
+	}
+
+	rollbackLogger.Warn("operation rolled back, restored pod is ready")
+	state.MarkOperationRolledBack(fmt.Sprintf("rolled back to backup taken by %s: pod did not become ready with the new configuration", op.Name))
+	return reconcile.Result{}, r.patchStatus(ctx, state)
+}
@@ -63,3 +63,39 @@ func rotateDirectories(dir string, keep int) error {
 
 	return nil
 }
+
+// rotateFiles keeps the keep most recent regular files in dir whose names match, by mtime.
+func rotateFiles(dir string, match func(name string) bool, keep int) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return fmt.Errorf("read dir: %w", err)
+	}
+
+	files := make([]timedDirEntry, 0, len(entries))
+	for _, e := range entries {
+		if e.IsDir() || !match(e.Name()) {
+			continue
+		}
+		info, err := e.Info()
+		if err != nil {
+			return fmt.Errorf("stat file %s: %w", e.Name(), err)
+		}
+		files = append(files, timedDirEntry{name: e.Name(), mtime: info.ModTime()})
+	}
+
+	if len(files) <= keep {
+		return nil
+	}
+
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].mtime.After(files[j].mtime)
+	})
+
+	for _, f := range files[keep:] {
+		if err := os.Remove(filepath.Join(dir, f.name)); err != nil {
+			return fmt.Errorf("remove old file %s: %w", f.name, err)
+		}
+	}
+
+	return nil
+}
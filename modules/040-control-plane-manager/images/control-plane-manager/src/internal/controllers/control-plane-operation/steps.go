@@ -47,6 +47,12 @@ var (
 	_ Step = (*waitPodReadyStep)(nil)
 	_ Step = (*certObserveStep)(nil)
 	_ Step = (*renewSignatureStep)(nil)
+	_ Step = (*restoreStep)(nil)
+	_ Step = (*snapshotEtcdStep)(nil)
+	_ Step = (*stopEtcdStep)(nil)
+	_ Step = (*restoreEtcdSnapshotStep)(nil)
+	_ Step = (*startKubeAPIServerStep)(nil)
+	_ Step = (*encryptionKeyRotationStep)(nil)
 )
 
 // StepOutcome is the terminal state when Step.Execute finishes.
@@ -59,6 +65,8 @@ const (
 	OutcomePending
 	// OutcomeAbandoned: the step decided the operation can't continue; pipeline marks it terminal and stops.
 	OutcomeAbandoned
+	// OutcomeRollback: the change applied by the operation did not converge; pipeline puts the operation backup back and stops.
+	OutcomeRollback
 )
 
 type StepResult struct {
@@ -76,16 +84,25 @@ type Step interface {
 // Reconciler-level deps (podWaiter) must be injected after construction.
 func defaultSteps() map[controlplanev1alpha1.StepName]Step {
 	return map[controlplanev1alpha1.StepName]Step{
-		controlplanev1alpha1.StepBackup:           &backupStep{},
-		controlplanev1alpha1.StepSyncCA:           &syncCAStep{},
-		controlplanev1alpha1.StepRenewPKICerts:    &renewPKICertsStep{},
-		controlplanev1alpha1.StepRenewKubeconfigs: &renewKubeconfigsStep{},
-		controlplanev1alpha1.StepSyncManifests:    &syncManifestsStep{},
-		controlplanev1alpha1.StepJoinEtcdCluster:  &joinEtcdClusterStep{},
-		controlplanev1alpha1.StepDefragEtcd:       &defragEtcdStep{},
-		controlplanev1alpha1.StepWaitPodReady:     &waitPodReadyStep{},
-		controlplanev1alpha1.StepCertObserve:      &certObserveStep{},
-		controlplanev1alpha1.StepRenewSignature:   &renewSignatureStep{},
+		controlplanev1alpha1.StepBackup:               &backupStep{},
+		controlplanev1alpha1.StepSyncCA:               &syncCAStep{},
+		controlplanev1alpha1.StepRenewPKICerts:        &renewPKICertsStep{},
+		controlplanev1alpha1.StepRenewKubeconfigs:     &renewKubeconfigsStep{},
+		controlplanev1alpha1.StepSyncManifests:        &syncManifestsStep{},
+		controlplanev1alpha1.StepJoinEtcdCluster:      &joinEtcdClusterStep{},
+		controlplanev1alpha1.StepDefragEtcd:           &defragEtcdStep{},
+		controlplanev1alpha1.StepWaitPodReady:         &waitPodReadyStep{},
+		controlplanev1alpha1.StepCertObserve:          &certObserveStep{},
+		controlplanev1alpha1.StepRenewSignature:       &renewSignatureStep{},
+		controlplanev1alpha1.StepRestore:              &restoreStep{},
+		controlplanev1alpha1.StepSnapshotEtcd:         &snapshotEtcdStep{},
+		controlplanev1alpha1.StepStopEtcd:             &stopEtcdStep{},
+		controlplanev1alpha1.StepRestoreEtcdSnapshot:  &restoreEtcdSnapshotStep{},
+		controlplanev1alpha1.StepStartKubeAPIServer:   &startKubeAPIServerStep{},
+		controlplanev1alpha1.StepAddEncryptionKey:     &encryptionKeyRotationStep{},
+		controlplanev1alpha1.StepPromoteEncryptionKey: &encryptionKeyRotationStep{},
+		controlplanev1alpha1.StepReencryptResources:   &encryptionKeyRotationStep{},
+		controlplanev1alpha1.StepRemoveEncryptionKey:  &encryptionKeyRotationStep{},
 	}
 }
 
@@ -192,7 +209,7 @@ func (c *joinEtcdClusterStep) Execute(_ context.Context, env *StepEnv, logger *l
 		// Member registered and local etcd bootstrapped: etcd ignores --initial-cluster on restart, so the self-only manifest from syncFullManifest is safe.
 		// Promote our own member only if it is still a learner (a previous join added it but never promoted it).
 		logger.Info("etcd already in cluster, syncing manifest and ensuring promotion")
-		annotations := buildSyncManifestAnnotations(op)
+		annotations := restoredEtcdAnnotations(op, buildSyncManifestAnnotations(op))
 		results, err := syncFullManifest(op.Spec.Component, env.Secrets.CPMData, annotations, env.Node)
 		if err != nil {
 			logger.Error("failed to sync manifests for joined etcd member", log.Err(err))
@@ -222,7 +239,7 @@ func (c *joinEtcdClusterStep) Execute(_ context.Context, env *StepEnv, logger *l
 		fallthrough
 	case etcdNeedsJoin:
 		logger.Info("etcd needs join, executing idempotent join flow")
-		if err := reconcileEtcdJoin(env.Node, op.Spec.Component, env.Secrets.CPMData, checksumAnnotationsFromSpec(op.Spec), logger); err != nil {
+		if err := reconcileEtcdJoin(env.Node, op.Spec.Component, env.Secrets.CPMData, restoredEtcdAnnotations(op, checksumAnnotationsFromSpec(op.Spec)), logger); err != nil {
 			return StepResult{}, err
 		}
 		return StepResult{Outcome: OutcomeCompleted, Message: joinMessage}, nil
@@ -299,6 +316,43 @@ func (c *defragEtcdStep) Execute(ctx context.Context, env *StepEnv, logger *log.
 	return c.defragEtcd(ctx, env.State, logger)
 }
 
+// snapshotEtcdStep saves a verified snapshot of the local etcd member and uploads it to S3 if configured.
+type snapshotEtcdStep struct {
+	snapshotEtcd func(ctx context.Context, state *controlplanev1alpha1.OperationState, logger *log.Logger) (StepResult, error)
+}
+
+func (c *snapshotEtcdStep) Execute(ctx context.Context, env *StepEnv, logger *log.Logger) (StepResult, error) {
+	return c.snapshotEtcd(ctx, env.State, logger)
+}
+
+// restoreEtcdSnapshotStep restores etcd from a snapshot as a new cluster and makes the other members re-join it.
+type restoreEtcdSnapshotStep struct {
+	restoreEtcdSnapshot func(ctx context.Context, state *controlplanev1alpha1.OperationState, logger *log.Logger) (StepResult, error)
+}
+
+func (c *restoreEtcdSnapshotStep) Execute(ctx context.Context, env *StepEnv, logger *log.Logger) (StepResult, error) {
+	return c.restoreEtcdSnapshot(ctx, env.State, logger)
+}
+
+// startKubeAPIServerStep starts kube-apiserver stopped for an etcd restore and waits for it to become ready.
+type startKubeAPIServerStep struct {
+	startKubeAPIServer func(ctx context.Context, state *controlplanev1alpha1.OperationState, logger *log.Logger) (StepResult, error)
+}
+
+func (c *startKubeAPIServerStep) Execute(ctx context.Context, env *StepEnv, logger *log.Logger) (StepResult, error) {
+	return c.startKubeAPIServer(ctx, env.State, logger)
+}
+
+// encryptionKeyRotationStep runs one stage of a secret encryption key rotation.
+// The four rotation steps differ only in the injected function.
+type encryptionKeyRotationStep struct {
+	rotate func(ctx context.Context, env *StepEnv, logger *log.Logger) (StepResult, error)
+}
+
+func (c *encryptionKeyRotationStep) Execute(ctx context.Context, env *StepEnv, logger *log.Logger) (StepResult, error) {
+	return c.rotate(ctx, env, logger)
+}
+
 // waitPodReadyStep waits for the static pod to become ready with the expected checksum annotations.
 type waitPodReadyStep struct {
 	waitForPod func(ctx context.Context, state *controlplanev1alpha1.OperationState, logger *log.Logger) (StepResult, error)
@@ -72,6 +72,9 @@ func pipelineStageIndex(c controlplanev1alpha1.OperationComponent) int {
 type approver struct {
 	approveChain *approveLink
 	approveQueue []controlplanev1alpha1.ControlPlaneOperation
+	// rotationInFlight is set while an encryption key rotation runs. Rotations bypass the approve chain:
+	// they span every kube-apiserver for a long time and wait for the rollouts the chain approves.
+	rotationInFlight bool
 }
 
 type approveLink struct {
@@ -95,12 +98,20 @@ func newApprover(nodes nodeCounts, operations []controlplanev1alpha1.ControlPlan
 	sortOperationsByPipelineOrder(approvedOperations)
 	sortOperationsByPipelineOrder(unapprovedOperations)
 
+	rotationInFlight := slices.ContainsFunc(approvedOperations, func(op controlplanev1alpha1.ControlPlaneOperation) bool {
+		return op.IsEncryptionKeyRotation()
+	})
+	approvedOperations = slices.DeleteFunc(approvedOperations, func(op controlplanev1alpha1.ControlPlaneOperation) bool {
+		return op.IsEncryptionKeyRotation()
+	})
+
 	approveChain := buildApproveChain(nodes)
 	approveChain.seedApprovedOperations(approvedOperations)
 
 	return &approver{
-		approveChain: approveChain,
-		approveQueue: unapprovedOperations,
+		approveChain:     approveChain,
+		approveQueue:     unapprovedOperations,
+		rotationInFlight: rotationInFlight,
 	}
 }
 
@@ -162,6 +173,14 @@ func buildApproveChain(nodes nodeCounts) *approveLink {
 }
 
 func (a *approver) tryApprove(operation controlplanev1alpha1.ControlPlaneOperation) bool {
+	if operation.IsEncryptionKeyRotation() {
+		if a.rotationInFlight {
+			return false
+		}
+		a.rotationInFlight = true
+		return true
+	}
+
 	return a.approveChain.tryReserveApproval(operation)
 }
 
@@ -160,6 +160,35 @@ func TestApprover_TryApprove_WorkloadConcurrencyAndPerNode(t *testing.T) {
 	})
 }
 
+func TestApprover_TryApprove_EncryptionKeyRotation(t *testing.T) {
+	t.Parallel()
+
+	t.Run("does not hold the apiserver slot", func(t *testing.T) {
+		t.Parallel()
+		rotation := newRotationOperation("rotate", "n1", true)
+		a := newApprover(nodeCounts{masters: 1}, []controlplanev1alpha1.ControlPlaneOperation{rotation})
+		require.Zero(t, a.approveChain.nextLink.components[controlplanev1alpha1.OperationComponentKubeAPIServer].approvedOperationsTotal)
+		require.True(t, a.tryApprove(newOperation("a1", "n1", controlplanev1alpha1.OperationComponentKubeAPIServer, false)))
+	})
+
+	t.Run("approves one rotation at a time", func(t *testing.T) {
+		t.Parallel()
+		a := newApprover(nodeCounts{masters: 3}, nil)
+		require.True(t, a.tryApprove(newRotationOperation("rotate-1", "n1", false)))
+		require.False(t, a.tryApprove(newRotationOperation("rotate-2", "n2", false)))
+
+		a = newApprover(nodeCounts{masters: 3}, []controlplanev1alpha1.ControlPlaneOperation{newRotationOperation("rotate-1", "n1", true)})
+		require.False(t, a.tryApprove(newRotationOperation("rotate-2", "n2", false)))
+	})
+
+	t.Run("is approved while etcd stage has reservation", func(t *testing.T) {
+		t.Parallel()
+		etcd := newOperation("e1", "n2", controlplanev1alpha1.OperationComponentEtcd, true)
+		a := newApprover(nodeCounts{masters: 3}, []controlplanev1alpha1.ControlPlaneOperation{etcd})
+		require.True(t, a.tryApprove(newRotationOperation("rotate", "n1", false)))
+	})
+}
+
 func TestNewApprover_PartitionAndOrder(t *testing.T) {
 	t.Parallel()
 
@@ -222,3 +251,14 @@ func newOperation(name, node string, component controlplanev1alpha1.OperationCom
 		},
 	}
 }
+
+func newRotationOperation(name, node string, approved bool) controlplanev1alpha1.ControlPlaneOperation {
+	op := newOperation(name, node, controlplanev1alpha1.OperationComponentKubeAPIServer, approved)
+	op.Spec.Steps = []controlplanev1alpha1.StepName{
+		controlplanev1alpha1.StepAddEncryptionKey,
+		controlplanev1alpha1.StepPromoteEncryptionKey,
+		controlplanev1alpha1.StepReencryptResources,
+		controlplanev1alpha1.StepRemoveEncryptionKey,
+	}
+	return op
+}
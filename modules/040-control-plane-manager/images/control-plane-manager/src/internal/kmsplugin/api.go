@@ -0,0 +1,38 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package kmsplugin speaks the KMS v2 plugin API of kube-apiserver through its generated client
+// k8s.io/kms/apis/v2: control-plane-manager checks the health of a plugin, and the reference plugin serves it.
+package kmsplugin
+
+import (
+	"google.golang.org/grpc"
+	kmsapi "k8s.io/kms/apis/v2"
+)
+
+const (
+	// APIVersion is the version a KMS v2 plugin reports in StatusResponse.
+	APIVersion = "v2"
+	// Healthy is the healthz value of a healthy plugin.
+	Healthy = "ok"
+)
+
+// NewServer returns a gRPC server serving kms.
+func NewServer(kms kmsapi.KeyManagementServiceServer) *grpc.Server {
+	server := grpc.NewServer()
+	kmsapi.RegisterKeyManagementServiceServer(server, kms)
+	return server
+}
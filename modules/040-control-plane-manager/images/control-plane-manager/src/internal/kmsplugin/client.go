@@ -0,0 +1,89 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kmsplugin
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	kmsapi "k8s.io/kms/apis/v2"
+)
+
+const unixScheme = "unix://"
+
+// SocketPath returns the path of the unix socket of endpoint, e.g. unix:///var/run/kmsplugin/kms.sock.
+func SocketPath(endpoint string) (string, error) {
+	path, ok := strings.CutPrefix(endpoint, unixScheme)
+	if !ok || path == "" {
+		return "", fmt.Errorf("KMS plugin endpoint %q is not a unix socket (unix:///path)", endpoint)
+	}
+	return path, nil
+}
+
+// Client calls a KMS v2 plugin the way kube-apiserver does.
+type Client struct {
+	kmsapi.KeyManagementServiceClient
+	conn *grpc.ClientConn
+}
+
+func NewClient(endpoint string) (*Client, error) {
+	path, err := SocketPath(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := grpc.NewClient("passthrough:///"+path,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithContextDialer(func(ctx context.Context, addr string) (net.Conn, error) {
+			return (&net.Dialer{}).DialContext(ctx, "unix", addr)
+		}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("create KMS plugin client: %w", err)
+	}
+	return &Client{KeyManagementServiceClient: kmsapi.NewKeyManagementServiceClient(conn), conn: conn}, nil
+}
+
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// CheckHealth calls Status and validates the response like kube-apiserver does before it uses the plugin.
+func CheckHealth(ctx context.Context, endpoint string) (*kmsapi.StatusResponse, error) {
+	client, err := NewClient(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+
+	status, err := client.Status(ctx, &kmsapi.StatusRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("call Status of KMS plugin %s: %w", endpoint, err)
+	}
+	switch {
+	case status.Version != APIVersion:
+		return status, fmt.Errorf("KMS plugin %s reports API version %q, expected %q", endpoint, status.Version, APIVersion)
+	case status.Healthz != Healthy:
+		return status, fmt.Errorf("KMS plugin %s is unhealthy: %s", endpoint, status.Healthz)
+	case status.KeyId == "":
+		return status, fmt.Errorf("KMS plugin %s reports an empty key ID", endpoint)
+	}
+	return status, nil
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kmsplugin
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	kmsapi "k8s.io/kms/apis/v2"
+)
+
+// serve starts plugin on a socket in a temporary directory and returns its endpoint.
+func serve(t *testing.T, plugin kmsapi.KeyManagementServiceServer) string {
+	t.Helper()
+	// A unix socket path is limited to about 100 bytes, t.TempDir() may be longer.
+	dir, err := os.MkdirTemp("", "kms")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	endpoint := "unix://" + filepath.Join(dir, "kms.sock")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- Serve(ctx, endpoint, plugin) }()
+	t.Cleanup(func() {
+		cancel()
+		require.NoError(t, <-done)
+	})
+
+	require.Eventually(t, func() bool {
+		_, err := os.Stat(filepath.Join(dir, "kms.sock"))
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+	return endpoint
+}
+
+func TestReferencePlugin(t *testing.T) {
+	t.Parallel()
+	oldKey := bytes.Repeat([]byte{1}, KeySize)
+	newKey := bytes.Repeat([]byte{2}, KeySize)
+	ctx := context.Background()
+
+	oldPlugin, err := NewReferencePlugin(oldKey)
+	require.NoError(t, err)
+	status, err := CheckHealth(ctx, serve(t, oldPlugin))
+	require.NoError(t, err)
+	oldKeyID := status.KeyId
+
+	endpoint := serve(t, mustReferencePlugin(t, newKey, oldKey))
+	client, err := NewClient(endpoint)
+	require.NoError(t, err)
+	defer client.Close()
+
+	encrypted, err := client.Encrypt(ctx, &kmsapi.EncryptRequest{Plaintext: []byte("data encryption key"), Uid: "1"})
+	require.NoError(t, err)
+	require.NotEqual(t, oldKeyID, encrypted.KeyId)
+	decrypted, err := client.Decrypt(ctx, &kmsapi.DecryptRequest{Ciphertext: encrypted.Ciphertext, Uid: "2", KeyId: encrypted.KeyId})
+	require.NoError(t, err)
+	require.Equal(t, "data encryption key", string(decrypted.Plaintext))
+
+	// Data encrypted before the key was replaced stays readable.
+	old, err := oldPlugin.Encrypt(ctx, &kmsapi.EncryptRequest{Plaintext: []byte("old")})
+	require.NoError(t, err)
+	decrypted, err = client.Decrypt(ctx, &kmsapi.DecryptRequest{Ciphertext: old.Ciphertext, KeyId: oldKeyID})
+	require.NoError(t, err)
+	require.Equal(t, "old", string(decrypted.Plaintext))
+
+	_, err = client.Decrypt(ctx, &kmsapi.DecryptRequest{Ciphertext: old.Ciphertext, KeyId: "unknown"})
+	require.ErrorContains(t, err, "unknown key ID")
+}
+
+func mustReferencePlugin(t *testing.T, keys ...[]byte) *ReferencePlugin {
+	t.Helper()
+	plugin, err := NewReferencePlugin(keys...)
+	require.NoError(t, err)
+	return plugin
+}
+
+type unhealthyPlugin struct {
+	*ReferencePlugin
+}
+
+func (unhealthyPlugin) Status(context.Context, *kmsapi.StatusRequest) (*kmsapi.StatusResponse, error) {
+	return &kmsapi.StatusResponse{Version: APIVersion, Healthz: "hsm unreachable", KeyId: "1"}, nil
+}
+
+func TestCheckHealth(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	_, err := CheckHealth(ctx, serve(t, unhealthyPlugin{mustReferencePlugin(t, make([]byte, KeySize))}))
+	require.ErrorContains(t, err, "is unhealthy: hsm unreachable")
+
+	_, err = CheckHealth(ctx, "tcp://127.0.0.1:1")
+	require.ErrorContains(t, err, "is not a unix socket")
+
+	shortCtx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	_, err = CheckHealth(shortCtx, "unix:///nonexistent/kms.sock")
+	require.Error(t, err)
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kmsplugin
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+
+	kmsapi "k8s.io/kms/apis/v2"
+)
+
+// KeySize is the size of a key encryption key of the reference plugin.
+const KeySize = 32
+
+// ReferencePlugin is a KMS v2 plugin that keeps its key encryption keys in local files. It is meant for
+// tests and for trying KMS encryption out: the keys are as exposed as the static key it replaces.
+type ReferencePlugin struct {
+	kmsapi.UnimplementedKeyManagementServiceServer
+
+	// aeads holds a cipher per key ID; keyID is the one new data encryption keys are sealed with.
+	aeads map[string]cipher.AEAD
+	keyID string
+}
+
+// NewReferencePlugin returns a plugin encrypting with the first key. The other keys only decrypt,
+// so a key can be replaced without losing what was encrypted with the previous one.
+func NewReferencePlugin(keys ...[]byte) (*ReferencePlugin, error) {
+	if len(keys) == 0 {
+		return nil, errors.New("no key encryption key")
+	}
+	p := &ReferencePlugin{aeads: map[string]cipher.AEAD{}}
+	for i, key := range keys {
+		if len(key) != KeySize {
+			return nil, fmt.Errorf("key %d is %d bytes long, expected %d", i, len(key), KeySize)
+		}
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			return nil, err
+		}
+		aead, err := cipher.NewGCM(block)
+		if err != nil {
+			return nil, err
+		}
+		id := referenceKeyID(key)
+		p.aeads[id] = aead
+		if i == 0 {
+			p.keyID = id
+		}
+	}
+	return p, nil
+}
+
+// referenceKeyID identifies a key without revealing it. kube-apiserver compares the key ID of Status
+// with the one of its cached data encryption key and generates a new one when they differ.
+func referenceKeyID(key []byte) string {
+	sum := sha256.Sum256(key)
+	return hex.EncodeToString(sum[:8])
+}
+
+func (p *ReferencePlugin) Status(context.Context, *kmsapi.StatusRequest) (*kmsapi.StatusResponse, error) {
+	return &kmsapi.StatusResponse{Version: APIVersion, Healthz: Healthy, KeyId: p.keyID}, nil
+}
+
+func (p *ReferencePlugin) Encrypt(_ context.Context, req *kmsapi.EncryptRequest) (*kmsapi.EncryptResponse, error) {
+	aead := p.aeads[p.keyID]
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("generate nonce: %w", err)
+	}
+	return &kmsapi.EncryptResponse{
+		Ciphertext: aead.Seal(nonce, nonce, req.Plaintext, nil),
+		KeyId:      p.keyID,
+	}, nil
+}
+
+func (p *ReferencePlugin) Decrypt(_ context.Context, req *kmsapi.DecryptRequest) (*kmsapi.DecryptResponse, error) {
+	aead, ok := p.aeads[req.KeyId]
+	if !ok {
+		return nil, fmt.Errorf("unknown key ID %q", req.KeyId)
+	}
+	if len(req.Ciphertext) < aead.NonceSize() {
+		return nil, errors.New("ciphertext is too short")
+	}
+	nonce, sealed := req.Ciphertext[:aead.NonceSize()], req.Ciphertext[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, sealed, nil)
+	if err != nil {
+		return nil, fmt.Errorf("decrypt with key %s: %w", req.KeyId, err)
+	}
+	return &kmsapi.DecryptResponse{Plaintext: plaintext}, nil
+}
+
+// Serve serves kms on the unix socket of endpoint until ctx is done. A socket left by a previous run is replaced.
+func Serve(ctx context.Context, endpoint string, kms kmsapi.KeyManagementServiceServer) error {
+	path, err := SocketPath(endpoint)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("remove stale socket: %w", err)
+	}
+	listener, err := net.Listen("unix", path)
+	if err != nil {
+		return fmt.Errorf("listen on %s: %w", path, err)
+	}
+	if err := os.Chmod(path, 0o600); err != nil {
+		listener.Close()
+		return fmt.Errorf("restrict socket permissions: %w", err)
+	}
+
+	server := NewServer(kms)
+	go func() {
+		<-ctx.Done()
+		server.GracefulStop()
+	}()
+	return server.Serve(listener)
+}
@@ -511,6 +511,43 @@ apiserver:
 		})
 	})
 
+	Context("etcd snapshots", func() {
+		Context("disabled", func() {
+			BeforeEach(func() {
+				f.HelmRender()
+			})
+
+			It("does not render the settings secret", func() {
+				Expect(f.RenderError).ShouldNot(HaveOccurred())
+				Expect(f.KubernetesResource("Secret", "kube-system", "d8-control-plane-manager-etcd-snapshots").Exists()).To(BeFalse())
+			})
+		})
+
+		Context("enabled with S3", func() {
+			BeforeEach(func() {
+				f.ValuesSetFromYaml("controlPlaneManager.internal.etcdSnapshots", `{"enabled": true, "cronSchedule": "0 */6 * * *"}`)
+				f.ValuesSetFromYaml("controlPlaneManager.etcd.snapshots", `{"retention": 3, "s3": {"endpoint": "https://s3.example.com", "bucket": "backups", "prefix": "main", "credentialsSecretName": "etcd-s3"}}`)
+				f.HelmRender()
+			})
+
+			It("renders the settings secret and mounts the snapshots directory", func() {
+				Expect(f.RenderError).ShouldNot(HaveOccurred())
+
+				s := f.KubernetesResource("Secret", "kube-system", "d8-control-plane-manager-etcd-snapshots")
+				Expect(s.Exists()).To(BeTrue())
+				Expect(s.Field("data.retention").String()).To(Equal(base64.StdEncoding.EncodeToString([]byte("3"))))
+				Expect(s.Field("data.s3-endpoint").String()).To(Equal(base64.StdEncoding.EncodeToString([]byte("https://s3.example.com"))))
+				Expect(s.Field("data.s3-region").String()).To(Equal(base64.StdEncoding.EncodeToString([]byte("us-east-1"))))
+				Expect(s.Field("data.s3-prefix").String()).To(Equal(base64.StdEncoding.EncodeToString([]byte("main"))))
+				Expect(s.Field("data.s3-credentials-secret-name").String()).To(Equal(base64.StdEncoding.EncodeToString([]byte("etcd-s3"))))
+				Expect(s.Field("data.s3-ca\\.crt").Exists()).To(BeFalse())
+
+				ds := f.KubernetesResource("DaemonSet", "kube-system", "d8-control-plane-manager")
+				Expect(ds.Field(`spec.template.spec.volumes.#(name=="etcd-snapshots").hostPath.path`).String()).To(Equal("/var/lib/etcd-snapshots"))
+			})
+		})
+	})
+
 	Context("apiserver tests", func() {
 		Context("DS sing enabled annotation", func() {
 			assertDsSignAnnotation := func(ff *Config, enabled bool) {
@@ -620,6 +657,110 @@ resources:
 				})
 			})
 
+			Context("With secretEncryptionKeys during key rotation", func() {
+				BeforeEach(func() {
+					f.ValuesSetFromYaml("controlPlaneManager.internal.secretEncryptionKey", `ABCDEFGHIJABCDEFGHIJABCDEFGHIJABCDEFGHIJABCD`)
+					f.ValuesSetFromYaml("controlPlaneManager.internal.secretEncryptionKeys", `
+- name: key-20261018120000
+  secret: KLMNOPQRSTKLMNOPQRSTKLMNOPQRSTKLMNOPQRSTKLMN
+- name: secretbox
+  secret: ABCDEFGHIJABCDEFGHIJABCDEFGHIJABCDEFGHIJABCD
+`)
+					f.HelmRender()
+				})
+
+				It("should render the keys in order", func() {
+					assertEncryptionConf(f, `
+apiVersion: apiserver.config.k8s.io/v1
+kind: EncryptionConfiguration
+resources:
+  - resources:
+    - secrets
+    providers:
+    - aescbc:
+        keys:
+        - name: key-20261018120000
+          secret: KLMNOPQRSTKLMNOPQRSTKLMNOPQRSTKLMNOPQRSTKLMN
+        - name: secretbox
+          secret: ABCDEFGHIJABCDEFGHIJABCDEFGHIJABCDEFGHIJABCD
+    - identity: {}
+`)
+				})
+			})
+
+			Context("With secretEncryptionKeys during a migration to KMS", func() {
+				BeforeEach(func() {
+					f.ValuesSetFromYaml("controlPlaneManager.apiserver.kms", `{"endpoint": "unix:///var/run/kmsplugin/kms.sock"}`)
+					f.ValuesSetFromYaml("controlPlaneManager.internal.secretEncryptionKey", `ABCDEFGHIJABCDEFGHIJABCDEFGHIJABCDEFGHIJABCD`)
+					f.ValuesSetFromYaml("controlPlaneManager.internal.secretEncryptionKeys", `
+- name: d8-kms
+  endpoint: unix:///var/run/kmsplugin/kms.sock
+- name: secretbox
+  secret: ABCDEFGHIJABCDEFGHIJABCDEFGHIJABCDEFGHIJABCD
+`)
+					f.HelmRender()
+				})
+
+				It("should render the KMS provider before the static key", func() {
+					assertEncryptionConf(f, `
+apiVersion: apiserver.config.k8s.io/v1
+kind: EncryptionConfiguration
+resources:
+  - resources:
+    - secrets
+    providers:
+    - kms:
+        apiVersion: v2
+        name: d8-kms
+        endpoint: unix:///var/run/kmsplugin/kms.sock
+        timeout: 3s
+    - aescbc:
+        keys:
+        - name: secretbox
+          secret: ABCDEFGHIJABCDEFGHIJABCDEFGHIJABCDEFGHIJABCD
+    - identity: {}
+`)
+				})
+
+				It("should pass the endpoint to control-plane-manager and mount the plugin socket into kube-apiserver", func() {
+					s := f.KubernetesResource("Secret", "kube-system", "d8-control-plane-manager-config")
+					endpoint, err := base64.StdEncoding.DecodeString(s.Field("data.kms-endpoint").String())
+					Expect(err).To(BeNil())
+					Expect(string(endpoint)).To(Equal("unix:///var/run/kmsplugin/kms.sock"))
+
+					manifest, err := base64.StdEncoding.DecodeString(s.Field("data.kube-apiserver\\.yaml\\.tpl").String())
+					Expect(err).To(BeNil())
+					Expect(string(manifest)).To(ContainSubstring("mountPath: /var/run/kmsplugin"))
+				})
+			})
+
+			Context("With the KMS provider only", func() {
+				BeforeEach(func() {
+					f.ValuesSetFromYaml("controlPlaneManager.internal.secretEncryptionKeys", `
+- name: d8-kms
+  endpoint: unix:///var/run/kmsplugin/kms.sock
+`)
+					f.HelmRender()
+				})
+
+				It("should render correctly without a static key", func() {
+					assertEncryptionConf(f, `
+apiVersion: apiserver.config.k8s.io/v1
+kind: EncryptionConfiguration
+resources:
+  - resources:
+    - secrets
+    providers:
+    - kms:
+        apiVersion: v2
+        name: d8-kms
+        endpoint: unix:///var/run/kmsplugin/kms.sock
+        timeout: 3s
+    - identity: {}
+`)
+				})
+			})
+
 			Context("With signature", func() {
 				BeforeEach(func() {
 					f.ValuesSetFromYaml("controlPlaneManager.apiserver.signature", "Enforce")
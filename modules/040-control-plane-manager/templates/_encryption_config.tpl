@@ -7,21 +7,44 @@ signature:
   pubKeyPath:  "/etc/kubernetes/pki/signature-public.jwks"
   mode: {{ .apiserver.signature | lower }}
 {{- end }}
-{{- if .apiserver.secretEncryptionKey }}
+{{- if or .apiserver.secretEncryptionKeys .apiserver.secretEncryptionKey }}
 resources:
   - resources:
     - secrets
     providers:
+  {{- if .apiserver.secretEncryptionKeys }}
+    {{- /* Consecutive static keys share one aescbc provider, the KMS provider is a provider of its own. */}}
+    {{- $inAESCBC := false }}
+    {{- range .apiserver.secretEncryptionKeys }}
+      {{- if .endpoint }}
+    - kms:
+        apiVersion: v2
+        name: {{ .name }}
+        endpoint: {{ .endpoint | quote }}
+        timeout: 3s
+        {{- $inAESCBC = false }}
+      {{- else }}
+        {{- if not $inAESCBC }}
+    - aescbc:
+        keys:
+        {{- end }}
+        - name: {{ .name }}
+          secret: {{ .secret | quote }}
+        {{- $inAESCBC = true }}
+      {{- end }}
+    {{- end }}
+  {{- else }}
     - aescbc:
         keys:
         - name: secretbox
           secret: {{ .apiserver.secretEncryptionKey | quote }}
+  {{- end }}
     - identity: {}
 {{- end }}
 {{- end }}
 
 {{- define "encryptionConfig" }}
-{{- if or (.apiserver.secretEncryptionKey) (.apiserver.signature) }}
+{{- if or (.apiserver.secretEncryptionKeys) (.apiserver.secretEncryptionKey) (.apiserver.signature) }}
 extra-file-secret-encryption-config.yaml: {{ include "encryptionConfigTemplate" . | b64enc }}
 {{- end }}
 {{- end }}
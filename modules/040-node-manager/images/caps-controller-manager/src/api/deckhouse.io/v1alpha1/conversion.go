@@ -43,3 +43,9 @@ func Convert_v1alpha1_SSHCredentialsSpec_To_v1alpha2_SSHCredentialsSpec(in *SSHC
 	out.SudoPasswordEncoded = encodedPass
 	return autoConvert_v1alpha1_SSHCredentialsSpec_To_v1alpha2_SSHCredentialsSpec(in, out, s)
 }
+
+//nolint:revive
+func Convert_v1alpha2_StaticInstanceSpec_To_v1alpha1_StaticInstanceSpec(in *v1alpha2.StaticInstanceSpec, out *StaticInstanceSpec, s conversion.Scope) error {
+	// BMC is not available in v1alpha1, StaticInstance.ConvertFrom keeps it in the conversion data annotation.
+	return autoConvert_v1alpha2_StaticInstanceSpec_To_v1alpha1_StaticInstanceSpec(in, out, s)
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	utilconversion "sigs.k8s.io/cluster-api/util/conversion"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	"caps-controller-manager/api/deckhouse.io/v1alpha2"
+)
+
+// ConvertTo converts StaticInstance to the Hub version (v1alpha2).
+// Fields which are not available in v1alpha1 are restored from the conversion data annotation.
+//
+//nolint:staticcheck
+func (src *StaticInstance) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1alpha2.StaticInstance)
+	if err := Convert_v1alpha1_StaticInstance_To_v1alpha2_StaticInstance(src, dst, nil); err != nil {
+		return err
+	}
+
+	restored := &v1alpha2.StaticInstance{}
+	if ok, err := utilconversion.UnmarshalData(src, restored); err != nil || !ok {
+		return err
+	}
+
+	dst.Spec.BMC = restored.Spec.BMC
+
+	return nil
+}
+
+// ConvertFrom converts StaticInstance from the Hub version (v1alpha2) to this version (v1alpha1).
+//
+//nolint:staticcheck
+func (dst *StaticInstance) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1alpha2.StaticInstance)
+	if err := Convert_v1alpha2_StaticInstance_To_v1alpha1_StaticInstance(src, dst, nil); err != nil {
+		return err
+	}
+
+	return utilconversion.MarshalData(src, dst)
+}
+
+// ConvertTo converts StaticInstanceList to the Hub version (v1alpha2).
+//
+//nolint:staticcheck
+func (src *StaticInstanceList) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1alpha2.StaticInstanceList)
+	return Convert_v1alpha1_StaticInstanceList_To_v1alpha2_StaticInstanceList(src, dst, nil)
+}
+
+// ConvertFrom converts StaticInstanceList from the Hub version (v1alpha2) to this version (v1alpha1).
+//
+//nolint:staticcheck
+func (dst *StaticInstanceList) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1alpha2.StaticInstanceList)
+	return Convert_v1alpha2_StaticInstanceList_To_v1alpha1_StaticInstanceList(src, dst, nil)
+}
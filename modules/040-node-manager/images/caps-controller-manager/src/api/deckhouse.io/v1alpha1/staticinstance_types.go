@@ -29,8 +29,8 @@ type StaticInstanceSpec struct {
 	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
 	// Important: Run "make" to regenerate code after modifying this file
 
-	// The IP address of the host.
-	// +kubebuilder:validation:Pattern=`^[0-9]{1,3}\.[0-9]{1,3}\.[0-9]{1,3}\.[0-9]{1,3}$`
+	// The IP address (IPv4 or IPv6) or DNS name of the host.
+	// +kubebuilder:validation:Pattern=`^(([0-9a-fA-F]{0,4}:){2,7}[0-9a-fA-F.]*|([a-zA-Z0-9]([-a-zA-Z0-9]*[a-zA-Z0-9])?\.)*[a-zA-Z0-9]([-a-zA-Z0-9]*[a-zA-Z0-9])?\.?)$`
 	Address string `json:"address"`
 
 	// The reference to the `SSHCredentials` object.
@@ -29,6 +29,8 @@ import (
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"caps-controller-manager/api/deckhouse.io/v1alpha2"
 )
 
 // log is for logging in this package.
@@ -77,6 +79,10 @@ func (v *StaticInstanceCustomValidator) ValidateCreate(ctx context.Context, obj
 
 	staticinstancelog.Info("validate create", "name", staticInstance.GetName())
 
+	if err := v1alpha2.ValidateAddress(staticInstance.Spec.Address); err != nil {
+		return nil, field.Invalid(field.NewPath("spec", "address"), staticInstance.Spec.Address, err.Error())
+	}
+
 	existing := &StaticInstance{}
 	err := v.Reader.Get(ctx, client.ObjectKey{Name: staticInstance.Name}, existing)
 	switch {
@@ -162,7 +168,7 @@ func (r *StaticInstance) validateAddressIfNoSkipBootstrap(ctx context.Context, c
 
 	for _, node := range nodes.Items {
 		for _, addr := range node.Status.Addresses {
-			if addr.Address == instanceAddr {
+			if v1alpha2.AddressesEqual(addr.Address, instanceAddr) {
 				return fmt.Errorf("Address %q already exists on node %q, if you need transfer the existing manually-bootstrapped cluster node under CAPS management, you should annotate this StaticInstance with static.node.deckhouse.io/skip-bootstrap-phase: \"\"", instanceAddr, node.Name)
 			}
 		}
@@ -123,11 +123,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha2.StaticInstanceSpec)(nil), (*StaticInstanceSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha2_StaticInstanceSpec_To_v1alpha1_StaticInstanceSpec(a.(*v1alpha2.StaticInstanceSpec), b.(*StaticInstanceSpec), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*StaticInstanceStatus)(nil), (*v1alpha2.StaticInstanceStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_StaticInstanceStatus_To_v1alpha2_StaticInstanceStatus(a.(*StaticInstanceStatus), b.(*v1alpha2.StaticInstanceStatus), scope)
 	}); err != nil {
@@ -158,6 +153,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1alpha2.StaticInstanceSpec)(nil), (*StaticInstanceSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_StaticInstanceSpec_To_v1alpha1_StaticInstanceSpec(a.(*v1alpha2.StaticInstanceSpec), b.(*StaticInstanceSpec), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -353,7 +353,17 @@ func Convert_v1alpha2_StaticInstanceCustomValidator_To_v1alpha1_StaticInstanceCu
 
 func autoConvert_v1alpha1_StaticInstanceList_To_v1alpha2_StaticInstanceList(in *StaticInstanceList, out *v1alpha2.StaticInstanceList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]v1alpha2.StaticInstance)(unsafe.Pointer(&in.Items))
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]v1alpha2.StaticInstance, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_StaticInstance_To_v1alpha2_StaticInstance(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
 	return nil
 }
 
@@ -364,7 +374,17 @@ func Convert_v1alpha1_StaticInstanceList_To_v1alpha2_StaticInstanceList(in *Stat
 
 func autoConvert_v1alpha2_StaticInstanceList_To_v1alpha1_StaticInstanceList(in *v1alpha2.StaticInstanceList, out *StaticInstanceList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]StaticInstance)(unsafe.Pointer(&in.Items))
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]StaticInstance, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha2_StaticInstance_To_v1alpha1_StaticInstance(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
 	return nil
 }
 
@@ -387,14 +407,10 @@ func Convert_v1alpha1_StaticInstanceSpec_To_v1alpha2_StaticInstanceSpec(in *Stat
 func autoConvert_v1alpha2_StaticInstanceSpec_To_v1alpha1_StaticInstanceSpec(in *v1alpha2.StaticInstanceSpec, out *StaticInstanceSpec, s conversion.Scope) error {
 	out.Address = in.Address
 	out.CredentialsRef = (*v1.ObjectReference)(unsafe.Pointer(in.CredentialsRef))
+	// WARNING: in.BMC requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1alpha2_StaticInstanceSpec_To_v1alpha1_StaticInstanceSpec is an autogenerated conversion function.
-func Convert_v1alpha2_StaticInstanceSpec_To_v1alpha1_StaticInstanceSpec(in *v1alpha2.StaticInstanceSpec, out *StaticInstanceSpec, s conversion.Scope) error {
-	return autoConvert_v1alpha2_StaticInstanceSpec_To_v1alpha1_StaticInstanceSpec(in, out, s)
-}
-
 func autoConvert_v1alpha1_StaticInstanceStatus_To_v1alpha2_StaticInstanceStatus(in *StaticInstanceStatus, out *v1alpha2.StaticInstanceStatus, s conversion.Scope) error {
 	out.MachineRef = (*v1.ObjectReference)(unsafe.Pointer(in.MachineRef))
 	out.NodeRef = (*v1.ObjectReference)(unsafe.Pointer(in.NodeRef))
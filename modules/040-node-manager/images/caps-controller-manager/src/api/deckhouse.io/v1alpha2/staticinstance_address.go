@@ -0,0 +1,84 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// ValidateAddress checks that address is an IPv4 or IPv6 literal or a DNS name.
+func ValidateAddress(address string) error {
+	if address == "" {
+		return fmt.Errorf("address must not be empty")
+	}
+
+	if strings.Contains(address, ":") {
+		ip, err := netip.ParseAddr(address)
+		if err != nil || !ip.Is6() || ip.Zone() != "" {
+			return fmt.Errorf("address %q is not a valid IPv6 address", address)
+		}
+
+		return nil
+	}
+
+	if looksLikeIPv4(address) {
+		if _, err := netip.ParseAddr(address); err != nil {
+			return fmt.Errorf("address %q is not a valid IPv4 address", address)
+		}
+
+		return nil
+	}
+
+	if errs := validation.IsDNS1123Subdomain(strings.ToLower(strings.TrimSuffix(address, "."))); len(errs) > 0 {
+		return fmt.Errorf("address %q is not a valid IP address or DNS name: %s", address, strings.Join(errs, ", "))
+	}
+
+	return nil
+}
+
+// NormalizeAddress returns the canonical form of address, so that different
+// spellings of the same IP address or DNS name compare equal.
+func NormalizeAddress(address string) string {
+	address = strings.TrimSpace(address)
+
+	if ip, err := netip.ParseAddr(address); err == nil {
+		return ip.Unmap().String()
+	}
+
+	return strings.ToLower(strings.TrimSuffix(address, "."))
+}
+
+// AddressesEqual reports whether a and b refer to the same IP address or DNS name.
+func AddressesEqual(a, b string) bool {
+	return NormalizeAddress(a) == NormalizeAddress(b)
+}
+
+// looksLikeIPv4 reports whether every label of address is numeric, which is never
+// a valid DNS name and must therefore be a (possibly malformed) IPv4 address.
+func looksLikeIPv4(address string) bool {
+	for _, label := range strings.Split(address, ".") {
+		if label == "" || strings.Trim(label, "0123456789") != "" {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import "testing"
+
+func TestValidateAddress(t *testing.T) {
+	tests := []struct {
+		address string
+		valid   bool
+	}{
+		{address: "192.168.1.10", valid: true},
+		{address: "2001:db8::10", valid: true},
+		{address: "2001:DB8:0:0:0:0:0:10", valid: true},
+		{address: "node-1.example.com", valid: true},
+		{address: "Node-1.Example.com.", valid: true},
+		{address: "worker", valid: true},
+		{address: "", valid: false},
+		{address: "192.168.1.256", valid: false},
+		{address: "10.0.0", valid: false},
+		{address: "2001:db8::g", valid: false},
+		{address: "fe80::1%eth0", valid: false},
+		{address: "::ffff:192.168.1.10", valid: true},
+		{address: "node_1.example.com", valid: false},
+		{address: "-node.example.com", valid: false},
+	}
+
+	for _, tt := range tests {
+		err := ValidateAddress(tt.address)
+		if tt.valid && err != nil {
+			t.Errorf("ValidateAddress(%q) returned unexpected error: %v", tt.address, err)
+		}
+		if !tt.valid && err == nil {
+			t.Errorf("ValidateAddress(%q) expected error, got nil", tt.address)
+		}
+	}
+}
+
+func TestAddressesEqual(t *testing.T) {
+	tests := []struct {
+		a, b  string
+		equal bool
+	}{
+		{a: "192.168.1.10", b: "192.168.1.10", equal: true},
+		{a: "192.168.1.10", b: "::ffff:192.168.1.10", equal: true},
+		{a: "2001:db8::10", b: "2001:DB8:0:0:0:0:0:10", equal: true},
+		{a: "node-1.example.com", b: "Node-1.Example.COM.", equal: true},
+		{a: "192.168.1.10", b: "192.168.1.11", equal: false},
+		{a: "node-1.example.com", b: "node-2.example.com", equal: false},
+	}
+
+	for _, tt := range tests {
+		if got := AddressesEqual(tt.a, tt.b); got != tt.equal {
+			t.Errorf("AddressesEqual(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.equal)
+		}
+	}
+}
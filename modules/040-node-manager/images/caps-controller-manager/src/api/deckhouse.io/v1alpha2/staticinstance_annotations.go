@@ -17,3 +17,6 @@ limitations under the License.
 package v1alpha2
 
 const SkipBootstrapPhaseAnnotation = "static.node.deckhouse.io/skip-bootstrap-phase"
+
+// LastPowerActionAnnotation holds the time of the last power on or power cycle of the host done through its BMC.
+const LastPowerActionAnnotation = "static.node.deckhouse.io/last-power-action"
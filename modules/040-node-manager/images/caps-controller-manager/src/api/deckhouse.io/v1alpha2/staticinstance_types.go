@@ -32,14 +32,51 @@ type StaticInstanceSpec struct {
 	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
 	// Important: Run "make" to regenerate code after modifying this file
 
-	// The IP address of the host.
-	//+kubebuilder:validation:Pattern=`^[0-9]{1,3}\.[0-9]{1,3}\.[0-9]{1,3}\.[0-9]{1,3}$`
+	// The IP address (IPv4 or IPv6) or DNS name of the host.
+	//+kubebuilder:validation:Pattern=`^(([0-9a-fA-F]{0,4}:){2,7}[0-9a-fA-F.]*|([a-zA-Z0-9]([-a-zA-Z0-9]*[a-zA-Z0-9])?\.)*[a-zA-Z0-9]([-a-zA-Z0-9]*[a-zA-Z0-9])?\.?)$`
 	Address string `json:"address"`
 
 	// The reference to the `SSHCredentials` object.
 	CredentialsRef *corev1.ObjectReference `json:"credentialsRef"`
+
+	// +optional
+	// The baseboard management controller of the host used for out-of-band power management.
+	// The host is powered on when it is picked for a StaticMachine and power-cycled when it stays
+	// unreachable during bootstrap or cleanup.
+	BMC *StaticInstanceBMC `json:"bmc,omitempty"`
+}
+
+// StaticInstanceBMC defines the baseboard management controller of the host.
+type StaticInstanceBMC struct {
+	// +optional
+	// +kubebuilder:validation:Enum=Redfish
+	// +kubebuilder:default=Redfish
+	// The protocol used to talk to the BMC.
+	Protocol BMCProtocol `json:"protocol,omitempty"`
+
+	// The URL of the BMC, for example `https://10.0.0.10`.
+	//+kubebuilder:validation:Pattern=`^https?://.+$`
+	Address string `json:"address"`
+
+	// +optional
+	// The ID of the Redfish ComputerSystem of the host. Can be omitted if the BMC manages a single system.
+	SystemID string `json:"systemID,omitempty"`
+
+	// The reference to the Secret with the `username` and `password` keys.
+	// The Secret must be in the `d8-cloud-instance-manager` namespace.
+	CredentialsSecretRef corev1.LocalObjectReference `json:"credentialsSecretRef"`
+
+	// +optional
+	// Skip verification of the BMC TLS certificate.
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
 }
 
+type BMCProtocol string
+
+const (
+	BMCProtocolRedfish BMCProtocol = "Redfish"
+)
+
 // StaticInstanceStatus defines the observed state of StaticInstance.
 type StaticInstanceStatus struct {
 	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
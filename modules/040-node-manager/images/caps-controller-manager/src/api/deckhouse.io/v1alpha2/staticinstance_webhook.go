@@ -76,6 +76,10 @@ func (r *StaticInstanceCustomValidator) ValidateCreate(_ context.Context, obj ru
 	}
 	staticinstancelog.Info("validate create", "name", staticInstance.Name)
 
+	if err := ValidateAddress(staticInstance.Spec.Address); err != nil {
+		return nil, field.Invalid(field.NewPath("spec", "address"), staticInstance.Spec.Address, err.Error())
+	}
+
 	ctx := context.Background()
 
 	existing := &StaticInstance{}
@@ -158,7 +162,7 @@ func (r *StaticInstance) validateAddressIfNoSkipBootstrap(ctx context.Context, c
 
 	for _, node := range nodes.Items {
 		for _, addr := range node.Status.Addresses {
-			if addr.Address == instanceAddr {
+			if AddressesEqual(addr.Address, instanceAddr) {
 				return fmt.Errorf("Address %q already exists on node %q, if you need transfer the existing manually-bootstrapped cluster node under CAPS management, you should annotate this StaticInstance with static.node.deckhouse.io/skip-bootstrap-phase: \"\"", instanceAddr, node.Name)
 			}
 		}
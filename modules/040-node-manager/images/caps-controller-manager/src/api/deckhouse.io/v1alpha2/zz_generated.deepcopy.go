@@ -156,6 +156,22 @@ func (in *StaticInstance) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StaticInstanceBMC) DeepCopyInto(out *StaticInstanceBMC) {
+	*out = *in
+	out.CredentialsSecretRef = in.CredentialsSecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StaticInstanceBMC.
+func (in *StaticInstanceBMC) DeepCopy() *StaticInstanceBMC {
+	if in == nil {
+		return nil
+	}
+	out := new(StaticInstanceBMC)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StaticInstanceCustomDefaulter) DeepCopyInto(out *StaticInstanceCustomDefaulter) {
 	*out = *in
@@ -226,6 +242,11 @@ func (in *StaticInstanceSpec) DeepCopyInto(out *StaticInstanceSpec) {
 		*out = new(v1.ObjectReference)
 		**out = **in
 	}
+	if in.BMC != nil {
+		in, out := &in.BMC, &out.BMC
+		*out = new(StaticInstanceBMC)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StaticInstanceSpec.
@@ -0,0 +1,78 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bmc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+
+	deckhousev1 "caps-controller-manager/api/deckhouse.io/v1alpha2"
+)
+
+// PowerState is the power state of a host as reported by its BMC.
+type PowerState string
+
+const (
+	PowerStateOn          PowerState = "On"
+	PowerStateOff         PowerState = "Off"
+	PowerStatePoweringOn  PowerState = "PoweringOn"
+	PowerStatePoweringOff PowerState = "PoweringOff"
+)
+
+// Credentials are used to authenticate to a BMC.
+type Credentials struct {
+	Username string
+	Password string
+}
+
+// PowerManager manages the power of a host through its BMC.
+type PowerManager interface {
+	// PowerState returns the current power state of the host.
+	PowerState(ctx context.Context) (PowerState, error)
+	// PowerOn powers the host on.
+	PowerOn(ctx context.Context) error
+	// PowerCycle restarts the host, or powers it on if it is powered off.
+	PowerCycle(ctx context.Context) error
+}
+
+// NewPowerManager returns a PowerManager for the BMC of a StaticInstance.
+func NewPowerManager(spec *deckhousev1.StaticInstanceBMC, credentials Credentials) (PowerManager, error) {
+	switch spec.Protocol {
+	case "", deckhousev1.BMCProtocolRedfish:
+		return NewRedfishClient(spec.Address, spec.SystemID, credentials, spec.InsecureSkipVerify), nil
+	default:
+		return nil, fmt.Errorf("unsupported BMC protocol %q", spec.Protocol)
+	}
+}
+
+// CredentialsFromSecret reads BMC credentials from the `username` and `password` keys of the Secret.
+func CredentialsFromSecret(secret *corev1.Secret) (Credentials, error) {
+	username, ok := secret.Data["username"]
+	if !ok || len(username) == 0 {
+		return Credentials{}, errors.New("secret has no 'username' key")
+	}
+
+	password, ok := secret.Data["password"]
+	if !ok {
+		return Credentials{}, errors.New("secret has no 'password' key")
+	}
+
+	return Credentials{Username: string(username), Password: string(password)}, nil
+}
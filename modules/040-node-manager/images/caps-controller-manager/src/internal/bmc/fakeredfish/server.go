@@ -0,0 +1,158 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Package fakeredfish provides a minimal in-process Redfish service for tests.
+package fakeredfish
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+)
+
+const (
+	// SystemID is the ID of the single ComputerSystem exposed by the Server.
+	SystemID = "1"
+
+	systemPath = "/redfish/v1/Systems/" + SystemID
+	resetPath  = systemPath + "/Actions/ComputerSystem.Reset"
+)
+
+// Server emulates a BMC with a single ComputerSystem. Reset requests change the
+// power state of the system immediately.
+type Server struct {
+	*httptest.Server
+
+	username string
+	password string
+
+	mu         sync.Mutex
+	powerState string
+	resets     []string
+}
+
+// NewServer starts a TLS Redfish service that accepts the given credentials.
+// The system is powered off initially.
+func NewServer(username, password string) *Server {
+	s := &Server{
+		username:   username,
+		password:   password,
+		powerState: "Off",
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /redfish/v1/Systems", s.handleSystems)
+	mux.HandleFunc("GET "+systemPath, s.handleSystem)
+	mux.HandleFunc("POST "+resetPath, s.handleReset)
+
+	s.Server = httptest.NewTLSServer(s.authenticate(mux))
+	return s
+}
+
+// PowerState returns the current power state of the system.
+func (s *Server) PowerState() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.powerState
+}
+
+// SetPowerState sets the power state of the system.
+func (s *Server) SetPowerState(state string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.powerState = state
+}
+
+// Resets returns the reset types requested so far.
+func (s *Server) Resets() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.resets...)
+}
+
+func (s *Server) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username, password, ok := r.BasicAuth()
+		if !ok || username != s.username || password != s.password {
+			writeError(w, http.StatusUnauthorized, "invalid credentials")
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (s *Server) handleSystems(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]any{
+		"Members": []map[string]string{{"@odata.id": systemPath}},
+	})
+}
+
+func (s *Server) handleSystem(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]any{
+		"@odata.id":  systemPath,
+		"Id":         SystemID,
+		"PowerState": s.PowerState(),
+		"Actions": map[string]any{
+			"#ComputerSystem.Reset": map[string]any{
+				"target":                            resetPath,
+				"ResetType@Redfish.AllowableValues": []string{"On", "ForceOff", "GracefulShutdown", "ForceRestart"},
+			},
+		},
+	})
+}
+
+func (s *Server) handleReset(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		ResetType string `json:"ResetType"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch req.ResetType {
+	case "On":
+		s.powerState = "On"
+	case "ForceOff", "GracefulShutdown":
+		s.powerState = "Off"
+	case "ForceRestart":
+		if s.powerState != "On" {
+			writeError(w, http.StatusConflict, "system is powered off")
+			return
+		}
+	default:
+		writeError(w, http.StatusBadRequest, "unsupported reset type "+req.ResetType)
+		return
+	}
+
+	s.resets = append(s.resets, req.ResetType)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeError(w http.ResponseWriter, status int, msg string) {
+	writeJSON(w, status, map[string]any{
+		"error": map[string]string{"code": "Base.1.0.GeneralError", "message": msg},
+	})
+}
@@ -0,0 +1,224 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bmc
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	redfishSystemsPath    = "/redfish/v1/Systems"
+	redfishResetAction    = "#ComputerSystem.Reset"
+	redfishRequestTimeout = 30 * time.Second
+)
+
+// RedfishClient manages the power of a host through the Redfish API of its BMC.
+type RedfishClient struct {
+	endpoint    string
+	systemID    string
+	credentials Credentials
+	httpClient  *http.Client
+}
+
+var _ PowerManager = &RedfishClient{}
+
+// NewRedfishClient creates a new RedfishClient. If systemID is empty,
+// the BMC must expose exactly one ComputerSystem.
+func NewRedfishClient(endpoint, systemID string, credentials Credentials, insecureSkipVerify bool) *RedfishClient {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{
+		InsecureSkipVerify: insecureSkipVerify, //nolint:gosec
+	}
+
+	return &RedfishClient{
+		endpoint:    strings.TrimSuffix(endpoint, "/"),
+		systemID:    systemID,
+		credentials: credentials,
+		httpClient: &http.Client{
+			Transport: transport,
+			Timeout:   redfishRequestTimeout,
+		},
+	}
+}
+
+type redfishCollection struct {
+	Members []struct {
+		ID string `json:"@odata.id"`
+	} `json:"Members"`
+}
+
+type redfishResetActionInfo struct {
+	Target          string   `json:"target"`
+	AllowableValues []string `json:"ResetType@Redfish.AllowableValues"`
+}
+
+type redfishSystem struct {
+	ID         string                            `json:"Id"`
+	PowerState PowerState                        `json:"PowerState"`
+	Actions    map[string]redfishResetActionInfo `json:"Actions"`
+}
+
+// PowerState returns the power state of the ComputerSystem.
+func (c *RedfishClient) PowerState(ctx context.Context) (PowerState, error) {
+	_, system, err := c.system(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return system.PowerState, nil
+}
+
+// PowerOn powers the ComputerSystem on. It is a no-op if the system is already powered on.
+func (c *RedfishClient) PowerOn(ctx context.Context) error {
+	path, system, err := c.system(ctx)
+	if err != nil {
+		return err
+	}
+
+	if system.PowerState == PowerStateOn || system.PowerState == PowerStatePoweringOn {
+		return nil
+	}
+
+	return c.reset(ctx, path, system, "On")
+}
+
+// PowerCycle restarts the ComputerSystem, or powers it on if it is powered off.
+func (c *RedfishClient) PowerCycle(ctx context.Context) error {
+	path, system, err := c.system(ctx)
+	if err != nil {
+		return err
+	}
+
+	if system.PowerState == PowerStateOff {
+		return c.reset(ctx, path, system, "On")
+	}
+
+	resetType := "ForceRestart"
+	if allowed := system.Actions[redfishResetAction].AllowableValues; len(allowed) > 0 && !slices.Contains(allowed, resetType) {
+		switch {
+		case slices.Contains(allowed, "PowerCycle"):
+			resetType = "PowerCycle"
+		case slices.Contains(allowed, "GracefulRestart"):
+			resetType = "GracefulRestart"
+		default:
+			return fmt.Errorf("BMC does not support restarting system %s, allowed reset types: %s", system.ID, strings.Join(allowed, ", "))
+		}
+	}
+
+	return c.reset(ctx, path, system, resetType)
+}
+
+func (c *RedfishClient) system(ctx context.Context) (string, *redfishSystem, error) {
+	path, err := c.systemPath(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+
+	system := &redfishSystem{}
+	if err := c.do(ctx, http.MethodGet, path, nil, system); err != nil {
+		return "", nil, fmt.Errorf("failed to get system: %w", err)
+	}
+
+	return path, system, nil
+}
+
+func (c *RedfishClient) systemPath(ctx context.Context) (string, error) {
+	if c.systemID != "" {
+		return redfishSystemsPath + "/" + c.systemID, nil
+	}
+
+	systems := &redfishCollection{}
+	if err := c.do(ctx, http.MethodGet, redfishSystemsPath, nil, systems); err != nil {
+		return "", fmt.Errorf("failed to list systems: %w", err)
+	}
+
+	if len(systems.Members) != 1 {
+		return "", fmt.Errorf("BMC manages %d systems, systemID must be specified", len(systems.Members))
+	}
+
+	return systems.Members[0].ID, nil
+}
+
+func (c *RedfishClient) reset(ctx context.Context, path string, system *redfishSystem, resetType string) error {
+	target := system.Actions[redfishResetAction].Target
+	if target == "" {
+		target = path + "/Actions/ComputerSystem.Reset"
+	}
+
+	body := map[string]string{"ResetType": resetType}
+	if err := c.do(ctx, http.MethodPost, target, body, nil); err != nil {
+		return fmt.Errorf("failed to reset system with %s: %w", resetType, err)
+	}
+
+	return nil
+}
+
+func (c *RedfishClient) do(ctx context.Context, method, path string, in, out any) error {
+	var body io.Reader
+	if in != nil {
+		data, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.endpoint+path, body)
+	if err != nil {
+		return err
+	}
+
+	req.SetBasicAuth(c.credentials.Username, c.credentials.Password)
+	req.Header.Set("Accept", "application/json")
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("%s %s: unexpected status %s: %s", method, path, resp.Status, strings.TrimSpace(string(msg)))
+	}
+
+	if out == nil {
+		return nil
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		if errors.Is(err, io.EOF) {
+			return fmt.Errorf("%s %s: empty response", method, path)
+		}
+		return fmt.Errorf("%s %s: failed to decode response: %w", method, path, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package bmc
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"caps-controller-manager/internal/bmc/fakeredfish"
+)
+
+func newTestRedfishClient(t *testing.T, systemID string) (*RedfishClient, *fakeredfish.Server) {
+	t.Helper()
+
+	server := fakeredfish.NewServer("admin", "secret")
+	t.Cleanup(server.Close)
+
+	return NewRedfishClient(server.URL, systemID, Credentials{Username: "admin", Password: "secret"}, true), server
+}
+
+func TestRedfishClient_PowerOn(t *testing.T) {
+	client, server := newTestRedfishClient(t, "")
+	ctx := context.Background()
+
+	state, err := client.PowerState(ctx)
+	if err != nil {
+		t.Fatalf("failed to get power state: %v", err)
+	}
+	if state != PowerStateOff {
+		t.Fatalf("expected power state Off, got %s", state)
+	}
+
+	if err := client.PowerOn(ctx); err != nil {
+		t.Fatalf("failed to power on: %v", err)
+	}
+	if server.PowerState() != "On" {
+		t.Fatalf("expected system to be powered on, got %s", server.PowerState())
+	}
+
+	// Powering on a running system is a no-op.
+	if err := client.PowerOn(ctx); err != nil {
+		t.Fatalf("failed to power on: %v", err)
+	}
+	if resets := server.Resets(); !slices.Equal(resets, []string{"On"}) {
+		t.Fatalf("expected a single On reset, got %v", resets)
+	}
+}
+
+func TestRedfishClient_PowerCycle(t *testing.T) {
+	tests := []struct {
+		name       string
+		powerState string
+		wantResets []string
+	}{
+		{
+			name:       "running system is restarted",
+			powerState: "On",
+			wantResets: []string{"ForceRestart"},
+		},
+		{
+			name:       "powered off system is powered on",
+			powerState: "Off",
+			wantResets: []string{"On"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, server := newTestRedfishClient(t, fakeredfish.SystemID)
+			server.SetPowerState(tt.powerState)
+
+			if err := client.PowerCycle(context.Background()); err != nil {
+				t.Fatalf("failed to power cycle: %v", err)
+			}
+			if resets := server.Resets(); !slices.Equal(resets, tt.wantResets) {
+				t.Fatalf("expected resets %v, got %v", tt.wantResets, resets)
+			}
+			if server.PowerState() != "On" {
+				t.Fatalf("expected system to be powered on, got %s", server.PowerState())
+			}
+		})
+	}
+}
+
+func TestRedfishClient_Errors(t *testing.T) {
+	server := fakeredfish.NewServer("admin", "secret")
+	t.Cleanup(server.Close)
+	ctx := context.Background()
+
+	client := NewRedfishClient(server.URL, "", Credentials{Username: "admin", Password: "wrong"}, true)
+	if _, err := client.PowerState(ctx); err == nil {
+		t.Fatal("expected error for invalid credentials")
+	}
+
+	client = NewRedfishClient(server.URL, "", Credentials{Username: "admin", Password: "secret"}, false)
+	if _, err := client.PowerState(ctx); err == nil {
+		t.Fatal("expected error for untrusted certificate")
+	}
+
+	client = NewRedfishClient(server.URL, "missing", Credentials{Username: "admin", Password: "secret"}, true)
+	if err := client.PowerOn(ctx); err == nil {
+		t.Fatal("expected error for unknown system")
+	}
+}
@@ -198,6 +198,15 @@ func (c *Client) setStaticInstancePhaseToBootstrapping(ctx context.Context,
 
 	tcpCondition := conditions.Get(staticInstance, infrav1.StaticInstanceCheckTCPConnection)
 	if tcpCondition == nil || tcpCondition.Status != metav1.ConditionTrue {
+		poweredOn, err := c.ensurePoweredOn(ctx, staticInstance, staticMachine.Labels["node-group"])
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to power on StaticInstance: %w", err)
+		}
+		if !poweredOn {
+			logger.Info("Waiting for StaticInstance to power on, requeueing", "requeueAfter", RequeueForStaticInstancePowerOn)
+			return ctrl.Result{RequeueAfter: RequeueForStaticInstancePowerOn}, nil
+		}
+
 		type taskDataStr struct {
 			address string
 			delay   time.Duration
@@ -244,6 +253,13 @@ func (c *Client) setStaticInstancePhaseToBootstrapping(ctx context.Context,
 				LastTransitionTime: metav1.Now(),
 			})
 
+			if tcpCondition != nil && tcpCondition.Status == metav1.ConditionFalse &&
+				time.Since(tcpCondition.LastTransitionTime.Time) > UnreachableStaticInstancePowerCycleTimeout {
+				if err := c.powerCycle(ctx, staticInstance, staticMachine.Labels["node-group"], "host is unreachable over TCP"); err != nil {
+					logger.Error(err, "Failed to power-cycle unreachable StaticInstance")
+				}
+			}
+
 			return ctrl.Result{RequeueAfter: delay}, nil
 		}
 
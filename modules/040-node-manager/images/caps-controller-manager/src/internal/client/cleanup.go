@@ -141,6 +141,11 @@ func (c *Client) cleanup(ctx context.Context,
 	logger.Info("Running cleanup task")
 	err, finished := c.taskManager.Spawn(c.taskManagerCtx, string(staticMachine.Spec.ProviderID), "cleanup", taskData, taskFunc)
 	if err != nil {
+		if !isHostReachable(staticInstance.Spec.Address, credentials.SSHPort) {
+			if pErr := c.powerCycle(ctx, staticInstance, staticMachine.Labels["node-group"], "host is unreachable during cleanup"); pErr != nil {
+				logger.Error(pErr, "Failed to power-cycle unreachable StaticInstance")
+			}
+		}
 		return err
 	}
 
@@ -0,0 +1,225 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	deckhousev1 "caps-controller-manager/api/deckhouse.io/v1alpha2"
+	"caps-controller-manager/internal/bmc"
+)
+
+const (
+	RequeueForStaticInstancePowerOn = 30 * time.Second
+
+	// UnreachableStaticInstancePowerCycleTimeout is how long a host must be unreachable before it is power-cycled.
+	UnreachableStaticInstancePowerCycleTimeout = 5 * time.Minute
+
+	// StaticInstancePowerActionInterval is the minimal interval between two power actions on the same host,
+	// it gives the host time to boot.
+	StaticInstancePowerActionInterval = 10 * time.Minute
+
+	hostReachabilityTimeout = 5 * time.Second
+
+	// bmcCredentialsNamespace is the namespace of the secrets referenced by StaticInstanceBMC.CredentialsSecretRef.
+	bmcCredentialsNamespace = "d8-cloud-instance-manager"
+)
+
+var (
+	errStaticInstancePowerOnRequested = errors.New("host power on requested")
+	errStaticInstancePoweringOn       = errors.New("host is powering on")
+)
+
+type powerTaskData struct {
+	bmc         deckhousev1.StaticInstanceBMC
+	credentials bmc.Credentials
+}
+
+// ensurePoweredOn powers on the host of the StaticInstance through its BMC if it is powered off.
+// It reports whether the host is powered on. Hosts without a BMC are considered powered on.
+func (c *Client) ensurePoweredOn(ctx context.Context, staticInstance *deckhousev1.StaticInstance, nodeGroup string) (bool, error) {
+	if staticInstance.Spec.BMC == nil {
+		return true, nil
+	}
+
+	logger := ctrl.LoggerFrom(ctx)
+
+	taskData, err := c.getPowerTaskData(ctx, staticInstance)
+	if err != nil {
+		return false, err
+	}
+
+	taskFunc := func(tCtx context.Context, data any) error {
+		tLogger := ctrl.LoggerFrom(tCtx)
+		t, ok := data.(powerTaskData)
+		if !ok {
+			return errors.New("invalid task data")
+		}
+
+		powerManager, tErr := bmc.NewPowerManager(&t.bmc, t.credentials)
+		if tErr != nil {
+			return tErr
+		}
+
+		state, tErr := powerManager.PowerState(tCtx)
+		if tErr != nil {
+			return fmt.Errorf("failed to get power state: %w", tErr)
+		}
+
+		switch state {
+		case bmc.PowerStateOn:
+			return nil
+		case bmc.PowerStatePoweringOn:
+			return errStaticInstancePoweringOn
+		}
+
+		tLogger.Info("Powering on host", "powerState", state)
+		if tErr = powerManager.PowerOn(tCtx); tErr != nil {
+			return fmt.Errorf("failed to power on: %w", tErr)
+		}
+		return errStaticInstancePowerOnRequested
+	}
+
+	logger.Info("Running power on task", "taskID", staticInstance.Name)
+	err, finished := c.taskManager.Spawn(c.taskManagerCtx, staticInstance.Name, "power-on", taskData, taskFunc)
+	if !finished {
+		return false, nil
+	}
+
+	switch {
+	case errors.Is(err, errStaticInstancePowerOnRequested):
+		logger.Info("Host powered on through BMC")
+		c.recorder.SendNormalEvent(staticInstance, nodeGroup, "StaticInstancePoweredOn", "Host powered on through BMC")
+		setLastPowerAction(staticInstance)
+		return false, nil
+	case errors.Is(err, errStaticInstancePoweringOn):
+		return false, nil
+	case err != nil:
+		c.recorder.SendWarningEvent(staticInstance, nodeGroup, "StaticInstancePowerOnFailed", err.Error())
+		return false, err
+	}
+
+	return true, nil
+}
+
+// powerCycle power-cycles the host of the StaticInstance through its BMC. It does nothing if the StaticInstance
+// has no BMC or the host has been powered on or power-cycled less than StaticInstancePowerActionInterval ago.
+func (c *Client) powerCycle(ctx context.Context, staticInstance *deckhousev1.StaticInstance, nodeGroup, reason string) error {
+	if staticInstance.Spec.BMC == nil || !canRunPowerAction(staticInstance) {
+		return nil
+	}
+
+	logger := ctrl.LoggerFrom(ctx)
+
+	taskData, err := c.getPowerTaskData(ctx, staticInstance)
+	if err != nil {
+		return err
+	}
+
+	taskFunc := func(tCtx context.Context, data any) error {
+		tLogger := ctrl.LoggerFrom(tCtx)
+		t, ok := data.(powerTaskData)
+		if !ok {
+			return errors.New("invalid task data")
+		}
+
+		powerManager, tErr := bmc.NewPowerManager(&t.bmc, t.credentials)
+		if tErr != nil {
+			return tErr
+		}
+
+		tLogger.Info("Power-cycling host")
+		if tErr = powerManager.PowerCycle(tCtx); tErr != nil {
+			return fmt.Errorf("failed to power-cycle: %w", tErr)
+		}
+		return nil
+	}
+
+	logger.Info("Running power cycle task", "taskID", staticInstance.Name, "reason", reason)
+	err, finished := c.taskManager.Spawn(c.taskManagerCtx, staticInstance.Name, "power-cycle", taskData, taskFunc)
+	if !finished {
+		return nil
+	}
+
+	if err != nil {
+		c.recorder.SendWarningEvent(staticInstance, nodeGroup, "StaticInstancePowerCycleFailed", err.Error())
+		return err
+	}
+
+	c.recorder.SendWarningEvent(staticInstance, nodeGroup, "StaticInstancePowerCycled", fmt.Sprintf("Host power-cycled through BMC: %s", reason))
+	setLastPowerAction(staticInstance)
+	return nil
+}
+
+func (c *Client) getPowerTaskData(ctx context.Context, staticInstance *deckhousev1.StaticInstance) (powerTaskData, error) {
+	// BMC credentials are read only from the module namespace, so a StaticInstance cannot point to arbitrary secrets.
+	key := client.ObjectKey{Namespace: bmcCredentialsNamespace, Name: staticInstance.Spec.BMC.CredentialsSecretRef.Name}
+	if key.Name == "" {
+		return powerTaskData{}, errors.New("BMC credentials secret name is empty")
+	}
+
+	secret := &corev1.Secret{}
+	if err := c.client.Get(ctx, key, secret); err != nil {
+		return powerTaskData{}, fmt.Errorf("failed to load BMC credentials: %w", err)
+	}
+
+	credentials, err := bmc.CredentialsFromSecret(secret)
+	if err != nil {
+		return powerTaskData{}, fmt.Errorf("invalid BMC credentials secret '%s': %w", key, err)
+	}
+
+	return powerTaskData{
+		bmc:         *staticInstance.Spec.BMC,
+		credentials: credentials,
+	}, nil
+}
+
+func canRunPowerAction(staticInstance *deckhousev1.StaticInstance) bool {
+	last, err := time.Parse(time.RFC3339, staticInstance.Annotations[deckhousev1.LastPowerActionAnnotation])
+	if err != nil {
+		return true
+	}
+
+	return time.Since(last) > StaticInstancePowerActionInterval
+}
+
+func setLastPowerAction(staticInstance *deckhousev1.StaticInstance) {
+	if staticInstance.Annotations == nil {
+		staticInstance.Annotations = make(map[string]string)
+	}
+
+	staticInstance.Annotations[deckhousev1.LastPowerActionAnnotation] = time.Now().UTC().Format(time.RFC3339)
+}
+
+func isHostReachable(host string, port int) bool {
+	conn, err := net.DialTimeout("tcp", net.JoinHostPort(host, strconv.Itoa(port)), hostReachabilityTimeout)
+	if err != nil {
+		return false
+	}
+
+	_ = conn.Close()
+	return true
+}
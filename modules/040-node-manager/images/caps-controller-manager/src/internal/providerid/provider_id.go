@@ -32,6 +32,8 @@ const (
 type ProviderID string
 
 // GenerateProviderID generates a provider ID for a static node.
+// The ID is derived from the StaticInstance name rather than its address,
+// so it does not depend on whether the host is addressed by IPv4, IPv6 or DNS name.
 func GenerateProviderID(staticInstanceName string) ProviderID {
 	sum := sha256.Sum256([]byte(staticInstanceName))
 
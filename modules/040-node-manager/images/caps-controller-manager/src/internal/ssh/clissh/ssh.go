@@ -101,7 +101,8 @@ func (s *SSH) ExecSSHCommand(command string, stdout io.Writer, stderr io.Writer)
 	}
 
 	args = append(args, []string{
-		fmt.Sprintf("%s@%s", s.credentials.User, s.address),
+		"-l", s.credentials.User,
+		s.address,
 		command,
 	}...)
 
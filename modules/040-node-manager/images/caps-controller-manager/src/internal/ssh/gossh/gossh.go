@@ -21,6 +21,8 @@ import (
 	"encoding/base64"
 	"fmt"
 	"io"
+	"net"
+	"strconv"
 	"strings"
 
 	"golang.org/x/crypto/ssh"
@@ -68,7 +70,7 @@ func CreateSSHClient(host string, credentials deckhousev1.SSHCredentialsSpec) (*
 		HostKeyCallback: ssh.InsecureIgnoreHostKey(),
 	}
 
-	addr := fmt.Sprintf("%s:%d", host, credentials.SSHPort)
+	addr := net.JoinHostPort(host, strconv.Itoa(credentials.SSHPort))
 
 	sshClient, err := ssh.Dial("tcp", addr, config)
 	if err != nil {
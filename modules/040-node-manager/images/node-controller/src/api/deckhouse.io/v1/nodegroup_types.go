@@ -292,6 +292,14 @@ type DisruptionsSpec struct {
 	// RollingUpdate specifies rolling update settings
 	// +optional
 	RollingUpdate *RollingUpdateDisruptionSpec `json:"rollingUpdate,omitempty"`
+
+	// Blackouts specifies periods when disruptions are never approved
+	// +optional
+	Blackouts []DisruptionBlackout `json:"blackouts,omitempty"`
+
+	// MaxConcurrentZones specifies how many topology zones may have disrupted nodes at the same time
+	// +optional
+	MaxConcurrentZones *int32 `json:"maxConcurrentZones,omitempty"`
 }
 
 // AutomaticDisruptionSpec defines automatic disruption settings
@@ -323,6 +331,35 @@ type DisruptionWindow struct {
 	// Days specifies the days of the week
 	// +optional
 	Days []string `json:"days,omitempty"`
+
+	// Timezone specifies the IANA timezone the window is defined in
+	// +optional
+	Timezone string `json:"timezone,omitempty"`
+
+	// StartDate specifies the first date (YYYY-MM-DD) the window is active
+	// +optional
+	StartDate string `json:"startDate,omitempty"`
+
+	// EndDate specifies the last date (YYYY-MM-DD) the window is active
+	// +optional
+	EndDate string `json:"endDate,omitempty"`
+}
+
+// DisruptionBlackout defines a period when disruptions are forbidden
+type DisruptionBlackout struct {
+	// From specifies the start of the period (YYYY-MM-DD or RFC3339)
+	From string `json:"from"`
+
+	// To specifies the end of the period (YYYY-MM-DD or RFC3339)
+	To string `json:"to"`
+
+	// Timezone specifies the IANA timezone for dates without an offset
+	// +optional
+	Timezone string `json:"timezone,omitempty"`
+
+	// Reason specifies why disruptions are forbidden
+	// +optional
+	Reason string `json:"reason,omitempty"`
 }
 
 // KubeletSpec defines kubelet settings
@@ -424,6 +461,79 @@ type UpdateSpec struct {
 	// MaxConcurrent specifies maximum concurrent updates
 	// +optional
 	MaxConcurrent *intstr.IntOrString `json:"maxConcurrent,omitempty"`
+
+	// HealthChecks specifies checks that must pass before the next node update is approved
+	// +optional
+	HealthChecks *UpdateHealthChecksSpec `json:"healthChecks,omitempty"`
+
+	// Canary specifies a canary stage of every configuration rollout
+	// +optional
+	Canary *CanaryUpdateSpec `json:"canary,omitempty"`
+}
+
+// CanaryUpdateSpec defines canary rollout settings
+type CanaryUpdateSpec struct {
+	// Nodes specifies how many nodes are updated first
+	Nodes int32 `json:"nodes"`
+
+	// SoakPeriodSeconds specifies how long updated canary nodes are observed before the rollout continues
+	// +optional
+	SoakPeriodSeconds *int32 `json:"soakPeriodSeconds,omitempty"`
+
+	// TimeoutSeconds specifies how long canary nodes may take to be updated
+	// +optional
+	TimeoutSeconds *int32 `json:"timeoutSeconds,omitempty"`
+}
+
+// UpdateHealthChecksSpec defines workload health checks gating node updates
+type UpdateHealthChecksSpec struct {
+	// PodDisruptionBudgets specifies namespaces whose PodDisruptionBudgets must be satisfied
+	// +optional
+	PodDisruptionBudgets *PodDisruptionBudgetsHealthCheck `json:"podDisruptionBudgets,omitempty"`
+
+	// Workloads specifies Deployments and StatefulSets that must be fully available
+	// +optional
+	Workloads []WorkloadHealthCheck `json:"workloads,omitempty"`
+
+	// HTTP specifies a Prometheus-compatible query endpoint
+	// +optional
+	HTTP *HTTPHealthCheck `json:"http,omitempty"`
+}
+
+// PodDisruptionBudgetsHealthCheck defines namespaces to check PodDisruptionBudgets in
+type PodDisruptionBudgetsHealthCheck struct {
+	// Namespaces specifies namespaces by name
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
+
+	// NamespaceSelector specifies namespaces by labels
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+}
+
+// WorkloadHealthCheck references a workload that must be fully available
+type WorkloadHealthCheck struct {
+	// Kind specifies the workload kind (Deployment or StatefulSet)
+	Kind string `json:"kind"`
+
+	// Namespace specifies the workload namespace
+	Namespace string `json:"namespace"`
+
+	// Name specifies the workload name
+	Name string `json:"name"`
+}
+
+// HTTPHealthCheck defines a query evaluated by a Prometheus-compatible HTTP API
+type HTTPHealthCheck struct {
+	// URL specifies the instant query endpoint, e.g. http://prometheus:9090/api/v1/query
+	URL string `json:"url"`
+
+	// Query specifies the query to evaluate
+	Query string `json:"query"`
+
+	// TimeoutSeconds specifies the request timeout
+	// +optional
+	TimeoutSeconds *int32 `json:"timeoutSeconds,omitempty"`
 }
 
 // GPUSpec defines GPU settings
@@ -519,6 +629,51 @@ type NodeGroupStatus struct {
 	// KubernetesVersion specifies the kubernetes version
 	// +optional
 	KubernetesVersion string `json:"kubernetesVersion,omitempty"`
+
+	// Update contains the state of the canary rollout
+	// +optional
+	Update *UpdateRolloutStatus `json:"update,omitempty"`
+}
+
+// UpdateRolloutPhase defines the phase of a canary rollout
+type UpdateRolloutPhase string
+
+const (
+	UpdateRolloutPhaseCanary      UpdateRolloutPhase = "Canary"
+	UpdateRolloutPhaseSoaking     UpdateRolloutPhase = "Soaking"
+	UpdateRolloutPhaseProgressing UpdateRolloutPhase = "Progressing"
+	UpdateRolloutPhasePaused      UpdateRolloutPhase = "Paused"
+)
+
+// UpdateRolloutStatus defines the observed state of a canary rollout
+type UpdateRolloutStatus struct {
+	// Checksum specifies the configuration checksum being rolled out
+	// +optional
+	Checksum string `json:"checksum,omitempty"`
+
+	// Phase specifies the rollout phase
+	// +optional
+	Phase UpdateRolloutPhase `json:"phase,omitempty"`
+
+	// PhaseStartedAt specifies when the current phase started
+	// +optional
+	PhaseStartedAt *metav1.Time `json:"phaseStartedAt,omitempty"`
+
+	// CanaryNodes specifies nodes updated in the canary stage
+	// +optional
+	CanaryNodes []string `json:"canaryNodes,omitempty"`
+
+	// FailedStep specifies the canary check that paused the rollout
+	// +optional
+	FailedStep string `json:"failedStep,omitempty"`
+
+	// FailedNode specifies the canary node that failed
+	// +optional
+	FailedNode string `json:"failedNode,omitempty"`
+
+	// Message specifies details of the failure
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 // MachineFailure describes a machine failure
@@ -73,6 +73,31 @@ func (in *CRISpec) DeepCopy() *CRISpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CanaryUpdateSpec) DeepCopyInto(out *CanaryUpdateSpec) {
+	*out = *in
+	if in.SoakPeriodSeconds != nil {
+		in, out := &in.SoakPeriodSeconds, &out.SoakPeriodSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryUpdateSpec.
+func (in *CanaryUpdateSpec) DeepCopy() *CanaryUpdateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CanaryUpdateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ChaosSpec) DeepCopyInto(out *ChaosSpec) {
 	*out = *in
@@ -189,6 +214,21 @@ func (in *ContainerdSpec) DeepCopy() *ContainerdSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DisruptionBlackout) DeepCopyInto(out *DisruptionBlackout) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DisruptionBlackout.
+func (in *DisruptionBlackout) DeepCopy() *DisruptionBlackout {
+	if in == nil {
+		return nil
+	}
+	out := new(DisruptionBlackout)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DisruptionWindow) DeepCopyInto(out *DisruptionWindow) {
 	*out = *in
@@ -222,6 +262,16 @@ func (in *DisruptionsSpec) DeepCopyInto(out *DisruptionsSpec) {
 		*out = new(RollingUpdateDisruptionSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Blackouts != nil {
+		in, out := &in.Blackouts, &out.Blackouts
+		*out = make([]DisruptionBlackout, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaxConcurrentZones != nil {
+		in, out := &in.MaxConcurrentZones, &out.MaxConcurrentZones
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DisruptionsSpec.
@@ -304,6 +354,26 @@ func (in *GPUSpec) DeepCopy() *GPUSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPHealthCheck) DeepCopyInto(out *HTTPHealthCheck) {
+	*out = *in
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPHealthCheck.
+func (in *HTTPHealthCheck) DeepCopy() *HTTPHealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPHealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubeletSpec) DeepCopyInto(out *KubeletSpec) {
 	*out = *in
@@ -590,6 +660,11 @@ func (in *NodeGroupStatus) DeepCopyInto(out *NodeGroupStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Update != nil {
+		in, out := &in.Update, &out.Update
+		*out = new(UpdateRolloutStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeGroupStatus.
@@ -698,6 +773,31 @@ func (in *OperatingSystemSpec) DeepCopy() *OperatingSystemSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodDisruptionBudgetsHealthCheck) DeepCopyInto(out *PodDisruptionBudgetsHealthCheck) {
+	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodDisruptionBudgetsHealthCheck.
+func (in *PodDisruptionBudgetsHealthCheck) DeepCopy() *PodDisruptionBudgetsHealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(PodDisruptionBudgetsHealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceReservationSpec) DeepCopyInto(out *ResourceReservationSpec) {
 	*out = *in
@@ -855,6 +955,60 @@ func (in *TopologyManagerSpec) DeepCopy() *TopologyManagerSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpdateHealthChecksSpec) DeepCopyInto(out *UpdateHealthChecksSpec) {
+	*out = *in
+	if in.PodDisruptionBudgets != nil {
+		in, out := &in.PodDisruptionBudgets, &out.PodDisruptionBudgets
+		*out = new(PodDisruptionBudgetsHealthCheck)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Workloads != nil {
+		in, out := &in.Workloads, &out.Workloads
+		*out = make([]WorkloadHealthCheck, len(*in))
+		copy(*out, *in)
+	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(HTTPHealthCheck)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpdateHealthChecksSpec.
+func (in *UpdateHealthChecksSpec) DeepCopy() *UpdateHealthChecksSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(UpdateHealthChecksSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpdateRolloutStatus) DeepCopyInto(out *UpdateRolloutStatus) {
+	*out = *in
+	if in.PhaseStartedAt != nil {
+		in, out := &in.PhaseStartedAt, &out.PhaseStartedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.CanaryNodes != nil {
+		in, out := &in.CanaryNodes, &out.CanaryNodes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpdateRolloutStatus.
+func (in *UpdateRolloutStatus) DeepCopy() *UpdateRolloutStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(UpdateRolloutStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UpdateSpec) DeepCopyInto(out *UpdateSpec) {
 	*out = *in
@@ -863,6 +1017,16 @@ func (in *UpdateSpec) DeepCopyInto(out *UpdateSpec) {
 		*out = new(intstr.IntOrString)
 		**out = **in
 	}
+	if in.HealthChecks != nil {
+		in, out := &in.HealthChecks, &out.HealthChecks
+		*out = new(UpdateHealthChecksSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Canary != nil {
+		in, out := &in.Canary, &out.Canary
+		*out = new(CanaryUpdateSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpdateSpec.
@@ -874,3 +1038,18 @@ func (in *UpdateSpec) DeepCopy() *UpdateSpec {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadHealthCheck) DeepCopyInto(out *WorkloadHealthCheck) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadHealthCheck.
+func (in *WorkloadHealthCheck) DeepCopy() *WorkloadHealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadHealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -17,9 +17,11 @@ limitations under the License.
 package common
 
 import (
+	appsv1 "k8s.io/api/apps/v1"
 	coordinationv1 "k8s.io/api/coordination/v1"
 	corev1 "k8s.io/api/core/v1"
 	discoveryv1 "k8s.io/api/discovery/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/fields"
@@ -155,6 +157,12 @@ func CacheOptions() (cache.Options, client.Options) {
 			// informer and still send every read to the apiserver.
 			DisableFor: []client.Object{
 				&coordinationv1.Lease{},
+				// Update health checks read these only while nodes wait for approval; live
+				// reads are cheaper than cluster-wide informers over user workloads.
+				&appsv1.Deployment{},
+				&appsv1.StatefulSet{},
+				&policyv1.PodDisruptionBudget{},
+				&corev1.Namespace{},
 			},
 		},
 	}
@@ -30,6 +30,9 @@ const (
 	DrainingAnnotation           = "update.node.deckhouse.io/draining"
 	DrainedAnnotation            = "update.node.deckhouse.io/drained"
 
+	// NodeGroup annotations
+	ResumeRolloutAnnotation = "update.node.deckhouse.io/resume-rollout"
+
 	// Node metadata annotations
 	ConfigurationChecksumAnnotation = "node.deckhouse.io/configuration-checksum"
 	ProviderIDAnnotation            = "node.deckhouse.io/provider-id"
@@ -248,5 +248,12 @@ func CalculateNodeGroupConditions(
 		})
 	}
 
+	// Conditions owned by other controllers are carried over, the status patch replaces the whole list.
+	for _, c := range currentConditions {
+		if c.Type == NodeGroupConditionTypeUpdatePaused {
+			newConditions = append(newConditions, c)
+		}
+	}
+
 	return fillTransitionTime(currentConditions, newConditions, curTime)
 }
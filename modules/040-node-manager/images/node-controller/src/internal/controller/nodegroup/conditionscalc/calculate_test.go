@@ -323,6 +323,29 @@ func TestCalculateNodeGroupConditions_TransitionTimePreserved(t *testing.T) {
 	}
 }
 
+func TestCalculateNodeGroupConditions_UpdatePausedCarriedOver(t *testing.T) {
+	t.Setenv("TEST_CONDITIONS_CALC_NOW_TIME", nowTime)
+	earlier := metav1.NewTime(mustParse(t, "2020-01-01T00:00:00Z"))
+
+	current := []NodeGroupCondition{
+		{Type: NodeGroupConditionTypeUpdatePaused, Status: ConditionTrue, Message: "Deployment app/web not found", LastTransitionTime: earlier},
+	}
+	ng := NodeGroup{Type: NodeTypeStatic, Desired: 1}
+	nodes := []*Node{{Ready: true}}
+
+	got := CalculateNodeGroupConditions(ng, nodes, current, nil, 0)
+
+	for _, c := range got {
+		if c.Type == NodeGroupConditionTypeUpdatePaused {
+			if c.Status != ConditionTrue || c.Message != "Deployment app/web not found" || !c.LastTransitionTime.Equal(&earlier) {
+				t.Fatalf("expected UpdatePaused condition to be kept as is, got %+v", c)
+			}
+			return
+		}
+	}
+	t.Fatal("expected UpdatePaused condition to be carried over")
+}
+
 func TestCalcErrorCondition_FrozenKeepsPreviousError(t *testing.T) {
 	t.Setenv("TEST_CONDITIONS_CALC_NOW_TIME", nowTime)
 	current := []NodeGroupCondition{
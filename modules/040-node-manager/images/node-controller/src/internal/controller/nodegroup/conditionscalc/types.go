@@ -47,6 +47,8 @@ const (
 	NodeGroupConditionTypeError                        NodeGroupConditionType = "Error"
 	NodeGroupConditionTypeScaling                      NodeGroupConditionType = "Scaling"
 	NodeGroupConditionTypeFrozen                       NodeGroupConditionType = "Frozen"
+	// NodeGroupConditionTypeUpdatePaused is owned by the update approval controller.
+	NodeGroupConditionTypeUpdatePaused NodeGroupConditionType = "UpdatePaused"
 )
 
 type NodeGroupCondition struct {
@@ -20,6 +20,8 @@ import (
 	"strconv"
 	"strings"
 	"time"
+	// Disruption windows may use any IANA timezone, the distroless image has no zoneinfo.
+	_ "time/tzdata"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -41,11 +43,13 @@ const (
 	RollingUpdateAnnotation          = nodecommon.RollingUpdateAnnotation
 	DrainingAnnotation               = nodecommon.DrainingAnnotation
 	DrainedAnnotation                = nodecommon.DrainedAnnotation
+	ResumeRolloutAnnotation          = nodecommon.ResumeRolloutAnnotation
 )
 
 type NodeInfo struct {
 	Name      string
 	NodeGroup string
+	Zone      string
 
 	ConfigurationChecksum string
 
@@ -58,6 +62,7 @@ type NodeInfo struct {
 	IsDraining           bool
 	IsDrained            bool
 	IsRollingUpdate      bool
+	IsDeleting           bool
 }
 
 func BuildNodeInfo(node *corev1.Node) NodeInfo {
@@ -69,8 +74,10 @@ func BuildNodeInfo(node *corev1.Node) NodeInfo {
 	info := NodeInfo{
 		Name:                  node.Name,
 		NodeGroup:             node.Labels[NodeGroupLabel],
+		Zone:                  node.Labels[corev1.LabelTopologyZone],
 		ConfigurationChecksum: annotations[ConfigurationChecksumAnnotation],
 		IsUnschedulable:       node.Spec.Unschedulable,
+		IsDeleting:            node.DeletionTimestamp != nil,
 	}
 
 	_, info.IsApproved = annotations[ApprovedAnnotation]
@@ -79,6 +86,11 @@ func BuildNodeInfo(node *corev1.Node) NodeInfo {
 	_, info.IsDisruptionApproved = annotations[DisruptionApprovedAnnotation]
 	_, info.IsRollingUpdate = annotations[RollingUpdateAnnotation]
 
+	// A RollingUpdate node is cordoned by the machine controller once its Instance is deleted.
+	if info.IsRollingUpdate && info.IsUnschedulable {
+		info.IsDeleting = true
+	}
+
 	if v, ok := annotations[DrainingAnnotation]; ok && v == "bashible" {
 		info.IsDraining = true
 	}
@@ -141,7 +153,15 @@ func IsInAllowedWindow(windows []v1.DisruptionWindow, now time.Time) bool {
 }
 
 func IsWindowAllowed(w v1.DisruptionWindow, now time.Time) bool {
-	now = now.UTC()
+	loc, err := LoadLocation(w.Timezone)
+	if err != nil {
+		return false
+	}
+	now = now.In(loc)
+
+	if !IsDateInRange(now, w.StartDate, w.EndDate) {
+		return false
+	}
 
 	const hhMM = "15:04"
 	fromInput, err := time.Parse(hhMM, w.From)
@@ -153,8 +173,8 @@ func IsWindowAllowed(w v1.DisruptionWindow, now time.Time) bool {
 		return false
 	}
 
-	fromTime := time.Date(now.Year(), now.Month(), now.Day(), fromInput.Hour(), fromInput.Minute(), 0, 0, time.UTC)
-	toTime := time.Date(now.Year(), now.Month(), now.Day(), toInput.Hour(), toInput.Minute(), 0, 0, time.UTC)
+	fromTime := time.Date(now.Year(), now.Month(), now.Day(), fromInput.Hour(), fromInput.Minute(), 0, 0, loc)
+	toTime := time.Date(now.Year(), now.Month(), now.Day(), toInput.Hour(), toInput.Minute(), 0, 0, loc)
 
 	if !IsDayAllowed(now, w.Days) {
 		return false
@@ -167,6 +187,103 @@ func IsWindowAllowed(w v1.DisruptionWindow, now time.Time) bool {
 	return now.Equal(fromTime) || now.Equal(toTime) || (now.After(fromTime) && now.Before(toTime))
 }
 
+// LoadLocation returns UTC for an empty timezone name.
+func LoadLocation(name string) (*time.Location, error) {
+	if name == "" {
+		return time.UTC, nil
+	}
+	return time.LoadLocation(name)
+}
+
+// IsDateInRange reports whether the calendar date of now lies within the inclusive
+// [startDate, endDate] range. Empty bounds are open.
+func IsDateInRange(now time.Time, startDate, endDate string) bool {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	if startDate != "" {
+		start, err := time.Parse(time.DateOnly, startDate)
+		if err != nil || today.Before(start) {
+			return false
+		}
+	}
+	if endDate != "" {
+		end, err := time.Parse(time.DateOnly, endDate)
+		if err != nil || today.After(end) {
+			return false
+		}
+	}
+	return true
+}
+
+// ActiveBlackout returns the first blackout period covering now.
+func ActiveBlackout(blackouts []v1.DisruptionBlackout, now time.Time) (v1.DisruptionBlackout, bool) {
+	for _, b := range blackouts {
+		if IsInBlackout(b, now) {
+			return b, true
+		}
+	}
+	return v1.DisruptionBlackout{}, false
+}
+
+// IsInBlackout reports whether now lies within the blackout period. A date-only To
+// covers the whole day. Malformed periods are treated as active to fail safe.
+func IsInBlackout(b v1.DisruptionBlackout, now time.Time) bool {
+	loc, err := LoadLocation(b.Timezone)
+	if err != nil {
+		return true
+	}
+	from, _, err := ParseBlackoutTime(b.From, loc)
+	if err != nil {
+		return true
+	}
+	to, dateOnly, err := ParseBlackoutTime(b.To, loc)
+	if err != nil {
+		return true
+	}
+	if dateOnly {
+		to = to.AddDate(0, 0, 1)
+		return !now.Before(from) && now.Before(to)
+	}
+	return !now.Before(from) && !now.After(to)
+}
+
+// ParseBlackoutTime parses a YYYY-MM-DD date in loc or an RFC3339 timestamp.
+func ParseBlackoutTime(value string, loc *time.Location) (time.Time, bool, error) {
+	if t, err := time.ParseInLocation(time.DateOnly, value, loc); err == nil {
+		return t, true, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	return t, false, err
+}
+
+// DisruptedZones returns the zones that have nodes being drained, disrupted or
+// deleted for a rolling update.
+// Nodes without a zone label are not taken into account.
+func DisruptedZones(nodes []NodeInfo) map[string]struct{} {
+	zones := make(map[string]struct{})
+	for _, node := range nodes {
+		if node.Zone == "" {
+			continue
+		}
+		if node.IsDraining || node.IsDrained || node.IsDisruptionApproved || node.IsDeleting {
+			zones[node.Zone] = struct{}{}
+		}
+	}
+	return zones
+}
+
+// IsZoneAllowed reports whether a node in zone may be disrupted given the zones
+// already disrupted and the NodeGroup's zone concurrency limit.
+func IsZoneAllowed(zone string, disrupted map[string]struct{}, maxConcurrentZones *int32) bool {
+	if maxConcurrentZones == nil || zone == "" {
+		return true
+	}
+	if _, ok := disrupted[zone]; ok {
+		return true
+	}
+	return len(disrupted) < int(*maxConcurrentZones)
+}
+
 func IsDayAllowed(now time.Time, days []string) bool {
 	if len(days) == 0 {
 		return true
@@ -76,8 +76,26 @@ func TestBuildNodeInfo(t *testing.T) {
 				IsUnschedulable:       true,
 				IsDraining:            true,
 				IsDrained:             true,
+				IsDeleting:            true,
 			},
 		},
+		{
+			name: "node with deletion timestamp is deleting",
+			node: &corev1.Node{ObjectMeta: metav1.ObjectMeta{
+				Name:              "n3",
+				DeletionTimestamp: &metav1.Time{},
+				Finalizers:        []string{"test"},
+			}},
+			want: NodeInfo{Name: "n3", IsDeleting: true},
+		},
+		{
+			name: "rolling update node that is still schedulable is not deleting",
+			node: &corev1.Node{ObjectMeta: metav1.ObjectMeta{
+				Name:        "n4",
+				Annotations: map[string]string{RollingUpdateAnnotation: ""},
+			}},
+			want: NodeInfo{Name: "n4", IsRollingUpdate: true},
+		},
 		{
 			name: "draining/drained with non-bashible value is not set",
 			node: &corev1.Node{
@@ -256,6 +274,48 @@ func TestIsWindowAllowed(t *testing.T) {
 			now:    mustTime(t, "03:00"),
 			want:   true,
 		},
+		{
+			name:   "window in timezone matches",
+			window: v1.DisruptionWindow{From: "14:00", To: "16:00", Timezone: "Europe/Moscow"},
+			now:    mustTime(t, "12:00"),
+			want:   true,
+		},
+		{
+			name:   "window in timezone does not match",
+			window: v1.DisruptionWindow{From: "08:00", To: "10:00", Timezone: "Europe/Moscow"},
+			now:    mustTime(t, "12:00"),
+			want:   false,
+		},
+		{
+			name:   "day is evaluated in window timezone",
+			window: v1.DisruptionWindow{From: "00:00", To: "23:59", Days: []string{"Sat"}, Timezone: "Asia/Tokyo"},
+			now:    mustTime(t, "23:00"),
+			want:   true,
+		},
+		{
+			name:   "unknown timezone",
+			window: v1.DisruptionWindow{From: "08:00", To: "18:00", Timezone: "Mars/Olympus"},
+			now:    mustTime(t, "12:00"),
+			want:   false,
+		},
+		{
+			name:   "inside date range",
+			window: v1.DisruptionWindow{From: "08:00", To: "18:00", StartDate: "2020-12-31", EndDate: "2021-01-01"},
+			now:    mustTime(t, "12:00"),
+			want:   true,
+		},
+		{
+			name:   "before start date",
+			window: v1.DisruptionWindow{From: "08:00", To: "18:00", StartDate: "2021-01-02"},
+			now:    mustTime(t, "12:00"),
+			want:   false,
+		},
+		{
+			name:   "after end date",
+			window: v1.DisruptionWindow{From: "08:00", To: "18:00", EndDate: "2020-12-31"},
+			now:    mustTime(t, "12:00"),
+			want:   false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -311,6 +371,109 @@ func TestIsInAllowedWindow(t *testing.T) {
 	}
 }
 
+func TestIsInBlackout(t *testing.T) {
+	tests := []struct {
+		name     string
+		blackout v1.DisruptionBlackout
+		now      time.Time
+		want     bool
+	}{
+		{
+			name:     "inside date range",
+			blackout: v1.DisruptionBlackout{From: "2020-12-24", To: "2021-01-08"},
+			now:      mustTime(t, "12:00"),
+			want:     true,
+		},
+		{
+			name:     "date-only end covers the whole day",
+			blackout: v1.DisruptionBlackout{From: "2020-12-31", To: "2021-01-01"},
+			now:      mustTime(t, "23:59"),
+			want:     true,
+		},
+		{
+			name:     "after date range",
+			blackout: v1.DisruptionBlackout{From: "2020-12-24", To: "2020-12-31"},
+			now:      mustTime(t, "00:00"),
+			want:     false,
+		},
+		{
+			name:     "date range in timezone",
+			blackout: v1.DisruptionBlackout{From: "2021-01-02", To: "2021-01-03", Timezone: "Asia/Tokyo"},
+			now:      mustTime(t, "16:00"),
+			want:     true,
+		},
+		{
+			name:     "before RFC3339 range",
+			blackout: v1.DisruptionBlackout{From: "2021-01-01T18:00:00Z", To: "2021-01-02T06:00:00Z"},
+			now:      mustTime(t, "12:00"),
+			want:     false,
+		},
+		{
+			name:     "inside RFC3339 range",
+			blackout: v1.DisruptionBlackout{From: "2021-01-01T10:00:00+03:00", To: "2021-01-01T16:00:00+03:00"},
+			now:      mustTime(t, "12:00"),
+			want:     true,
+		},
+		{
+			name:     "malformed period blocks disruptions",
+			blackout: v1.DisruptionBlackout{From: "bad", To: "2021-01-01"},
+			now:      mustTime(t, "12:00"),
+			want:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsInBlackout(tt.blackout, tt.now); got != tt.want {
+				t.Fatalf("IsInBlackout() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsZoneAllowed(t *testing.T) {
+	one := int32(1)
+	two := int32(2)
+
+	nodes := []NodeInfo{
+		{Name: "a1", Zone: "a", IsDraining: true},
+		{Name: "a2", Zone: "a"},
+		{Name: "b1", Zone: "b"},
+		{Name: "c1", Zone: "c"},
+		{Name: "x1", IsDisruptionApproved: true},
+	}
+	disrupted := DisruptedZones(nodes)
+	if len(disrupted) != 1 {
+		t.Fatalf("DisruptedZones() = %v, want only zone a", disrupted)
+	}
+
+	deleting := DisruptedZones(append(nodes, NodeInfo{Name: "c2", Zone: "c", IsDeleting: true}))
+	if _, ok := deleting["c"]; !ok || len(deleting) != 2 {
+		t.Fatalf("DisruptedZones() = %v, want zones a and c", deleting)
+	}
+
+	tests := []struct {
+		name string
+		zone string
+		max  *int32
+		want bool
+	}{
+		{name: "no limit", zone: "b", max: nil, want: true},
+		{name: "same zone is allowed", zone: "a", max: &one, want: true},
+		{name: "other zone exceeds limit", zone: "b", max: &one, want: false},
+		{name: "other zone within limit", zone: "b", max: &two, want: true},
+		{name: "node without zone is not limited", zone: "", max: &one, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsZoneAllowed(tt.zone, disrupted, tt.max); got != tt.want {
+				t.Fatalf("IsZoneAllowed() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestIsDayAllowed(t *testing.T) {
 	friday := mustTime(t, "12:00")
 
@@ -19,6 +19,7 @@ package updateapproval
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
 
 	corev1 "k8s.io/api/core/v1"
@@ -48,11 +49,13 @@ func init() {
 type Reconciler struct {
 	register.Base
 	deckhouseNodeName string
+	httpClient        *http.Client
 }
 
 func New() *Reconciler {
 	return &Reconciler{
 		deckhouseNodeName: os.Getenv("DECKHOUSE_NODE_NAME"),
+		httpClient:        &http.Client{},
 	}
 }
 
@@ -133,13 +136,27 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{}, nil
 	}
 
-	if _, err := engineSvc.ApproveUpdates(ctx, ng, nodeInfos); err != nil {
+	gate, err := r.gateUpdates(ctx, kubeSvc, ng, nodeInfos, ngChecksum)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if gate.paused {
+		return ctrl.Result{RequeueAfter: gate.requeueAfter}, nil
+	}
+
+	approved, err := engineSvc.ApproveUpdatesWithLimit(ctx, ng, nodeInfos, gate.limit)
+	if err != nil {
 		if errors.IsConflict(err) {
 			logger.Info("approve updates conflict, likely concurrent node patch", "nodegroup", ng.Name)
 		}
 		return ctrl.Result{}, err
 	}
-	logger.V(1).Info("updateapproval completed without mutations", "nodegroup", ng.Name)
+	if err := r.recordCanaryNodes(ctx, kubeSvc, ng, approved); err != nil {
+		return ctrl.Result{}, err
+	}
+	if len(approved) == 0 {
+		logger.V(1).Info("updateapproval completed without mutations", "nodegroup", ng.Name)
+	}
 
-	return ctrl.Result{}, nil
+	return ctrl.Result{RequeueAfter: gate.requeueAfter}, nil
 }
@@ -19,8 +19,11 @@ package updateapproval
 import (
 	"context"
 	"testing"
+	"time"
 
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -30,6 +33,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	v1 "github.com/deckhouse/node-controller/api/deckhouse.io/v1"
+	deckhousev1alpha2 "github.com/deckhouse/node-controller/api/deckhouse.io/v1alpha2"
 	ua "github.com/deckhouse/node-controller/internal/controller/updateapproval/common"
 	"github.com/deckhouse/node-controller/internal/register"
 )
@@ -44,9 +48,16 @@ func newTestReconciler(t *testing.T, objs ...runtime.Object) *Reconciler {
 	if err := v1.AddToScheme(scheme); err != nil {
 		t.Fatalf("add v1 scheme: %v", err)
 	}
+	if err := appsv1.AddToScheme(scheme); err != nil {
+		t.Fatalf("add appsv1 scheme: %v", err)
+	}
+	if err := deckhousev1alpha2.AddToScheme(scheme); err != nil {
+		t.Fatalf("add v1alpha2 scheme: %v", err)
+	}
 	cl := fake.NewClientBuilder().
 		WithScheme(scheme).
 		WithRuntimeObjects(objs...).
+		WithStatusSubresource(&v1.NodeGroup{}).
 		Build()
 	return &Reconciler{
 		Base: register.Base{Client: cl, Recorder: record.NewFakeRecorder(10)},
@@ -167,6 +178,187 @@ func TestReconcile_WaitingNode_GetsApproved(t *testing.T) {
 	}
 }
 
+func TestReconcile_HealthCheckFailed_UpdatesPaused(t *testing.T) {
+	ng := &v1.NodeGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "worker"},
+		Spec: v1.NodeGroupSpec{
+			NodeType: v1.NodeTypeStatic,
+			Update: &v1.UpdateSpec{HealthChecks: &v1.UpdateHealthChecksSpec{
+				Workloads: []v1.WorkloadHealthCheck{{Kind: "Deployment", Namespace: "app", Name: "web"}},
+			}},
+		},
+	}
+	secret := makeChecksumSecret(map[string]string{"worker": "abc123"})
+	node := makeReadyNode("n1", "worker", map[string]string{
+		ua.ConfigurationChecksumAnnotation: "old-checksum",
+		ua.WaitingForApprovalAnnotation:    "",
+	})
+
+	r := newTestReconciler(t, ng, secret, node)
+	res := reconcileUA(t, r, "worker")
+	if res.RequeueAfter != healthCheckRetryInterval {
+		t.Fatalf("expected requeue after %s, got %+v", healthCheckRetryInterval, res)
+	}
+
+	updated := getNode(t, r, "n1")
+	if _, ok := updated.Annotations[ua.ApprovedAnnotation]; ok {
+		t.Fatal("expected node not to be approved while health checks fail")
+	}
+
+	gotNG := &v1.NodeGroup{}
+	if err := r.Client.Get(context.Background(), types.NamespacedName{Name: "worker"}, gotNG); err != nil {
+		t.Fatalf("get nodegroup: %v", err)
+	}
+	if !meta.IsStatusConditionTrue(gotNG.Status.Conditions, "UpdatePaused") {
+		t.Fatalf("expected UpdatePaused condition to be True, got %+v", gotNG.Status.Conditions)
+	}
+}
+
+func TestReconcile_HealthCheckPassed_NodeApproved(t *testing.T) {
+	ng := &v1.NodeGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "worker"},
+		Spec: v1.NodeGroupSpec{
+			NodeType: v1.NodeTypeStatic,
+			Update: &v1.UpdateSpec{HealthChecks: &v1.UpdateHealthChecksSpec{
+				Workloads: []v1.WorkloadHealthCheck{{Kind: "Deployment", Namespace: "app", Name: "web"}},
+			}},
+		},
+		Status: v1.NodeGroupStatus{Conditions: []metav1.Condition{
+			{Type: "UpdatePaused", Status: metav1.ConditionTrue, Message: "Deployment app/web not found"},
+		}},
+	}
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "app", Name: "web"},
+		Status:     appsv1.DeploymentStatus{UpdatedReplicas: 1, AvailableReplicas: 1},
+	}
+	secret := makeChecksumSecret(map[string]string{"worker": "abc123"})
+	node := makeReadyNode("n1", "worker", map[string]string{
+		ua.ConfigurationChecksumAnnotation: "old-checksum",
+		ua.WaitingForApprovalAnnotation:    "",
+	})
+
+	r := newTestReconciler(t, ng, deployment, secret, node)
+	reconcileUA(t, r, "worker")
+
+	updated := getNode(t, r, "n1")
+	if _, ok := updated.Annotations[ua.ApprovedAnnotation]; !ok {
+		t.Fatal("expected approved annotation to be set")
+	}
+
+	gotNG := &v1.NodeGroup{}
+	if err := r.Client.Get(context.Background(), types.NamespacedName{Name: "worker"}, gotNG); err != nil {
+		t.Fatalf("get nodegroup: %v", err)
+	}
+	if !meta.IsStatusConditionFalse(gotNG.Status.Conditions, "UpdatePaused") {
+		t.Fatalf("expected UpdatePaused condition to be False, got %+v", gotNG.Status.Conditions)
+	}
+}
+
+func TestReconcile_Canary_OnlyCanaryNodesApproved(t *testing.T) {
+	maxConcurrent := intstr.FromInt32(2)
+	ng := &v1.NodeGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "worker"},
+		Spec: v1.NodeGroupSpec{
+			NodeType: v1.NodeTypeStatic,
+			Update: &v1.UpdateSpec{
+				MaxConcurrent: &maxConcurrent,
+				Canary:        &v1.CanaryUpdateSpec{Nodes: 1},
+			},
+		},
+	}
+	secret := makeChecksumSecret(map[string]string{"worker": "abc123"})
+	n1 := makeReadyNode("n1", "worker", map[string]string{
+		ua.ConfigurationChecksumAnnotation: "old",
+		ua.WaitingForApprovalAnnotation:    "",
+	})
+	n2 := makeReadyNode("n2", "worker", map[string]string{
+		ua.ConfigurationChecksumAnnotation: "old",
+		ua.WaitingForApprovalAnnotation:    "",
+	})
+
+	r := newTestReconciler(t, ng, secret, n1, n2)
+	reconcileUA(t, r, "worker")
+
+	approved := 0
+	for _, name := range []string{"n1", "n2"} {
+		if _, ok := getNode(t, r, name).Annotations[ua.ApprovedAnnotation]; ok {
+			approved++
+		}
+	}
+	if approved != 1 {
+		t.Fatalf("expected exactly 1 canary node approved, got %d", approved)
+	}
+
+	gotNG := &v1.NodeGroup{}
+	if err := r.Client.Get(context.Background(), types.NamespacedName{Name: "worker"}, gotNG); err != nil {
+		t.Fatalf("get nodegroup: %v", err)
+	}
+	if gotNG.Status.Update == nil || gotNG.Status.Update.Phase != v1.UpdateRolloutPhaseCanary {
+		t.Fatalf("expected canary rollout phase, got %+v", gotNG.Status.Update)
+	}
+	if len(gotNG.Status.Update.CanaryNodes) != 1 {
+		t.Fatalf("expected 1 canary node in status, got %v", gotNG.Status.Update.CanaryNodes)
+	}
+}
+
+func TestReconcile_CanaryFailed_UpdatesPaused(t *testing.T) {
+	maxConcurrent := intstr.FromInt32(2)
+	ng := &v1.NodeGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "worker"},
+		Spec: v1.NodeGroupSpec{
+			NodeType: v1.NodeTypeStatic,
+			Update: &v1.UpdateSpec{
+				MaxConcurrent: &maxConcurrent,
+				Canary:        &v1.CanaryUpdateSpec{Nodes: 1},
+			},
+		},
+		Status: v1.NodeGroupStatus{Update: &v1.UpdateRolloutStatus{
+			Checksum:       "abc123",
+			Phase:          v1.UpdateRolloutPhaseCanary,
+			PhaseStartedAt: &metav1.Time{Time: time.Now()},
+			CanaryNodes:    []string{"n1"},
+		}},
+	}
+	instance := &deckhousev1alpha2.Instance{
+		ObjectMeta: metav1.ObjectMeta{Name: "n1"},
+		Status: deckhousev1alpha2.InstanceStatus{
+			BashibleStatus: deckhousev1alpha2.BashibleStatusError,
+			Message:        "step 032_configure_containerd failed",
+		},
+	}
+	secret := makeChecksumSecret(map[string]string{"worker": "abc123"})
+	n1 := makeReadyNode("n1", "worker", map[string]string{
+		ua.ConfigurationChecksumAnnotation: "old",
+		ua.ApprovedAnnotation:              "",
+	})
+	n2 := makeReadyNode("n2", "worker", map[string]string{
+		ua.ConfigurationChecksumAnnotation: "old",
+		ua.WaitingForApprovalAnnotation:    "",
+	})
+
+	r := newTestReconciler(t, ng, instance, secret, n1, n2)
+	reconcileUA(t, r, "worker")
+
+	if _, ok := getNode(t, r, "n2").Annotations[ua.ApprovedAnnotation]; ok {
+		t.Fatal("expected n2 not to be approved after canary failure")
+	}
+
+	gotNG := &v1.NodeGroup{}
+	if err := r.Client.Get(context.Background(), types.NamespacedName{Name: "worker"}, gotNG); err != nil {
+		t.Fatalf("get nodegroup: %v", err)
+	}
+	st := gotNG.Status.Update
+	if st == nil || st.Phase != v1.UpdateRolloutPhasePaused {
+		t.Fatalf("expected paused rollout, got %+v", st)
+	}
+	if st.FailedNode != "n1" || st.FailedStep != "Bashible" {
+		t.Fatalf("unexpected failure %q on %q", st.FailedStep, st.FailedNode)
+	}
+	if !meta.IsStatusConditionTrue(gotNG.Status.Conditions, "UpdatePaused") {
+		t.Fatalf("expected UpdatePaused condition to be True, got %+v", gotNG.Status.Conditions)
+	}
+}
+
 func TestReconcile_UpToDate_CleanedUp(t *testing.T) {
 	ng := &v1.NodeGroup{
 		ObjectMeta: metav1.ObjectMeta{Name: "worker"},
@@ -0,0 +1,212 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/deckhouse/node-controller/api/deckhouse.io/v1"
+	ua "github.com/deckhouse/node-controller/internal/controller/updateapproval/common"
+)
+
+const (
+	DefaultCanarySoakPeriod = 10 * time.Minute
+	DefaultCanaryTimeout    = 30 * time.Minute
+)
+
+// Canary steps reported in status.update.failedStep.
+const (
+	CanaryStepBashible  = "Bashible"
+	CanaryStepNodeReady = "NodeReady"
+	CanaryStepDrain     = "Drain"
+	CanaryStepUpdate    = "Update"
+)
+
+func CanarySoakPeriod(spec *v1.CanaryUpdateSpec) time.Duration {
+	if spec.SoakPeriodSeconds != nil {
+		return time.Duration(*spec.SoakPeriodSeconds) * time.Second
+	}
+	return DefaultCanarySoakPeriod
+}
+
+func CanaryTimeout(spec *v1.CanaryUpdateSpec) time.Duration {
+	if spec.TimeoutSeconds != nil {
+		return time.Duration(*spec.TimeoutSeconds) * time.Second
+	}
+	return DefaultCanaryTimeout
+}
+
+// NextRolloutStatus advances the canary rollout of ngChecksum. bashibleErrors maps canary
+// node names to the error reported by bashible on them.
+func NextRolloutStatus(
+	spec *v1.CanaryUpdateSpec,
+	cur *v1.UpdateRolloutStatus,
+	nodes []ua.NodeInfo,
+	ngChecksum string,
+	bashibleErrors map[string]string,
+	now time.Time,
+) *v1.UpdateRolloutStatus {
+	if cur == nil || cur.Checksum != ngChecksum {
+		return &v1.UpdateRolloutStatus{
+			Checksum:       ngChecksum,
+			Phase:          v1.UpdateRolloutPhaseCanary,
+			PhaseStartedAt: &metav1.Time{Time: now},
+		}
+	}
+
+	st := cur.DeepCopy()
+	byName := make(map[string]ua.NodeInfo, len(nodes))
+	for _, node := range nodes {
+		byName[node.Name] = node
+	}
+
+	switch st.Phase {
+	case v1.UpdateRolloutPhaseCanary:
+		if len(st.CanaryNodes) == 0 {
+			if allUpToDate(nodes, ngChecksum) {
+				setPhase(st, v1.UpdateRolloutPhaseProgressing, now)
+			}
+			return st
+		}
+		if failCanary(st, byName, bashibleErrors, false, now) {
+			return st
+		}
+
+		done := true
+		for _, name := range st.CanaryNodes {
+			node, ok := byName[name]
+			if ok && !isNodeUpdated(node, ngChecksum) {
+				done = false
+				break
+			}
+		}
+
+		if !done {
+			if st.PhaseStartedAt != nil && now.Sub(st.PhaseStartedAt.Time) > CanaryTimeout(spec) {
+				failCanaryTimeout(st, byName, ngChecksum, now)
+			}
+			return st
+		}
+
+		if len(st.CanaryNodes) >= int(spec.Nodes) || !hasWaiting(nodes, st.CanaryNodes) {
+			setPhase(st, v1.UpdateRolloutPhaseSoaking, now)
+		}
+
+	case v1.UpdateRolloutPhaseSoaking:
+		if failCanary(st, byName, bashibleErrors, true, now) {
+			return st
+		}
+		if st.PhaseStartedAt == nil || now.Sub(st.PhaseStartedAt.Time) >= CanarySoakPeriod(spec) {
+			setPhase(st, v1.UpdateRolloutPhaseProgressing, now)
+		}
+	}
+
+	return st
+}
+
+// CanaryApprovalLimit returns how many more nodes may be approved in the current phase;
+// a negative value means the rollout is limited by MaxConcurrent only.
+func CanaryApprovalLimit(spec *v1.CanaryUpdateSpec, st *v1.UpdateRolloutStatus) int {
+	switch st.Phase {
+	case v1.UpdateRolloutPhaseCanary:
+		if remaining := int(spec.Nodes) - len(st.CanaryNodes); remaining > 0 {
+			return remaining
+		}
+		return 0
+	case v1.UpdateRolloutPhaseSoaking, v1.UpdateRolloutPhasePaused:
+		return 0
+	default:
+		return -1
+	}
+}
+
+func failCanary(st *v1.UpdateRolloutStatus, byName map[string]ua.NodeInfo, bashibleErrors map[string]string, requireReady bool, now time.Time) bool {
+	for _, name := range st.CanaryNodes {
+		if msg, ok := bashibleErrors[name]; ok {
+			pause(st, CanaryStepBashible, name, fmt.Sprintf("bashible failed on canary node %s: %s", name, msg), now)
+			return true
+		}
+		node, ok := byName[name]
+		if requireReady && ok && !node.IsReady {
+			pause(st, CanaryStepNodeReady, name, fmt.Sprintf("canary node %s is not Ready", name), now)
+			return true
+		}
+	}
+	return false
+}
+
+func failCanaryTimeout(st *v1.UpdateRolloutStatus, byName map[string]ua.NodeInfo, ngChecksum string, now time.Time) {
+	for _, name := range st.CanaryNodes {
+		node, ok := byName[name]
+		if !ok || isNodeUpdated(node, ngChecksum) {
+			continue
+		}
+		switch {
+		case node.IsDraining:
+			pause(st, CanaryStepDrain, name, fmt.Sprintf("canary node %s was not drained in time", name), now)
+		case !node.IsReady:
+			pause(st, CanaryStepNodeReady, name, fmt.Sprintf("canary node %s did not become Ready in time", name), now)
+		default:
+			pause(st, CanaryStepUpdate, name, fmt.Sprintf("canary node %s was not updated in time", name), now)
+		}
+		return
+	}
+}
+
+func pause(st *v1.UpdateRolloutStatus, step, node, msg string, now time.Time) {
+	setPhase(st, v1.UpdateRolloutPhasePaused, now)
+	st.FailedStep = step
+	st.FailedNode = node
+	st.Message = msg
+}
+
+func setPhase(st *v1.UpdateRolloutStatus, phase v1.UpdateRolloutPhase, now time.Time) {
+	st.Phase = phase
+	st.PhaseStartedAt = &metav1.Time{Time: now}
+}
+
+func isNodeUpdated(node ua.NodeInfo, ngChecksum string) bool {
+	return node.ConfigurationChecksum == ngChecksum && !node.IsApproved && node.IsReady
+}
+
+func allUpToDate(nodes []ua.NodeInfo, ngChecksum string) bool {
+	for _, node := range nodes {
+		if node.ConfigurationChecksum != ngChecksum || node.IsApproved || node.IsWaitingForApproval {
+			return false
+		}
+	}
+	return true
+}
+
+func hasWaiting(nodes []ua.NodeInfo, exclude []string) bool {
+	skip := make(map[string]struct{}, len(exclude))
+	for _, name := range exclude {
+		skip[name] = struct{}{}
+	}
+	for _, node := range nodes {
+		if _, ok := skip[node.Name]; ok {
+			continue
+		}
+		if node.IsWaitingForApproval {
+			return true
+		}
+	}
+	return false
+}
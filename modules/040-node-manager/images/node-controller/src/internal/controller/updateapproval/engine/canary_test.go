@@ -0,0 +1,177 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/deckhouse/node-controller/api/deckhouse.io/v1"
+	ua "github.com/deckhouse/node-controller/internal/controller/updateapproval/common"
+)
+
+func TestNextRolloutStatus(t *testing.T) {
+	const ngChecksum = "abc"
+	now := time.Date(2021, 1, 1, 13, 30, 0, 0, time.UTC)
+	started := func(ago time.Duration) *metav1.Time {
+		return &metav1.Time{Time: now.Add(-ago)}
+	}
+	updated := func(name string) ua.NodeInfo {
+		return nodeInfo(name, func(n *ua.NodeInfo) {
+			n.ConfigurationChecksum = ngChecksum
+			n.IsReady = true
+		})
+	}
+	waiting := func(name string) ua.NodeInfo {
+		return nodeInfo(name, func(n *ua.NodeInfo) {
+			n.ConfigurationChecksum = "old"
+			n.IsReady = true
+			n.IsWaitingForApproval = true
+		})
+	}
+	approved := func(name string, mutate func(*ua.NodeInfo)) ua.NodeInfo {
+		return nodeInfo(name, func(n *ua.NodeInfo) {
+			n.ConfigurationChecksum = "old"
+			n.IsReady = true
+			n.IsApproved = true
+			if mutate != nil {
+				mutate(n)
+			}
+		})
+	}
+	spec := &v1.CanaryUpdateSpec{Nodes: 1}
+
+	tests := []struct {
+		name           string
+		cur            *v1.UpdateRolloutStatus
+		nodes          []ua.NodeInfo
+		bashibleErrors map[string]string
+		wantPhase      v1.UpdateRolloutPhase
+		wantFailedStep string
+		wantLimit      int
+	}{
+		{
+			name:      "new checksum starts canary",
+			cur:       &v1.UpdateRolloutStatus{Checksum: "old", Phase: v1.UpdateRolloutPhaseProgressing},
+			nodes:     []ua.NodeInfo{waiting("n1"), waiting("n2")},
+			wantPhase: v1.UpdateRolloutPhaseCanary,
+			wantLimit: 1,
+		},
+		{
+			name:      "nothing to update skips canary",
+			cur:       &v1.UpdateRolloutStatus{Checksum: ngChecksum, Phase: v1.UpdateRolloutPhaseCanary, PhaseStartedAt: started(0)},
+			nodes:     []ua.NodeInfo{updated("n1")},
+			wantPhase: v1.UpdateRolloutPhaseProgressing,
+			wantLimit: -1,
+		},
+		{
+			name:      "canary node is updating",
+			cur:       &v1.UpdateRolloutStatus{Checksum: ngChecksum, Phase: v1.UpdateRolloutPhaseCanary, PhaseStartedAt: started(time.Minute), CanaryNodes: []string{"n1"}},
+			nodes:     []ua.NodeInfo{approved("n1", nil), waiting("n2")},
+			wantPhase: v1.UpdateRolloutPhaseCanary,
+			wantLimit: 0,
+		},
+		{
+			name:           "bashible failure pauses rollout",
+			cur:            &v1.UpdateRolloutStatus{Checksum: ngChecksum, Phase: v1.UpdateRolloutPhaseCanary, PhaseStartedAt: started(time.Minute), CanaryNodes: []string{"n1"}},
+			nodes:          []ua.NodeInfo{approved("n1", nil), waiting("n2")},
+			bashibleErrors: map[string]string{"n1": "step failed"},
+			wantPhase:      v1.UpdateRolloutPhasePaused,
+			wantFailedStep: CanaryStepBashible,
+			wantLimit:      0,
+		},
+		{
+			name: "drain timeout pauses rollout",
+			cur:  &v1.UpdateRolloutStatus{Checksum: ngChecksum, Phase: v1.UpdateRolloutPhaseCanary, PhaseStartedAt: started(time.Hour), CanaryNodes: []string{"n1"}},
+			nodes: []ua.NodeInfo{approved("n1", func(n *ua.NodeInfo) {
+				n.IsDraining = true
+			}), waiting("n2")},
+			wantPhase:      v1.UpdateRolloutPhasePaused,
+			wantFailedStep: CanaryStepDrain,
+			wantLimit:      0,
+		},
+		{
+			name: "not ready timeout pauses rollout",
+			cur:  &v1.UpdateRolloutStatus{Checksum: ngChecksum, Phase: v1.UpdateRolloutPhaseCanary, PhaseStartedAt: started(time.Hour), CanaryNodes: []string{"n1"}},
+			nodes: []ua.NodeInfo{approved("n1", func(n *ua.NodeInfo) {
+				n.IsReady = false
+			}), waiting("n2")},
+			wantPhase:      v1.UpdateRolloutPhasePaused,
+			wantFailedStep: CanaryStepNodeReady,
+			wantLimit:      0,
+		},
+		{
+			name:      "updated canary starts soaking",
+			cur:       &v1.UpdateRolloutStatus{Checksum: ngChecksum, Phase: v1.UpdateRolloutPhaseCanary, PhaseStartedAt: started(time.Minute), CanaryNodes: []string{"n1"}},
+			nodes:     []ua.NodeInfo{updated("n1"), waiting("n2")},
+			wantPhase: v1.UpdateRolloutPhaseSoaking,
+			wantLimit: 0,
+		},
+		{
+			name: "canary not ready while soaking pauses rollout",
+			cur:  &v1.UpdateRolloutStatus{Checksum: ngChecksum, Phase: v1.UpdateRolloutPhaseSoaking, PhaseStartedAt: started(time.Minute), CanaryNodes: []string{"n1"}},
+			nodes: []ua.NodeInfo{nodeInfo("n1", func(n *ua.NodeInfo) {
+				n.ConfigurationChecksum = ngChecksum
+			}), waiting("n2")},
+			wantPhase:      v1.UpdateRolloutPhasePaused,
+			wantFailedStep: CanaryStepNodeReady,
+			wantLimit:      0,
+		},
+		{
+			name:      "soak period not elapsed",
+			cur:       &v1.UpdateRolloutStatus{Checksum: ngChecksum, Phase: v1.UpdateRolloutPhaseSoaking, PhaseStartedAt: started(time.Minute), CanaryNodes: []string{"n1"}},
+			nodes:     []ua.NodeInfo{updated("n1"), waiting("n2")},
+			wantPhase: v1.UpdateRolloutPhaseSoaking,
+			wantLimit: 0,
+		},
+		{
+			name:      "soak period elapsed continues rollout",
+			cur:       &v1.UpdateRolloutStatus{Checksum: ngChecksum, Phase: v1.UpdateRolloutPhaseSoaking, PhaseStartedAt: started(DefaultCanarySoakPeriod), CanaryNodes: []string{"n1"}},
+			nodes:     []ua.NodeInfo{updated("n1"), waiting("n2")},
+			wantPhase: v1.UpdateRolloutPhaseProgressing,
+			wantLimit: -1,
+		},
+		{
+			name:           "paused rollout stays paused",
+			cur:            &v1.UpdateRolloutStatus{Checksum: ngChecksum, Phase: v1.UpdateRolloutPhasePaused, PhaseStartedAt: started(time.Hour), CanaryNodes: []string{"n1"}, FailedStep: CanaryStepBashible, FailedNode: "n1"},
+			nodes:          []ua.NodeInfo{updated("n1"), waiting("n2")},
+			wantPhase:      v1.UpdateRolloutPhasePaused,
+			wantFailedStep: CanaryStepBashible,
+			wantLimit:      0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NextRolloutStatus(spec, tt.cur, tt.nodes, ngChecksum, tt.bashibleErrors, now)
+			if got.Phase != tt.wantPhase {
+				t.Fatalf("expected phase %s, got %s (%s)", tt.wantPhase, got.Phase, got.Message)
+			}
+			if got.FailedStep != tt.wantFailedStep {
+				t.Fatalf("expected failed step %q, got %q", tt.wantFailedStep, got.FailedStep)
+			}
+			if got.Phase == v1.UpdateRolloutPhasePaused && got.FailedNode != "n1" {
+				t.Fatalf("expected failed node n1, got %q", got.FailedNode)
+			}
+			if limit := CanaryApprovalLimit(spec, got); limit != tt.wantLimit {
+				t.Fatalf("expected approval limit %d, got %d", tt.wantLimit, limit)
+			}
+		})
+	}
+}
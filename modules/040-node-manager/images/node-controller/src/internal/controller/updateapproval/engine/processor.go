@@ -121,6 +121,17 @@ func (p Processor) ApproveDisruptions(ctx context.Context, ng *v1.NodeGroup, nod
 		now = time.Date(2021, 1, 1, 13, 30, 0, 0, time.UTC)
 	}
 
+	var maxConcurrentZones *int32
+	if ng.Spec.Disruptions != nil && approvalMode != "Manual" {
+		if blackout, ok := ua.ActiveBlackout(ng.Spec.Disruptions.Blackouts, now); ok {
+			logger.V(1).Info("disruptions are blocked by blackout period", "nodegroup", ng.Name,
+				"from", blackout.From, "to", blackout.To, "reason", blackout.Reason)
+			return false, nil
+		}
+		maxConcurrentZones = ng.Spec.Disruptions.MaxConcurrentZones
+	}
+	disruptedZones := ua.DisruptedZones(nodes)
+
 	for _, node := range nodes {
 		if !node.IsApproved || node.IsDraining || (!node.IsDisruptionRequired && !node.IsRollingUpdate) || node.IsDisruptionApproved {
 			continue
@@ -147,6 +158,11 @@ func (p Processor) ApproveDisruptions(ctx context.Context, ng *v1.NodeGroup, nod
 			}
 		}
 
+		if !ua.IsZoneAllowed(node.Zone, disruptedZones, maxConcurrentZones) {
+			logger.V(1).Info("skip disruption, another zone is being disrupted", "node", node.Name, "nodegroup", ng.Name, "zone", node.Zone)
+			continue
+		}
+
 		switch {
 		case approvalMode == "RollingUpdate":
 			logger.Info("deleting instance for rolling update", "node", node.Name, "nodegroup", ng.Name)
@@ -195,6 +211,13 @@ func (p Processor) ApproveDisruptions(ctx context.Context, ng *v1.NodeGroup, nod
 }
 
 func (p Processor) ApproveUpdates(ctx context.Context, ng *v1.NodeGroup, nodes []ua.NodeInfo) (bool, error) {
+	approved, err := p.ApproveUpdatesWithLimit(ctx, ng, nodes, -1)
+	return len(approved) > 0, err
+}
+
+// ApproveUpdatesWithLimit approves at most limit nodes on top of MaxConcurrent and returns
+// their names. A negative limit means no additional limit.
+func (p Processor) ApproveUpdatesWithLimit(ctx context.Context, ng *v1.NodeGroup, nodes []ua.NodeInfo, limit int) ([]string, error) {
 	logger := log.FromContext(ctx)
 	var maxConcurrent = ng.Spec.Update
 	var max *intstr.IntOrString
@@ -214,10 +237,16 @@ func (p Processor) ApproveUpdates(ctx context.Context, ng *v1.NodeGroup, nodes [
 		}
 	}
 	if currentUpdates >= concurrency || !hasWaiting {
-		return false, nil
+		return nil, nil
 	}
 
 	countToApprove := concurrency - currentUpdates
+	if limit >= 0 && limit < countToApprove {
+		countToApprove = limit
+	}
+	if countToApprove <= 0 {
+		return nil, nil
+	}
 	approvedNodes := make([]ua.NodeInfo, 0, countToApprove)
 
 	if ng.Status.Desired <= ng.Status.Ready || ng.Spec.NodeType != v1.NodeTypeCloudEphemeral {
@@ -262,9 +291,10 @@ func (p Processor) ApproveUpdates(ctx context.Context, ng *v1.NodeGroup, nodes [
 	}
 
 	if len(approvedNodes) == 0 {
-		return false, nil
+		return nil, nil
 	}
 
+	names := make([]string, 0, len(approvedNodes))
 	for _, node := range approvedNodes {
 		logger.Info("approving node update", "node", node.Name, "nodegroup", ng.Name)
 		patch := map[string]interface{}{
@@ -276,12 +306,13 @@ func (p Processor) ApproveUpdates(ctx context.Context, ng *v1.NodeGroup, nodes [
 			},
 		}
 		if err := p.Kube.PatchNode(ctx, node.Name, patch); err != nil {
-			return false, err
+			return names, err
 		}
+		names = append(names, node.Name)
 		uametrics.SetNodeStatusMetrics(node.Name, node.NodeGroup, "Approved")
 		p.Recorder.Event(ng, corev1.EventTypeNormal, "NodeApproved", "Update approved for node "+node.Name)
 	}
-	return true, nil
+	return names, nil
 }
 
 func (p Processor) NeedDrainNode(ctx context.Context, node *ua.NodeInfo, ng *v1.NodeGroup) bool {
@@ -300,6 +300,24 @@ func TestApproveDisruptions(t *testing.T) {
 			}),
 			wantFinished: false,
 		},
+		{
+			name: "automatic, inside blackout period is skipped",
+			ng: &v1.NodeGroup{
+				ObjectMeta: metav1.ObjectMeta{Name: "worker"},
+				Spec: v1.NodeGroupSpec{Disruptions: &v1.DisruptionsSpec{
+					ApprovalMode: v1.DisruptionApprovalModeAutomatic,
+					Automatic:    &v1.AutomaticDisruptionSpec{DrainBeforeApproval: ptr(false)},
+					Blackouts: []v1.DisruptionBlackout{
+						{From: "2020-12-25", To: "2021-01-08", Reason: "holidays"},
+					},
+				}},
+			},
+			nodeInfo: nodeInfo("n1", func(i *ua.NodeInfo) {
+				i.IsApproved = true
+				i.IsDisruptionRequired = true
+			}),
+			wantFinished: false,
+		},
 		{
 			name: "rolling update outside window is skipped",
 			ng: &v1.NodeGroup{
@@ -341,6 +359,50 @@ func TestApproveDisruptions(t *testing.T) {
 	}
 }
 
+func TestApproveDisruptions_OneZoneAtATime(t *testing.T) {
+	ng := &v1.NodeGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "worker"},
+		Status:     v1.NodeGroupStatus{Ready: 3, Nodes: 3},
+		Spec: v1.NodeGroupSpec{Disruptions: &v1.DisruptionsSpec{
+			ApprovalMode:       v1.DisruptionApprovalModeAutomatic,
+			Automatic:          &v1.AutomaticDisruptionSpec{DrainBeforeApproval: ptr(true)},
+			MaxConcurrentZones: ptr(int32(1)),
+		}},
+	}
+	nodes := []ua.NodeInfo{
+		nodeInfo("a1", func(i *ua.NodeInfo) {
+			i.Zone = "a"
+			i.IsApproved = true
+			i.IsDraining = true
+		}),
+		nodeInfo("b1", func(i *ua.NodeInfo) {
+			i.Zone = "b"
+			i.IsApproved = true
+			i.IsDisruptionRequired = true
+		}),
+		nodeInfo("a2", func(i *ua.NodeInfo) {
+			i.Zone = "a"
+			i.IsApproved = true
+			i.IsDisruptionRequired = true
+		}),
+	}
+	p, cl := newProcessor(t, node("a1", nil), node("b1", nil), node("a2", nil))
+
+	finished, err := p.ApproveDisruptions(context.Background(), ng, nodes)
+	if err != nil {
+		t.Fatalf("ApproveDisruptions: %v", err)
+	}
+	if !finished {
+		t.Fatal("expected finished = true")
+	}
+	if _, ok := getNodeAnnotations(t, cl, "b1")[ua.DrainingAnnotation]; ok {
+		t.Fatal("node b1 must not be drained while zone a is disrupted")
+	}
+	if _, ok := getNodeAnnotations(t, cl, "a2")[ua.DrainingAnnotation]; !ok {
+		t.Fatal("expected node a2 in the disrupted zone to be drained")
+	}
+}
+
 func TestApproveDisruptions_RollingUpdateDeletesInstance(t *testing.T) {
 	scheme := runtime.NewScheme()
 	if err := corev1.AddToScheme(scheme); err != nil {
@@ -0,0 +1,172 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package updateapproval
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	v1 "github.com/deckhouse/node-controller/api/deckhouse.io/v1"
+	calcconditions "github.com/deckhouse/node-controller/internal/controller/nodegroup/conditionscalc"
+	ua "github.com/deckhouse/node-controller/internal/controller/updateapproval/common"
+	"github.com/deckhouse/node-controller/internal/controller/updateapproval/engine"
+	"github.com/deckhouse/node-controller/internal/controller/updateapproval/healthcheck"
+	"github.com/deckhouse/node-controller/internal/controller/updateapproval/kubeclient"
+)
+
+const (
+	// healthCheckRetryInterval is how often failed update health checks are re-evaluated.
+	healthCheckRetryInterval = 30 * time.Second
+	// canaryPollInterval is how often canary nodes are re-evaluated while they are updated.
+	canaryPollInterval = 30 * time.Second
+)
+
+var updatePausedCondition = string(calcconditions.NodeGroupConditionTypeUpdatePaused)
+
+type updateGate struct {
+	// paused is set when no node may be approved until requeueAfter.
+	paused bool
+	// limit is the number of nodes that may be approved on top of MaxConcurrent, -1 for no limit.
+	limit        int
+	requeueAfter time.Duration
+}
+
+// gateUpdates advances the canary rollout and runs the update health checks before the
+// next node is approved. The outcome is persisted in status.update and the UpdatePaused
+// condition of the NodeGroup.
+func (r *Reconciler) gateUpdates(ctx context.Context, kubeSvc kubeclient.Client, ng *v1.NodeGroup, nodes []ua.NodeInfo, ngChecksum string) (updateGate, error) {
+	logger := log.FromContext(ctx)
+	gate := updateGate{limit: -1}
+
+	update := ng.Spec.Update
+
+	var rollout *v1.UpdateRolloutStatus
+	var pauseMessages []string
+
+	if update != nil && update.Canary != nil && ngChecksum != "" {
+		current := ng.Status.Update
+		if _, ok := ng.Annotations[ua.ResumeRolloutAnnotation]; ok {
+			if current != nil && current.Phase == v1.UpdateRolloutPhasePaused {
+				logger.Info("resuming paused canary rollout", "nodegroup", ng.Name)
+				current = nil
+			}
+			if err := kubeSvc.RemoveNodeGroupAnnotation(ctx, ng, ua.ResumeRolloutAnnotation); err != nil {
+				return gate, err
+			}
+		}
+
+		var canaryNodes []string
+		if current != nil {
+			canaryNodes = current.CanaryNodes
+		}
+		bashibleErrors, err := kubeSvc.GetBashibleErrors(ctx, canaryNodes)
+		if err != nil {
+			return gate, err
+		}
+
+		now := time.Now()
+		rollout = engine.NextRolloutStatus(update.Canary, current, nodes, ngChecksum, bashibleErrors, now)
+		gate.limit = engine.CanaryApprovalLimit(update.Canary, rollout)
+
+		switch rollout.Phase {
+		case v1.UpdateRolloutPhaseCanary:
+			gate.requeueAfter = canaryPollInterval
+		case v1.UpdateRolloutPhaseSoaking:
+			gate.paused = true
+			gate.requeueAfter = engine.CanarySoakPeriod(update.Canary) - now.Sub(rollout.PhaseStartedAt.Time)
+			if gate.requeueAfter <= 0 {
+				gate.requeueAfter = time.Second
+			}
+		case v1.UpdateRolloutPhasePaused:
+			pauseMessages = append(pauseMessages, "canary step "+rollout.FailedStep+" failed: "+rollout.Message)
+		}
+	}
+
+	if update != nil && update.HealthChecks != nil && len(pauseMessages) == 0 && !gate.paused && hasWaitingNodes(nodes) {
+		checker := healthcheck.Checker{Client: r.Client, HTTPClient: r.httpClient}
+		result, err := checker.Check(ctx, update.HealthChecks)
+		if err != nil {
+			return gate, err
+		}
+		if !result.Healthy {
+			logger.Info("update health checks failed, pausing updates", "nodegroup", ng.Name, "failures", result.Failures)
+			pauseMessages = append(pauseMessages, result.Message())
+			gate.requeueAfter = healthCheckRetryInterval
+		}
+	}
+
+	gated := update != nil && (update.Canary != nil || update.HealthChecks != nil)
+	paused := len(pauseMessages) > 0
+	message := strings.Join(pauseMessages, "; ")
+
+	wasPaused := meta.IsStatusConditionTrue(ng.Status.Conditions, updatePausedCondition)
+	if _, err := kubeSvc.PatchNodeGroupStatus(ctx, ng, func(status *v1.NodeGroupStatus) {
+		status.Update = rollout
+		switch {
+		case !gated:
+			meta.RemoveStatusCondition(&status.Conditions, updatePausedCondition)
+		case paused:
+			meta.SetStatusCondition(&status.Conditions, metav1.Condition{Type: updatePausedCondition, Status: metav1.ConditionTrue, Message: message})
+		default:
+			meta.SetStatusCondition(&status.Conditions, metav1.Condition{Type: updatePausedCondition, Status: metav1.ConditionFalse})
+		}
+	}); err != nil {
+		return gate, err
+	}
+
+	switch {
+	case paused && !wasPaused:
+		r.Recorder.Event(ng, corev1.EventTypeWarning, "UpdatePaused", "Node updates are paused: "+message)
+	case !paused && wasPaused:
+		logger.Info("update gates passed, resuming updates", "nodegroup", ng.Name)
+	}
+
+	if paused {
+		gate.paused = true
+	}
+	return gate, nil
+}
+
+// recordCanaryNodes stores nodes approved in the canary phase in status.update.
+func (r *Reconciler) recordCanaryNodes(ctx context.Context, kubeSvc kubeclient.Client, ng *v1.NodeGroup, approved []string) error {
+	if len(approved) == 0 || ng.Status.Update == nil || ng.Status.Update.Phase != v1.UpdateRolloutPhaseCanary {
+		return nil
+	}
+	_, err := kubeSvc.PatchNodeGroupStatus(ctx, ng, func(status *v1.NodeGroupStatus) {
+		if len(status.Update.CanaryNodes) == 0 {
+			// The canary timeout starts with the first approved node.
+			status.Update.PhaseStartedAt = &metav1.Time{Time: time.Now()}
+		}
+		status.Update.CanaryNodes = append(status.Update.CanaryNodes, approved...)
+	})
+	return err
+}
+
+func hasWaitingNodes(nodes []ua.NodeInfo) bool {
+	for _, node := range nodes {
+		if node.IsWaitingForApproval {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,328 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package healthcheck
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/deckhouse/node-controller/api/deckhouse.io/v1"
+)
+
+const defaultHTTPTimeout = 10 * time.Second
+
+// Result is the outcome of all health checks of a NodeGroup.
+type Result struct {
+	Healthy bool
+	// Failures contains a message per failed check.
+	Failures []string
+}
+
+func (r Result) Message() string {
+	return strings.Join(r.Failures, "; ")
+}
+
+// Checker evaluates UpdateHealthChecksSpec against the cluster.
+type Checker struct {
+	Client     client.Client
+	HTTPClient *http.Client
+}
+
+// Check runs every configured check. Unhealthy workloads and unreachable endpoints are
+// reported as failures; only errors talking to the apiserver are returned.
+func (c Checker) Check(ctx context.Context, spec *v1.UpdateHealthChecksSpec) (Result, error) {
+	var failures []string
+
+	if spec.PodDisruptionBudgets != nil {
+		f, err := c.checkPodDisruptionBudgets(ctx, spec.PodDisruptionBudgets)
+		if err != nil {
+			return Result{}, err
+		}
+		failures = append(failures, f...)
+	}
+
+	for _, w := range spec.Workloads {
+		f, err := c.checkWorkload(ctx, w)
+		if err != nil {
+			return Result{}, err
+		}
+		if f != "" {
+			failures = append(failures, f)
+		}
+	}
+
+	if spec.HTTP != nil {
+		if f := c.checkHTTP(ctx, spec.HTTP); f != "" {
+			failures = append(failures, f)
+		}
+	}
+
+	return Result{Healthy: len(failures) == 0, Failures: failures}, nil
+}
+
+func (c Checker) checkPodDisruptionBudgets(ctx context.Context, spec *v1.PodDisruptionBudgetsHealthCheck) ([]string, error) {
+	namespaces, err := c.selectNamespaces(ctx, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	var failures []string
+	for _, ns := range namespaces {
+		pdbs := &policyv1.PodDisruptionBudgetList{}
+		if err := c.Client.List(ctx, pdbs, client.InNamespace(ns)); err != nil {
+			return nil, fmt.Errorf("failed to list PodDisruptionBudgets: %w", err)
+		}
+		for _, pdb := range pdbs.Items {
+			if pdb.Status.ObservedGeneration < pdb.Generation {
+				failures = append(failures, fmt.Sprintf("PodDisruptionBudget %s/%s status is not observed yet", pdb.Namespace, pdb.Name))
+				continue
+			}
+			if pdb.Status.CurrentHealthy < pdb.Status.DesiredHealthy {
+				failures = append(failures, fmt.Sprintf("PodDisruptionBudget %s/%s has %d healthy pods, %d desired",
+					pdb.Namespace, pdb.Name, pdb.Status.CurrentHealthy, pdb.Status.DesiredHealthy))
+			}
+		}
+	}
+	return failures, nil
+}
+
+// selectNamespaces returns the listed and label-selected namespaces, or all namespaces
+// ("") if neither is set.
+func (c Checker) selectNamespaces(ctx context.Context, spec *v1.PodDisruptionBudgetsHealthCheck) ([]string, error) {
+	if len(spec.Namespaces) == 0 && spec.NamespaceSelector == nil {
+		return []string{metav1.NamespaceAll}, nil
+	}
+
+	seen := make(map[string]struct{})
+	namespaces := make([]string, 0, len(spec.Namespaces))
+	for _, ns := range spec.Namespaces {
+		if _, ok := seen[ns]; !ok {
+			seen[ns] = struct{}{}
+			namespaces = append(namespaces, ns)
+		}
+	}
+
+	if spec.NamespaceSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(spec.NamespaceSelector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid namespaceSelector: %w", err)
+		}
+		list := &corev1.NamespaceList{}
+		if err := c.Client.List(ctx, list, client.MatchingLabelsSelector{Selector: selector}); err != nil {
+			return nil, fmt.Errorf("failed to list namespaces: %w", err)
+		}
+		for _, ns := range list.Items {
+			if _, ok := seen[ns.Name]; !ok {
+				seen[ns.Name] = struct{}{}
+				namespaces = append(namespaces, ns.Name)
+			}
+		}
+	}
+	return namespaces, nil
+}
+
+func (c Checker) checkWorkload(ctx context.Context, w v1.WorkloadHealthCheck) (string, error) {
+	key := types.NamespacedName{Namespace: w.Namespace, Name: w.Name}
+	ref := w.Kind + " " + key.String()
+
+	var obj client.Object
+	switch w.Kind {
+	case "Deployment":
+		obj = &appsv1.Deployment{}
+	case "StatefulSet":
+		obj = &appsv1.StatefulSet{}
+	default:
+		return fmt.Sprintf("%s: unsupported kind", ref), nil
+	}
+
+	if err := c.Client.Get(ctx, key, obj); err != nil {
+		if errors.IsNotFound(err) {
+			return fmt.Sprintf("%s not found", ref), nil
+		}
+		return "", fmt.Errorf("failed to get %s: %w", ref, err)
+	}
+
+	var generation, observed int64
+	var desired, updated, available int32
+	switch o := obj.(type) {
+	case *appsv1.Deployment:
+		generation, observed = o.Generation, o.Status.ObservedGeneration
+		desired, updated, available = replicas(o.Spec.Replicas), o.Status.UpdatedReplicas, o.Status.AvailableReplicas
+	case *appsv1.StatefulSet:
+		generation, observed = o.Generation, o.Status.ObservedGeneration
+		desired, updated, available = replicas(o.Spec.Replicas), o.Status.UpdatedReplicas, o.Status.AvailableReplicas
+	}
+
+	switch {
+	case observed < generation:
+		return fmt.Sprintf("%s status is not observed yet", ref), nil
+	case updated < desired || available < desired:
+		return fmt.Sprintf("%s has %d/%d available and %d/%d updated replicas", ref, available, desired, updated, desired), nil
+	}
+	return "", nil
+}
+
+func replicas(r *int32) int32 {
+	if r == nil {
+		return 1
+	}
+	return *r
+}
+
+// checkHTTP evaluates the query with the Prometheus instant query API. The check passes
+// if the result has at least one sample and every sample value is non-zero and not NaN.
+func (c Checker) checkHTTP(ctx context.Context, spec *v1.HTTPHealthCheck) string {
+	timeout := defaultHTTPTimeout
+	if spec.TimeoutSeconds != nil && *spec.TimeoutSeconds > 0 {
+		timeout = time.Duration(*spec.TimeoutSeconds) * time.Second
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	u, err := url.Parse(spec.URL)
+	if err != nil {
+		return fmt.Sprintf("query endpoint: invalid url: %v", err)
+	}
+	q := u.Query()
+	q.Set("query", spec.Query)
+	u.RawQuery = q.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return fmt.Sprintf("query endpoint: %v", err)
+	}
+
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Sprintf("query endpoint: %v", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return fmt.Sprintf("query endpoint: failed to read response: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Sprintf("query endpoint returned %s", resp.Status)
+	}
+
+	healthy, err := EvaluateQueryResponse(body)
+	if err != nil {
+		return fmt.Sprintf("query endpoint: %v", err)
+	}
+	if !healthy {
+		return fmt.Sprintf("query %q is not healthy", spec.Query)
+	}
+	return ""
+}
+
+type queryResponse struct {
+	Status string `json:"status"`
+	Error  string `json:"error"`
+	Data   struct {
+		ResultType string          `json:"resultType"`
+		Result     json.RawMessage `json:"result"`
+	} `json:"data"`
+}
+
+type querySample struct {
+	Value []interface{} `json:"value"`
+}
+
+// EvaluateQueryResponse reports whether a Prometheus query API response is healthy.
+func EvaluateQueryResponse(body []byte) (bool, error) {
+	var resp queryResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return false, fmt.Errorf("failed to decode response: %w", err)
+	}
+	if resp.Status != "success" {
+		return false, fmt.Errorf("query failed: %s", resp.Error)
+	}
+
+	var values []string
+	switch resp.Data.ResultType {
+	case "vector":
+		var samples []querySample
+		if err := json.Unmarshal(resp.Data.Result, &samples); err != nil {
+			return false, fmt.Errorf("failed to decode vector: %w", err)
+		}
+		for _, s := range samples {
+			v, err := sampleValue(s.Value)
+			if err != nil {
+				return false, err
+			}
+			values = append(values, v)
+		}
+	case "scalar":
+		var sample []interface{}
+		if err := json.Unmarshal(resp.Data.Result, &sample); err != nil {
+			return false, fmt.Errorf("failed to decode scalar: %w", err)
+		}
+		v, err := sampleValue(sample)
+		if err != nil {
+			return false, err
+		}
+		values = append(values, v)
+	default:
+		return false, fmt.Errorf("unsupported result type %q", resp.Data.ResultType)
+	}
+
+	if len(values) == 0 {
+		return false, nil
+	}
+	for _, v := range values {
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return false, fmt.Errorf("invalid sample value %q", v)
+		}
+		if f == 0 || math.IsNaN(f) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func sampleValue(sample []interface{}) (string, error) {
+	if len(sample) != 2 {
+		return "", fmt.Errorf("invalid sample %v", sample)
+	}
+	v, ok := sample[1].(string)
+	if !ok {
+		return "", fmt.Errorf("invalid sample value %v", sample[1])
+	}
+	return v, nil
+}
@@ -0,0 +1,202 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package healthcheck
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	v1 "github.com/deckhouse/node-controller/api/deckhouse.io/v1"
+)
+
+func newChecker(t *testing.T, objs ...client.Object) Checker {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	for _, add := range []func(*runtime.Scheme) error{corev1.AddToScheme, appsv1.AddToScheme, policyv1.AddToScheme} {
+		if err := add(scheme); err != nil {
+			t.Fatalf("add scheme: %v", err)
+		}
+	}
+	cl := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+	return Checker{Client: cl}
+}
+
+func ptr[T any](v T) *T { return &v }
+
+func pdb(ns, name string, current, desired int32) *policyv1.PodDisruptionBudget {
+	return &policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: name},
+		Status:     policyv1.PodDisruptionBudgetStatus{CurrentHealthy: current, DesiredHealthy: desired},
+	}
+}
+
+func TestCheck_PodDisruptionBudgets(t *testing.T) {
+	prod := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "prod", Labels: map[string]string{"tier": "prod"}}}
+	dev := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "dev"}}
+	c := newChecker(t, prod, dev, pdb("prod", "db", 2, 2), pdb("dev", "web", 0, 1))
+
+	tests := []struct {
+		name        string
+		spec        *v1.PodDisruptionBudgetsHealthCheck
+		wantHealthy bool
+	}{
+		{name: "satisfied in listed namespace", spec: &v1.PodDisruptionBudgetsHealthCheck{Namespaces: []string{"prod"}}, wantHealthy: true},
+		{name: "violated in listed namespace", spec: &v1.PodDisruptionBudgetsHealthCheck{Namespaces: []string{"dev"}}, wantHealthy: false},
+		{
+			name: "satisfied in selected namespace",
+			spec: &v1.PodDisruptionBudgetsHealthCheck{
+				NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "prod"}},
+			},
+			wantHealthy: true,
+		},
+		{name: "all namespaces", spec: &v1.PodDisruptionBudgetsHealthCheck{}, wantHealthy: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := c.Check(context.Background(), &v1.UpdateHealthChecksSpec{PodDisruptionBudgets: tt.spec})
+			if err != nil {
+				t.Fatalf("Check: %v", err)
+			}
+			if res.Healthy != tt.wantHealthy {
+				t.Fatalf("Healthy = %v, want %v (failures: %v)", res.Healthy, tt.wantHealthy, res.Failures)
+			}
+		})
+	}
+}
+
+func TestCheck_Workloads(t *testing.T) {
+	ready := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "app", Name: "ready", Generation: 2},
+		Spec:       appsv1.DeploymentSpec{Replicas: ptr(int32(3))},
+		Status:     appsv1.DeploymentStatus{ObservedGeneration: 2, UpdatedReplicas: 3, AvailableReplicas: 3},
+	}
+	degraded := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "app", Name: "degraded", Generation: 1},
+		Spec:       appsv1.DeploymentSpec{Replicas: ptr(int32(3))},
+		Status:     appsv1.DeploymentStatus{ObservedGeneration: 1, UpdatedReplicas: 3, AvailableReplicas: 2},
+	}
+	rolling := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "app", Name: "db", Generation: 3},
+		Spec:       appsv1.StatefulSetSpec{Replicas: ptr(int32(2))},
+		Status:     appsv1.StatefulSetStatus{ObservedGeneration: 2, UpdatedReplicas: 2, AvailableReplicas: 2},
+	}
+	c := newChecker(t, ready, degraded, rolling)
+
+	tests := []struct {
+		name        string
+		workload    v1.WorkloadHealthCheck
+		wantHealthy bool
+	}{
+		{name: "available deployment", workload: v1.WorkloadHealthCheck{Kind: "Deployment", Namespace: "app", Name: "ready"}, wantHealthy: true},
+		{name: "degraded deployment", workload: v1.WorkloadHealthCheck{Kind: "Deployment", Namespace: "app", Name: "degraded"}, wantHealthy: false},
+		{name: "statefulset not observed", workload: v1.WorkloadHealthCheck{Kind: "StatefulSet", Namespace: "app", Name: "db"}, wantHealthy: false},
+		{name: "missing workload", workload: v1.WorkloadHealthCheck{Kind: "Deployment", Namespace: "app", Name: "missing"}, wantHealthy: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := c.Check(context.Background(), &v1.UpdateHealthChecksSpec{Workloads: []v1.WorkloadHealthCheck{tt.workload}})
+			if err != nil {
+				t.Fatalf("Check: %v", err)
+			}
+			if res.Healthy != tt.wantHealthy {
+				t.Fatalf("Healthy = %v, want %v (failures: %v)", res.Healthy, tt.wantHealthy, res.Failures)
+			}
+		})
+	}
+}
+
+func TestCheck_HTTP(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("query") {
+		case "healthy":
+			_, _ = w.Write([]byte(`{"status":"success","data":{"resultType":"vector","result":[{"metric":{},"value":[1700000000,"1"]}]}}`))
+		case "unhealthy":
+			_, _ = w.Write([]byte(`{"status":"success","data":{"resultType":"vector","result":[{"metric":{},"value":[1700000000,"0"]}]}}`))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer srv.Close()
+
+	c := newChecker(t)
+	c.HTTPClient = srv.Client()
+
+	tests := []struct {
+		query       string
+		wantHealthy bool
+	}{
+		{query: "healthy", wantHealthy: true},
+		{query: "unhealthy", wantHealthy: false},
+		{query: "broken", wantHealthy: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			res, err := c.Check(context.Background(), &v1.UpdateHealthChecksSpec{
+				HTTP: &v1.HTTPHealthCheck{URL: srv.URL + "/api/v1/query", Query: tt.query},
+			})
+			if err != nil {
+				t.Fatalf("Check: %v", err)
+			}
+			if res.Healthy != tt.wantHealthy {
+				t.Fatalf("Healthy = %v, want %v (failures: %v)", res.Healthy, tt.wantHealthy, res.Failures)
+			}
+		})
+	}
+}
+
+func TestEvaluateQueryResponse(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		want    bool
+		wantErr bool
+	}{
+		{name: "empty vector", body: `{"status":"success","data":{"resultType":"vector","result":[]}}`, want: false},
+		{name: "all non-zero", body: `{"status":"success","data":{"resultType":"vector","result":[{"value":[1,"1"]},{"value":[1,"0.5"]}]}}`, want: true},
+		{name: "one zero", body: `{"status":"success","data":{"resultType":"vector","result":[{"value":[1,"1"]},{"value":[1,"0"]}]}}`, want: false},
+		{name: "NaN", body: `{"status":"success","data":{"resultType":"vector","result":[{"value":[1,"NaN"]}]}}`, want: false},
+		{name: "scalar", body: `{"status":"success","data":{"resultType":"scalar","result":[1,"1"]}}`, want: true},
+		{name: "query error", body: `{"status":"error","error":"parse error"}`, wantErr: true},
+		{name: "matrix is unsupported", body: `{"status":"success","data":{"resultType":"matrix","result":[]}}`, wantErr: true},
+		{name: "invalid json", body: `{`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := EvaluateQueryResponse([]byte(tt.body))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("EvaluateQueryResponse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Fatalf("EvaluateQueryResponse() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -22,12 +22,15 @@ import (
 	"fmt"
 
 	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	v1 "github.com/deckhouse/node-controller/api/deckhouse.io/v1"
+	deckhousev1alpha2 "github.com/deckhouse/node-controller/api/deckhouse.io/v1alpha2"
 	nodecommon "github.com/deckhouse/node-controller/internal/common"
 )
 
@@ -69,3 +72,50 @@ func (c Client) DeleteInstance(ctx context.Context, instanceName string) error {
 	}
 	return nil
 }
+
+// PatchNodeGroupStatus applies mutate to the NodeGroup status and patches it if it has
+// changed. It reports whether the status was patched.
+func (c Client) PatchNodeGroupStatus(ctx context.Context, ng *v1.NodeGroup, mutate func(*v1.NodeGroupStatus)) (bool, error) {
+	patch := client.MergeFromWithOptions(ng.DeepCopy(), client.MergeFromWithOptimisticLock{})
+	before := ng.Status.DeepCopy()
+	mutate(&ng.Status)
+	if apiequality.Semantic.DeepEqual(before, &ng.Status) {
+		return false, nil
+	}
+	if err := c.Client.Status().Patch(ctx, ng, patch); err != nil {
+		return false, fmt.Errorf("failed to patch nodegroup %s status: %w", ng.Name, err)
+	}
+	return true, nil
+}
+
+// RemoveNodeGroupAnnotation removes the annotation from the NodeGroup if present.
+func (c Client) RemoveNodeGroupAnnotation(ctx context.Context, ng *v1.NodeGroup, annotation string) error {
+	if _, ok := ng.Annotations[annotation]; !ok {
+		return nil
+	}
+	patch := client.MergeFrom(ng.DeepCopy())
+	delete(ng.Annotations, annotation)
+	if err := c.Client.Patch(ctx, ng, patch); err != nil {
+		return fmt.Errorf("failed to remove annotation %s from nodegroup %s: %w", annotation, ng.Name, err)
+	}
+	return nil
+}
+
+// GetBashibleErrors returns the bashible error message of every named node whose
+// Instance reports a bashible failure.
+func (c Client) GetBashibleErrors(ctx context.Context, nodeNames []string) (map[string]string, error) {
+	errs := make(map[string]string)
+	for _, name := range nodeNames {
+		instance := &deckhousev1alpha2.Instance{}
+		if err := c.Client.Get(ctx, types.NamespacedName{Name: name}, instance); err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to get instance %s: %w", name, err)
+		}
+		if instance.Status.BashibleStatus == deckhousev1alpha2.BashibleStatusError {
+			errs[name] = instance.Status.Message
+		}
+	}
+	return errs, nil
+}
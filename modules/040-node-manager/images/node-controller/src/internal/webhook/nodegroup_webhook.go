@@ -38,12 +38,15 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 	"reflect"
 	"regexp"
 	"strings"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
@@ -55,6 +58,7 @@ import (
 
 	v1 "github.com/deckhouse/node-controller/api/deckhouse.io/v1"
 	"github.com/deckhouse/node-controller/internal/clusterprefix"
+	ua "github.com/deckhouse/node-controller/internal/controller/updateapproval/common"
 )
 
 var webhookLog = logf.Log.WithName("nodegroup-webhook")
@@ -340,6 +344,12 @@ func (w *NodeGroupValidator) Handle(ctx context.Context, req admission.Request)
 		}
 	}
 
+	if ng.Spec.Update != nil && ng.Spec.Update.HealthChecks != nil {
+		if err := validateUpdateHealthChecks(ng.Spec.Update.HealthChecks); err != nil {
+			return admission.Denied(err.Error())
+		}
+	}
+
 	// Return with warnings if any
 	if len(warnings) > 0 {
 		return admission.Allowed("").WithWarnings(warnings...)
@@ -391,7 +401,7 @@ func validateLabelSelectorImmutability(oldNG, newNG *v1.NodeGroup) error {
 	return nil
 }
 
-// validateDisruptionWindows validates the format of disruption windows.
+// validateDisruptionWindows validates the format of disruption windows and blackout periods.
 func validateDisruptionWindows(d *v1.DisruptionsSpec) error {
 	timeRegex := regexp.MustCompile(`^(?:\d|[01]\d|2[0-3]):[0-5]\d$`)
 	validDays := map[string]bool{
@@ -412,10 +422,60 @@ func validateDisruptionWindows(d *v1.DisruptionsSpec) error {
 					return fmt.Errorf("%s[%d].days: invalid day %q, expected one of Mon,Tue,Wed,Thu,Fri,Sat,Sun", path, i, day)
 				}
 			}
+			if w.Timezone != "" {
+				if _, err := time.LoadLocation(w.Timezone); err != nil {
+					return fmt.Errorf("%s[%d].timezone: unknown timezone %q", path, i, w.Timezone)
+				}
+			}
+			var start, end time.Time
+			if w.StartDate != "" {
+				t, err := time.Parse(time.DateOnly, w.StartDate)
+				if err != nil {
+					return fmt.Errorf("%s[%d].startDate: invalid date format %q, expected YYYY-MM-DD", path, i, w.StartDate)
+				}
+				start = t
+			}
+			if w.EndDate != "" {
+				t, err := time.Parse(time.DateOnly, w.EndDate)
+				if err != nil {
+					return fmt.Errorf("%s[%d].endDate: invalid date format %q, expected YYYY-MM-DD", path, i, w.EndDate)
+				}
+				end = t
+			}
+			if !start.IsZero() && !end.IsZero() && end.Before(start) {
+				return fmt.Errorf("%s[%d]: endDate %q is before startDate %q", path, i, w.EndDate, w.StartDate)
+			}
 		}
 		return nil
 	}
 
+	for i, b := range d.Blackouts {
+		path := ".spec.disruptions.blackouts"
+		loc := time.UTC
+		if b.Timezone != "" {
+			l, err := time.LoadLocation(b.Timezone)
+			if err != nil {
+				return fmt.Errorf("%s[%d].timezone: unknown timezone %q", path, i, b.Timezone)
+			}
+			loc = l
+		}
+		from, _, err := ua.ParseBlackoutTime(b.From, loc)
+		if err != nil {
+			return fmt.Errorf("%s[%d].from: invalid value %q, expected YYYY-MM-DD or RFC3339", path, i, b.From)
+		}
+		to, _, err := ua.ParseBlackoutTime(b.To, loc)
+		if err != nil {
+			return fmt.Errorf("%s[%d].to: invalid value %q, expected YYYY-MM-DD or RFC3339", path, i, b.To)
+		}
+		if to.Before(from) {
+			return fmt.Errorf("%s[%d]: to %q is before from %q", path, i, b.To, b.From)
+		}
+	}
+
+	if d.MaxConcurrentZones != nil && *d.MaxConcurrentZones < 1 {
+		return fmt.Errorf(".spec.disruptions.maxConcurrentZones: must be at least 1, got %d", *d.MaxConcurrentZones)
+	}
+
 	if d.Automatic != nil && d.Automatic.Windows != nil {
 		if err := validateWindows(d.Automatic.Windows, ".spec.disruptions.automatic.windows"); err != nil {
 			return err
@@ -431,6 +491,30 @@ func validateDisruptionWindows(d *v1.DisruptionsSpec) error {
 	return nil
 }
 
+// validateUpdateHealthChecks validates the namespace selector and the query endpoint.
+func validateUpdateHealthChecks(hc *v1.UpdateHealthChecksSpec) error {
+	if hc.PodDisruptionBudgets != nil && hc.PodDisruptionBudgets.NamespaceSelector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(hc.PodDisruptionBudgets.NamespaceSelector); err != nil {
+			return fmt.Errorf(".spec.update.healthChecks.podDisruptionBudgets.namespaceSelector: %v", err)
+		}
+	}
+
+	for i, w := range hc.Workloads {
+		if w.Kind != "Deployment" && w.Kind != "StatefulSet" {
+			return fmt.Errorf(".spec.update.healthChecks.workloads[%d].kind: unsupported kind %q, expected Deployment or StatefulSet", i, w.Kind)
+		}
+	}
+
+	if hc.HTTP != nil {
+		u, err := url.Parse(hc.HTTP.URL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf(".spec.update.healthChecks.http.url: invalid URL %q, expected http(s)://host/path", hc.HTTP.URL)
+		}
+	}
+
+	return nil
+}
+
 func getCRIType(ng *v1.NodeGroup, defaultCRI string) string {
 	if ng.Spec.CRI != nil && ng.Spec.CRI.Type != "" {
 		return string(ng.Spec.CRI.Type)
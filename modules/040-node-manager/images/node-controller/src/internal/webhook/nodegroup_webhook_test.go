@@ -687,6 +687,52 @@ func TestValidation_ValidDisruptionWindows(t *testing.T) {
 	}
 }
 
+func TestValidation_DisruptionBlackoutInvalidRange(t *testing.T) {
+	s := newScheme()
+	c := fake.NewClientBuilder().WithScheme(s).Build()
+	w := &NodeGroupValidator{Client: c, decoder: admission.NewDecoder(s)}
+
+	ng := baseNodeGroup("worker", v1.NodeTypeStatic)
+	ng.Spec.Disruptions = &v1.DisruptionsSpec{
+		ApprovalMode: v1.DisruptionApprovalModeAutomatic,
+		Blackouts: []v1.DisruptionBlackout{
+			{From: "2026-12-31", To: "2026-12-24"},
+		},
+	}
+
+	resp := w.Handle(context.Background(), makeAdmissionRequest(t, "CREATE", ng, nil))
+	if resp.Allowed {
+		t.Fatal("expected denied: blackout ends before it starts")
+	}
+}
+
+func TestValidation_ValidDisruptionCalendar(t *testing.T) {
+	s := newScheme()
+	c := fake.NewClientBuilder().WithScheme(s).Build()
+	w := &NodeGroupValidator{Client: c, decoder: admission.NewDecoder(s)}
+
+	maxZones := int32(1)
+	ng := baseNodeGroup("worker", v1.NodeTypeStatic)
+	ng.Spec.Disruptions = &v1.DisruptionsSpec{
+		ApprovalMode: v1.DisruptionApprovalModeAutomatic,
+		Automatic: &v1.AutomaticDisruptionSpec{
+			Windows: []v1.DisruptionWindow{
+				{From: "01:00", To: "06:00", Timezone: "Europe/Berlin", StartDate: "2026-01-01", EndDate: "2026-06-30"},
+			},
+		},
+		Blackouts: []v1.DisruptionBlackout{
+			{From: "2026-12-24", To: "2027-01-08", Timezone: "Europe/Berlin", Reason: "holidays"},
+			{From: "2026-03-01T18:00:00Z", To: "2026-03-02T06:00:00Z"},
+		},
+		MaxConcurrentZones: &maxZones,
+	}
+
+	resp := w.Handle(context.Background(), makeAdmissionRequest(t, "CREATE", ng, nil))
+	if !resp.Allowed {
+		t.Fatalf("expected allowed for valid disruption calendar, got: %s", resp.Result.Message)
+	}
+}
+
 func TestValidation_MaxPodsWarning(t *testing.T) {
 	s := newScheme()
 